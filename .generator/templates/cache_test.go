@@ -1,13 +1,17 @@
 package okta
 
 import (
+	"context"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_Create_Cache_Key(t *testing.T) {
@@ -71,3 +75,64 @@ func Test_Cache_Cleared_Successful(t *testing.T) {
 	found = myCache.Has(cacheKey)
 	assert.False(t, found, "cache was not cleared")
 }
+
+func Test_Cache_Key_Includes_Representation(t *testing.T) {
+	full, _ := http.NewRequest("GET", "https://example.okta.com/api/v1/users", nil)
+	minimal, _ := http.NewRequest("GET", "https://example.okta.com/api/v1/users", nil)
+	minimal.Header.Set("Content-Type", "application/json; okta-response=omitCredentials")
+	assert.NotEqual(t, CreateCacheKey(full), CreateCacheKey(minimal))
+}
+
+// mapCache is a Cache returning the responses it was given, as naive custom
+// caches do.
+type mapCache struct {
+	NoOpCache
+	mu        sync.Mutex
+	responses map[string]*http.Response
+}
+
+func (c *mapCache) Get(key string) *http.Response {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.responses[key]
+}
+
+func (c *mapCache) Set(key string, value *http.Response) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[key] = value
+}
+
+func Test_Cached_Responses_Are_Copies(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"00u1","profile":{"login":"bob@example.com"}}`), nil
+	})
+
+	cache := &mapCache{responses: map[string]*http.Response{}}
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(true), WithCacheManager(cache))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	first, resp, err := client.UserAPI.GetUser(context.Background(), "00u1").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "bob@example.com", first.Profile.GetLogin())
+	resp.Header.Set("X-Mutated", "true")
+	concurrently(func(i int) {
+		user, _, err := client.UserAPI.GetUser(context.Background(), "00u1").Execute()
+		if assert.NoError(t, err) {
+			assert.Equal(t, "bob@example.com", user.Profile.GetLogin(), "Every cache hit should read the whole body")
+		}
+	})
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	cached := cache.Get("https://example.okta.com/api/v1/users/00u1")
+	assert.Empty(t, cached.Header.Get("X-Mutated"), "Callers should not share the headers of the cached response")
+	body, err := ioutil.ReadAll(CopyResponse(cached).Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "bob@example.com")
+	body, err = ioutil.ReadAll(cached.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "bob@example.com", "CopyResponse should leave the body of the response readable")
+}
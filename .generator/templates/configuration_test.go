@@ -1,7 +1,13 @@
 package okta
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,3 +28,105 @@ func TestUserAgentWithExtra(t *testing.T) {
 	userAgent := "okta-sdk-golang/" + VERSION + " golang/" + runtime.Version() + " " + runtime.GOOS + "/" + runtime.GOARCH + " extra/info"
 	require.Equal(t, userAgent, configuration.UserAgent)
 }
+
+func TestClientUserAgent(t *testing.T) {
+	configuration, err := NewConfiguration(WithUserAgentExtra("extra/info"))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	require.Equal(t, configuration.UserAgent, client.UserAgent())
+	require.Equal(t, client.UserAgent(), client.UserAgent())
+
+	configuration.UserAgentExtra = "other/info"
+	userAgent := "okta-sdk-golang/" + VERSION + " golang/" + runtime.Version() + " " + runtime.GOOS + "/" + runtime.GOARCH + " other/info"
+	require.Equal(t, userAgent, client.UserAgent(), "The user agent should follow changes of the configuration")
+}
+
+func TestAPIVersion(t *testing.T) {
+	configuration, err := NewConfiguration(WithAPIVersion("2023.11.0"))
+	require.NoError(t, err, "Pinning an older API version should not error")
+	require.Equal(t, "2023.11.0", NewAPIClient(configuration).APIVersion())
+
+	_, err = NewConfiguration(WithAPIVersion("2099.01.0"))
+	require.Error(t, err, "Pinning a newer API version should error")
+
+	_, err = NewConfiguration(WithAPIVersion("latest"))
+	require.Error(t, err, "Pinning a malformed API version should error")
+}
+
+func TestOrgUrlWithPort(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	configuration, err := NewConfiguration(WithOrgUrl(server.URL), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	require.Equal(t, strings.TrimPrefix(server.URL, "http://"), configuration.Host, "The port of the org URL should be kept")
+	_, _, err = NewAPIClient(configuration).UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err, "Requests should be sent to the port of the org URL")
+}
+
+func TestConfigurationFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "okta.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`okta:
+  client:
+    orgUrl: https://example.okta.com
+    authorizationMode: PrivateKey
+    clientId: 0oa1
+    scopes:
+      - okta.users.read
+      - okta.groups.read
+    privateKeyId: kid1
+    proxy:
+      host: proxy.example.com
+      port: 3128
+    rateLimit:
+      maxRetries: 4
+      maxBackoff: 60
+`), 0o600))
+	t.Setenv("OKTA_CLIENT_CLIENTID", "0oaEnv")
+
+	configuration, err := NewConfigurationFromFile(path, WithPrivateKeyId("kid2"))
+	require.NoError(t, err)
+	require.Equal(t, "https://example.okta.com", configuration.Okta.Client.OrgUrl)
+	require.Equal(t, "example.okta.com", configuration.Host)
+	require.Equal(t, "PrivateKey", configuration.Okta.Client.AuthorizationMode)
+	require.Equal(t, "0oa1", configuration.Okta.Client.ClientId, "Environment variables should be ignored")
+	require.Equal(t, []string{"okta.users.read", "okta.groups.read"}, configuration.Okta.Client.Scopes)
+	require.Equal(t, "kid2", configuration.Okta.Client.PrivateKeyId, "Setters should override the file")
+	require.Equal(t, "proxy.example.com", configuration.Okta.Client.Proxy.Host)
+	require.Equal(t, int32(3128), configuration.Okta.Client.Proxy.Port)
+	require.Equal(t, int32(4), configuration.Okta.Client.RateLimit.MaxRetries)
+	require.Equal(t, int64(60), configuration.Okta.Client.RateLimit.MaxBackoff)
+
+	_, err = NewConfigurationFromFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.Error(t, err)
+	require.NoError(t, os.WriteFile(path, []byte("okta: [not a map"), 0o600))
+	_, err = NewConfigurationFromFile(path)
+	require.Error(t, err)
+}
+
+func TestConfigurationFromEnv(t *testing.T) {
+	t.Setenv("OKTA_CLIENT_ORGURL", "https://example.okta.com")
+	t.Setenv("OKTA_CLIENT_AUTHORIZATIONMODE", "JWK")
+	t.Setenv("OKTA_CLIENT_CLIENTID", "0oa1")
+	t.Setenv("OKTA_CLIENT_SCOPES", "okta.users.read,okta.groups.read")
+	t.Setenv("OKTA_CLIENT_JWK", `{"kty":"RSA"}`)
+	t.Setenv("OKTA_CLIENT_PROXY_HOST", "proxy.example.com")
+	t.Setenv("OKTA_CLIENT_RATE_LIMIT_MAX_RETRIES", "5")
+
+	configuration, err := NewConfigurationFromEnv(WithClientId("0oa2"))
+	require.NoError(t, err)
+	require.Equal(t, "https://example.okta.com", configuration.Okta.Client.OrgUrl)
+	require.Equal(t, "JWK", configuration.Okta.Client.AuthorizationMode)
+	require.Equal(t, "0oa2", configuration.Okta.Client.ClientId, "Setters should override the environment")
+	require.Equal(t, []string{"okta.users.read", "okta.groups.read"}, configuration.Okta.Client.Scopes)
+	require.Equal(t, `{"kty":"RSA"}`, configuration.Okta.Client.JWK)
+	require.Equal(t, "proxy.example.com", configuration.Okta.Client.Proxy.Host)
+	require.Equal(t, int32(5), configuration.Okta.Client.RateLimit.MaxRetries)
+
+	t.Setenv("OKTA_CLIENT_RATE_LIMIT_MAX_RETRIES", "many")
+	_, err = NewConfigurationFromEnv()
+	require.Error(t, err, "Invalid variables should not be ignored")
+}
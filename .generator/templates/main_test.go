@@ -26,7 +26,8 @@ func TestMain(m *testing.M) {
 		fmt.Printf("failed to clean up organization before integration tests: %v", err)
 	}
 	exitVal := m.Run()
-	apiClient = apiClient.RefreshNext()
+	// the sweep must see what the tests created, whatever the cache holds
+	apiClient.cfg.Context = WithNoCache(apiClient.cfg.Context)
 	err = sweep()
 	if err != nil {
 		fmt.Printf("failed to clean up organization after integration tests: %v", err)
@@ -0,0 +1,205 @@
+package okta
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// AccessToken is the credential the client sends in the Authorization header,
+// as returned by APIClient.AccessToken.
+type AccessToken struct {
+	// TokenType is "SSWS", "Bearer" or "DPoP".
+	TokenType string
+	// Token is the raw token without the token type prefix.
+	Token string
+	// ExpiresAt is when the client stops using the token, zero when unknown.
+	ExpiresAt time.Time
+
+	dpopKey   crypto.Signer
+	dpopNonce string
+	newID     IDGenerator
+}
+
+// Header returns the value of the Authorization header for the token.
+func (t *AccessToken) Header() string {
+	return t.TokenType + " " + t.Token
+}
+
+// DPoPProof returns a DPoP proof for a request made with a DPoP bound token.
+// Every request needs its own proof.
+func (t *AccessToken) DPoPProof(method, URL string) (string, error) {
+	if t.dpopKey == nil {
+		return "", errors.New("access token is not DPoP bound")
+	}
+	return generateDpopJWT(t.dpopKey, method, URL, t.dpopNonce, t.Token, t.newID)
+}
+
+// AccessToken runs the configured authorization mode and returns the token it
+// uses, requesting a new OAuth 2.0 access token when none is cached. This is
+// useful to pass the token to other tools or to run the client as a token
+// provider sidecar.
+func (c *APIClient) AccessToken(ctx context.Context) (*AccessToken, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	orgURL := c.cfg.Okta.Client.OrgUrl
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, orgURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := c.authorization(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := auth.Authorize(http.MethodGet, orgURL); err != nil {
+		return nil, err
+	}
+	tokenType, token, ok := strings.Cut(req.Header.Get("Authorization"), " ")
+	if !ok || token == "" {
+		return nil, errors.New("authorization mode did not produce an access token")
+	}
+
+	accessToken := &AccessToken{TokenType: tokenType, Token: token, newID: c.cfg.IDGenerator}
+	switch c.cfg.Okta.Client.AuthorizationMode {
+	case "SSWS":
+		accessToken.ExpiresAt = c.ssws.status(c.cfg).ExpiresAt
+	case "Bearer":
+	default:
+		if _, expiresAt, found := c.tokenCache.GetWithExpiration(AccessTokenCacheKey); found {
+			accessToken.ExpiresAt = expiresAt
+		}
+		if tokenType == "DPoP" {
+			if key, found := c.tokenCache.Get(DpopAccessTokenPrivateKey); found {
+				accessToken.dpopKey, _ = key.(crypto.Signer)
+			}
+			if nonce, found := c.tokenCache.Get(DpopAccessTokenNonce); found {
+				accessToken.dpopNonce, _ = nonce.(string)
+			}
+		}
+	}
+	return accessToken, nil
+}
+
+// TokenSource returns an oauth2.TokenSource of the access tokens of the
+// PrivateKey, JWK and JWT authorization modes, so that HTTP or gRPC clients
+// outside the SDK authenticate as the same service app. Tokens come from the
+// token cache and token store of the client and are renewed as the client
+// renews them. ctx is used for the token requests.
+//
+// DPoP bound tokens need a proof per request, which oauth2 transports do not
+// send, so the source fails for them: use Transport or PerRPCCredentials
+// instead.
+func (c *APIClient) TokenSource(ctx context.Context) oauth2.TokenSource {
+	return &clientTokenSource{ctx: ctx, c: c}
+}
+
+type clientTokenSource struct {
+	ctx context.Context
+	c   *APIClient
+}
+
+func (s *clientTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.c.serviceAppToken(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token.TokenType == "DPoP" {
+		return nil, errors.New("access token is DPoP bound, use Transport or PerRPCCredentials")
+	}
+	return &oauth2.Token{AccessToken: token.Token, TokenType: token.TokenType, Expiry: token.ExpiresAt}, nil
+}
+
+// serviceAppToken returns the access token of the OAuth 2.0 authorization
+// modes, and an error for the modes using API tokens.
+func (c *APIClient) serviceAppToken(ctx context.Context) (*AccessToken, error) {
+	switch mode := c.cfg.Okta.Client.AuthorizationMode; mode {
+	case "PrivateKey", "JWK", "JWT":
+	default:
+		return nil, fmt.Errorf("token source requires the PrivateKey, JWK or JWT authorization mode, not %s", mode)
+	}
+	return c.AccessToken(ctx)
+}
+
+// Transport returns an http.RoundTripper authorizing the requests it sends
+// with base, or http.DefaultTransport when nil, with the access token of the
+// PrivateKey, JWK and JWT authorization modes. Unlike oauth2 transports, it
+// signs a DPoP proof for every request when the token is DPoP bound.
+func (c *APIClient) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &tokenTransport{c: c, base: base}
+}
+
+type tokenTransport struct {
+	c    *APIClient
+	base http.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.c.serviceAppToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	// a RoundTripper must not modify the request of the caller
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", token.Header())
+	if token.TokenType == "DPoP" {
+		proof, err := token.DPoPProof(req.Method, req.URL.String())
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("DPoP", proof)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// PerRPCCredentials authorizes gRPC calls with the access token of a client.
+// It implements credentials.PerRPCCredentials of google.golang.org/grpc
+// without depending on it:
+//
+//	conn, err := grpc.NewClient(target,
+//		grpc.WithTransportCredentials(credentials.NewTLS(nil)),
+//		grpc.WithPerRPCCredentials(client.PerRPCCredentials()))
+type PerRPCCredentials struct {
+	c *APIClient
+}
+
+// PerRPCCredentials returns the gRPC credentials of the PrivateKey, JWK and
+// JWT authorization modes. DPoP bound tokens are sent with a proof for a POST
+// to the URI of the service, as gRPC calls are.
+func (c *APIClient) PerRPCCredentials() *PerRPCCredentials {
+	return &PerRPCCredentials{c: c}
+}
+
+// GetRequestMetadata returns the authorization metadata of a call to uri.
+func (p *PerRPCCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := p.c.serviceAppToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	metadata := map[string]string{"authorization": token.Header()}
+	if token.TokenType == "DPoP" {
+		if len(uri) == 0 {
+			return nil, errors.New("DPoP proof requires the URI of the call")
+		}
+		proof, err := token.DPoPProof(http.MethodPost, uri[0])
+		if err != nil {
+			return nil, err
+		}
+		metadata["dpop"] = proof
+	}
+	return metadata, nil
+}
+
+// RequireTransportSecurity reports that tokens are only sent over TLS.
+func (p *PerRPCCredentials) RequireTransportSecurity() bool {
+	return true
+}
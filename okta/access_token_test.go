@@ -0,0 +1,218 @@
+package okta
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func Test_Access_Token_For_Private_Key(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := NewCryptoSigner(key, "")
+	require.NoError(t, err)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"token_type":"Bearer","expires_in":3600,"access_token":"abc","scope":"okta.users.read"}`), nil
+	})
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithAuthorizationMode("PrivateKey"),
+		WithClientId("client"),
+		WithScopes([]string{"okta.users.read"}),
+		WithPrivateKeySigner(signer),
+		WithCache(false),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	token, err := client.AccessToken(context.Background())
+	require.NoError(t, err, "Getting an access token should not error")
+	assert.Equal(t, "Bearer", token.TokenType)
+	assert.Equal(t, "abc", token.Token)
+	assert.Equal(t, "Bearer abc", token.Header())
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, 5*time.Second)
+	_, err = token.DPoPProof(http.MethodGet, "https://example.okta.com/api/v1/users")
+	assert.Error(t, err, "Bearer tokens should not produce DPoP proofs")
+
+	_, err = client.AccessToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount(), "Cached access token should be reused")
+}
+
+func Test_Access_Token_For_SSWS(t *testing.T) {
+	expiresAt := time.Now().Add(24 * time.Hour)
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("token"),
+		WithTokenExpiry(expiresAt, time.Hour),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+
+	token, err := NewAPIClient(configuration).AccessToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "SSWS token", token.Header())
+	assert.True(t, expiresAt.Equal(token.ExpiresAt))
+}
+
+func Test_Token_Source(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := NewCryptoSigner(key, "")
+	require.NoError(t, err)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"token_type":"Bearer","expires_in":3600,"access_token":"abc","scope":"okta.users.read"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "https://gateway.example.com/v1/invoices", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "Bearer abc", req.Header.Get("Authorization"))
+		return mockJSONResponse(200, `[]`), nil
+	})
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithAuthorizationMode("PrivateKey"),
+		WithClientId("client"),
+		WithScopes([]string{"okta.users.read"}),
+		WithPrivateKeySigner(signer),
+		WithCache(false),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	ts := client.TokenSource(context.Background())
+	token, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "abc", token.AccessToken)
+	assert.Equal(t, "Bearer", token.Type())
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.Expiry, 5*time.Second)
+	assert.True(t, token.Valid())
+
+	resp, err := oauth2.NewClient(context.Background(), ts).Get("https://gateway.example.com/v1/invoices")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST /oauth2/v1/token"], "The token of the client should be reused")
+
+	configuration, err = NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"))
+	require.NoError(t, err)
+	_, err = NewAPIClient(configuration).TokenSource(context.Background()).Token()
+	assert.Error(t, err, "API tokens are not OAuth 2.0 access tokens")
+}
+
+func Test_DPoP_Requirement_Is_Cached(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := NewCryptoSigner(key, "")
+	require.NoError(t, err)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var withoutProof int
+	httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+		proof := req.Header.Get("DPoP")
+		if proof == "" {
+			withoutProof++
+			return mockJSONResponse(400, `{"error":"invalid_dpop_proof"}`), nil
+		}
+		return mockJSONResponse(200, `{"token_type":"DPoP","expires_in":3600,"access_token":"abc","scope":"okta.users.read"}`), nil
+	})
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithAuthorizationMode("PrivateKey"),
+		WithClientId("client"),
+		WithScopes([]string{"okta.users.read"}),
+		WithPrivateKeySigner(signer),
+		WithCache(false),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	for i := 0; i < 3; i++ {
+		token, err := client.AccessToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "DPoP", token.TokenType)
+		_, err = token.DPoPProof(http.MethodGet, "https://example.okta.com/api/v1/users")
+		assert.NoError(t, err)
+		// Force a token refresh.
+		client.tokenCache.Delete(AccessTokenCacheKey)
+	}
+	assert.Equal(t, 1, withoutProof, "Only the first token request should be sent without a DPoP proof")
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+}
+
+func Test_Token_Transport_And_RPC_Credentials_With_DPoP(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := NewCryptoSigner(key, "")
+	require.NoError(t, err)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("DPoP") == "" {
+			return mockJSONResponse(400, `{"error":"invalid_dpop_proof"}`), nil
+		}
+		return mockJSONResponse(200, `{"token_type":"DPoP","expires_in":3600,"access_token":"abc","scope":"okta.users.read"}`), nil
+	})
+	dpopClaims := func(proof string) DpopClaims {
+		parsed, err := jwt.ParseSigned(proof)
+		require.NoError(t, err)
+		var claims DpopClaims
+		require.NoError(t, parsed.UnsafeClaimsWithoutVerification(&claims))
+		return claims
+	}
+	httpmock.RegisterResponder("GET", "https://gateway.example.com/v1/invoices", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DPoP abc", req.Header.Get("Authorization"))
+		claims := dpopClaims(req.Header.Get("DPoP"))
+		assert.Equal(t, http.MethodGet, claims.HTTPMethod)
+		assert.Equal(t, "https://gateway.example.com/v1/invoices", claims.HTTPURI)
+		assert.NotEmpty(t, claims.AccessToken)
+		return mockJSONResponse(200, `[]`), nil
+	})
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithAuthorizationMode("PrivateKey"),
+		WithClientId("client"),
+		WithScopes([]string{"okta.users.read"}),
+		WithPrivateKeySigner(signer),
+		WithCache(false),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, err = client.TokenSource(context.Background()).Token()
+	assert.Error(t, err, "DPoP bound tokens cannot be used by oauth2 transports")
+
+	req, err := http.NewRequest(http.MethodGet, "https://gateway.example.com/v1/invoices", nil)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: client.Transport(nil)}).Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Empty(t, req.Header.Get("Authorization"), "The request of the caller should not be modified")
+
+	credentials := client.PerRPCCredentials()
+	assert.True(t, credentials.RequireTransportSecurity())
+	metadata, err := credentials.GetRequestMetadata(context.Background(), "https://billing.example.com/billing.v1.Invoices")
+	require.NoError(t, err)
+	assert.Equal(t, "DPoP abc", metadata["authorization"])
+	claims := dpopClaims(metadata["dpop"])
+	assert.Equal(t, http.MethodPost, claims.HTTPMethod)
+	assert.Equal(t, "https://billing.example.com/billing.v1.Invoices", claims.HTTPURI)
+}
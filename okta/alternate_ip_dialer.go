@@ -0,0 +1,243 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+// AlternateIPDialing configures an AlternateIPDialer.
+type AlternateIPDialing struct {
+	// FallbackDelay is how long a connection attempt to an address is given
+	// before another address is tried in parallel, 300 milliseconds by
+	// default. An attempt failing starts the next one right away.
+	FallbackDelay time.Duration
+	// AttemptTimeout bounds a single connection attempt, 5 seconds by
+	// default.
+	AttemptTimeout time.Duration
+	// FailureTTL is how long an address that failed is tried after the
+	// others, 1 minute by default.
+	FailureTTL time.Duration
+	// MaxAttempts limits the addresses tried per connection, all of them when
+	// 0.
+	MaxAttempts int
+	// Resolver looks up the addresses of hosts, net.DefaultResolver when nil.
+	Resolver IPResolver
+}
+
+// IPResolver looks up the IP addresses of a host. *net.Resolver implements
+// it.
+type IPResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// AlternateIPDialer opens connections to hosts with several A and AAAA
+// records, such as the cells of an Okta org, by racing their addresses: when
+// the connection to an address fails or takes longer than FallbackDelay, the
+// next address is tried, alternating between IPv6 and IPv4 as in RFC 8305.
+// Addresses that failed recently are tried last, so that an unreachable edge
+// node doesn't slow down every connection. Use its DialContext with an
+// http.Transport, or use WithAlternateIPDialing.
+type AlternateIPDialer struct {
+	opts AlternateIPDialing
+	dial func(ctx context.Context, network, address string) (net.Conn, error)
+	now  func() time.Time
+
+	mu       sync.Mutex
+	failures map[string]time.Time
+}
+
+// NewAlternateIPDialer returns an AlternateIPDialer configured by opts.
+func NewAlternateIPDialer(opts AlternateIPDialing) *AlternateIPDialer {
+	if opts.FallbackDelay <= 0 {
+		opts.FallbackDelay = 300 * time.Millisecond
+	}
+	if opts.AttemptTimeout <= 0 {
+		opts.AttemptTimeout = 5 * time.Second
+	}
+	if opts.FailureTTL <= 0 {
+		opts.FailureTTL = time.Minute
+	}
+	if opts.Resolver == nil {
+		opts.Resolver = net.DefaultResolver
+	}
+	dialer := &net.Dialer{}
+	return &AlternateIPDialer{
+		opts:     opts,
+		dial:     dialer.DialContext,
+		now:      time.Now,
+		failures: make(map[string]time.Time),
+	}
+}
+
+// dialResult is the outcome of a connection attempt to ip.
+type dialResult struct {
+	conn net.Conn
+	ip   string
+	err  error
+}
+
+// DialContext connects to address on the named network, "tcp", "tcp4" or
+// "tcp6". An address with an IP rather than a host name is dialed as is.
+// When every address fails, the errors of all attempts are returned.
+func (d *AlternateIPDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if net.ParseIP(host) != nil {
+		return d.dial(ctx, network, address)
+	}
+	addrs, err := d.opts.Resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	ips := d.order(network, addrs)
+	if len(ips) == 0 {
+		return nil, &net.AddrError{Err: "no suitable address found", Addr: host}
+	}
+	if d.opts.MaxAttempts > 0 && len(ips) > d.opts.MaxAttempts {
+		ips = ips[:d.opts.MaxAttempts]
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan dialResult, len(ips))
+	attempt := func(ip string) {
+		attemptCtx, cancel := context.WithTimeout(ctx, d.opts.AttemptTimeout)
+		defer cancel()
+		conn, err := d.dial(attemptCtx, network, net.JoinHostPort(ip, port))
+		results <- dialResult{conn: conn, ip: ip, err: err}
+	}
+	fallback := time.NewTimer(d.opts.FallbackDelay)
+	defer fallback.Stop()
+	next, pending := 0, 0
+	startNext := func() {
+		if next == len(ips) || ctx.Err() != nil {
+			return
+		}
+		go attempt(ips[next])
+		next++
+		pending++
+		fallback.Reset(d.opts.FallbackDelay)
+	}
+
+	startNext()
+	var errs []error
+	for pending > 0 {
+		select {
+		case <-fallback.C:
+			startNext()
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				d.forget(res.ip)
+				cancel()
+				// Close the connections of the attempts that lost the race.
+				go func(pending int) {
+					for ; pending > 0; pending-- {
+						if late := <-results; late.conn != nil {
+							late.conn.Close()
+						}
+					}
+				}(pending)
+				return res.conn, nil
+			}
+			if ctx.Err() == nil {
+				d.remember(res.ip)
+			}
+			errs = append(errs, res.err)
+			startNext()
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("failed to connect to any of the %d addresses of %s: %w", len(errs), host, errors.Join(errs...))
+}
+
+// order returns the addresses of network to try, alternating between the
+// address families starting with the first one resolved, with those that
+// failed within FailureTTL last, the oldest failure first.
+func (d *AlternateIPDialer) order(network string, addrs []net.IPAddr) []string {
+	var primary, secondary []string
+	primaryIsV4 := false
+	for i, addr := range addrs {
+		isV4 := addr.IP.To4() != nil
+		if (network == "tcp4" && !isV4) || (network == "tcp6" && isV4) {
+			continue
+		}
+		if len(primary) == 0 && len(secondary) == 0 {
+			primaryIsV4 = isV4
+		}
+		if isV4 == primaryIsV4 {
+			primary = append(primary, addrs[i].String())
+		} else {
+			secondary = append(secondary, addrs[i].String())
+		}
+	}
+	ips := make([]string, 0, len(primary)+len(secondary))
+	for i := 0; i < len(primary) || i < len(secondary); i++ {
+		if i < len(primary) {
+			ips = append(ips, primary[i])
+		}
+		if i < len(secondary) {
+			ips = append(ips, secondary[i])
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	failedAt := make(map[string]time.Time)
+	for ip, at := range d.failures {
+		if now.Sub(at) >= d.opts.FailureTTL {
+			delete(d.failures, ip)
+			continue
+		}
+		failedAt[ip] = at
+	}
+	sort.SliceStable(ips, func(i, j int) bool {
+		ti, failedI := failedAt[ips[i]]
+		tj, failedJ := failedAt[ips[j]]
+		if failedI != failedJ {
+			return failedJ
+		}
+		return ti.Before(tj)
+	})
+	return ips
+}
+
+// remember records that the connection to ip failed.
+func (d *AlternateIPDialer) remember(ip string) {
+	d.mu.Lock()
+	d.failures[ip] = d.now()
+	d.mu.Unlock()
+}
+
+// forget clears the failure of ip after a connection to it succeeded.
+func (d *AlternateIPDialer) forget(ip string) {
+	d.mu.Lock()
+	delete(d.failures, ip)
+	d.mu.Unlock()
+}
+
+// FailedAddresses returns the addresses whose last connection attempt failed
+// within FailureTTL, to report which edge nodes are unreachable.
+func (d *AlternateIPDialer) FailedAddresses() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	var ips []string
+	for ip, at := range d.failures {
+		if now.Sub(at) < d.opts.FailureTTL {
+			ips = append(ips, ip)
+		}
+	}
+	sort.Strings(ips)
+	return ips
+}
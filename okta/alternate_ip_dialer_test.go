@@ -0,0 +1,118 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticResolver []string
+
+func (r staticResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	var addrs []net.IPAddr
+	for _, ip := range r {
+		addrs = append(addrs, net.IPAddr{IP: net.ParseIP(ip)})
+	}
+	return addrs, nil
+}
+
+func Test_Alternate_IP_Dialer(t *testing.T) {
+	t.Run("addresses alternate families and failed ones go last", func(t *testing.T) {
+		d := NewAlternateIPDialer(AlternateIPDialing{})
+		addrs, _ := staticResolver{"2001:db8::1", "2001:db8::2", "10.0.0.1", "10.0.0.2"}.LookupIPAddr(context.Background(), "")
+		assert.Equal(t, []string{"2001:db8::1", "10.0.0.1", "2001:db8::2", "10.0.0.2"}, d.order("tcp", addrs))
+		assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, d.order("tcp4", addrs))
+
+		now := time.Now()
+		d.now = func() time.Time { return now }
+		d.remember("10.0.0.1")
+		now = now.Add(time.Second)
+		d.remember("2001:db8::1")
+		assert.Equal(t, []string{"2001:db8::2", "10.0.0.2", "10.0.0.1", "2001:db8::1"}, d.order("tcp", addrs))
+		assert.Equal(t, []string{"10.0.0.1", "2001:db8::1"}, d.FailedAddresses())
+
+		now = now.Add(time.Minute)
+		assert.Equal(t, []string{"2001:db8::1", "10.0.0.1", "2001:db8::2", "10.0.0.2"}, d.order("tcp", addrs))
+		assert.Empty(t, d.FailedAddresses())
+	})
+
+	t.Run("falls back to the next address", func(t *testing.T) {
+		d := NewAlternateIPDialer(AlternateIPDialing{
+			Resolver:      staticResolver{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+			FallbackDelay: 10 * time.Millisecond,
+		})
+		var mu sync.Mutex
+		var dialed []string
+		d.dial = func(ctx context.Context, network, address string) (net.Conn, error) {
+			mu.Lock()
+			dialed = append(dialed, address)
+			mu.Unlock()
+			switch address {
+			case "10.0.0.1:443":
+				return nil, errors.New("connection refused")
+			case "10.0.0.2:443":
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			client, server := net.Pipe()
+			server.Close()
+			return client, nil
+		}
+		conn, err := d.DialContext(context.Background(), "tcp", "example.okta.com:443")
+		require.NoError(t, err)
+		conn.Close()
+		mu.Lock()
+		assert.Equal(t, []string{"10.0.0.1:443", "10.0.0.2:443", "10.0.0.3:443"}, dialed)
+		mu.Unlock()
+		assert.Equal(t, []string{"10.0.0.1"}, d.FailedAddresses())
+
+		// the failed address is tried last on the next connection
+		mu.Lock()
+		dialed = nil
+		mu.Unlock()
+		d.opts.Resolver = staticResolver{"10.0.0.1", "10.0.0.3"}
+		conn, err = d.DialContext(context.Background(), "tcp", "example.okta.com:443")
+		require.NoError(t, err)
+		conn.Close()
+		mu.Lock()
+		assert.Equal(t, []string{"10.0.0.3:443"}, dialed)
+		mu.Unlock()
+	})
+
+	t.Run("reports the errors of every address", func(t *testing.T) {
+		d := NewAlternateIPDialer(AlternateIPDialing{Resolver: staticResolver{"10.0.0.1", "10.0.0.2"}, MaxAttempts: 1})
+		d.dial = func(ctx context.Context, network, address string) (net.Conn, error) {
+			return nil, errors.New("refused " + address)
+		}
+		_, err := d.DialContext(context.Background(), "tcp", "example.okta.com:443")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "refused 10.0.0.1:443")
+		assert.NotContains(t, err.Error(), "10.0.0.2")
+	})
+
+	t.Run("connects through the client", func(t *testing.T) {
+		httpClient := &http.Client{}
+		cfg, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"),
+			WithAlternateIPDialing(AlternateIPDialing{}), WithHttpClientPtr(httpClient))
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			client := NewAPIClient(cfg)
+			assert.Same(t, httpClient, cfg.HTTPClient, "The configuration should be left as is")
+			assert.Nil(t, httpClient.Transport)
+			base := client.httpClient.Transport
+			if injector, ok := base.(*FaultInjector); ok {
+				base = injector.Base
+			}
+			transport, ok := base.(*http.Transport)
+			require.True(t, ok, "Clients sharing the configuration should wrap the transport once")
+			assert.NotNil(t, transport.DialContext)
+		}
+	})
+}
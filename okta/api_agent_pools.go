@@ -309,7 +309,7 @@ func (a *AgentPoolsAPIService) ActivateAgentPoolsUpdateExecute(r ApiActivateAgen
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.ActivateAgentPoolsUpdate")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/activate"
@@ -358,7 +358,7 @@ func (a *AgentPoolsAPIService) ActivateAgentPoolsUpdateExecute(r ApiActivateAgen
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -373,6 +373,7 @@ func (a *AgentPoolsAPIService) ActivateAgentPoolsUpdateExecute(r ApiActivateAgen
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -481,7 +482,7 @@ func (a *AgentPoolsAPIService) CreateAgentPoolsUpdateExecute(r ApiCreateAgentPoo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.CreateAgentPoolsUpdate")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates"
@@ -534,7 +535,7 @@ func (a *AgentPoolsAPIService) CreateAgentPoolsUpdateExecute(r ApiCreateAgentPoo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -549,6 +550,7 @@ func (a *AgentPoolsAPIService) CreateAgentPoolsUpdateExecute(r ApiCreateAgentPoo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -666,7 +668,7 @@ func (a *AgentPoolsAPIService) DeactivateAgentPoolsUpdateExecute(r ApiDeactivate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.DeactivateAgentPoolsUpdate")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/deactivate"
@@ -715,7 +717,7 @@ func (a *AgentPoolsAPIService) DeactivateAgentPoolsUpdateExecute(r ApiDeactivate
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -730,6 +732,7 @@ func (a *AgentPoolsAPIService) DeactivateAgentPoolsUpdateExecute(r ApiDeactivate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -833,7 +836,7 @@ func (a *AgentPoolsAPIService) DeleteAgentPoolsUpdateExecute(r ApiDeleteAgentPoo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.DeleteAgentPoolsUpdate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}"
@@ -882,7 +885,7 @@ func (a *AgentPoolsAPIService) DeleteAgentPoolsUpdateExecute(r ApiDeleteAgentPoo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -897,6 +900,7 @@ func (a *AgentPoolsAPIService) DeleteAgentPoolsUpdateExecute(r ApiDeleteAgentPoo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -992,7 +996,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateInstanceExecute(r ApiGetAgentP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.GetAgentPoolsUpdateInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}"
@@ -1041,7 +1045,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateInstanceExecute(r ApiGetAgentP
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1056,6 +1060,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateInstanceExecute(r ApiGetAgentP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1158,7 +1163,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateSettingsExecute(r ApiGetAgentP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.GetAgentPoolsUpdateSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/settings"
@@ -1206,7 +1211,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateSettingsExecute(r ApiGetAgentP
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1221,6 +1226,7 @@ func (a *AgentPoolsAPIService) GetAgentPoolsUpdateSettingsExecute(r ApiGetAgentP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1341,7 +1347,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsExecute(r ApiListAgentPoolsRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.ListAgentPools")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools"
@@ -1397,7 +1403,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsExecute(r ApiListAgentPoolsRequest)
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1412,6 +1418,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsExecute(r ApiListAgentPoolsRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1509,7 +1516,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsUpdatesExecute(r ApiListAgentPoolsU
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.ListAgentPoolsUpdates")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates"
@@ -1560,7 +1567,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsUpdatesExecute(r ApiListAgentPoolsU
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1575,6 +1582,7 @@ func (a *AgentPoolsAPIService) ListAgentPoolsUpdatesExecute(r ApiListAgentPoolsU
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1680,7 +1688,7 @@ func (a *AgentPoolsAPIService) PauseAgentPoolsUpdateExecute(r ApiPauseAgentPools
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.PauseAgentPoolsUpdate")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/pause"
@@ -1729,7 +1737,7 @@ func (a *AgentPoolsAPIService) PauseAgentPoolsUpdateExecute(r ApiPauseAgentPools
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1744,6 +1752,7 @@ func (a *AgentPoolsAPIService) PauseAgentPoolsUpdateExecute(r ApiPauseAgentPools
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1849,7 +1858,7 @@ func (a *AgentPoolsAPIService) ResumeAgentPoolsUpdateExecute(r ApiResumeAgentPoo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.ResumeAgentPoolsUpdate")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/resume"
@@ -1898,7 +1907,7 @@ func (a *AgentPoolsAPIService) ResumeAgentPoolsUpdateExecute(r ApiResumeAgentPoo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1913,6 +1922,7 @@ func (a *AgentPoolsAPIService) ResumeAgentPoolsUpdateExecute(r ApiResumeAgentPoo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2018,7 +2028,7 @@ func (a *AgentPoolsAPIService) RetryAgentPoolsUpdateExecute(r ApiRetryAgentPools
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.RetryAgentPoolsUpdate")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/retry"
@@ -2067,7 +2077,7 @@ func (a *AgentPoolsAPIService) RetryAgentPoolsUpdateExecute(r ApiRetryAgentPools
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2082,6 +2092,7 @@ func (a *AgentPoolsAPIService) RetryAgentPoolsUpdateExecute(r ApiRetryAgentPools
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2187,7 +2198,7 @@ func (a *AgentPoolsAPIService) StopAgentPoolsUpdateExecute(r ApiStopAgentPoolsUp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.StopAgentPoolsUpdate")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}/stop"
@@ -2236,7 +2247,7 @@ func (a *AgentPoolsAPIService) StopAgentPoolsUpdateExecute(r ApiStopAgentPoolsUp
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2251,6 +2262,7 @@ func (a *AgentPoolsAPIService) StopAgentPoolsUpdateExecute(r ApiStopAgentPoolsUp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2362,7 +2374,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateExecute(r ApiUpdateAgentPoo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.UpdateAgentPoolsUpdate")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/{updateId}"
@@ -2416,7 +2428,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateExecute(r ApiUpdateAgentPoo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2431,6 +2443,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateExecute(r ApiUpdateAgentPoo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2551,7 +2564,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateSettingsExecute(r ApiUpdate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AgentPoolsAPIService.UpdateAgentPoolsUpdateSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/agentPools/{poolId}/updates/settings"
@@ -2604,7 +2617,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateSettingsExecute(r ApiUpdate
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2619,6 +2632,7 @@ func (a *AgentPoolsAPIService) UpdateAgentPoolsUpdateSettingsExecute(r ApiUpdate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -226,7 +226,7 @@ func (a *ApiServiceIntegrationsAPIService) ActivateApiServiceIntegrationInstance
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.ActivateApiServiceIntegrationInstanceSecret")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}/lifecycle/activate"
@@ -275,7 +275,7 @@ func (a *ApiServiceIntegrationsAPIService) ActivateApiServiceIntegrationInstance
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -290,6 +290,7 @@ func (a *ApiServiceIntegrationsAPIService) ActivateApiServiceIntegrationInstance
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -407,7 +408,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceEx
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.CreateApiServiceIntegrationInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services"
@@ -459,7 +460,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceEx
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -474,6 +475,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceEx
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -588,7 +590,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceSe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.CreateApiServiceIntegrationInstanceSecret")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets"
@@ -636,7 +638,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceSe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -651,6 +653,7 @@ func (a *ApiServiceIntegrationsAPIService) CreateApiServiceIntegrationInstanceSe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -768,7 +771,7 @@ func (a *ApiServiceIntegrationsAPIService) DeactivateApiServiceIntegrationInstan
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.DeactivateApiServiceIntegrationInstanceSecret")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}/lifecycle/deactivate"
@@ -817,7 +820,7 @@ func (a *ApiServiceIntegrationsAPIService) DeactivateApiServiceIntegrationInstan
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -832,6 +835,7 @@ func (a *ApiServiceIntegrationsAPIService) DeactivateApiServiceIntegrationInstan
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -944,7 +948,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceEx
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.DeleteApiServiceIntegrationInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}"
@@ -992,7 +996,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceEx
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1007,6 +1011,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceEx
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -1112,7 +1117,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceSe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.DeleteApiServiceIntegrationInstanceSecret")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}"
@@ -1161,7 +1166,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceSe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1176,6 +1181,7 @@ func (a *ApiServiceIntegrationsAPIService) DeleteApiServiceIntegrationInstanceSe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -1280,7 +1286,7 @@ func (a *ApiServiceIntegrationsAPIService) GetApiServiceIntegrationInstanceExecu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.GetApiServiceIntegrationInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}"
@@ -1328,7 +1334,7 @@ func (a *ApiServiceIntegrationsAPIService) GetApiServiceIntegrationInstanceExecu
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1343,6 +1349,7 @@ func (a *ApiServiceIntegrationsAPIService) GetApiServiceIntegrationInstanceExecu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -1457,7 +1464,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstanceSecr
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.ListApiServiceIntegrationInstanceSecrets")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets"
@@ -1505,7 +1512,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstanceSecr
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1520,6 +1527,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstanceSecr
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -1638,7 +1646,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstancesExe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiServiceIntegrationsAPIService.ListApiServiceIntegrationInstances")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/integrations/api/v1/api-services"
@@ -1688,7 +1696,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstancesExe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1703,6 +1711,7 @@ func (a *ApiServiceIntegrationsAPIService) ListApiServiceIntegrationInstancesExe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -160,7 +160,7 @@ func (a *ApiTokenAPIService) GetApiTokenExecute(r ApiGetApiTokenRequest) (*ApiTo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiTokenAPIService.GetApiToken")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/api-tokens/{apiTokenId}"
@@ -208,7 +208,7 @@ func (a *ApiTokenAPIService) GetApiTokenExecute(r ApiGetApiTokenRequest) (*ApiTo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -223,6 +223,7 @@ func (a *ApiTokenAPIService) GetApiTokenExecute(r ApiGetApiTokenRequest) (*ApiTo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -322,7 +323,7 @@ func (a *ApiTokenAPIService) ListApiTokensExecute(r ApiListApiTokensRequest) ([]
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiTokenAPIService.ListApiTokens")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/api-tokens"
@@ -369,7 +370,7 @@ func (a *ApiTokenAPIService) ListApiTokensExecute(r ApiListApiTokensRequest) ([]
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -384,6 +385,7 @@ func (a *ApiTokenAPIService) ListApiTokensExecute(r ApiListApiTokensRequest) ([]
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -472,7 +474,7 @@ func (a *ApiTokenAPIService) RevokeApiTokenExecute(r ApiRevokeApiTokenRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiTokenAPIService.RevokeApiToken")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/api-tokens/{apiTokenId}"
@@ -520,7 +522,7 @@ func (a *ApiTokenAPIService) RevokeApiTokenExecute(r ApiRevokeApiTokenRequest) (
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -535,6 +537,7 @@ func (a *ApiTokenAPIService) RevokeApiTokenExecute(r ApiRevokeApiTokenRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -622,7 +625,7 @@ func (a *ApiTokenAPIService) RevokeCurrentApiTokenExecute(r ApiRevokeCurrentApiT
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiTokenAPIService.RevokeCurrentApiToken")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/api-tokens/current"
@@ -669,7 +672,7 @@ func (a *ApiTokenAPIService) RevokeCurrentApiTokenExecute(r ApiRevokeCurrentApiT
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -684,6 +687,7 @@ func (a *ApiTokenAPIService) RevokeCurrentApiTokenExecute(r ApiRevokeCurrentApiT
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -770,7 +774,7 @@ func (a *ApiTokenAPIService) UpsertApiTokenExecute(r ApiUpsertApiTokenRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiTokenAPIService.UpsertApiToken")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/api-tokens/{apiTokenId}"
@@ -823,7 +827,7 @@ func (a *ApiTokenAPIService) UpsertApiTokenExecute(r ApiUpsertApiTokenRequest) (
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -838,6 +842,7 @@ func (a *ApiTokenAPIService) UpsertApiTokenExecute(r ApiUpsertApiTokenRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
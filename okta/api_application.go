@@ -187,7 +187,7 @@ func (a *ApplicationAPIService) ActivateApplicationExecute(r ApiActivateApplicat
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.ActivateApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/lifecycle/activate"
@@ -235,7 +235,7 @@ func (a *ApplicationAPIService) ActivateApplicationExecute(r ApiActivateApplicat
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -250,6 +250,7 @@ func (a *ApplicationAPIService) ActivateApplicationExecute(r ApiActivateApplicat
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -358,7 +359,7 @@ func (a *ApplicationAPIService) CreateApplicationExecute(r ApiCreateApplicationR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.CreateApplication")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps"
@@ -416,7 +417,7 @@ func (a *ApplicationAPIService) CreateApplicationExecute(r ApiCreateApplicationR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -431,6 +432,7 @@ func (a *ApplicationAPIService) CreateApplicationExecute(r ApiCreateApplicationR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -531,7 +533,7 @@ func (a *ApplicationAPIService) DeactivateApplicationExecute(r ApiDeactivateAppl
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.DeactivateApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/lifecycle/deactivate"
@@ -579,7 +581,7 @@ func (a *ApplicationAPIService) DeactivateApplicationExecute(r ApiDeactivateAppl
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -594,6 +596,7 @@ func (a *ApplicationAPIService) DeactivateApplicationExecute(r ApiDeactivateAppl
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -684,7 +687,7 @@ func (a *ApplicationAPIService) DeleteApplicationExecute(r ApiDeleteApplicationR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.DeleteApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}"
@@ -732,7 +735,7 @@ func (a *ApplicationAPIService) DeleteApplicationExecute(r ApiDeleteApplicationR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -747,6 +750,7 @@ func (a *ApplicationAPIService) DeleteApplicationExecute(r ApiDeleteApplicationR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -845,7 +849,7 @@ func (a *ApplicationAPIService) GetApplicationExecute(r ApiGetApplicationRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.GetApplication")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}"
@@ -896,7 +900,7 @@ func (a *ApplicationAPIService) GetApplicationExecute(r ApiGetApplicationRequest
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -911,6 +915,7 @@ func (a *ApplicationAPIService) GetApplicationExecute(r ApiGetApplicationRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1050,7 +1055,7 @@ func (a *ApplicationAPIService) ListApplicationsExecute(r ApiListApplicationsReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.ListApplications")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps"
@@ -1115,7 +1120,7 @@ func (a *ApplicationAPIService) ListApplicationsExecute(r ApiListApplicationsReq
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1130,6 +1135,7 @@ func (a *ApplicationAPIService) ListApplicationsExecute(r ApiListApplicationsReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1226,7 +1232,7 @@ func (a *ApplicationAPIService) ReplaceApplicationExecute(r ApiReplaceApplicatio
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationAPIService.ReplaceApplication")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}"
@@ -1279,7 +1285,7 @@ func (a *ApplicationAPIService) ReplaceApplicationExecute(r ApiReplaceApplicatio
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1294,6 +1300,7 @@ func (a *ApplicationAPIService) ReplaceApplicationExecute(r ApiReplaceApplicatio
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
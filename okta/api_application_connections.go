@@ -162,7 +162,7 @@ func (a *ApplicationConnectionsAPIService) ActivateDefaultProvisioningConnection
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationConnectionsAPIService.ActivateDefaultProvisioningConnectionForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/connections/default/lifecycle/activate"
@@ -210,7 +210,7 @@ func (a *ApplicationConnectionsAPIService) ActivateDefaultProvisioningConnection
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -225,6 +225,7 @@ func (a *ApplicationConnectionsAPIService) ActivateDefaultProvisioningConnection
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -315,7 +316,7 @@ func (a *ApplicationConnectionsAPIService) DeactivateDefaultProvisioningConnecti
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationConnectionsAPIService.DeactivateDefaultProvisioningConnectionForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/connections/default/lifecycle/deactivate"
@@ -363,7 +364,7 @@ func (a *ApplicationConnectionsAPIService) DeactivateDefaultProvisioningConnecti
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -378,6 +379,7 @@ func (a *ApplicationConnectionsAPIService) DeactivateDefaultProvisioningConnecti
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -470,7 +472,7 @@ func (a *ApplicationConnectionsAPIService) GetDefaultProvisioningConnectionForAp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationConnectionsAPIService.GetDefaultProvisioningConnectionForApplication")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/connections/default"
@@ -518,7 +520,7 @@ func (a *ApplicationConnectionsAPIService) GetDefaultProvisioningConnectionForAp
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -533,6 +535,7 @@ func (a *ApplicationConnectionsAPIService) GetDefaultProvisioningConnectionForAp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -648,7 +651,7 @@ func (a *ApplicationConnectionsAPIService) UpdateDefaultProvisioningConnectionFo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationConnectionsAPIService.UpdateDefaultProvisioningConnectionForApplication")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/connections/default"
@@ -704,7 +707,7 @@ func (a *ApplicationConnectionsAPIService) UpdateDefaultProvisioningConnectionFo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -719,6 +722,7 @@ func (a *ApplicationConnectionsAPIService) UpdateDefaultProvisioningConnectionFo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -848,7 +852,7 @@ func (a *ApplicationConnectionsAPIService) VerifyProvisioningConnectionForApplic
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationConnectionsAPIService.VerifyProvisioningConnectionForApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appName}/{appId}/oauth2/callback"
@@ -903,7 +907,7 @@ func (a *ApplicationConnectionsAPIService) VerifyProvisioningConnectionForApplic
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -918,6 +922,7 @@ func (a *ApplicationConnectionsAPIService) VerifyProvisioningConnectionForApplic
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
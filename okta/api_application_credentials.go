@@ -239,7 +239,7 @@ func (a *ApplicationCredentialsAPIService) CloneApplicationKeyExecute(r ApiClone
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.CloneApplicationKey")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/keys/{keyId}/clone"
@@ -292,7 +292,7 @@ func (a *ApplicationCredentialsAPIService) CloneApplicationKeyExecute(r ApiClone
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -307,6 +307,7 @@ func (a *ApplicationCredentialsAPIService) CloneApplicationKeyExecute(r ApiClone
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -415,7 +416,7 @@ func (a *ApplicationCredentialsAPIService) GenerateApplicationKeyExecute(r ApiGe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.GenerateApplicationKey")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/keys/generate"
@@ -466,7 +467,7 @@ func (a *ApplicationCredentialsAPIService) GenerateApplicationKeyExecute(r ApiGe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -481,6 +482,7 @@ func (a *ApplicationCredentialsAPIService) GenerateApplicationKeyExecute(r ApiGe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -589,7 +591,7 @@ func (a *ApplicationCredentialsAPIService) GenerateCsrForApplicationExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.GenerateCsrForApplication")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/csrs"
@@ -642,7 +644,7 @@ func (a *ApplicationCredentialsAPIService) GenerateCsrForApplicationExecute(r Ap
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -657,6 +659,7 @@ func (a *ApplicationCredentialsAPIService) GenerateCsrForApplicationExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -774,7 +777,7 @@ func (a *ApplicationCredentialsAPIService) GetApplicationKeyExecute(r ApiGetAppl
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.GetApplicationKey")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/keys/{keyId}"
@@ -823,7 +826,7 @@ func (a *ApplicationCredentialsAPIService) GetApplicationKeyExecute(r ApiGetAppl
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -838,6 +841,7 @@ func (a *ApplicationCredentialsAPIService) GetApplicationKeyExecute(r ApiGetAppl
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -943,7 +947,7 @@ func (a *ApplicationCredentialsAPIService) GetCsrForApplicationExecute(r ApiGetC
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.GetCsrForApplication")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/csrs/{csrId}"
@@ -992,7 +996,7 @@ func (a *ApplicationCredentialsAPIService) GetCsrForApplicationExecute(r ApiGetC
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1007,6 +1011,7 @@ func (a *ApplicationCredentialsAPIService) GetCsrForApplicationExecute(r ApiGetC
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1109,7 +1114,7 @@ func (a *ApplicationCredentialsAPIService) ListApplicationKeysExecute(r ApiListA
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.ListApplicationKeys")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/keys"
@@ -1157,7 +1162,7 @@ func (a *ApplicationCredentialsAPIService) ListApplicationKeysExecute(r ApiListA
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1172,6 +1177,7 @@ func (a *ApplicationCredentialsAPIService) ListApplicationKeysExecute(r ApiListA
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1274,7 +1280,7 @@ func (a *ApplicationCredentialsAPIService) ListCsrsForApplicationExecute(r ApiLi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.ListCsrsForApplication")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/csrs"
@@ -1322,7 +1328,7 @@ func (a *ApplicationCredentialsAPIService) ListCsrsForApplicationExecute(r ApiLi
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1337,6 +1343,7 @@ func (a *ApplicationCredentialsAPIService) ListCsrsForApplicationExecute(r ApiLi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1448,7 +1455,7 @@ func (a *ApplicationCredentialsAPIService) PublishCsrFromApplicationExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.PublishCsrFromApplication")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/csrs/{csrId}/lifecycle/publish"
@@ -1502,7 +1509,7 @@ func (a *ApplicationCredentialsAPIService) PublishCsrFromApplicationExecute(r Ap
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1517,6 +1524,7 @@ func (a *ApplicationCredentialsAPIService) PublishCsrFromApplicationExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1632,7 +1640,7 @@ func (a *ApplicationCredentialsAPIService) RevokeCsrFromApplicationExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApplicationCredentialsAPIService.RevokeCsrFromApplication")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/apps/{appId}/credentials/csrs/{csrId}"
@@ -1681,7 +1689,7 @@ func (a *ApplicationCredentialsAPIService) RevokeCsrFromApplicationExecute(r Api
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1696,6 +1704,7 @@ func (a *ApplicationCredentialsAPIService) RevokeCsrFromApplicationExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -141,7 +141,7 @@ func (a *AttackProtectionAPIService) GetAuthenticatorSettingsExecute(r ApiGetAut
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AttackProtectionAPIService.GetAuthenticatorSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/attack-protection/api/v1/authenticator-settings"
@@ -188,7 +188,7 @@ func (a *AttackProtectionAPIService) GetAuthenticatorSettingsExecute(r ApiGetAut
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -203,6 +203,7 @@ func (a *AttackProtectionAPIService) GetAuthenticatorSettingsExecute(r ApiGetAut
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -290,7 +291,7 @@ func (a *AttackProtectionAPIService) GetUserLockoutSettingsExecute(r ApiGetUserL
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AttackProtectionAPIService.GetUserLockoutSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/attack-protection/api/v1/user-lockout-settings"
@@ -337,7 +338,7 @@ func (a *AttackProtectionAPIService) GetUserLockoutSettingsExecute(r ApiGetUserL
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -352,6 +353,7 @@ func (a *AttackProtectionAPIService) GetUserLockoutSettingsExecute(r ApiGetUserL
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -445,7 +447,7 @@ func (a *AttackProtectionAPIService) ReplaceAuthenticatorSettingsExecute(r ApiRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AttackProtectionAPIService.ReplaceAuthenticatorSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/attack-protection/api/v1/authenticator-settings"
@@ -497,7 +499,7 @@ func (a *AttackProtectionAPIService) ReplaceAuthenticatorSettingsExecute(r ApiRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -512,6 +514,7 @@ func (a *AttackProtectionAPIService) ReplaceAuthenticatorSettingsExecute(r ApiRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -617,7 +620,7 @@ func (a *AttackProtectionAPIService) ReplaceUserLockoutSettingsExecute(r ApiRepl
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AttackProtectionAPIService.ReplaceUserLockoutSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/attack-protection/api/v1/user-lockout-settings"
@@ -669,7 +672,7 @@ func (a *AttackProtectionAPIService) ReplaceUserLockoutSettingsExecute(r ApiRepl
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -684,6 +687,7 @@ func (a *AttackProtectionAPIService) ReplaceUserLockoutSettingsExecute(r ApiRepl
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
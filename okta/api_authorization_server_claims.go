@@ -172,7 +172,7 @@ func (a *AuthorizationServerClaimsAPIService) CreateOAuth2ClaimExecute(r ApiCrea
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClaimsAPIService.CreateOAuth2Claim")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/claims"
@@ -225,7 +225,7 @@ func (a *AuthorizationServerClaimsAPIService) CreateOAuth2ClaimExecute(r ApiCrea
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -240,6 +240,7 @@ func (a *AuthorizationServerClaimsAPIService) CreateOAuth2ClaimExecute(r ApiCrea
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -355,7 +356,7 @@ func (a *AuthorizationServerClaimsAPIService) DeleteOAuth2ClaimExecute(r ApiDele
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClaimsAPIService.DeleteOAuth2Claim")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/claims/{claimId}"
@@ -404,7 +405,7 @@ func (a *AuthorizationServerClaimsAPIService) DeleteOAuth2ClaimExecute(r ApiDele
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -419,6 +420,7 @@ func (a *AuthorizationServerClaimsAPIService) DeleteOAuth2ClaimExecute(r ApiDele
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -514,7 +516,7 @@ func (a *AuthorizationServerClaimsAPIService) GetOAuth2ClaimExecute(r ApiGetOAut
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClaimsAPIService.GetOAuth2Claim")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/claims/{claimId}"
@@ -563,7 +565,7 @@ func (a *AuthorizationServerClaimsAPIService) GetOAuth2ClaimExecute(r ApiGetOAut
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -578,6 +580,7 @@ func (a *AuthorizationServerClaimsAPIService) GetOAuth2ClaimExecute(r ApiGetOAut
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -680,7 +683,7 @@ func (a *AuthorizationServerClaimsAPIService) ListOAuth2ClaimsExecute(r ApiListO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClaimsAPIService.ListOAuth2Claims")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/claims"
@@ -728,7 +731,7 @@ func (a *AuthorizationServerClaimsAPIService) ListOAuth2ClaimsExecute(r ApiListO
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -743,6 +746,7 @@ func (a *AuthorizationServerClaimsAPIService) ListOAuth2ClaimsExecute(r ApiListO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -854,7 +858,7 @@ func (a *AuthorizationServerClaimsAPIService) ReplaceOAuth2ClaimExecute(r ApiRep
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AuthorizationServerClaimsAPIService.ReplaceOAuth2Claim")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/authorizationServers/{authServerId}/claims/{claimId}"
@@ -908,7 +912,7 @@ func (a *AuthorizationServerClaimsAPIService) ReplaceOAuth2ClaimExecute(r ApiRep
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -923,6 +927,7 @@ func (a *AuthorizationServerClaimsAPIService) ReplaceOAuth2ClaimExecute(r ApiRep
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
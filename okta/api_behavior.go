@@ -191,7 +191,7 @@ func (a *BehaviorAPIService) ActivateBehaviorDetectionRuleExecute(r ApiActivateB
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.ActivateBehaviorDetectionRule")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors/{behaviorId}/lifecycle/activate"
@@ -239,7 +239,7 @@ func (a *BehaviorAPIService) ActivateBehaviorDetectionRuleExecute(r ApiActivateB
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -254,6 +254,7 @@ func (a *BehaviorAPIService) ActivateBehaviorDetectionRuleExecute(r ApiActivateB
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -359,7 +360,7 @@ func (a *BehaviorAPIService) CreateBehaviorDetectionRuleExecute(r ApiCreateBehav
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.CreateBehaviorDetectionRule")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors"
@@ -411,7 +412,7 @@ func (a *BehaviorAPIService) CreateBehaviorDetectionRuleExecute(r ApiCreateBehav
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -426,6 +427,7 @@ func (a *BehaviorAPIService) CreateBehaviorDetectionRuleExecute(r ApiCreateBehav
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -528,7 +530,7 @@ func (a *BehaviorAPIService) DeactivateBehaviorDetectionRuleExecute(r ApiDeactiv
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.DeactivateBehaviorDetectionRule")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors/{behaviorId}/lifecycle/deactivate"
@@ -576,7 +578,7 @@ func (a *BehaviorAPIService) DeactivateBehaviorDetectionRuleExecute(r ApiDeactiv
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -591,6 +593,7 @@ func (a *BehaviorAPIService) DeactivateBehaviorDetectionRuleExecute(r ApiDeactiv
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -691,7 +694,7 @@ func (a *BehaviorAPIService) DeleteBehaviorDetectionRuleExecute(r ApiDeleteBehav
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.DeleteBehaviorDetectionRule")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors/{behaviorId}"
@@ -739,7 +742,7 @@ func (a *BehaviorAPIService) DeleteBehaviorDetectionRuleExecute(r ApiDeleteBehav
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -754,6 +757,7 @@ func (a *BehaviorAPIService) DeleteBehaviorDetectionRuleExecute(r ApiDeleteBehav
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -846,7 +850,7 @@ func (a *BehaviorAPIService) GetBehaviorDetectionRuleExecute(r ApiGetBehaviorDet
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.GetBehaviorDetectionRule")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors/{behaviorId}"
@@ -894,7 +898,7 @@ func (a *BehaviorAPIService) GetBehaviorDetectionRuleExecute(r ApiGetBehaviorDet
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -909,6 +913,7 @@ func (a *BehaviorAPIService) GetBehaviorDetectionRuleExecute(r ApiGetBehaviorDet
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1008,7 +1013,7 @@ func (a *BehaviorAPIService) ListBehaviorDetectionRulesExecute(r ApiListBehavior
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.ListBehaviorDetectionRules")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors"
@@ -1055,7 +1060,7 @@ func (a *BehaviorAPIService) ListBehaviorDetectionRulesExecute(r ApiListBehavior
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1070,6 +1075,7 @@ func (a *BehaviorAPIService) ListBehaviorDetectionRulesExecute(r ApiListBehavior
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1166,7 +1172,7 @@ func (a *BehaviorAPIService) ReplaceBehaviorDetectionRuleExecute(r ApiReplaceBeh
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BehaviorAPIService.ReplaceBehaviorDetectionRule")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/behaviors/{behaviorId}"
@@ -1219,7 +1225,7 @@ func (a *BehaviorAPIService) ReplaceBehaviorDetectionRuleExecute(r ApiReplaceBeh
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1234,6 +1240,7 @@ func (a *BehaviorAPIService) ReplaceBehaviorDetectionRuleExecute(r ApiReplaceBeh
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
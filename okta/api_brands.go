@@ -185,7 +185,7 @@ func (a *BrandsAPIService) CreateBrandExecute(r ApiCreateBrandRequest) (*Brand,
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BrandsAPIService.CreateBrand")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands"
@@ -234,7 +234,7 @@ func (a *BrandsAPIService) CreateBrandExecute(r ApiCreateBrandRequest) (*Brand,
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -249,6 +249,7 @@ func (a *BrandsAPIService) CreateBrandExecute(r ApiCreateBrandRequest) (*Brand,
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -361,7 +362,7 @@ func (a *BrandsAPIService) DeleteBrandExecute(r ApiDeleteBrandRequest) (*APIResp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BrandsAPIService.DeleteBrand")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}"
@@ -409,7 +410,7 @@ func (a *BrandsAPIService) DeleteBrandExecute(r ApiDeleteBrandRequest) (*APIResp
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -424,6 +425,7 @@ func (a *BrandsAPIService) DeleteBrandExecute(r ApiDeleteBrandRequest) (*APIResp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -535,7 +537,7 @@ func (a *BrandsAPIService) GetBrandExecute(r ApiGetBrandRequest) (*BrandWithEmbe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BrandsAPIService.GetBrand")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}"
@@ -586,7 +588,7 @@ func (a *BrandsAPIService) GetBrandExecute(r ApiGetBrandRequest) (*BrandWithEmbe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -601,6 +603,7 @@ func (a *BrandsAPIService) GetBrandExecute(r ApiGetBrandRequest) (*BrandWithEmbe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -703,7 +706,7 @@ func (a *BrandsAPIService) ListBrandDomainsExecute(r ApiListBrandDomainsRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BrandsAPIService.ListBrandDomains")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/domains"
@@ -751,7 +754,7 @@ func (a *BrandsAPIService) ListBrandDomainsExecute(r ApiListBrandDomainsRequest)
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -766,6 +769,7 @@ func (a *BrandsAPIService) ListBrandDomainsExecute(r ApiListBrandDomainsRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -893,7 +897,7 @@ func (a *BrandsAPIService) ListBrandsExecute(r ApiListBrandsRequest) ([]BrandWit
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BrandsAPIService.ListBrands")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands"
@@ -952,7 +956,7 @@ func (a *BrandsAPIService) ListBrandsExecute(r ApiListBrandsRequest) ([]BrandWit
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -967,6 +971,7 @@ func (a *BrandsAPIService) ListBrandsExecute(r ApiListBrandsRequest) ([]BrandWit
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1069,7 +1074,7 @@ func (a *BrandsAPIService) ReplaceBrandExecute(r ApiReplaceBrandRequest) (*Brand
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BrandsAPIService.ReplaceBrand")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}"
@@ -1122,7 +1127,7 @@ func (a *BrandsAPIService) ReplaceBrandExecute(r ApiReplaceBrandRequest) (*Brand
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1137,6 +1142,7 @@ func (a *BrandsAPIService) ReplaceBrandExecute(r ApiReplaceBrandRequest) (*Brand
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -223,7 +223,7 @@ func (a *CAPTCHAAPIService) CreateCaptchaInstanceExecute(r ApiCreateCaptchaInsta
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.CreateCaptchaInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas"
@@ -275,7 +275,7 @@ func (a *CAPTCHAAPIService) CreateCaptchaInstanceExecute(r ApiCreateCaptchaInsta
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -290,6 +290,7 @@ func (a *CAPTCHAAPIService) CreateCaptchaInstanceExecute(r ApiCreateCaptchaInsta
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -391,7 +392,7 @@ func (a *CAPTCHAAPIService) DeleteCaptchaInstanceExecute(r ApiDeleteCaptchaInsta
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.DeleteCaptchaInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas/{captchaId}"
@@ -439,7 +440,7 @@ func (a *CAPTCHAAPIService) DeleteCaptchaInstanceExecute(r ApiDeleteCaptchaInsta
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -454,6 +455,7 @@ func (a *CAPTCHAAPIService) DeleteCaptchaInstanceExecute(r ApiDeleteCaptchaInsta
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -541,7 +543,7 @@ func (a *CAPTCHAAPIService) DeleteOrgCaptchaSettingsExecute(r ApiDeleteOrgCaptch
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.DeleteOrgCaptchaSettings")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/captcha"
@@ -588,7 +590,7 @@ func (a *CAPTCHAAPIService) DeleteOrgCaptchaSettingsExecute(r ApiDeleteOrgCaptch
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -603,6 +605,7 @@ func (a *CAPTCHAAPIService) DeleteOrgCaptchaSettingsExecute(r ApiDeleteOrgCaptch
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -695,7 +698,7 @@ func (a *CAPTCHAAPIService) GetCaptchaInstanceExecute(r ApiGetCaptchaInstanceReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.GetCaptchaInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas/{captchaId}"
@@ -743,7 +746,7 @@ func (a *CAPTCHAAPIService) GetCaptchaInstanceExecute(r ApiGetCaptchaInstanceReq
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -758,6 +761,7 @@ func (a *CAPTCHAAPIService) GetCaptchaInstanceExecute(r ApiGetCaptchaInstanceReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -858,7 +862,7 @@ func (a *CAPTCHAAPIService) GetOrgCaptchaSettingsExecute(r ApiGetOrgCaptchaSetti
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.GetOrgCaptchaSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/captcha"
@@ -905,7 +909,7 @@ func (a *CAPTCHAAPIService) GetOrgCaptchaSettingsExecute(r ApiGetOrgCaptchaSetti
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -920,6 +924,7 @@ func (a *CAPTCHAAPIService) GetOrgCaptchaSettingsExecute(r ApiGetOrgCaptchaSetti
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1007,7 +1012,7 @@ func (a *CAPTCHAAPIService) ListCaptchaInstancesExecute(r ApiListCaptchaInstance
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.ListCaptchaInstances")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas"
@@ -1054,7 +1059,7 @@ func (a *CAPTCHAAPIService) ListCaptchaInstancesExecute(r ApiListCaptchaInstance
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1069,6 +1074,7 @@ func (a *CAPTCHAAPIService) ListCaptchaInstancesExecute(r ApiListCaptchaInstance
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1165,7 +1171,7 @@ func (a *CAPTCHAAPIService) ReplaceCaptchaInstanceExecute(r ApiReplaceCaptchaIns
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.ReplaceCaptchaInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas/{captchaId}"
@@ -1218,7 +1224,7 @@ func (a *CAPTCHAAPIService) ReplaceCaptchaInstanceExecute(r ApiReplaceCaptchaIns
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1233,6 +1239,7 @@ func (a *CAPTCHAAPIService) ReplaceCaptchaInstanceExecute(r ApiReplaceCaptchaIns
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1351,7 +1358,7 @@ func (a *CAPTCHAAPIService) ReplacesOrgCaptchaSettingsExecute(r ApiReplacesOrgCa
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.ReplacesOrgCaptchaSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/captcha"
@@ -1403,7 +1410,7 @@ func (a *CAPTCHAAPIService) ReplacesOrgCaptchaSettingsExecute(r ApiReplacesOrgCa
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1418,6 +1425,7 @@ func (a *CAPTCHAAPIService) ReplacesOrgCaptchaSettingsExecute(r ApiReplacesOrgCa
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1526,7 +1534,7 @@ func (a *CAPTCHAAPIService) UpdateCaptchaInstanceExecute(r ApiUpdateCaptchaInsta
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CAPTCHAAPIService.UpdateCaptchaInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/captchas/{captchaId}"
@@ -1579,7 +1587,7 @@ func (a *CAPTCHAAPIService) UpdateCaptchaInstanceExecute(r ApiUpdateCaptchaInsta
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1594,6 +1602,7 @@ func (a *CAPTCHAAPIService) UpdateCaptchaInstanceExecute(r ApiUpdateCaptchaInsta
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -193,7 +193,7 @@ func (a *CustomDomainAPIService) CreateCustomDomainExecute(r ApiCreateCustomDoma
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.CreateCustomDomain")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains"
@@ -245,7 +245,7 @@ func (a *CustomDomainAPIService) CreateCustomDomainExecute(r ApiCreateCustomDoma
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -260,6 +260,7 @@ func (a *CustomDomainAPIService) CreateCustomDomainExecute(r ApiCreateCustomDoma
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -360,7 +361,7 @@ func (a *CustomDomainAPIService) DeleteCustomDomainExecute(r ApiDeleteCustomDoma
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.DeleteCustomDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains/{domainId}"
@@ -408,7 +409,7 @@ func (a *CustomDomainAPIService) DeleteCustomDomainExecute(r ApiDeleteCustomDoma
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -423,6 +424,7 @@ func (a *CustomDomainAPIService) DeleteCustomDomainExecute(r ApiDeleteCustomDoma
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -515,7 +517,7 @@ func (a *CustomDomainAPIService) GetCustomDomainExecute(r ApiGetCustomDomainRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.GetCustomDomain")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains/{domainId}"
@@ -563,7 +565,7 @@ func (a *CustomDomainAPIService) GetCustomDomainExecute(r ApiGetCustomDomainRequ
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -578,6 +580,7 @@ func (a *CustomDomainAPIService) GetCustomDomainExecute(r ApiGetCustomDomainRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -677,7 +680,7 @@ func (a *CustomDomainAPIService) ListCustomDomainsExecute(r ApiListCustomDomains
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.ListCustomDomains")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains"
@@ -724,7 +727,7 @@ func (a *CustomDomainAPIService) ListCustomDomainsExecute(r ApiListCustomDomains
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -739,6 +742,7 @@ func (a *CustomDomainAPIService) ListCustomDomainsExecute(r ApiListCustomDomains
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -835,7 +839,7 @@ func (a *CustomDomainAPIService) ReplaceCustomDomainExecute(r ApiReplaceCustomDo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.ReplaceCustomDomain")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains/{domainId}"
@@ -888,7 +892,7 @@ func (a *CustomDomainAPIService) ReplaceCustomDomainExecute(r ApiReplaceCustomDo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -903,6 +907,7 @@ func (a *CustomDomainAPIService) ReplaceCustomDomainExecute(r ApiReplaceCustomDo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1021,7 +1026,7 @@ func (a *CustomDomainAPIService) UpsertCertificateExecute(r ApiUpsertCertificate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.UpsertCertificate")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains/{domainId}/certificate"
@@ -1074,7 +1079,7 @@ func (a *CustomDomainAPIService) UpsertCertificateExecute(r ApiUpsertCertificate
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1089,6 +1094,7 @@ func (a *CustomDomainAPIService) UpsertCertificateExecute(r ApiUpsertCertificate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1193,7 +1199,7 @@ func (a *CustomDomainAPIService) VerifyDomainExecute(r ApiVerifyDomainRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomDomainAPIService.VerifyDomain")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/domains/{domainId}/verify"
@@ -1241,7 +1247,7 @@ func (a *CustomDomainAPIService) VerifyDomainExecute(r ApiVerifyDomainRequest) (
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1256,6 +1262,7 @@ func (a *CustomDomainAPIService) VerifyDomainExecute(r ApiVerifyDomainRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -360,7 +360,7 @@ func (a *CustomTemplatesAPIService) CreateEmailCustomizationExecute(r ApiCreateE
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.CreateEmailCustomization")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations"
@@ -411,7 +411,7 @@ func (a *CustomTemplatesAPIService) CreateEmailCustomizationExecute(r ApiCreateE
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -426,6 +426,7 @@ func (a *CustomTemplatesAPIService) CreateEmailCustomizationExecute(r ApiCreateE
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -556,7 +557,7 @@ func (a *CustomTemplatesAPIService) DeleteAllCustomizationsExecute(r ApiDeleteAl
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.DeleteAllCustomizations")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations"
@@ -605,7 +606,7 @@ func (a *CustomTemplatesAPIService) DeleteAllCustomizationsExecute(r ApiDeleteAl
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -620,6 +621,7 @@ func (a *CustomTemplatesAPIService) DeleteAllCustomizationsExecute(r ApiDeleteAl
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -719,7 +721,7 @@ func (a *CustomTemplatesAPIService) DeleteEmailCustomizationExecute(r ApiDeleteE
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.DeleteEmailCustomization")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}"
@@ -769,7 +771,7 @@ func (a *CustomTemplatesAPIService) DeleteEmailCustomizationExecute(r ApiDeleteE
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -784,6 +786,7 @@ func (a *CustomTemplatesAPIService) DeleteEmailCustomizationExecute(r ApiDeleteE
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -897,7 +900,7 @@ func (a *CustomTemplatesAPIService) GetCustomizationPreviewExecute(r ApiGetCusto
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.GetCustomizationPreview")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}/preview"
@@ -947,7 +950,7 @@ func (a *CustomTemplatesAPIService) GetCustomizationPreviewExecute(r ApiGetCusto
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -962,6 +965,7 @@ func (a *CustomTemplatesAPIService) GetCustomizationPreviewExecute(r ApiGetCusto
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1073,7 +1077,7 @@ func (a *CustomTemplatesAPIService) GetEmailCustomizationExecute(r ApiGetEmailCu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.GetEmailCustomization")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}"
@@ -1123,7 +1127,7 @@ func (a *CustomTemplatesAPIService) GetEmailCustomizationExecute(r ApiGetEmailCu
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1138,6 +1142,7 @@ func (a *CustomTemplatesAPIService) GetEmailCustomizationExecute(r ApiGetEmailCu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1255,7 +1260,7 @@ func (a *CustomTemplatesAPIService) GetEmailDefaultContentExecute(r ApiGetEmailD
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.GetEmailDefaultContent")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/default-content"
@@ -1307,7 +1312,7 @@ func (a *CustomTemplatesAPIService) GetEmailDefaultContentExecute(r ApiGetEmailD
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1322,6 +1327,7 @@ func (a *CustomTemplatesAPIService) GetEmailDefaultContentExecute(r ApiGetEmailD
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1439,7 +1445,7 @@ func (a *CustomTemplatesAPIService) GetEmailDefaultPreviewExecute(r ApiGetEmailD
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.GetEmailDefaultPreview")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/default-content/preview"
@@ -1491,7 +1497,7 @@ func (a *CustomTemplatesAPIService) GetEmailDefaultPreviewExecute(r ApiGetEmailD
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1506,6 +1512,7 @@ func (a *CustomTemplatesAPIService) GetEmailDefaultPreviewExecute(r ApiGetEmailD
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1611,7 +1618,7 @@ func (a *CustomTemplatesAPIService) GetEmailSettingsExecute(r ApiGetEmailSetting
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.GetEmailSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/settings"
@@ -1660,7 +1667,7 @@ func (a *CustomTemplatesAPIService) GetEmailSettingsExecute(r ApiGetEmailSetting
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1675,6 +1682,7 @@ func (a *CustomTemplatesAPIService) GetEmailSettingsExecute(r ApiGetEmailSetting
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1787,7 +1795,7 @@ func (a *CustomTemplatesAPIService) GetEmailTemplateExecute(r ApiGetEmailTemplat
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.GetEmailTemplate")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}"
@@ -1839,7 +1847,7 @@ func (a *CustomTemplatesAPIService) GetEmailTemplateExecute(r ApiGetEmailTemplat
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1854,6 +1862,7 @@ func (a *CustomTemplatesAPIService) GetEmailTemplateExecute(r ApiGetEmailTemplat
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1976,7 +1985,7 @@ func (a *CustomTemplatesAPIService) ListEmailCustomizationsExecute(r ApiListEmai
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.ListEmailCustomizations")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations"
@@ -2031,7 +2040,7 @@ func (a *CustomTemplatesAPIService) ListEmailCustomizationsExecute(r ApiListEmai
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2046,6 +2055,7 @@ func (a *CustomTemplatesAPIService) ListEmailCustomizationsExecute(r ApiListEmai
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2169,7 +2179,7 @@ func (a *CustomTemplatesAPIService) ListEmailTemplatesExecute(r ApiListEmailTemp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.ListEmailTemplates")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email"
@@ -2226,7 +2236,7 @@ func (a *CustomTemplatesAPIService) ListEmailTemplatesExecute(r ApiListEmailTemp
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2241,6 +2251,7 @@ func (a *CustomTemplatesAPIService) ListEmailTemplatesExecute(r ApiListEmailTemp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2359,7 +2370,7 @@ func (a *CustomTemplatesAPIService) ReplaceEmailCustomizationExecute(r ApiReplac
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.ReplaceEmailCustomization")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}"
@@ -2411,7 +2422,7 @@ func (a *CustomTemplatesAPIService) ReplaceEmailCustomizationExecute(r ApiReplac
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2426,6 +2437,7 @@ func (a *CustomTemplatesAPIService) ReplaceEmailCustomizationExecute(r ApiReplac
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2561,7 +2573,7 @@ func (a *CustomTemplatesAPIService) ReplaceEmailSettingsExecute(r ApiReplaceEmai
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.ReplaceEmailSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/settings"
@@ -2612,7 +2624,7 @@ func (a *CustomTemplatesAPIService) ReplaceEmailSettingsExecute(r ApiReplaceEmai
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2627,6 +2639,7 @@ func (a *CustomTemplatesAPIService) ReplaceEmailSettingsExecute(r ApiReplaceEmai
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2765,7 +2778,7 @@ func (a *CustomTemplatesAPIService) SendTestEmailExecute(r ApiSendTestEmailReque
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CustomTemplatesAPIService.SendTestEmail")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/templates/email/{templateName}/test"
@@ -2817,7 +2830,7 @@ func (a *CustomTemplatesAPIService) SendTestEmailExecute(r ApiSendTestEmailReque
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2832,6 +2845,7 @@ func (a *CustomTemplatesAPIService) SendTestEmailExecute(r ApiSendTestEmailReque
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -164,7 +164,7 @@ func (a *DeviceAssuranceAPIService) CreateDeviceAssurancePolicyExecute(r ApiCrea
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAssuranceAPIService.CreateDeviceAssurancePolicy")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/device-assurances"
@@ -216,7 +216,7 @@ func (a *DeviceAssuranceAPIService) CreateDeviceAssurancePolicyExecute(r ApiCrea
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -231,6 +231,7 @@ func (a *DeviceAssuranceAPIService) CreateDeviceAssurancePolicyExecute(r ApiCrea
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -331,7 +332,7 @@ func (a *DeviceAssuranceAPIService) DeleteDeviceAssurancePolicyExecute(r ApiDele
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAssuranceAPIService.DeleteDeviceAssurancePolicy")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/device-assurances/{deviceAssuranceId}"
@@ -379,7 +380,7 @@ func (a *DeviceAssuranceAPIService) DeleteDeviceAssurancePolicyExecute(r ApiDele
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -394,6 +395,7 @@ func (a *DeviceAssuranceAPIService) DeleteDeviceAssurancePolicyExecute(r ApiDele
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -498,7 +500,7 @@ func (a *DeviceAssuranceAPIService) GetDeviceAssurancePolicyExecute(r ApiGetDevi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAssuranceAPIService.GetDeviceAssurancePolicy")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/device-assurances/{deviceAssuranceId}"
@@ -546,7 +548,7 @@ func (a *DeviceAssuranceAPIService) GetDeviceAssurancePolicyExecute(r ApiGetDevi
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -561,6 +563,7 @@ func (a *DeviceAssuranceAPIService) GetDeviceAssurancePolicyExecute(r ApiGetDevi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -660,7 +663,7 @@ func (a *DeviceAssuranceAPIService) ListDeviceAssurancePoliciesExecute(r ApiList
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAssuranceAPIService.ListDeviceAssurancePolicies")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/device-assurances"
@@ -707,7 +710,7 @@ func (a *DeviceAssuranceAPIService) ListDeviceAssurancePoliciesExecute(r ApiList
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -722,6 +725,7 @@ func (a *DeviceAssuranceAPIService) ListDeviceAssurancePoliciesExecute(r ApiList
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -818,7 +822,7 @@ func (a *DeviceAssuranceAPIService) ReplaceDeviceAssurancePolicyExecute(r ApiRep
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DeviceAssuranceAPIService.ReplaceDeviceAssurancePolicy")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/device-assurances/{deviceAssuranceId}"
@@ -871,7 +875,7 @@ func (a *DeviceAssuranceAPIService) ReplaceDeviceAssurancePolicyExecute(r ApiRep
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -886,6 +890,7 @@ func (a *DeviceAssuranceAPIService) ReplaceDeviceAssurancePolicyExecute(r ApiRep
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -107,7 +107,7 @@ func (a *DirectoriesIntegrationAPIService) UpdateADGroupMembershipExecute(r ApiU
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DirectoriesIntegrationAPIService.UpdateADGroupMembership")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/directories/{appInstanceId}/groups/modify"
@@ -146,7 +146,7 @@ func (a *DirectoriesIntegrationAPIService) UpdateADGroupMembershipExecute(r ApiU
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -161,6 +161,7 @@ func (a *DirectoriesIntegrationAPIService) UpdateADGroupMembershipExecute(r ApiU
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
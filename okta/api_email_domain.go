@@ -186,7 +186,7 @@ func (a *EmailDomainAPIService) CreateEmailDomainExecute(r ApiCreateEmailDomainR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.CreateEmailDomain")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains"
@@ -241,7 +241,7 @@ func (a *EmailDomainAPIService) CreateEmailDomainExecute(r ApiCreateEmailDomainR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -256,6 +256,7 @@ func (a *EmailDomainAPIService) CreateEmailDomainExecute(r ApiCreateEmailDomainR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -387,7 +388,7 @@ func (a *EmailDomainAPIService) DeleteEmailDomainExecute(r ApiDeleteEmailDomainR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.DeleteEmailDomain")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains/{emailDomainId}"
@@ -438,7 +439,7 @@ func (a *EmailDomainAPIService) DeleteEmailDomainExecute(r ApiDeleteEmailDomainR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -453,6 +454,7 @@ func (a *EmailDomainAPIService) DeleteEmailDomainExecute(r ApiDeleteEmailDomainR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -564,7 +566,7 @@ func (a *EmailDomainAPIService) GetEmailDomainExecute(r ApiGetEmailDomainRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.GetEmailDomain")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains/{emailDomainId}"
@@ -615,7 +617,7 @@ func (a *EmailDomainAPIService) GetEmailDomainExecute(r ApiGetEmailDomainRequest
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -630,6 +632,7 @@ func (a *EmailDomainAPIService) GetEmailDomainExecute(r ApiGetEmailDomainRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -736,7 +739,7 @@ func (a *EmailDomainAPIService) ListEmailDomainsExecute(r ApiListEmailDomainsReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.ListEmailDomains")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains"
@@ -786,7 +789,7 @@ func (a *EmailDomainAPIService) ListEmailDomainsExecute(r ApiListEmailDomainsReq
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -801,6 +804,7 @@ func (a *EmailDomainAPIService) ListEmailDomainsExecute(r ApiListEmailDomainsReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -904,7 +908,7 @@ func (a *EmailDomainAPIService) ReplaceEmailDomainExecute(r ApiReplaceEmailDomai
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.ReplaceEmailDomain")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains/{emailDomainId}"
@@ -960,7 +964,7 @@ func (a *EmailDomainAPIService) ReplaceEmailDomainExecute(r ApiReplaceEmailDomai
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -975,6 +979,7 @@ func (a *EmailDomainAPIService) ReplaceEmailDomainExecute(r ApiReplaceEmailDomai
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1089,7 +1094,7 @@ func (a *EmailDomainAPIService) VerifyEmailDomainExecute(r ApiVerifyEmailDomainR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailDomainAPIService.VerifyEmailDomain")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-domains/{emailDomainId}/verify"
@@ -1137,7 +1142,7 @@ func (a *EmailDomainAPIService) VerifyEmailDomainExecute(r ApiVerifyEmailDomainR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1152,6 +1157,7 @@ func (a *EmailDomainAPIService) VerifyEmailDomainExecute(r ApiVerifyEmailDomainR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -178,7 +178,7 @@ func (a *EmailServerAPIService) CreateEmailServerExecute(r ApiCreateEmailServerR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.CreateEmailServer")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers"
@@ -227,7 +227,7 @@ func (a *EmailServerAPIService) CreateEmailServerExecute(r ApiCreateEmailServerR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -242,6 +242,7 @@ func (a *EmailServerAPIService) CreateEmailServerExecute(r ApiCreateEmailServerR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -342,7 +343,7 @@ func (a *EmailServerAPIService) DeleteEmailServerExecute(r ApiDeleteEmailServerR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.DeleteEmailServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers/{emailServerId}"
@@ -390,7 +391,7 @@ func (a *EmailServerAPIService) DeleteEmailServerExecute(r ApiDeleteEmailServerR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -405,6 +406,7 @@ func (a *EmailServerAPIService) DeleteEmailServerExecute(r ApiDeleteEmailServerR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -497,7 +499,7 @@ func (a *EmailServerAPIService) GetEmailServerExecute(r ApiGetEmailServerRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.GetEmailServer")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers/{emailServerId}"
@@ -545,7 +547,7 @@ func (a *EmailServerAPIService) GetEmailServerExecute(r ApiGetEmailServerRequest
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -560,6 +562,7 @@ func (a *EmailServerAPIService) GetEmailServerExecute(r ApiGetEmailServerRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -659,7 +662,7 @@ func (a *EmailServerAPIService) ListEmailServersExecute(r ApiListEmailServersReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.ListEmailServers")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers"
@@ -706,7 +709,7 @@ func (a *EmailServerAPIService) ListEmailServersExecute(r ApiListEmailServersReq
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -721,6 +724,7 @@ func (a *EmailServerAPIService) ListEmailServersExecute(r ApiListEmailServersReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -815,7 +819,7 @@ func (a *EmailServerAPIService) TestEmailServerExecute(r ApiTestEmailServerReque
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.TestEmailServer")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers/{emailServerId}/test"
@@ -865,7 +869,7 @@ func (a *EmailServerAPIService) TestEmailServerExecute(r ApiTestEmailServerReque
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -880,6 +884,7 @@ func (a *EmailServerAPIService) TestEmailServerExecute(r ApiTestEmailServerReque
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -990,7 +995,7 @@ func (a *EmailServerAPIService) UpdateEmailServerExecute(r ApiUpdateEmailServerR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EmailServerAPIService.UpdateEmailServer")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/email-servers/{emailServerId}"
@@ -1040,7 +1045,7 @@ func (a *EmailServerAPIService) UpdateEmailServerExecute(r ApiUpdateEmailServerR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1055,6 +1060,7 @@ func (a *EmailServerAPIService) UpdateEmailServerExecute(r ApiUpdateEmailServerR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -225,7 +225,7 @@ func (a *EventHookAPIService) ActivateEventHookExecute(r ApiActivateEventHookReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.ActivateEventHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}/lifecycle/activate"
@@ -273,7 +273,7 @@ func (a *EventHookAPIService) ActivateEventHookExecute(r ApiActivateEventHookReq
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -288,6 +288,7 @@ func (a *EventHookAPIService) ActivateEventHookExecute(r ApiActivateEventHookReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -403,7 +404,7 @@ func (a *EventHookAPIService) CreateEventHookExecute(r ApiCreateEventHookRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.CreateEventHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks"
@@ -455,7 +456,7 @@ func (a *EventHookAPIService) CreateEventHookExecute(r ApiCreateEventHookRequest
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -470,6 +471,7 @@ func (a *EventHookAPIService) CreateEventHookExecute(r ApiCreateEventHookRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -572,7 +574,7 @@ func (a *EventHookAPIService) DeactivateEventHookExecute(r ApiDeactivateEventHoo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.DeactivateEventHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}/lifecycle/deactivate"
@@ -620,7 +622,7 @@ func (a *EventHookAPIService) DeactivateEventHookExecute(r ApiDeactivateEventHoo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -635,6 +637,7 @@ func (a *EventHookAPIService) DeactivateEventHookExecute(r ApiDeactivateEventHoo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -736,7 +739,7 @@ func (a *EventHookAPIService) DeleteEventHookExecute(r ApiDeleteEventHookRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.DeleteEventHook")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}"
@@ -784,7 +787,7 @@ func (a *EventHookAPIService) DeleteEventHookExecute(r ApiDeleteEventHookRequest
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -799,6 +802,7 @@ func (a *EventHookAPIService) DeleteEventHookExecute(r ApiDeleteEventHookRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -891,7 +895,7 @@ func (a *EventHookAPIService) GetEventHookExecute(r ApiGetEventHookRequest) (*Ev
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.GetEventHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}"
@@ -939,7 +943,7 @@ func (a *EventHookAPIService) GetEventHookExecute(r ApiGetEventHookRequest) (*Ev
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -954,6 +958,7 @@ func (a *EventHookAPIService) GetEventHookExecute(r ApiGetEventHookRequest) (*Ev
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1053,7 +1058,7 @@ func (a *EventHookAPIService) ListEventHooksExecute(r ApiListEventHooksRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.ListEventHooks")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks"
@@ -1100,7 +1105,7 @@ func (a *EventHookAPIService) ListEventHooksExecute(r ApiListEventHooksRequest)
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1115,6 +1120,7 @@ func (a *EventHookAPIService) ListEventHooksExecute(r ApiListEventHooksRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1214,7 +1220,7 @@ func (a *EventHookAPIService) ReplaceEventHookExecute(r ApiReplaceEventHookReque
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.ReplaceEventHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}"
@@ -1267,7 +1273,7 @@ func (a *EventHookAPIService) ReplaceEventHookExecute(r ApiReplaceEventHookReque
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1282,6 +1288,7 @@ func (a *EventHookAPIService) ReplaceEventHookExecute(r ApiReplaceEventHookReque
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1401,7 +1408,7 @@ func (a *EventHookAPIService) VerifyEventHookExecute(r ApiVerifyEventHookRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "EventHookAPIService.VerifyEventHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/eventHooks/{eventHookId}/lifecycle/verify"
@@ -1449,7 +1456,7 @@ func (a *EventHookAPIService) VerifyEventHookExecute(r ApiVerifyEventHookRequest
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1464,6 +1471,7 @@ func (a *EventHookAPIService) VerifyEventHookExecute(r ApiVerifyEventHookRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -178,7 +178,7 @@ func (a *FeatureAPIService) GetFeatureExecute(r ApiGetFeatureRequest) (*Feature,
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "FeatureAPIService.GetFeature")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/features/{featureId}"
@@ -226,7 +226,7 @@ func (a *FeatureAPIService) GetFeatureExecute(r ApiGetFeatureRequest) (*Feature,
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -241,6 +241,7 @@ func (a *FeatureAPIService) GetFeatureExecute(r ApiGetFeatureRequest) (*Feature,
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -345,7 +346,7 @@ func (a *FeatureAPIService) ListFeatureDependenciesExecute(r ApiListFeatureDepen
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "FeatureAPIService.ListFeatureDependencies")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/features/{featureId}/dependencies"
@@ -393,7 +394,7 @@ func (a *FeatureAPIService) ListFeatureDependenciesExecute(r ApiListFeatureDepen
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -408,6 +409,7 @@ func (a *FeatureAPIService) ListFeatureDependenciesExecute(r ApiListFeatureDepen
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -512,7 +514,7 @@ func (a *FeatureAPIService) ListFeatureDependentsExecute(r ApiListFeatureDepende
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "FeatureAPIService.ListFeatureDependents")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/features/{featureId}/dependents"
@@ -560,7 +562,7 @@ func (a *FeatureAPIService) ListFeatureDependentsExecute(r ApiListFeatureDepende
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -575,6 +577,7 @@ func (a *FeatureAPIService) ListFeatureDependentsExecute(r ApiListFeatureDepende
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -674,7 +677,7 @@ func (a *FeatureAPIService) ListFeaturesExecute(r ApiListFeaturesRequest) ([]Fea
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "FeatureAPIService.ListFeatures")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/features"
@@ -721,7 +724,7 @@ func (a *FeatureAPIService) ListFeaturesExecute(r ApiListFeaturesRequest) ([]Fea
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -736,6 +739,7 @@ func (a *FeatureAPIService) ListFeaturesExecute(r ApiListFeaturesRequest) ([]Fea
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -846,7 +850,7 @@ func (a *FeatureAPIService) UpdateFeatureLifecycleExecute(r ApiUpdateFeatureLife
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "FeatureAPIService.UpdateFeatureLifecycle")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/features/{featureId}/{lifecycle}"
@@ -898,7 +902,7 @@ func (a *FeatureAPIService) UpdateFeatureLifecycleExecute(r ApiUpdateFeatureLife
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -913,6 +917,7 @@ func (a *FeatureAPIService) UpdateFeatureLifecycleExecute(r ApiUpdateFeatureLife
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -140,7 +140,7 @@ func (a *GroupOwnerAPIService) AssignGroupOwnerExecute(r ApiAssignGroupOwnerRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupOwnerAPIService.AssignGroupOwner")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/owners"
@@ -193,7 +193,7 @@ func (a *GroupOwnerAPIService) AssignGroupOwnerExecute(r ApiAssignGroupOwnerRequ
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -208,6 +208,7 @@ func (a *GroupOwnerAPIService) AssignGroupOwnerExecute(r ApiAssignGroupOwnerRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -323,7 +324,7 @@ func (a *GroupOwnerAPIService) DeleteGroupOwnerExecute(r ApiDeleteGroupOwnerRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupOwnerAPIService.DeleteGroupOwner")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/owners/{ownerId}"
@@ -372,7 +373,7 @@ func (a *GroupOwnerAPIService) DeleteGroupOwnerExecute(r ApiDeleteGroupOwnerRequ
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -387,6 +388,7 @@ func (a *GroupOwnerAPIService) DeleteGroupOwnerExecute(r ApiDeleteGroupOwnerRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -500,7 +502,7 @@ func (a *GroupOwnerAPIService) ListGroupOwnersExecute(r ApiListGroupOwnersReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GroupOwnerAPIService.ListGroupOwners")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/owners"
@@ -557,7 +559,7 @@ func (a *GroupOwnerAPIService) ListGroupOwnersExecute(r ApiListGroupOwnersReques
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -572,6 +574,7 @@ func (a *GroupOwnerAPIService) ListGroupOwnersExecute(r ApiListGroupOwnersReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -466,7 +466,7 @@ func (a *IdentityProviderAPIService) ActivateIdentityProviderExecute(r ApiActiva
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ActivateIdentityProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/lifecycle/activate"
@@ -514,7 +514,7 @@ func (a *IdentityProviderAPIService) ActivateIdentityProviderExecute(r ApiActiva
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -529,6 +529,7 @@ func (a *IdentityProviderAPIService) ActivateIdentityProviderExecute(r ApiActiva
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -640,7 +641,7 @@ func (a *IdentityProviderAPIService) CloneIdentityProviderKeyExecute(r ApiCloneI
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.CloneIdentityProviderKey")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/keys/{idpKeyId}/clone"
@@ -693,7 +694,7 @@ func (a *IdentityProviderAPIService) CloneIdentityProviderKeyExecute(r ApiCloneI
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -708,6 +709,7 @@ func (a *IdentityProviderAPIService) CloneIdentityProviderKeyExecute(r ApiCloneI
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -813,7 +815,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderExecute(r ApiCreateId
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.CreateIdentityProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps"
@@ -865,7 +867,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderExecute(r ApiCreateId
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -880,6 +882,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderExecute(r ApiCreateId
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -985,7 +988,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderKeyExecute(r ApiCreat
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.CreateIdentityProviderKey")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/credentials/keys"
@@ -1037,7 +1040,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderKeyExecute(r ApiCreat
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1052,6 +1055,7 @@ func (a *IdentityProviderAPIService) CreateIdentityProviderKeyExecute(r ApiCreat
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1154,7 +1158,7 @@ func (a *IdentityProviderAPIService) DeactivateIdentityProviderExecute(r ApiDeac
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.DeactivateIdentityProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/lifecycle/deactivate"
@@ -1202,7 +1206,7 @@ func (a *IdentityProviderAPIService) DeactivateIdentityProviderExecute(r ApiDeac
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1217,6 +1221,7 @@ func (a *IdentityProviderAPIService) DeactivateIdentityProviderExecute(r ApiDeac
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1317,7 +1322,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderExecute(r ApiDeleteId
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.DeleteIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}"
@@ -1365,7 +1370,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderExecute(r ApiDeleteId
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1380,6 +1385,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderExecute(r ApiDeleteId
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1470,7 +1476,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderKeyExecute(r ApiDelet
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.DeleteIdentityProviderKey")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/credentials/keys/{idpKeyId}"
@@ -1518,7 +1524,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderKeyExecute(r ApiDelet
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1533,6 +1539,7 @@ func (a *IdentityProviderAPIService) DeleteIdentityProviderKeyExecute(r ApiDelet
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1631,7 +1638,7 @@ func (a *IdentityProviderAPIService) GenerateCsrForIdentityProviderExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GenerateCsrForIdentityProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/csrs"
@@ -1684,7 +1691,7 @@ func (a *IdentityProviderAPIService) GenerateCsrForIdentityProviderExecute(r Api
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1699,6 +1706,7 @@ func (a *IdentityProviderAPIService) GenerateCsrForIdentityProviderExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1820,7 +1828,7 @@ func (a *IdentityProviderAPIService) GenerateIdentityProviderSigningKeyExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GenerateIdentityProviderSigningKey")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/keys/generate"
@@ -1872,7 +1880,7 @@ func (a *IdentityProviderAPIService) GenerateIdentityProviderSigningKeyExecute(r
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1887,6 +1895,7 @@ func (a *IdentityProviderAPIService) GenerateIdentityProviderSigningKeyExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1992,7 +2001,7 @@ func (a *IdentityProviderAPIService) GetCsrForIdentityProviderExecute(r ApiGetCs
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GetCsrForIdentityProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}"
@@ -2041,7 +2050,7 @@ func (a *IdentityProviderAPIService) GetCsrForIdentityProviderExecute(r ApiGetCs
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2056,6 +2065,7 @@ func (a *IdentityProviderAPIService) GetCsrForIdentityProviderExecute(r ApiGetCs
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2158,7 +2168,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderExecute(r ApiGetIdentity
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GetIdentityProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}"
@@ -2206,7 +2216,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderExecute(r ApiGetIdentity
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2221,6 +2231,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderExecute(r ApiGetIdentity
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2326,7 +2337,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderApplicationUserExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GetIdentityProviderApplicationUser")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/users/{userId}"
@@ -2375,7 +2386,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderApplicationUserExecute(r
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2390,6 +2401,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderApplicationUserExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2492,7 +2504,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderKeyExecute(r ApiGetIdent
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GetIdentityProviderKey")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/credentials/keys/{idpKeyId}"
@@ -2540,7 +2552,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderKeyExecute(r ApiGetIdent
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2555,6 +2567,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderKeyExecute(r ApiGetIdent
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2660,7 +2673,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderSigningKeyExecute(r ApiG
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.GetIdentityProviderSigningKey")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/keys/{idpKeyId}"
@@ -2709,7 +2722,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderSigningKeyExecute(r ApiG
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2724,6 +2737,7 @@ func (a *IdentityProviderAPIService) GetIdentityProviderSigningKeyExecute(r ApiG
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2835,7 +2849,7 @@ func (a *IdentityProviderAPIService) LinkUserToIdentityProviderExecute(r ApiLink
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.LinkUserToIdentityProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/users/{userId}"
@@ -2889,7 +2903,7 @@ func (a *IdentityProviderAPIService) LinkUserToIdentityProviderExecute(r ApiLink
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2904,6 +2918,7 @@ func (a *IdentityProviderAPIService) LinkUserToIdentityProviderExecute(r ApiLink
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -3018,7 +3033,7 @@ func (a *IdentityProviderAPIService) ListCsrsForIdentityProviderExecute(r ApiLis
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListCsrsForIdentityProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/csrs"
@@ -3066,7 +3081,7 @@ func (a *IdentityProviderAPIService) ListCsrsForIdentityProviderExecute(r ApiLis
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3081,6 +3096,7 @@ func (a *IdentityProviderAPIService) ListCsrsForIdentityProviderExecute(r ApiLis
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3211,7 +3227,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderApplicationUsersExecute
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListIdentityProviderApplicationUsers")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/users"
@@ -3271,7 +3287,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderApplicationUsersExecute
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3286,6 +3302,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderApplicationUsersExecute
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3399,7 +3416,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderKeysExecute(r ApiListId
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListIdentityProviderKeys")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/credentials/keys"
@@ -3452,7 +3469,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderKeysExecute(r ApiListId
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3467,6 +3484,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderKeysExecute(r ApiListId
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3557,7 +3575,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderSigningKeysExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListIdentityProviderSigningKeys")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/keys"
@@ -3605,7 +3623,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderSigningKeysExecute(r Ap
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3620,6 +3638,7 @@ func (a *IdentityProviderAPIService) ListIdentityProviderSigningKeysExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3747,7 +3766,7 @@ func (a *IdentityProviderAPIService) ListIdentityProvidersExecute(r ApiListIdent
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListIdentityProviders")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps"
@@ -3806,7 +3825,7 @@ func (a *IdentityProviderAPIService) ListIdentityProvidersExecute(r ApiListIdent
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3821,6 +3840,7 @@ func (a *IdentityProviderAPIService) ListIdentityProvidersExecute(r ApiListIdent
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3914,7 +3934,7 @@ func (a *IdentityProviderAPIService) ListSocialAuthTokensExecute(r ApiListSocial
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ListSocialAuthTokens")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/users/{userId}/credentials/tokens"
@@ -3963,7 +3983,7 @@ func (a *IdentityProviderAPIService) ListSocialAuthTokensExecute(r ApiListSocial
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3978,6 +3998,7 @@ func (a *IdentityProviderAPIService) ListSocialAuthTokensExecute(r ApiListSocial
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4089,7 +4110,7 @@ func (a *IdentityProviderAPIService) PublishCsrForIdentityProviderExecute(r ApiP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.PublishCsrForIdentityProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}/lifecycle/publish"
@@ -4143,7 +4164,7 @@ func (a *IdentityProviderAPIService) PublishCsrForIdentityProviderExecute(r ApiP
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4158,6 +4179,7 @@ func (a *IdentityProviderAPIService) PublishCsrForIdentityProviderExecute(r ApiP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -4278,7 +4300,7 @@ func (a *IdentityProviderAPIService) ReplaceIdentityProviderExecute(r ApiReplace
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.ReplaceIdentityProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}"
@@ -4331,7 +4353,7 @@ func (a *IdentityProviderAPIService) ReplaceIdentityProviderExecute(r ApiReplace
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4346,6 +4368,7 @@ func (a *IdentityProviderAPIService) ReplaceIdentityProviderExecute(r ApiReplace
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -4461,7 +4484,7 @@ func (a *IdentityProviderAPIService) RevokeCsrForIdentityProviderExecute(r ApiRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.RevokeCsrForIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}"
@@ -4510,7 +4533,7 @@ func (a *IdentityProviderAPIService) RevokeCsrForIdentityProviderExecute(r ApiRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4525,6 +4548,7 @@ func (a *IdentityProviderAPIService) RevokeCsrForIdentityProviderExecute(r ApiRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -4618,7 +4642,7 @@ func (a *IdentityProviderAPIService) UnlinkUserFromIdentityProviderExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityProviderAPIService.UnlinkUserFromIdentityProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/idps/{idpId}/users/{userId}"
@@ -4667,7 +4691,7 @@ func (a *IdentityProviderAPIService) UnlinkUserFromIdentityProviderExecute(r Api
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -4682,6 +4706,7 @@ func (a *IdentityProviderAPIService) UnlinkUserFromIdentityProviderExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -221,7 +221,7 @@ func (a *InlineHookAPIService) ActivateInlineHookExecute(r ApiActivateInlineHook
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "InlineHookAPIService.ActivateInlineHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/inlineHooks/{inlineHookId}/lifecycle/activate"
@@ -269,7 +269,7 @@ func (a *InlineHookAPIService) ActivateInlineHookExecute(r ApiActivateInlineHook
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -284,6 +284,7 @@ func (a *InlineHookAPIService) ActivateInlineHookExecute(r ApiActivateInlineHook
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -389,7 +390,7 @@ func (a *InlineHookAPIService) CreateInlineHookExecute(r ApiCreateInlineHookRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "InlineHookAPIService.CreateInlineHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/inlineHooks"
@@ -441,7 +442,7 @@ func (a *InlineHookAPIService) CreateInlineHookExecute(r ApiCreateInlineHookRequ
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -456,6 +457,7 @@ func (a *InlineHookAPIService) CreateInlineHookExecute(r ApiCreateInlineHookRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -558,7 +560,7 @@ func (a *InlineHookAPIService) DeactivateInlineHookExecute(r ApiDeactivateInline
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "InlineHookAPIService.DeactivateInlineHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/inlineHooks/{inlineHookId}/lifecycle/deactivate"
@@ -606,7 +608,7 @@ func (a *InlineHookAPIService) DeactivateInlineHookExecute(r ApiDeactivateInline
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -621,6 +623,7 @@ func (a *InlineHookAPIService) DeactivateInlineHookExecute(r ApiDeactivateInline
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -721,7 +724,7 @@ func (a *InlineHookAPIService) DeleteInlineHookExecute(r ApiDeleteInlineHookRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "InlineHookAPIService.DeleteInlineHook")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/inlineHooks/{inlineHookId}"
@@ -769,7 +772,7 @@ func (a *InlineHookAPIService) DeleteInlineHookExecute(r ApiDeleteInlineHookRequ
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -784,6 +787,7 @@ func (a *InlineHookAPIService) DeleteInlineHookExecute(r ApiDeleteInlineHookRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -882,7 +886,7 @@ func (a *InlineHookAPIService) ExecuteInlineHookExecute(r ApiExecuteInlineHookRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "InlineHookAPIService.ExecuteInlineHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/inlineHooks/{inlineHookId}/execute"
@@ -935,7 +939,7 @@ func (a *InlineHookAPIService) ExecuteInlineHookExecute(r ApiExecuteInlineHookRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -950,6 +954,7 @@ func (a *InlineHookAPIService) ExecuteInlineHookExecute(r ApiExecuteInlineHookRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1064,7 +1069,7 @@ func (a *InlineHookAPIService) GetInlineHookExecute(r ApiGetInlineHookRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "InlineHookAPIService.GetInlineHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/inlineHooks/{inlineHookId}"
@@ -1112,7 +1117,7 @@ func (a *InlineHookAPIService) GetInlineHookExecute(r ApiGetInlineHookRequest) (
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1127,6 +1132,7 @@ func (a *InlineHookAPIService) GetInlineHookExecute(r ApiGetInlineHookRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1232,7 +1238,7 @@ func (a *InlineHookAPIService) ListInlineHooksExecute(r ApiListInlineHooksReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "InlineHookAPIService.ListInlineHooks")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/inlineHooks"
@@ -1282,7 +1288,7 @@ func (a *InlineHookAPIService) ListInlineHooksExecute(r ApiListInlineHooksReques
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1297,6 +1303,7 @@ func (a *InlineHookAPIService) ListInlineHooksExecute(r ApiListInlineHooksReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1393,7 +1400,7 @@ func (a *InlineHookAPIService) ReplaceInlineHookExecute(r ApiReplaceInlineHookRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "InlineHookAPIService.ReplaceInlineHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/inlineHooks/{inlineHookId}"
@@ -1446,7 +1453,7 @@ func (a *InlineHookAPIService) ReplaceInlineHookExecute(r ApiReplaceInlineHookRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1461,6 +1468,7 @@ func (a *InlineHookAPIService) ReplaceInlineHookExecute(r ApiReplaceInlineHookRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1581,7 +1589,7 @@ func (a *InlineHookAPIService) UpdateInlineHookExecute(r ApiUpdateInlineHookRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "InlineHookAPIService.UpdateInlineHook")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/inlineHooks/{inlineHookId}"
@@ -1634,7 +1642,7 @@ func (a *InlineHookAPIService) UpdateInlineHookExecute(r ApiUpdateInlineHookRequ
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1649,6 +1657,7 @@ func (a *InlineHookAPIService) UpdateInlineHookExecute(r ApiUpdateInlineHookRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
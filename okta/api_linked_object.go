@@ -149,7 +149,7 @@ func (a *LinkedObjectAPIService) CreateLinkedObjectDefinitionExecute(r ApiCreate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LinkedObjectAPIService.CreateLinkedObjectDefinition")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/user/linkedObjects"
@@ -201,7 +201,7 @@ func (a *LinkedObjectAPIService) CreateLinkedObjectDefinitionExecute(r ApiCreate
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -216,6 +216,7 @@ func (a *LinkedObjectAPIService) CreateLinkedObjectDefinitionExecute(r ApiCreate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -328,7 +329,7 @@ func (a *LinkedObjectAPIService) DeleteLinkedObjectDefinitionExecute(r ApiDelete
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LinkedObjectAPIService.DeleteLinkedObjectDefinition")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/user/linkedObjects/{linkedObjectName}"
@@ -376,7 +377,7 @@ func (a *LinkedObjectAPIService) DeleteLinkedObjectDefinitionExecute(r ApiDelete
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -391,6 +392,7 @@ func (a *LinkedObjectAPIService) DeleteLinkedObjectDefinitionExecute(r ApiDelete
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -483,7 +485,7 @@ func (a *LinkedObjectAPIService) GetLinkedObjectDefinitionExecute(r ApiGetLinked
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LinkedObjectAPIService.GetLinkedObjectDefinition")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/user/linkedObjects/{linkedObjectName}"
@@ -531,7 +533,7 @@ func (a *LinkedObjectAPIService) GetLinkedObjectDefinitionExecute(r ApiGetLinked
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -546,6 +548,7 @@ func (a *LinkedObjectAPIService) GetLinkedObjectDefinitionExecute(r ApiGetLinked
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -645,7 +648,7 @@ func (a *LinkedObjectAPIService) ListLinkedObjectDefinitionsExecute(r ApiListLin
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LinkedObjectAPIService.ListLinkedObjectDefinitions")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/schemas/user/linkedObjects"
@@ -692,7 +695,7 @@ func (a *LinkedObjectAPIService) ListLinkedObjectDefinitionsExecute(r ApiListLin
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -707,6 +710,7 @@ func (a *LinkedObjectAPIService) ListLinkedObjectDefinitionsExecute(r ApiListLin
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
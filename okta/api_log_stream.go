@@ -199,7 +199,7 @@ func (a *LogStreamAPIService) ActivateLogStreamExecute(r ApiActivateLogStreamReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.ActivateLogStream")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams/{logStreamId}/lifecycle/activate"
@@ -247,7 +247,7 @@ func (a *LogStreamAPIService) ActivateLogStreamExecute(r ApiActivateLogStreamReq
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -262,6 +262,7 @@ func (a *LogStreamAPIService) ActivateLogStreamExecute(r ApiActivateLogStreamReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -367,7 +368,7 @@ func (a *LogStreamAPIService) CreateLogStreamExecute(r ApiCreateLogStreamRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.CreateLogStream")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams"
@@ -419,7 +420,7 @@ func (a *LogStreamAPIService) CreateLogStreamExecute(r ApiCreateLogStreamRequest
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -434,6 +435,7 @@ func (a *LogStreamAPIService) CreateLogStreamExecute(r ApiCreateLogStreamRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -536,7 +538,7 @@ func (a *LogStreamAPIService) DeactivateLogStreamExecute(r ApiDeactivateLogStrea
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.DeactivateLogStream")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams/{logStreamId}/lifecycle/deactivate"
@@ -584,7 +586,7 @@ func (a *LogStreamAPIService) DeactivateLogStreamExecute(r ApiDeactivateLogStrea
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -599,6 +601,7 @@ func (a *LogStreamAPIService) DeactivateLogStreamExecute(r ApiDeactivateLogStrea
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -699,7 +702,7 @@ func (a *LogStreamAPIService) DeleteLogStreamExecute(r ApiDeleteLogStreamRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.DeleteLogStream")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams/{logStreamId}"
@@ -747,7 +750,7 @@ func (a *LogStreamAPIService) DeleteLogStreamExecute(r ApiDeleteLogStreamRequest
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -762,6 +765,7 @@ func (a *LogStreamAPIService) DeleteLogStreamExecute(r ApiDeleteLogStreamRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -854,7 +858,7 @@ func (a *LogStreamAPIService) GetLogStreamExecute(r ApiGetLogStreamRequest) (*Li
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.GetLogStream")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams/{logStreamId}"
@@ -902,7 +906,7 @@ func (a *LogStreamAPIService) GetLogStreamExecute(r ApiGetLogStreamRequest) (*Li
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -917,6 +921,7 @@ func (a *LogStreamAPIService) GetLogStreamExecute(r ApiGetLogStreamRequest) (*Li
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1037,7 +1042,7 @@ func (a *LogStreamAPIService) ListLogStreamsExecute(r ApiListLogStreamsRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.ListLogStreams")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams"
@@ -1093,7 +1098,7 @@ func (a *LogStreamAPIService) ListLogStreamsExecute(r ApiListLogStreamsRequest)
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1108,6 +1113,7 @@ func (a *LogStreamAPIService) ListLogStreamsExecute(r ApiListLogStreamsRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1212,7 +1218,7 @@ func (a *LogStreamAPIService) ReplaceLogStreamExecute(r ApiReplaceLogStreamReque
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LogStreamAPIService.ReplaceLogStream")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logStreams/{logStreamId}"
@@ -1265,7 +1271,7 @@ func (a *LogStreamAPIService) ReplaceLogStreamExecute(r ApiReplaceLogStreamReque
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1280,6 +1286,7 @@ func (a *LogStreamAPIService) ReplaceLogStreamExecute(r ApiReplaceLogStreamReque
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
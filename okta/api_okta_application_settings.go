@@ -119,7 +119,7 @@ func (a *OktaApplicationSettingsAPIService) GetFirstPartyAppSettingsExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OktaApplicationSettingsAPIService.GetFirstPartyAppSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/first-party-app-settings/{appName}"
@@ -167,7 +167,7 @@ func (a *OktaApplicationSettingsAPIService) GetFirstPartyAppSettingsExecute(r Ap
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -182,6 +182,7 @@ func (a *OktaApplicationSettingsAPIService) GetFirstPartyAppSettingsExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -278,7 +279,7 @@ func (a *OktaApplicationSettingsAPIService) ReplaceFirstPartyAppSettingsExecute(
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OktaApplicationSettingsAPIService.ReplaceFirstPartyAppSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/first-party-app-settings/{appName}"
@@ -331,7 +332,7 @@ func (a *OktaApplicationSettingsAPIService) ReplaceFirstPartyAppSettingsExecute(
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -346,6 +347,7 @@ func (a *OktaApplicationSettingsAPIService) ReplaceFirstPartyAppSettingsExecute(
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
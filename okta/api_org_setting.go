@@ -416,7 +416,7 @@ func (a *OrgSettingAPIService) AssignClientPrivilegesSettingExecute(r ApiAssignC
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.AssignClientPrivilegesSetting")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/settings/clientPrivilegesSetting"
@@ -465,7 +465,7 @@ func (a *OrgSettingAPIService) AssignClientPrivilegesSettingExecute(r ApiAssignC
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -480,6 +480,7 @@ func (a *OrgSettingAPIService) AssignClientPrivilegesSettingExecute(r ApiAssignC
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -573,7 +574,7 @@ func (a *OrgSettingAPIService) BulkRemoveEmailAddressBouncesExecute(r ApiBulkRem
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.BulkRemoveEmailAddressBounces")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/email/bounces/remove-list"
@@ -622,7 +623,7 @@ func (a *OrgSettingAPIService) BulkRemoveEmailAddressBouncesExecute(r ApiBulkRem
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -637,6 +638,7 @@ func (a *OrgSettingAPIService) BulkRemoveEmailAddressBouncesExecute(r ApiBulkRem
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -736,7 +738,7 @@ func (a *OrgSettingAPIService) ExtendOktaSupportExecute(r ApiExtendOktaSupportRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.ExtendOktaSupport")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaSupport/extend"
@@ -783,7 +785,7 @@ func (a *OrgSettingAPIService) ExtendOktaSupportExecute(r ApiExtendOktaSupportRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -798,6 +800,7 @@ func (a *OrgSettingAPIService) ExtendOktaSupportExecute(r ApiExtendOktaSupportRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -885,7 +888,7 @@ func (a *OrgSettingAPIService) GetClientPrivilegesSettingExecute(r ApiGetClientP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetClientPrivilegesSetting")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/settings/clientPrivilegesSetting"
@@ -932,7 +935,7 @@ func (a *OrgSettingAPIService) GetClientPrivilegesSettingExecute(r ApiGetClientP
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -947,6 +950,7 @@ func (a *OrgSettingAPIService) GetClientPrivilegesSettingExecute(r ApiGetClientP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1034,7 +1038,7 @@ func (a *OrgSettingAPIService) GetOktaCommunicationSettingsExecute(r ApiGetOktaC
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOktaCommunicationSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaCommunication"
@@ -1081,7 +1085,7 @@ func (a *OrgSettingAPIService) GetOktaCommunicationSettingsExecute(r ApiGetOktaC
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1096,6 +1100,7 @@ func (a *OrgSettingAPIService) GetOktaCommunicationSettingsExecute(r ApiGetOktaC
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1183,7 +1188,7 @@ func (a *OrgSettingAPIService) GetOrgContactTypesExecute(r ApiGetOrgContactTypes
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOrgContactTypes")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/contacts"
@@ -1230,7 +1235,7 @@ func (a *OrgSettingAPIService) GetOrgContactTypesExecute(r ApiGetOrgContactTypes
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1245,6 +1250,7 @@ func (a *OrgSettingAPIService) GetOrgContactTypesExecute(r ApiGetOrgContactTypes
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1335,7 +1341,7 @@ func (a *OrgSettingAPIService) GetOrgContactUserExecute(r ApiGetOrgContactUserRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOrgContactUser")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/contacts/{contactType}"
@@ -1383,7 +1389,7 @@ func (a *OrgSettingAPIService) GetOrgContactUserExecute(r ApiGetOrgContactUserRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1398,6 +1404,7 @@ func (a *OrgSettingAPIService) GetOrgContactUserExecute(r ApiGetOrgContactUserRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1497,7 +1504,7 @@ func (a *OrgSettingAPIService) GetOrgOktaSupportSettingsExecute(r ApiGetOrgOktaS
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOrgOktaSupportSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaSupport"
@@ -1544,7 +1551,7 @@ func (a *OrgSettingAPIService) GetOrgOktaSupportSettingsExecute(r ApiGetOrgOktaS
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1559,6 +1566,7 @@ func (a *OrgSettingAPIService) GetOrgOktaSupportSettingsExecute(r ApiGetOrgOktaS
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1646,7 +1654,7 @@ func (a *OrgSettingAPIService) GetOrgPreferencesExecute(r ApiGetOrgPreferencesRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOrgPreferences")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/preferences"
@@ -1693,7 +1701,7 @@ func (a *OrgSettingAPIService) GetOrgPreferencesExecute(r ApiGetOrgPreferencesRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1708,6 +1716,7 @@ func (a *OrgSettingAPIService) GetOrgPreferencesExecute(r ApiGetOrgPreferencesRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1795,7 +1804,7 @@ func (a *OrgSettingAPIService) GetOrgSettingsExecute(r ApiGetOrgSettingsRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetOrgSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org"
@@ -1842,7 +1851,7 @@ func (a *OrgSettingAPIService) GetOrgSettingsExecute(r ApiGetOrgSettingsRequest)
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1857,6 +1866,7 @@ func (a *OrgSettingAPIService) GetOrgSettingsExecute(r ApiGetOrgSettingsRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1944,7 +1954,7 @@ func (a *OrgSettingAPIService) GetThirdPartyAdminSettingExecute(r ApiGetThirdPar
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetThirdPartyAdminSetting")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/orgSettings/thirdPartyAdminSetting"
@@ -1991,7 +2001,7 @@ func (a *OrgSettingAPIService) GetThirdPartyAdminSettingExecute(r ApiGetThirdPar
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2006,6 +2016,7 @@ func (a *OrgSettingAPIService) GetThirdPartyAdminSettingExecute(r ApiGetThirdPar
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2093,7 +2104,7 @@ func (a *OrgSettingAPIService) GetWellknownOrgMetadataExecute(r ApiGetWellknownO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GetWellknownOrgMetadata")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/.well-known/okta-organization"
@@ -2126,7 +2137,7 @@ func (a *OrgSettingAPIService) GetWellknownOrgMetadataExecute(r ApiGetWellknownO
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2141,6 +2152,7 @@ func (a *OrgSettingAPIService) GetWellknownOrgMetadataExecute(r ApiGetWellknownO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 429 {
 			var v Error
@@ -2216,7 +2228,7 @@ func (a *OrgSettingAPIService) GrantOktaSupportExecute(r ApiGrantOktaSupportRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.GrantOktaSupport")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaSupport/grant"
@@ -2263,7 +2275,7 @@ func (a *OrgSettingAPIService) GrantOktaSupportExecute(r ApiGrantOktaSupportRequ
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2278,6 +2290,7 @@ func (a *OrgSettingAPIService) GrantOktaSupportExecute(r ApiGrantOktaSupportRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2365,7 +2378,7 @@ func (a *OrgSettingAPIService) OptInUsersToOktaCommunicationEmailsExecute(r ApiO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.OptInUsersToOktaCommunicationEmails")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaCommunication/optIn"
@@ -2412,7 +2425,7 @@ func (a *OrgSettingAPIService) OptInUsersToOktaCommunicationEmailsExecute(r ApiO
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2427,6 +2440,7 @@ func (a *OrgSettingAPIService) OptInUsersToOktaCommunicationEmailsExecute(r ApiO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2514,7 +2528,7 @@ func (a *OrgSettingAPIService) OptOutUsersFromOktaCommunicationEmailsExecute(r A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.OptOutUsersFromOktaCommunicationEmails")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaCommunication/optOut"
@@ -2561,7 +2575,7 @@ func (a *OrgSettingAPIService) OptOutUsersFromOktaCommunicationEmailsExecute(r A
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2576,6 +2590,7 @@ func (a *OrgSettingAPIService) OptOutUsersFromOktaCommunicationEmailsExecute(r A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2672,7 +2687,7 @@ func (a *OrgSettingAPIService) ReplaceOrgContactUserExecute(r ApiReplaceOrgConta
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.ReplaceOrgContactUser")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/contacts/{contactType}"
@@ -2725,7 +2740,7 @@ func (a *OrgSettingAPIService) ReplaceOrgContactUserExecute(r ApiReplaceOrgConta
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2740,6 +2755,7 @@ func (a *OrgSettingAPIService) ReplaceOrgContactUserExecute(r ApiReplaceOrgConta
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2857,7 +2873,7 @@ func (a *OrgSettingAPIService) ReplaceOrgSettingsExecute(r ApiReplaceOrgSettings
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.ReplaceOrgSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org"
@@ -2909,7 +2925,7 @@ func (a *OrgSettingAPIService) ReplaceOrgSettingsExecute(r ApiReplaceOrgSettings
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2924,6 +2940,7 @@ func (a *OrgSettingAPIService) ReplaceOrgSettingsExecute(r ApiReplaceOrgSettings
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -3023,7 +3040,7 @@ func (a *OrgSettingAPIService) RevokeOktaSupportExecute(r ApiRevokeOktaSupportRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.RevokeOktaSupport")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/privacy/oktaSupport/revoke"
@@ -3070,7 +3087,7 @@ func (a *OrgSettingAPIService) RevokeOktaSupportExecute(r ApiRevokeOktaSupportRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3085,6 +3102,7 @@ func (a *OrgSettingAPIService) RevokeOktaSupportExecute(r ApiRevokeOktaSupportRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3172,7 +3190,7 @@ func (a *OrgSettingAPIService) UpdateOrgHideOktaUIFooterExecute(r ApiUpdateOrgHi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.UpdateOrgHideOktaUIFooter")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/preferences/hideEndUserFooter"
@@ -3219,7 +3237,7 @@ func (a *OrgSettingAPIService) UpdateOrgHideOktaUIFooterExecute(r ApiUpdateOrgHi
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3234,6 +3252,7 @@ func (a *OrgSettingAPIService) UpdateOrgHideOktaUIFooterExecute(r ApiUpdateOrgHi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3327,7 +3346,7 @@ func (a *OrgSettingAPIService) UpdateOrgSettingsExecute(r ApiUpdateOrgSettingsRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.UpdateOrgSettings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org"
@@ -3376,7 +3395,7 @@ func (a *OrgSettingAPIService) UpdateOrgSettingsExecute(r ApiUpdateOrgSettingsRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3391,6 +3410,7 @@ func (a *OrgSettingAPIService) UpdateOrgSettingsExecute(r ApiUpdateOrgSettingsRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -3490,7 +3510,7 @@ func (a *OrgSettingAPIService) UpdateOrgShowOktaUIFooterExecute(r ApiUpdateOrgSh
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.UpdateOrgShowOktaUIFooter")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/preferences/showEndUserFooter"
@@ -3537,7 +3557,7 @@ func (a *OrgSettingAPIService) UpdateOrgShowOktaUIFooterExecute(r ApiUpdateOrgSh
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3552,6 +3572,7 @@ func (a *OrgSettingAPIService) UpdateOrgShowOktaUIFooterExecute(r ApiUpdateOrgSh
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3639,7 +3660,7 @@ func (a *OrgSettingAPIService) UpdateThirdPartyAdminSettingExecute(r ApiUpdateTh
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.UpdateThirdPartyAdminSetting")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/orgSettings/thirdPartyAdminSetting"
@@ -3686,7 +3707,7 @@ func (a *OrgSettingAPIService) UpdateThirdPartyAdminSettingExecute(r ApiUpdateTh
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3701,6 +3722,7 @@ func (a *OrgSettingAPIService) UpdateThirdPartyAdminSettingExecute(r ApiUpdateTh
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -3792,7 +3814,7 @@ func (a *OrgSettingAPIService) UploadOrgLogoExecute(r ApiUploadOrgLogoRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrgSettingAPIService.UploadOrgLogo")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/org/logo"
@@ -3856,7 +3878,7 @@ func (a *OrgSettingAPIService) UploadOrgLogoExecute(r ApiUploadOrgLogoRequest) (
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -3871,6 +3893,7 @@ func (a *OrgSettingAPIService) UploadOrgLogoExecute(r ApiUploadOrgLogoRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
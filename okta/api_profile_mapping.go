@@ -137,7 +137,7 @@ func (a *ProfileMappingAPIService) GetProfileMappingExecute(r ApiGetProfileMappi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProfileMappingAPIService.GetProfileMapping")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/mappings/{mappingId}"
@@ -185,7 +185,7 @@ func (a *ProfileMappingAPIService) GetProfileMappingExecute(r ApiGetProfileMappi
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -200,6 +200,7 @@ func (a *ProfileMappingAPIService) GetProfileMappingExecute(r ApiGetProfileMappi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -331,7 +332,7 @@ func (a *ProfileMappingAPIService) ListProfileMappingsExecute(r ApiListProfileMa
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProfileMappingAPIService.ListProfileMappings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/mappings"
@@ -390,7 +391,7 @@ func (a *ProfileMappingAPIService) ListProfileMappingsExecute(r ApiListProfileMa
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -405,6 +406,7 @@ func (a *ProfileMappingAPIService) ListProfileMappingsExecute(r ApiListProfileMa
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -501,7 +503,7 @@ func (a *ProfileMappingAPIService) UpdateProfileMappingExecute(r ApiUpdateProfil
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProfileMappingAPIService.UpdateProfileMapping")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/mappings/{mappingId}"
@@ -554,7 +556,7 @@ func (a *ProfileMappingAPIService) UpdateProfileMappingExecute(r ApiUpdateProfil
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -569,6 +571,7 @@ func (a *ProfileMappingAPIService) UpdateProfileMappingExecute(r ApiUpdateProfil
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -164,7 +164,7 @@ func (a *PushProviderAPIService) CreatePushProviderExecute(r ApiCreatePushProvid
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PushProviderAPIService.CreatePushProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/push-providers"
@@ -216,7 +216,7 @@ func (a *PushProviderAPIService) CreatePushProviderExecute(r ApiCreatePushProvid
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -231,6 +231,7 @@ func (a *PushProviderAPIService) CreatePushProviderExecute(r ApiCreatePushProvid
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -331,7 +332,7 @@ func (a *PushProviderAPIService) DeletePushProviderExecute(r ApiDeletePushProvid
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PushProviderAPIService.DeletePushProvider")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/push-providers/{pushProviderId}"
@@ -379,7 +380,7 @@ func (a *PushProviderAPIService) DeletePushProviderExecute(r ApiDeletePushProvid
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -394,6 +395,7 @@ func (a *PushProviderAPIService) DeletePushProviderExecute(r ApiDeletePushProvid
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -498,7 +500,7 @@ func (a *PushProviderAPIService) GetPushProviderExecute(r ApiGetPushProviderRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PushProviderAPIService.GetPushProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/push-providers/{pushProviderId}"
@@ -546,7 +548,7 @@ func (a *PushProviderAPIService) GetPushProviderExecute(r ApiGetPushProviderRequ
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -561,6 +563,7 @@ func (a *PushProviderAPIService) GetPushProviderExecute(r ApiGetPushProviderRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -667,7 +670,7 @@ func (a *PushProviderAPIService) ListPushProvidersExecute(r ApiListPushProviders
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PushProviderAPIService.ListPushProviders")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/push-providers"
@@ -717,7 +720,7 @@ func (a *PushProviderAPIService) ListPushProvidersExecute(r ApiListPushProviders
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -732,6 +735,7 @@ func (a *PushProviderAPIService) ListPushProvidersExecute(r ApiListPushProviders
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -828,7 +832,7 @@ func (a *PushProviderAPIService) ReplacePushProviderExecute(r ApiReplacePushProv
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PushProviderAPIService.ReplacePushProvider")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/push-providers/{pushProviderId}"
@@ -881,7 +885,7 @@ func (a *PushProviderAPIService) ReplacePushProviderExecute(r ApiReplacePushProv
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -896,6 +900,7 @@ func (a *PushProviderAPIService) ReplacePushProviderExecute(r ApiReplacePushProv
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
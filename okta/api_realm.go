@@ -164,7 +164,7 @@ func (a *RealmAPIService) CreateRealmExecute(r ApiCreateRealmRequest) (*Realm, *
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAPIService.CreateRealm")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realms"
@@ -216,7 +216,7 @@ func (a *RealmAPIService) CreateRealmExecute(r ApiCreateRealmRequest) (*Realm, *
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -231,6 +231,7 @@ func (a *RealmAPIService) CreateRealmExecute(r ApiCreateRealmRequest) (*Realm, *
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -331,7 +332,7 @@ func (a *RealmAPIService) DeleteRealmExecute(r ApiDeleteRealmRequest) (*APIRespo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAPIService.DeleteRealm")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realms/{realmId}"
@@ -379,7 +380,7 @@ func (a *RealmAPIService) DeleteRealmExecute(r ApiDeleteRealmRequest) (*APIRespo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -394,6 +395,7 @@ func (a *RealmAPIService) DeleteRealmExecute(r ApiDeleteRealmRequest) (*APIRespo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -498,7 +500,7 @@ func (a *RealmAPIService) GetRealmExecute(r ApiGetRealmRequest) (*Realm, *APIRes
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAPIService.GetRealm")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realms/{realmId}"
@@ -546,7 +548,7 @@ func (a *RealmAPIService) GetRealmExecute(r ApiGetRealmRequest) (*Realm, *APIRes
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -561,6 +563,7 @@ func (a *RealmAPIService) GetRealmExecute(r ApiGetRealmRequest) (*Realm, *APIRes
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -707,7 +710,7 @@ func (a *RealmAPIService) ListRealmsExecute(r ApiListRealmsRequest) ([]Realm, *A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAPIService.ListRealms")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realms"
@@ -769,7 +772,7 @@ func (a *RealmAPIService) ListRealmsExecute(r ApiListRealmsRequest) ([]Realm, *A
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -784,6 +787,7 @@ func (a *RealmAPIService) ListRealmsExecute(r ApiListRealmsRequest) ([]Realm, *A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -880,7 +884,7 @@ func (a *RealmAPIService) ReplaceRealmExecute(r ApiReplaceRealmRequest) (*Realm,
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAPIService.ReplaceRealm")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realms/{realmId}"
@@ -933,7 +937,7 @@ func (a *RealmAPIService) ReplaceRealmExecute(r ApiReplaceRealmRequest) (*Realm,
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -948,6 +952,7 @@ func (a *RealmAPIService) ReplaceRealmExecute(r ApiReplaceRealmRequest) (*Realm,
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -215,7 +215,7 @@ func (a *RealmAssignmentAPIService) ActivateRealmAssignmentExecute(r ApiActivate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.ActivateRealmAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/{assignmentId}/lifecycle/activate"
@@ -263,7 +263,7 @@ func (a *RealmAssignmentAPIService) ActivateRealmAssignmentExecute(r ApiActivate
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -278,6 +278,7 @@ func (a *RealmAssignmentAPIService) ActivateRealmAssignmentExecute(r ApiActivate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -385,7 +386,7 @@ func (a *RealmAssignmentAPIService) CreateRealmAssignmentExecute(r ApiCreateReal
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.CreateRealmAssignment")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments"
@@ -437,7 +438,7 @@ func (a *RealmAssignmentAPIService) CreateRealmAssignmentExecute(r ApiCreateReal
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -452,6 +453,7 @@ func (a *RealmAssignmentAPIService) CreateRealmAssignmentExecute(r ApiCreateReal
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -552,7 +554,7 @@ func (a *RealmAssignmentAPIService) DeactivateRealmAssignmentExecute(r ApiDeacti
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.DeactivateRealmAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/{assignmentId}/lifecycle/deactivate"
@@ -600,7 +602,7 @@ func (a *RealmAssignmentAPIService) DeactivateRealmAssignmentExecute(r ApiDeacti
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -615,6 +617,7 @@ func (a *RealmAssignmentAPIService) DeactivateRealmAssignmentExecute(r ApiDeacti
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -717,7 +720,7 @@ func (a *RealmAssignmentAPIService) DeleteRealmAssignmentExecute(r ApiDeleteReal
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.DeleteRealmAssignment")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/{assignmentId}"
@@ -765,7 +768,7 @@ func (a *RealmAssignmentAPIService) DeleteRealmAssignmentExecute(r ApiDeleteReal
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -780,6 +783,7 @@ func (a *RealmAssignmentAPIService) DeleteRealmAssignmentExecute(r ApiDeleteReal
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -887,7 +891,7 @@ func (a *RealmAssignmentAPIService) ExecuteRealmAssignmentExecute(r ApiExecuteRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.ExecuteRealmAssignment")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/operations"
@@ -939,7 +943,7 @@ func (a *RealmAssignmentAPIService) ExecuteRealmAssignmentExecute(r ApiExecuteRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -954,6 +958,7 @@ func (a *RealmAssignmentAPIService) ExecuteRealmAssignmentExecute(r ApiExecuteRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1056,7 +1061,7 @@ func (a *RealmAssignmentAPIService) GetRealmAssignmentExecute(r ApiGetRealmAssig
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.GetRealmAssignment")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/{assignmentId}"
@@ -1104,7 +1109,7 @@ func (a *RealmAssignmentAPIService) GetRealmAssignmentExecute(r ApiGetRealmAssig
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1119,6 +1124,7 @@ func (a *RealmAssignmentAPIService) GetRealmAssignmentExecute(r ApiGetRealmAssig
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1244,7 +1250,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentOperationsExecute(r ApiLi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.ListRealmAssignmentOperations")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/operations"
@@ -1297,7 +1303,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentOperationsExecute(r ApiLi
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1312,6 +1318,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentOperationsExecute(r ApiLi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1413,7 +1420,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentsExecute(r ApiListRealmAs
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.ListRealmAssignments")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments"
@@ -1466,7 +1473,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentsExecute(r ApiListRealmAs
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1481,6 +1488,7 @@ func (a *RealmAssignmentAPIService) ListRealmAssignmentsExecute(r ApiListRealmAs
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1577,7 +1585,7 @@ func (a *RealmAssignmentAPIService) ReplaceRealmAssignmentExecute(r ApiReplaceRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RealmAssignmentAPIService.ReplaceRealmAssignment")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/realm-assignments/{assignmentId}"
@@ -1630,7 +1638,7 @@ func (a *RealmAssignmentAPIService) ReplaceRealmAssignmentExecute(r ApiReplaceRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1645,6 +1653,7 @@ func (a *RealmAssignmentAPIService) ReplaceRealmAssignmentExecute(r ApiReplaceRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -342,7 +342,7 @@ func (a *ResourceSetAPIService) AddMembersToBindingExecute(r ApiAddMembersToBind
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.AddMembersToBinding")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members"
@@ -396,7 +396,7 @@ func (a *ResourceSetAPIService) AddMembersToBindingExecute(r ApiAddMembersToBind
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -411,6 +411,7 @@ func (a *ResourceSetAPIService) AddMembersToBindingExecute(r ApiAddMembersToBind
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -531,7 +532,7 @@ func (a *ResourceSetAPIService) AddResourceSetResourceExecute(r ApiAddResourceSe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.AddResourceSetResource")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/resources"
@@ -584,7 +585,7 @@ func (a *ResourceSetAPIService) AddResourceSetResourceExecute(r ApiAddResourceSe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -599,6 +600,7 @@ func (a *ResourceSetAPIService) AddResourceSetResourceExecute(r ApiAddResourceSe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -717,7 +719,7 @@ func (a *ResourceSetAPIService) CreateResourceSetExecute(r ApiCreateResourceSetR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.CreateResourceSet")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets"
@@ -769,7 +771,7 @@ func (a *ResourceSetAPIService) CreateResourceSetExecute(r ApiCreateResourceSetR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -784,6 +786,7 @@ func (a *ResourceSetAPIService) CreateResourceSetExecute(r ApiCreateResourceSetR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -892,7 +895,7 @@ func (a *ResourceSetAPIService) CreateResourceSetBindingExecute(r ApiCreateResou
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.CreateResourceSetBinding")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings"
@@ -945,7 +948,7 @@ func (a *ResourceSetAPIService) CreateResourceSetBindingExecute(r ApiCreateResou
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -960,6 +963,7 @@ func (a *ResourceSetAPIService) CreateResourceSetBindingExecute(r ApiCreateResou
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1075,7 +1079,7 @@ func (a *ResourceSetAPIService) DeleteBindingExecute(r ApiDeleteBindingRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.DeleteBinding")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}"
@@ -1124,7 +1128,7 @@ func (a *ResourceSetAPIService) DeleteBindingExecute(r ApiDeleteBindingRequest)
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1139,6 +1143,7 @@ func (a *ResourceSetAPIService) DeleteBindingExecute(r ApiDeleteBindingRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1229,7 +1234,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetExecute(r ApiDeleteResourceSetR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.DeleteResourceSet")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}"
@@ -1277,7 +1282,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetExecute(r ApiDeleteResourceSetR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1292,6 +1297,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetExecute(r ApiDeleteResourceSetR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1385,7 +1391,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetResourceExecute(r ApiDeleteReso
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.DeleteResourceSetResource")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/resources/{resourceId}"
@@ -1434,7 +1440,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetResourceExecute(r ApiDeleteReso
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1449,6 +1455,7 @@ func (a *ResourceSetAPIService) DeleteResourceSetResourceExecute(r ApiDeleteReso
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1544,7 +1551,7 @@ func (a *ResourceSetAPIService) GetBindingExecute(r ApiGetBindingRequest) (*Reso
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.GetBinding")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}"
@@ -1593,7 +1600,7 @@ func (a *ResourceSetAPIService) GetBindingExecute(r ApiGetBindingRequest) (*Reso
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1608,6 +1615,7 @@ func (a *ResourceSetAPIService) GetBindingExecute(r ApiGetBindingRequest) (*Reso
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1716,7 +1724,7 @@ func (a *ResourceSetAPIService) GetMemberOfBindingExecute(r ApiGetMemberOfBindin
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.GetMemberOfBinding")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members/{memberId}"
@@ -1766,7 +1774,7 @@ func (a *ResourceSetAPIService) GetMemberOfBindingExecute(r ApiGetMemberOfBindin
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1781,6 +1789,7 @@ func (a *ResourceSetAPIService) GetMemberOfBindingExecute(r ApiGetMemberOfBindin
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1883,7 +1892,7 @@ func (a *ResourceSetAPIService) GetResourceSetExecute(r ApiGetResourceSetRequest
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.GetResourceSet")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}"
@@ -1931,7 +1940,7 @@ func (a *ResourceSetAPIService) GetResourceSetExecute(r ApiGetResourceSetRequest
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1946,6 +1955,7 @@ func (a *ResourceSetAPIService) GetResourceSetExecute(r ApiGetResourceSetRequest
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2055,7 +2065,7 @@ func (a *ResourceSetAPIService) ListBindingsExecute(r ApiListBindingsRequest) (*
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.ListBindings")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings"
@@ -2106,7 +2116,7 @@ func (a *ResourceSetAPIService) ListBindingsExecute(r ApiListBindingsRequest) (*
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2121,6 +2131,7 @@ func (a *ResourceSetAPIService) ListBindingsExecute(r ApiListBindingsRequest) (*
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2233,7 +2244,7 @@ func (a *ResourceSetAPIService) ListMembersOfBindingExecute(r ApiListMembersOfBi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.ListMembersOfBinding")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members"
@@ -2285,7 +2296,7 @@ func (a *ResourceSetAPIService) ListMembersOfBindingExecute(r ApiListMembersOfBi
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2300,6 +2311,7 @@ func (a *ResourceSetAPIService) ListMembersOfBindingExecute(r ApiListMembersOfBi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2402,7 +2414,7 @@ func (a *ResourceSetAPIService) ListResourceSetResourcesExecute(r ApiListResourc
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.ListResourceSetResources")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/resources"
@@ -2450,7 +2462,7 @@ func (a *ResourceSetAPIService) ListResourceSetResourcesExecute(r ApiListResourc
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2465,6 +2477,7 @@ func (a *ResourceSetAPIService) ListResourceSetResourcesExecute(r ApiListResourc
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2571,7 +2584,7 @@ func (a *ResourceSetAPIService) ListResourceSetsExecute(r ApiListResourceSetsReq
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.ListResourceSets")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets"
@@ -2621,7 +2634,7 @@ func (a *ResourceSetAPIService) ListResourceSetsExecute(r ApiListResourceSetsReq
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2636,6 +2649,7 @@ func (a *ResourceSetAPIService) ListResourceSetsExecute(r ApiListResourceSetsReq
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2732,7 +2746,7 @@ func (a *ResourceSetAPIService) ReplaceResourceSetExecute(r ApiReplaceResourceSe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.ReplaceResourceSet")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}"
@@ -2785,7 +2799,7 @@ func (a *ResourceSetAPIService) ReplaceResourceSetExecute(r ApiReplaceResourceSe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2800,6 +2814,7 @@ func (a *ResourceSetAPIService) ReplaceResourceSetExecute(r ApiReplaceResourceSe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -2918,7 +2933,7 @@ func (a *ResourceSetAPIService) UnassignMemberFromBindingExecute(r ApiUnassignMe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ResourceSetAPIService.UnassignMemberFromBinding")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members/{memberId}"
@@ -2968,7 +2983,7 @@ func (a *ResourceSetAPIService) UnassignMemberFromBindingExecute(r ApiUnassignMe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2983,6 +2998,7 @@ func (a *ResourceSetAPIService) UnassignMemberFromBindingExecute(r ApiUnassignMe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
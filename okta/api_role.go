@@ -241,7 +241,7 @@ func (a *RoleAPIService) CreateRoleExecute(r ApiCreateRoleRequest) (*IamRole, *A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.CreateRole")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles"
@@ -293,7 +293,7 @@ func (a *RoleAPIService) CreateRoleExecute(r ApiCreateRoleRequest) (*IamRole, *A
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -308,6 +308,7 @@ func (a *RoleAPIService) CreateRoleExecute(r ApiCreateRoleRequest) (*IamRole, *A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -417,7 +418,7 @@ func (a *RoleAPIService) CreateRolePermissionExecute(r ApiCreateRolePermissionRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.CreateRolePermission")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}"
@@ -468,7 +469,7 @@ func (a *RoleAPIService) CreateRolePermissionExecute(r ApiCreateRolePermissionRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -483,6 +484,7 @@ func (a *RoleAPIService) CreateRolePermissionExecute(r ApiCreateRolePermissionRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -585,7 +587,7 @@ func (a *RoleAPIService) DeleteRoleExecute(r ApiDeleteRoleRequest) (*APIResponse
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.DeleteRole")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}"
@@ -633,7 +635,7 @@ func (a *RoleAPIService) DeleteRoleExecute(r ApiDeleteRoleRequest) (*APIResponse
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -648,6 +650,7 @@ func (a *RoleAPIService) DeleteRoleExecute(r ApiDeleteRoleRequest) (*APIResponse
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -741,7 +744,7 @@ func (a *RoleAPIService) DeleteRolePermissionExecute(r ApiDeleteRolePermissionRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.DeleteRolePermission")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}"
@@ -790,7 +793,7 @@ func (a *RoleAPIService) DeleteRolePermissionExecute(r ApiDeleteRolePermissionRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -805,6 +808,7 @@ func (a *RoleAPIService) DeleteRolePermissionExecute(r ApiDeleteRolePermissionRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -897,7 +901,7 @@ func (a *RoleAPIService) GetRoleExecute(r ApiGetRoleRequest) (*IamRole, *APIResp
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.GetRole")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}"
@@ -945,7 +949,7 @@ func (a *RoleAPIService) GetRoleExecute(r ApiGetRoleRequest) (*IamRole, *APIResp
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -960,6 +964,7 @@ func (a *RoleAPIService) GetRoleExecute(r ApiGetRoleRequest) (*IamRole, *APIResp
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1065,7 +1070,7 @@ func (a *RoleAPIService) GetRolePermissionExecute(r ApiGetRolePermissionRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.GetRolePermission")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}"
@@ -1114,7 +1119,7 @@ func (a *RoleAPIService) GetRolePermissionExecute(r ApiGetRolePermissionRequest)
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1129,6 +1134,7 @@ func (a *RoleAPIService) GetRolePermissionExecute(r ApiGetRolePermissionRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1231,7 +1237,7 @@ func (a *RoleAPIService) ListRolePermissionsExecute(r ApiListRolePermissionsRequ
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.ListRolePermissions")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}/permissions"
@@ -1279,7 +1285,7 @@ func (a *RoleAPIService) ListRolePermissionsExecute(r ApiListRolePermissionsRequ
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1294,6 +1300,7 @@ func (a *RoleAPIService) ListRolePermissionsExecute(r ApiListRolePermissionsRequ
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1400,7 +1407,7 @@ func (a *RoleAPIService) ListRolesExecute(r ApiListRolesRequest) (*IamRoles, *AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.ListRoles")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles"
@@ -1450,7 +1457,7 @@ func (a *RoleAPIService) ListRolesExecute(r ApiListRolesRequest) (*IamRoles, *AP
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1465,6 +1472,7 @@ func (a *RoleAPIService) ListRolesExecute(r ApiListRolesRequest) (*IamRoles, *AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1561,7 +1569,7 @@ func (a *RoleAPIService) ReplaceRoleExecute(r ApiReplaceRoleRequest) (*IamRole,
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.ReplaceRole")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}"
@@ -1614,7 +1622,7 @@ func (a *RoleAPIService) ReplaceRoleExecute(r ApiReplaceRoleRequest) (*IamRole,
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1629,6 +1637,7 @@ func (a *RoleAPIService) ReplaceRoleExecute(r ApiReplaceRoleRequest) (*IamRole,
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1752,7 +1761,7 @@ func (a *RoleAPIService) ReplaceRolePermissionExecute(r ApiReplaceRolePermission
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAPIService.ReplaceRolePermission")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}"
@@ -1803,7 +1812,7 @@ func (a *RoleAPIService) ReplaceRolePermissionExecute(r ApiReplaceRolePermission
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1818,6 +1827,7 @@ func (a *RoleAPIService) ReplaceRolePermissionExecute(r ApiReplaceRolePermission
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
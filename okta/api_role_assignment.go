@@ -292,7 +292,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToClientExecute(r ApiAssignRoleToCl
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.AssignRoleToClient")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/oauth2/v1/clients/{clientId}/roles"
@@ -345,7 +345,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToClientExecute(r ApiAssignRoleToCl
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -360,6 +360,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToClientExecute(r ApiAssignRoleToCl
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -475,7 +476,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToGroupExecute(r ApiAssignRoleToGro
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.AssignRoleToGroup")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles"
@@ -531,7 +532,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToGroupExecute(r ApiAssignRoleToGro
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -546,6 +547,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToGroupExecute(r ApiAssignRoleToGro
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -673,7 +675,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToUserExecute(r ApiAssignRoleToUser
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.AssignRoleToUser")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles"
@@ -729,7 +731,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToUserExecute(r ApiAssignRoleToUser
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -744,6 +746,7 @@ func (a *RoleAssignmentAPIService) AssignRoleToUserExecute(r ApiAssignRoleToUser
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -859,7 +862,7 @@ func (a *RoleAssignmentAPIService) DeleteRoleFromClientExecute(r ApiDeleteRoleFr
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.DeleteRoleFromClient")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/oauth2/v1/clients/{clientId}/roles/{roleId}"
@@ -908,7 +911,7 @@ func (a *RoleAssignmentAPIService) DeleteRoleFromClientExecute(r ApiDeleteRoleFr
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -923,6 +926,7 @@ func (a *RoleAssignmentAPIService) DeleteRoleFromClientExecute(r ApiDeleteRoleFr
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1018,7 +1022,7 @@ func (a *RoleAssignmentAPIService) GetGroupAssignedRoleExecute(r ApiGetGroupAssi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.GetGroupAssignedRole")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}"
@@ -1067,7 +1071,7 @@ func (a *RoleAssignmentAPIService) GetGroupAssignedRoleExecute(r ApiGetGroupAssi
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1082,6 +1086,7 @@ func (a *RoleAssignmentAPIService) GetGroupAssignedRoleExecute(r ApiGetGroupAssi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1187,7 +1192,7 @@ func (a *RoleAssignmentAPIService) GetUserAssignedRoleExecute(r ApiGetUserAssign
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.GetUserAssignedRole")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}"
@@ -1236,7 +1241,7 @@ func (a *RoleAssignmentAPIService) GetUserAssignedRoleExecute(r ApiGetUserAssign
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1251,6 +1256,7 @@ func (a *RoleAssignmentAPIService) GetUserAssignedRoleExecute(r ApiGetUserAssign
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1359,7 +1365,7 @@ func (a *RoleAssignmentAPIService) ListAssignedRolesForUserExecute(r ApiListAssi
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.ListAssignedRolesForUser")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles"
@@ -1410,7 +1416,7 @@ func (a *RoleAssignmentAPIService) ListAssignedRolesForUserExecute(r ApiListAssi
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1425,6 +1431,7 @@ func (a *RoleAssignmentAPIService) ListAssignedRolesForUserExecute(r ApiListAssi
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1533,7 +1540,7 @@ func (a *RoleAssignmentAPIService) ListGroupAssignedRolesExecute(r ApiListGroupA
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.ListGroupAssignedRoles")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles"
@@ -1584,7 +1591,7 @@ func (a *RoleAssignmentAPIService) ListGroupAssignedRolesExecute(r ApiListGroupA
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1599,6 +1606,7 @@ func (a *RoleAssignmentAPIService) ListGroupAssignedRolesExecute(r ApiListGroupA
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1701,7 +1709,7 @@ func (a *RoleAssignmentAPIService) ListRolesForClientExecute(r ApiListRolesForCl
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.ListRolesForClient")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/oauth2/v1/clients/{clientId}/roles"
@@ -1749,7 +1757,7 @@ func (a *RoleAssignmentAPIService) ListRolesForClientExecute(r ApiListRolesForCl
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1764,6 +1772,7 @@ func (a *RoleAssignmentAPIService) ListRolesForClientExecute(r ApiListRolesForCl
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1876,7 +1885,7 @@ func (a *RoleAssignmentAPIService) ListUsersWithRoleAssignmentsExecute(r ApiList
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.ListUsersWithRoleAssignments")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/iam/assignees/users"
@@ -1929,7 +1938,7 @@ func (a *RoleAssignmentAPIService) ListUsersWithRoleAssignmentsExecute(r ApiList
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1944,6 +1953,7 @@ func (a *RoleAssignmentAPIService) ListUsersWithRoleAssignmentsExecute(r ApiList
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2037,7 +2047,7 @@ func (a *RoleAssignmentAPIService) RetrieveClientRoleExecute(r ApiRetrieveClient
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.RetrieveClientRole")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/oauth2/v1/clients/{clientId}/roles/{roleId}"
@@ -2086,7 +2096,7 @@ func (a *RoleAssignmentAPIService) RetrieveClientRoleExecute(r ApiRetrieveClient
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2101,6 +2111,7 @@ func (a *RoleAssignmentAPIService) RetrieveClientRoleExecute(r ApiRetrieveClient
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2204,7 +2215,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromGroupExecute(r ApiUnassignRol
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.UnassignRoleFromGroup")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/groups/{groupId}/roles/{roleId}"
@@ -2253,7 +2264,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromGroupExecute(r ApiUnassignRol
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2268,6 +2279,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromGroupExecute(r ApiUnassignRol
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -2361,7 +2373,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromUserExecute(r ApiUnassignRole
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "RoleAssignmentAPIService.UnassignRoleFromUser")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/roles/{roleId}"
@@ -2410,7 +2422,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromUserExecute(r ApiUnassignRole
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2425,6 +2437,7 @@ func (a *RoleAssignmentAPIService) UnassignRoleFromUserExecute(r ApiUnassignRole
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -197,7 +197,7 @@ func (a *SessionAPIService) CloseCurrentSessionExecute(r ApiCloseCurrentSessionR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.CloseCurrentSession")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/me"
@@ -233,7 +233,7 @@ func (a *SessionAPIService) CloseCurrentSessionExecute(r ApiCloseCurrentSessionR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -248,6 +248,7 @@ func (a *SessionAPIService) CloseCurrentSessionExecute(r ApiCloseCurrentSessionR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
@@ -319,7 +320,7 @@ func (a *SessionAPIService) CreateSessionExecute(r ApiCreateSessionRequest) (*Se
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.CreateSession")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions"
@@ -371,7 +372,7 @@ func (a *SessionAPIService) CreateSessionExecute(r ApiCreateSessionRequest) (*Se
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -386,6 +387,7 @@ func (a *SessionAPIService) CreateSessionExecute(r ApiCreateSessionRequest) (*Se
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -481,7 +483,7 @@ func (a *SessionAPIService) GetCurrentSessionExecute(r ApiGetCurrentSessionReque
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.GetCurrentSession")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/me"
@@ -517,7 +519,7 @@ func (a *SessionAPIService) GetCurrentSessionExecute(r ApiGetCurrentSessionReque
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -532,6 +534,7 @@ func (a *SessionAPIService) GetCurrentSessionExecute(r ApiGetCurrentSessionReque
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
 		return localVarReturnValue, localAPIResponse, newErr
@@ -600,7 +603,7 @@ func (a *SessionAPIService) GetSessionExecute(r ApiGetSessionRequest) (*Session,
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.GetSession")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/{sessionId}"
@@ -648,7 +651,7 @@ func (a *SessionAPIService) GetSessionExecute(r ApiGetSessionRequest) (*Session,
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -663,6 +666,7 @@ func (a *SessionAPIService) GetSessionExecute(r ApiGetSessionRequest) (*Session,
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -758,7 +762,7 @@ func (a *SessionAPIService) RefreshCurrentSessionExecute(r ApiRefreshCurrentSess
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.RefreshCurrentSession")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/me/lifecycle/refresh"
@@ -794,7 +798,7 @@ func (a *SessionAPIService) RefreshCurrentSessionExecute(r ApiRefreshCurrentSess
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -809,6 +813,7 @@ func (a *SessionAPIService) RefreshCurrentSessionExecute(r ApiRefreshCurrentSess
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
 		return localVarReturnValue, localAPIResponse, newErr
@@ -877,7 +882,7 @@ func (a *SessionAPIService) RefreshSessionExecute(r ApiRefreshSessionRequest) (*
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.RefreshSession")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/{sessionId}/lifecycle/refresh"
@@ -925,7 +930,7 @@ func (a *SessionAPIService) RefreshSessionExecute(r ApiRefreshSessionRequest) (*
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -940,6 +945,7 @@ func (a *SessionAPIService) RefreshSessionExecute(r ApiRefreshSessionRequest) (*
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1028,7 +1034,7 @@ func (a *SessionAPIService) RevokeSessionExecute(r ApiRevokeSessionRequest) (*AP
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SessionAPIService.RevokeSession")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/sessions/{sessionId}"
@@ -1076,7 +1082,7 @@ func (a *SessionAPIService) RevokeSessionExecute(r ApiRevokeSessionRequest) (*AP
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1091,6 +1097,7 @@ func (a *SessionAPIService) RevokeSessionExecute(r ApiRevokeSessionRequest) (*AP
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -194,7 +194,7 @@ func (a *SSFReceiverAPIService) ActivateSecurityEventsProviderInstanceExecute(r
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFReceiverAPIService.ActivateSecurityEventsProviderInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/security-events-providers/{securityEventProviderId}/lifecycle/activate"
@@ -242,7 +242,7 @@ func (a *SSFReceiverAPIService) ActivateSecurityEventsProviderInstanceExecute(r
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -257,6 +257,7 @@ func (a *SSFReceiverAPIService) ActivateSecurityEventsProviderInstanceExecute(r
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -374,7 +375,7 @@ func (a *SSFReceiverAPIService) CreateSecurityEventsProviderInstanceExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFReceiverAPIService.CreateSecurityEventsProviderInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/security-events-providers"
@@ -426,7 +427,7 @@ func (a *SSFReceiverAPIService) CreateSecurityEventsProviderInstanceExecute(r Ap
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -441,6 +442,7 @@ func (a *SSFReceiverAPIService) CreateSecurityEventsProviderInstanceExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -556,7 +558,7 @@ func (a *SSFReceiverAPIService) DeactivateSecurityEventsProviderInstanceExecute(
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFReceiverAPIService.DeactivateSecurityEventsProviderInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/security-events-providers/{securityEventProviderId}/lifecycle/deactivate"
@@ -604,7 +606,7 @@ func (a *SSFReceiverAPIService) DeactivateSecurityEventsProviderInstanceExecute(
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -619,6 +621,7 @@ func (a *SSFReceiverAPIService) DeactivateSecurityEventsProviderInstanceExecute(
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -731,7 +734,7 @@ func (a *SSFReceiverAPIService) DeleteSecurityEventsProviderInstanceExecute(r Ap
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFReceiverAPIService.DeleteSecurityEventsProviderInstance")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/security-events-providers/{securityEventProviderId}"
@@ -779,7 +782,7 @@ func (a *SSFReceiverAPIService) DeleteSecurityEventsProviderInstanceExecute(r Ap
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -794,6 +797,7 @@ func (a *SSFReceiverAPIService) DeleteSecurityEventsProviderInstanceExecute(r Ap
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -898,7 +902,7 @@ func (a *SSFReceiverAPIService) GetSecurityEventsProviderInstanceExecute(r ApiGe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFReceiverAPIService.GetSecurityEventsProviderInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/security-events-providers/{securityEventProviderId}"
@@ -946,7 +950,7 @@ func (a *SSFReceiverAPIService) GetSecurityEventsProviderInstanceExecute(r ApiGe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -961,6 +965,7 @@ func (a *SSFReceiverAPIService) GetSecurityEventsProviderInstanceExecute(r ApiGe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -1072,7 +1077,7 @@ func (a *SSFReceiverAPIService) ListSecurityEventsProviderInstancesExecute(r Api
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFReceiverAPIService.ListSecurityEventsProviderInstances")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/security-events-providers"
@@ -1119,7 +1124,7 @@ func (a *SSFReceiverAPIService) ListSecurityEventsProviderInstancesExecute(r Api
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1134,6 +1139,7 @@ func (a *SSFReceiverAPIService) ListSecurityEventsProviderInstancesExecute(r Api
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -1242,7 +1248,7 @@ func (a *SSFReceiverAPIService) ReplaceSecurityEventsProviderInstanceExecute(r A
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFReceiverAPIService.ReplaceSecurityEventsProviderInstance")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/security-events-providers/{securityEventProviderId}"
@@ -1295,7 +1301,7 @@ func (a *SSFReceiverAPIService) ReplaceSecurityEventsProviderInstanceExecute(r A
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1310,6 +1316,7 @@ func (a *SSFReceiverAPIService) ReplaceSecurityEventsProviderInstanceExecute(r A
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
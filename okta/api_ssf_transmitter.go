@@ -190,7 +190,7 @@ func (a *SSFTransmitterAPIService) CreateSsfStreamExecute(r ApiCreateSsfStreamRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFTransmitterAPIService.CreateSsfStream")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/ssf/stream"
@@ -228,7 +228,7 @@ func (a *SSFTransmitterAPIService) CreateSsfStreamExecute(r ApiCreateSsfStreamRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -243,6 +243,7 @@ func (a *SSFTransmitterAPIService) CreateSsfStreamExecute(r ApiCreateSsfStreamRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -361,7 +362,7 @@ func (a *SSFTransmitterAPIService) DeleteSsfStreamExecute(r ApiDeleteSsfStreamRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFTransmitterAPIService.DeleteSsfStream")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/ssf/stream"
@@ -397,7 +398,7 @@ func (a *SSFTransmitterAPIService) DeleteSsfStreamExecute(r ApiDeleteSsfStreamRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -412,6 +413,7 @@ func (a *SSFTransmitterAPIService) DeleteSsfStreamExecute(r ApiDeleteSsfStreamRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -534,7 +536,7 @@ func (a *SSFTransmitterAPIService) GetSsfStreamsExecute(r ApiGetSsfStreamsReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFTransmitterAPIService.GetSsfStreams")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/ssf/stream"
@@ -570,7 +572,7 @@ func (a *SSFTransmitterAPIService) GetSsfStreamsExecute(r ApiGetSsfStreamsReques
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -585,6 +587,7 @@ func (a *SSFTransmitterAPIService) GetSsfStreamsExecute(r ApiGetSsfStreamsReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -696,7 +699,7 @@ func (a *SSFTransmitterAPIService) GetWellknownSsfMetadataExecute(r ApiGetWellkn
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFTransmitterAPIService.GetWellknownSsfMetadata")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/.well-known/ssf-configuration"
@@ -729,7 +732,7 @@ func (a *SSFTransmitterAPIService) GetWellknownSsfMetadataExecute(r ApiGetWellkn
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -744,6 +747,7 @@ func (a *SSFTransmitterAPIService) GetWellknownSsfMetadataExecute(r ApiGetWellkn
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
@@ -863,7 +867,7 @@ func (a *SSFTransmitterAPIService) ReplaceSsfStreamExecute(r ApiReplaceSsfStream
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFTransmitterAPIService.ReplaceSsfStream")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/ssf/stream"
@@ -901,7 +905,7 @@ func (a *SSFTransmitterAPIService) ReplaceSsfStreamExecute(r ApiReplaceSsfStream
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -916,6 +920,7 @@ func (a *SSFTransmitterAPIService) ReplaceSsfStreamExecute(r ApiReplaceSsfStream
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1047,7 +1052,7 @@ func (a *SSFTransmitterAPIService) UpdateSsfStreamExecute(r ApiUpdateSsfStreamRe
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SSFTransmitterAPIService.UpdateSsfStream")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/ssf/stream"
@@ -1085,7 +1090,7 @@ func (a *SSFTransmitterAPIService) UpdateSsfStreamExecute(r ApiUpdateSsfStreamRe
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1100,6 +1105,7 @@ func (a *SSFTransmitterAPIService) UpdateSsfStreamExecute(r ApiUpdateSsfStreamRe
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -27,6 +27,7 @@ import (
 	"bytes"
 	"context"
 	"io/ioutil"
+	"iter"
 	"net/http"
 	"net/url"
 	"time"
@@ -47,6 +48,10 @@ type SystemLogAPI interface {
 	// ListLogEventsExecute executes the request
 	//  @return []LogEvent
 	ListLogEventsExecute(r ApiListLogEventsRequest) ([]LogEvent, *APIResponse, error)
+
+	// Stream polls the System Log for new events, resuming from the cursor
+	// saved in the checkpoint store of opts.
+	Stream(ctx context.Context, opts LogStreamOptions) iter.Seq2[LogEvent, error]
 }
 
 // SystemLogAPIService SystemLogAPI service
@@ -141,7 +146,7 @@ func (a *SystemLogAPIService) ListLogEventsExecute(r ApiListLogEventsRequest) ([
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SystemLogAPIService.ListLogEvents")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/logs"
@@ -209,7 +214,7 @@ func (a *SystemLogAPIService) ListLogEventsExecute(r ApiListLogEventsRequest) ([
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -224,6 +229,7 @@ func (a *SystemLogAPIService) ListLogEventsExecute(r ApiListLogEventsRequest) ([
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
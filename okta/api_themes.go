@@ -233,7 +233,7 @@ func (a *ThemesAPIService) DeleteBrandThemeBackgroundImageExecute(r ApiDeleteBra
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThemesAPIService.DeleteBrandThemeBackgroundImage")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/background-image"
@@ -282,7 +282,7 @@ func (a *ThemesAPIService) DeleteBrandThemeBackgroundImageExecute(r ApiDeleteBra
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -297,6 +297,7 @@ func (a *ThemesAPIService) DeleteBrandThemeBackgroundImageExecute(r ApiDeleteBra
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -390,7 +391,7 @@ func (a *ThemesAPIService) DeleteBrandThemeFaviconExecute(r ApiDeleteBrandThemeF
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThemesAPIService.DeleteBrandThemeFavicon")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/favicon"
@@ -439,7 +440,7 @@ func (a *ThemesAPIService) DeleteBrandThemeFaviconExecute(r ApiDeleteBrandThemeF
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -454,6 +455,7 @@ func (a *ThemesAPIService) DeleteBrandThemeFaviconExecute(r ApiDeleteBrandThemeF
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -547,7 +549,7 @@ func (a *ThemesAPIService) DeleteBrandThemeLogoExecute(r ApiDeleteBrandThemeLogo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThemesAPIService.DeleteBrandThemeLogo")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/logo"
@@ -596,7 +598,7 @@ func (a *ThemesAPIService) DeleteBrandThemeLogoExecute(r ApiDeleteBrandThemeLogo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -611,6 +613,7 @@ func (a *ThemesAPIService) DeleteBrandThemeLogoExecute(r ApiDeleteBrandThemeLogo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -706,7 +709,7 @@ func (a *ThemesAPIService) GetBrandThemeExecute(r ApiGetBrandThemeRequest) (*The
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThemesAPIService.GetBrandTheme")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}"
@@ -755,7 +758,7 @@ func (a *ThemesAPIService) GetBrandThemeExecute(r ApiGetBrandThemeRequest) (*The
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -770,6 +773,7 @@ func (a *ThemesAPIService) GetBrandThemeExecute(r ApiGetBrandThemeRequest) (*The
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -874,7 +878,7 @@ func (a *ThemesAPIService) ListBrandThemesExecute(r ApiListBrandThemesRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThemesAPIService.ListBrandThemes")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes"
@@ -922,7 +926,7 @@ func (a *ThemesAPIService) ListBrandThemesExecute(r ApiListBrandThemesRequest) (
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -937,6 +941,7 @@ func (a *ThemesAPIService) ListBrandThemesExecute(r ApiListBrandThemesRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1048,7 +1053,7 @@ func (a *ThemesAPIService) ReplaceBrandThemeExecute(r ApiReplaceBrandThemeReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThemesAPIService.ReplaceBrandTheme")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}"
@@ -1102,7 +1107,7 @@ func (a *ThemesAPIService) ReplaceBrandThemeExecute(r ApiReplaceBrandThemeReques
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1117,6 +1122,7 @@ func (a *ThemesAPIService) ReplaceBrandThemeExecute(r ApiReplaceBrandThemeReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1240,7 +1246,7 @@ func (a *ThemesAPIService) UploadBrandThemeBackgroundImageExecute(r ApiUploadBra
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThemesAPIService.UploadBrandThemeBackgroundImage")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/background-image"
@@ -1306,7 +1312,7 @@ func (a *ThemesAPIService) UploadBrandThemeBackgroundImageExecute(r ApiUploadBra
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1321,6 +1327,7 @@ func (a *ThemesAPIService) UploadBrandThemeBackgroundImageExecute(r ApiUploadBra
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1444,7 +1451,7 @@ func (a *ThemesAPIService) UploadBrandThemeFaviconExecute(r ApiUploadBrandThemeF
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThemesAPIService.UploadBrandThemeFavicon")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/favicon"
@@ -1510,7 +1517,7 @@ func (a *ThemesAPIService) UploadBrandThemeFaviconExecute(r ApiUploadBrandThemeF
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1525,6 +1532,7 @@ func (a *ThemesAPIService) UploadBrandThemeFaviconExecute(r ApiUploadBrandThemeF
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1648,7 +1656,7 @@ func (a *ThemesAPIService) UploadBrandThemeLogoExecute(r ApiUploadBrandThemeLogo
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThemesAPIService.UploadBrandThemeLogo")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/brands/{brandId}/themes/{themeId}/logo"
@@ -1714,7 +1722,7 @@ func (a *ThemesAPIService) UploadBrandThemeLogoExecute(r ApiUploadBrandThemeLogo
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1729,6 +1737,7 @@ func (a *ThemesAPIService) UploadBrandThemeLogoExecute(r ApiUploadBrandThemeLogo
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
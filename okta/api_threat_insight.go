@@ -113,7 +113,7 @@ func (a *ThreatInsightAPIService) GetCurrentConfigurationExecute(r ApiGetCurrent
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThreatInsightAPIService.GetCurrentConfiguration")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/threats/configuration"
@@ -160,7 +160,7 @@ func (a *ThreatInsightAPIService) GetCurrentConfigurationExecute(r ApiGetCurrent
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -175,6 +175,7 @@ func (a *ThreatInsightAPIService) GetCurrentConfigurationExecute(r ApiGetCurrent
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -268,7 +269,7 @@ func (a *ThreatInsightAPIService) UpdateConfigurationExecute(r ApiUpdateConfigur
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ThreatInsightAPIService.UpdateConfiguration")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/threats/configuration"
@@ -320,7 +321,7 @@ func (a *ThreatInsightAPIService) UpdateConfigurationExecute(r ApiUpdateConfigur
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -335,6 +336,7 @@ func (a *ThreatInsightAPIService) UpdateConfigurationExecute(r ApiUpdateConfigur
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -191,7 +191,7 @@ func (a *TrustedOriginAPIService) ActivateTrustedOriginExecute(r ApiActivateTrus
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.ActivateTrustedOrigin")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins/{trustedOriginId}/lifecycle/activate"
@@ -239,7 +239,7 @@ func (a *TrustedOriginAPIService) ActivateTrustedOriginExecute(r ApiActivateTrus
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -254,6 +254,7 @@ func (a *TrustedOriginAPIService) ActivateTrustedOriginExecute(r ApiActivateTrus
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -359,7 +360,7 @@ func (a *TrustedOriginAPIService) CreateTrustedOriginExecute(r ApiCreateTrustedO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.CreateTrustedOrigin")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins"
@@ -411,7 +412,7 @@ func (a *TrustedOriginAPIService) CreateTrustedOriginExecute(r ApiCreateTrustedO
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -426,6 +427,7 @@ func (a *TrustedOriginAPIService) CreateTrustedOriginExecute(r ApiCreateTrustedO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -528,7 +530,7 @@ func (a *TrustedOriginAPIService) DeactivateTrustedOriginExecute(r ApiDeactivate
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.DeactivateTrustedOrigin")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins/{trustedOriginId}/lifecycle/deactivate"
@@ -576,7 +578,7 @@ func (a *TrustedOriginAPIService) DeactivateTrustedOriginExecute(r ApiDeactivate
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -591,6 +593,7 @@ func (a *TrustedOriginAPIService) DeactivateTrustedOriginExecute(r ApiDeactivate
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -691,7 +694,7 @@ func (a *TrustedOriginAPIService) DeleteTrustedOriginExecute(r ApiDeleteTrustedO
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.DeleteTrustedOrigin")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins/{trustedOriginId}"
@@ -739,7 +742,7 @@ func (a *TrustedOriginAPIService) DeleteTrustedOriginExecute(r ApiDeleteTrustedO
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -754,6 +757,7 @@ func (a *TrustedOriginAPIService) DeleteTrustedOriginExecute(r ApiDeleteTrustedO
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -846,7 +850,7 @@ func (a *TrustedOriginAPIService) GetTrustedOriginExecute(r ApiGetTrustedOriginR
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.GetTrustedOrigin")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins/{trustedOriginId}"
@@ -894,7 +898,7 @@ func (a *TrustedOriginAPIService) GetTrustedOriginExecute(r ApiGetTrustedOriginR
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -909,6 +913,7 @@ func (a *TrustedOriginAPIService) GetTrustedOriginExecute(r ApiGetTrustedOriginR
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1036,7 +1041,7 @@ func (a *TrustedOriginAPIService) ListTrustedOriginsExecute(r ApiListTrustedOrig
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.ListTrustedOrigins")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins"
@@ -1095,7 +1100,7 @@ func (a *TrustedOriginAPIService) ListTrustedOriginsExecute(r ApiListTrustedOrig
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1110,6 +1115,7 @@ func (a *TrustedOriginAPIService) ListTrustedOriginsExecute(r ApiListTrustedOrig
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1206,7 +1212,7 @@ func (a *TrustedOriginAPIService) ReplaceTrustedOriginExecute(r ApiReplaceTruste
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TrustedOriginAPIService.ReplaceTrustedOrigin")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/trustedOrigins/{trustedOriginId}"
@@ -1259,7 +1265,7 @@ func (a *TrustedOriginAPIService) ReplaceTrustedOriginExecute(r ApiReplaceTruste
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1274,6 +1280,7 @@ func (a *TrustedOriginAPIService) ReplaceTrustedOriginExecute(r ApiReplaceTruste
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
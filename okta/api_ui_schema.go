@@ -164,7 +164,7 @@ func (a *UISchemaAPIService) CreateUISchemaExecute(r ApiCreateUISchemaRequest) (
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UISchemaAPIService.CreateUISchema")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/uischemas"
@@ -216,7 +216,7 @@ func (a *UISchemaAPIService) CreateUISchemaExecute(r ApiCreateUISchemaRequest) (
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -231,6 +231,7 @@ func (a *UISchemaAPIService) CreateUISchemaExecute(r ApiCreateUISchemaRequest) (
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -331,7 +332,7 @@ func (a *UISchemaAPIService) DeleteUISchemasExecute(r ApiDeleteUISchemasRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UISchemaAPIService.DeleteUISchemas")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/uischemas/{id}"
@@ -379,7 +380,7 @@ func (a *UISchemaAPIService) DeleteUISchemasExecute(r ApiDeleteUISchemasRequest)
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -394,6 +395,7 @@ func (a *UISchemaAPIService) DeleteUISchemasExecute(r ApiDeleteUISchemasRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -498,7 +500,7 @@ func (a *UISchemaAPIService) GetUISchemaExecute(r ApiGetUISchemaRequest) (*UISch
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UISchemaAPIService.GetUISchema")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/uischemas/{id}"
@@ -546,7 +548,7 @@ func (a *UISchemaAPIService) GetUISchemaExecute(r ApiGetUISchemaRequest) (*UISch
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -561,6 +563,7 @@ func (a *UISchemaAPIService) GetUISchemaExecute(r ApiGetUISchemaRequest) (*UISch
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -660,7 +663,7 @@ func (a *UISchemaAPIService) ListUISchemasExecute(r ApiListUISchemasRequest) ([]
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UISchemaAPIService.ListUISchemas")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/uischemas"
@@ -707,7 +710,7 @@ func (a *UISchemaAPIService) ListUISchemasExecute(r ApiListUISchemasRequest) ([]
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -722,6 +725,7 @@ func (a *UISchemaAPIService) ListUISchemasExecute(r ApiListUISchemasRequest) ([]
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -818,7 +822,7 @@ func (a *UISchemaAPIService) ReplaceUISchemasExecute(r ApiReplaceUISchemasReques
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UISchemaAPIService.ReplaceUISchemas")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/meta/uischemas/{id}"
@@ -871,7 +875,7 @@ func (a *UISchemaAPIService) ReplaceUISchemasExecute(r ApiReplaceUISchemasReques
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -886,6 +890,7 @@ func (a *UISchemaAPIService) ReplaceUISchemasExecute(r ApiReplaceUISchemasReques
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
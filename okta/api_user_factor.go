@@ -202,6 +202,14 @@ For `call` and `sms` factors, Okta enforces a rate limit of one OTP challenge pe
 	// VerifyFactorExecute executes the request
 	//  @return UserFactorVerifyResponse
 	VerifyFactorExecute(r ApiVerifyFactorRequest) (*UserFactorVerifyResponse, *APIResponse, error)
+
+	// ResetAll removes the factors of a user, except those of the given types
+	// and those the policies of the user require.
+	ResetAll(ctx context.Context, userID string, except ...FactorType) (*FactorResetSummary, error)
+
+	// ForceResetAll removes the factors of a user, except those of the given
+	// types.
+	ForceResetAll(ctx context.Context, userID string, except ...FactorType) (*FactorResetSummary, error)
 }
 
 // UserFactorAPIService UserFactorAPI service
@@ -268,7 +276,7 @@ func (a *UserFactorAPIService) ActivateFactorExecute(r ApiActivateFactorRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.ActivateFactor")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}/lifecycle/activate"
@@ -319,7 +327,7 @@ func (a *UserFactorAPIService) ActivateFactorExecute(r ApiActivateFactorRequest)
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -334,6 +342,7 @@ func (a *UserFactorAPIService) ActivateFactorExecute(r ApiActivateFactorRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -490,7 +499,7 @@ func (a *UserFactorAPIService) EnrollFactorExecute(r ApiEnrollFactorRequest) (*L
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.EnrollFactor")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors"
@@ -558,7 +567,7 @@ func (a *UserFactorAPIService) EnrollFactorExecute(r ApiEnrollFactorRequest) (*L
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -573,6 +582,7 @@ func (a *UserFactorAPIService) EnrollFactorExecute(r ApiEnrollFactorRequest) (*L
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -690,7 +700,7 @@ func (a *UserFactorAPIService) GetFactorExecute(r ApiGetFactorRequest) (*ListFac
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.GetFactor")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}"
@@ -739,7 +749,7 @@ func (a *UserFactorAPIService) GetFactorExecute(r ApiGetFactorRequest) (*ListFac
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -754,6 +764,7 @@ func (a *UserFactorAPIService) GetFactorExecute(r ApiGetFactorRequest) (*ListFac
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -862,7 +873,7 @@ func (a *UserFactorAPIService) GetFactorTransactionStatusExecute(r ApiGetFactorT
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.GetFactorTransactionStatus")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}/transactions/{transactionId}"
@@ -912,7 +923,7 @@ func (a *UserFactorAPIService) GetFactorTransactionStatusExecute(r ApiGetFactorT
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -927,6 +938,7 @@ func (a *UserFactorAPIService) GetFactorTransactionStatusExecute(r ApiGetFactorT
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1029,7 +1041,7 @@ func (a *UserFactorAPIService) ListFactorsExecute(r ApiListFactorsRequest) ([]Li
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.ListFactors")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors"
@@ -1077,7 +1089,7 @@ func (a *UserFactorAPIService) ListFactorsExecute(r ApiListFactorsRequest) ([]Li
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1092,6 +1104,7 @@ func (a *UserFactorAPIService) ListFactorsExecute(r ApiListFactorsRequest) ([]Li
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1194,7 +1207,7 @@ func (a *UserFactorAPIService) ListSupportedFactorsExecute(r ApiListSupportedFac
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.ListSupportedFactors")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/catalog"
@@ -1242,7 +1255,7 @@ func (a *UserFactorAPIService) ListSupportedFactorsExecute(r ApiListSupportedFac
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1257,6 +1270,7 @@ func (a *UserFactorAPIService) ListSupportedFactorsExecute(r ApiListSupportedFac
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1359,7 +1373,7 @@ func (a *UserFactorAPIService) ListSupportedSecurityQuestionsExecute(r ApiListSu
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.ListSupportedSecurityQuestions")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/questions"
@@ -1407,7 +1421,7 @@ func (a *UserFactorAPIService) ListSupportedSecurityQuestionsExecute(r ApiListSu
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1422,6 +1436,7 @@ func (a *UserFactorAPIService) ListSupportedSecurityQuestionsExecute(r ApiListSu
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1544,7 +1559,7 @@ func (a *UserFactorAPIService) ResendEnrollFactorExecute(r ApiResendEnrollFactor
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.ResendEnrollFactor")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}/resend"
@@ -1601,7 +1616,7 @@ func (a *UserFactorAPIService) ResendEnrollFactorExecute(r ApiResendEnrollFactor
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1616,6 +1631,7 @@ func (a *UserFactorAPIService) ResendEnrollFactorExecute(r ApiResendEnrollFactor
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -1740,7 +1756,7 @@ func (a *UserFactorAPIService) UnenrollFactorExecute(r ApiUnenrollFactorRequest)
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.UnenrollFactor")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}"
@@ -1792,7 +1808,7 @@ func (a *UserFactorAPIService) UnenrollFactorExecute(r ApiUnenrollFactorRequest)
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, nil)
-		return localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -1807,6 +1823,7 @@ func (a *UserFactorAPIService) UnenrollFactorExecute(r ApiUnenrollFactorRequest)
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 403 {
 			var v Error
@@ -1946,7 +1963,7 @@ func (a *UserFactorAPIService) VerifyFactorExecute(r ApiVerifyFactorRequest) (*U
 	}
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "UserFactorAPIService.VerifyFactor")
 	if err != nil {
-		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarPath := localBasePath + "/api/v1/users/{userId}/factors/{factorId}/verify"
@@ -2012,7 +2029,7 @@ func (a *UserFactorAPIService) VerifyFactorExecute(r ApiVerifyFactorRequest) (*U
 	localVarHTTPResponse, err = a.client.do(r.ctx, req)
 	if err != nil {
 		localAPIResponse = newAPIResponse(localVarHTTPResponse, a.client, localVarReturnValue)
-		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, localAPIResponse, &GenericOpenAPIError{error: err.Error(), err: err}
 	}
 
 	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
@@ -2027,6 +2044,7 @@ func (a *UserFactorAPIService) VerifyFactorExecute(r ApiVerifyFactorRequest) (*U
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
+			err:   newAPIError(localVarHTTPResponse, localVarBody),
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
 			var v Error
@@ -0,0 +1,128 @@
+package okta
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-jose/go-jose/v3"
+)
+
+// ClientAssertionSigner signs JWTs with a key the SDK never sees, such as a key
+// held by AWS KMS, GCP KMS, a PKCS#11 token or Vault. Pass it to
+// WithClientAssertionSigner to sign the client assertions of the PrivateKey
+// and JWK authorization modes, and to NewDPoPSigner to sign DPoP proofs.
+//
+// Signers sign with RS256 unless they also implement Algorithm() string,
+// returning the JWA name of their algorithm such as "ES256" or "EdDSA".
+type ClientAssertionSigner interface {
+	// Sign returns the signature of payload, the signing input of a JWT, in
+	// the format of RFC 7518: PKCS #1 v1.5 or PSS for RSA and the
+	// concatenated R and S for EC. Services signing digests need the digest
+	// of payload with the hash of the algorithm, SHA-256 for RS256 and ES256.
+	Sign(payload []byte) ([]byte, error)
+	// KeyID returns the id of the key registered with the service
+	// application, set as the "kid" header when not empty.
+	KeyID() string
+}
+
+// signatureAlgorithms are the algorithms a ClientAssertionSigner can sign with.
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// signatureAlgorithm returns the algorithm of signer.
+func signatureAlgorithm(signer ClientAssertionSigner) (jose.SignatureAlgorithm, error) {
+	withAlgorithm, ok := signer.(interface{ Algorithm() string })
+	if !ok {
+		return jose.RS256, nil
+	}
+	alg := jose.SignatureAlgorithm(withAlgorithm.Algorithm())
+	for _, supported := range signatureAlgorithms {
+		if alg == supported {
+			return alg, nil
+		}
+	}
+	return "", fmt.Errorf("client assertion signer uses the unsupported algorithm %q", alg)
+}
+
+// assertionSigner is the jose.OpaqueSigner of a ClientAssertionSigner.
+type assertionSigner struct {
+	signer ClientAssertionSigner
+	alg    jose.SignatureAlgorithm
+}
+
+func (s assertionSigner) Public() *jose.JSONWebKey {
+	return nil
+}
+
+func (s assertionSigner) Algs() []jose.SignatureAlgorithm {
+	return []jose.SignatureAlgorithm{s.alg}
+}
+
+func (s assertionSigner) SignPayload(payload []byte, alg jose.SignatureAlgorithm) ([]byte, error) {
+	return s.signer.Sign(payload)
+}
+
+// newAssertionSigner returns the jose.Signer of a ClientAssertionSigner, with
+// opts when they are not nil.
+func newAssertionSigner(signer ClientAssertionSigner, opts *jose.SignerOptions) (jose.Signer, error) {
+	alg, err := signatureAlgorithm(signer)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &jose.SignerOptions{}
+	}
+	if keyID := signer.KeyID(); keyID != "" {
+		opts = opts.WithHeader("kid", keyID)
+	}
+	return jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: assertionSigner{signer: signer, alg: alg}}, opts)
+}
+
+// privateKeySigner returns the signer of the client assertions set with
+// WithPrivateKeySigner or WithClientAssertionSigner, or nil to sign them with
+// the private key of the configuration.
+func (c *APIClient) privateKeySigner() (jose.Signer, error) {
+	if c.cfg.PrivateKeySigner != nil || c.cfg.ClientAssertionSigner == nil {
+		return c.cfg.PrivateKeySigner, nil
+	}
+	return newAssertionSigner(c.cfg.ClientAssertionSigner, nil)
+}
+
+// NewDPoPSigner adapts a ClientAssertionSigner for WithDPoPSigner, so that
+// DPoP proofs are signed by the same external service. As DPoP proofs carry
+// their public key, signer must also implement Public() crypto.PublicKey.
+// The returned crypto.Signer can only be used by the SDK, its Sign method
+// fails as the external signer signs payloads rather than digests.
+func NewDPoPSigner(signer ClientAssertionSigner) (crypto.Signer, error) {
+	withPublic, ok := signer.(interface{ Public() crypto.PublicKey })
+	if !ok {
+		return nil, errors.New("DPoP signer does not implement Public() crypto.PublicKey")
+	}
+	alg, err := signatureAlgorithm(signer)
+	if err != nil {
+		return nil, err
+	}
+	return dpopPayloadSigner{signer: signer, alg: alg, public: withPublic.Public()}, nil
+}
+
+// dpopPayloadSigner is the crypto.Signer returned by NewDPoPSigner, which
+// newOpaqueSigner signs payloads with.
+type dpopPayloadSigner struct {
+	signer ClientAssertionSigner
+	alg    jose.SignatureAlgorithm
+	public crypto.PublicKey
+}
+
+func (s dpopPayloadSigner) Public() crypto.PublicKey {
+	return s.public
+}
+
+func (s dpopPayloadSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return nil, errors.New("DPoP signer signs payloads, not digests")
+}
@@ -0,0 +1,147 @@
+package okta
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"net/http"
+	"testing"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// kmsSigner signs payloads the way KMS services do, with a key the SDK never
+// sees.
+type kmsSigner struct {
+	key crypto.Signer
+}
+
+func (s kmsSigner) Sign(payload []byte) ([]byte, error) {
+	digest := sha256.Sum256(payload)
+	switch key := s.key.(type) {
+	case *rsa.PrivateKey:
+		return rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			return nil, err
+		}
+		signature := make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+		return signature, nil
+	}
+	return nil, nil
+}
+
+func (s kmsSigner) KeyID() string {
+	return "kms-key"
+}
+
+func (s kmsSigner) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+// ecKMSSigner is a kmsSigner of an EC key, signing with ES256.
+type ecKMSSigner struct {
+	kmsSigner
+}
+
+func (s ecKMSSigner) Algorithm() string {
+	return "ES256"
+}
+
+func Test_Client_Assertion_Signer(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tests := []struct {
+		name   string
+		signer ClientAssertionSigner
+		public crypto.PublicKey
+		alg    jose.SignatureAlgorithm
+	}{
+		{name: "RSA", signer: kmsSigner{rsaKey}, public: rsaKey.Public(), alg: jose.RS256},
+		{name: "EC", signer: ecKMSSigner{kmsSigner{ecKey}}, public: ecKey.Public(), alg: jose.ES256},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+				require.NoError(t, req.ParseForm())
+				assertion, err := jwt.ParseSigned(req.PostForm.Get("client_assertion"))
+				require.NoError(t, err)
+				assert.Equal(t, "kms-key", assertion.Headers[0].KeyID)
+				assert.Equal(t, string(tt.alg), assertion.Headers[0].Algorithm)
+				var claims jwt.Claims
+				require.NoError(t, assertion.Claims(tt.public, &claims), "Assertion should verify with the public key of the KMS key")
+				assert.Equal(t, "client", claims.Subject)
+
+				if req.Header.Get("DPoP") == "" {
+					return mockJSONResponse(400, `{"error":"invalid_dpop_proof"}`), nil
+				}
+				requireSelfSignedDPoPProof(t, req.Header.Get("DPoP"), tt.alg)
+				return mockJSONResponse(200, `{"token_type":"DPoP","expires_in":3600,"access_token":"token","scope":"okta.users.read"}`), nil
+			})
+			httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "DPoP token", req.Header.Get("Authorization"))
+				requireSelfSignedDPoPProof(t, req.Header.Get("Dpop"), tt.alg)
+				return mockJSONResponse(200, "[]"), nil
+			})
+
+			dpopSigner, err := NewDPoPSigner(tt.signer)
+			require.NoError(t, err)
+			configuration, err := NewConfiguration(
+				WithOrgUrl("https://example.okta.com"),
+				WithAuthorizationMode("PrivateKey"),
+				WithClientId("client"),
+				WithScopes([]string{"okta.users.read"}),
+				WithClientAssertionSigner(tt.signer),
+				WithDPoPSigner(dpopSigner),
+				WithCache(false),
+			)
+			require.NoError(t, err, "Creating a new config should not error")
+			client := NewAPIClient(configuration)
+
+			_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+			require.NoError(t, err, "Request should succeed with a KMS held key")
+		})
+	}
+}
+
+// unsupportedSigner signs with an algorithm client assertions cannot use.
+type unsupportedSigner struct {
+	kmsSigner
+}
+
+func (s unsupportedSigner) Algorithm() string {
+	return "HS256"
+}
+
+func Test_Client_Assertion_Signer_Errors(t *testing.T) {
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithAuthorizationMode("PrivateKey"),
+		WithClientId("client"),
+		WithScopes([]string{"okta.users.read"}),
+		WithClientAssertionSigner(unsupportedSigner{}),
+		WithCache(false),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	assert.ErrorContains(t, err, `unsupported algorithm "HS256"`)
+
+	_, err = NewDPoPSigner(struct{ ClientAssertionSigner }{kmsSigner{}})
+	assert.ErrorContains(t, err, "does not implement Public() crypto.PublicKey")
+}
@@ -0,0 +1,517 @@
+// Package authzserver provisions custom authorization servers from a
+// declarative spec, as SaaS vendors do for each of their customers.
+//
+// Provision creates the authorization server, its scopes, claims, access
+// policies and rules, and trusts the authorization servers of the spec. It is
+// idempotent: resources are matched by name and only the missing or changed
+// ones are written, so it can be run again after a failure or to apply a
+// changed spec. Resources missing from the spec are left alone.
+package authzserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// Kinds of the resources reported in Change.
+const (
+	KindServer        = "server"
+	KindScope         = "scope"
+	KindClaim         = "claim"
+	KindPolicy        = "policy"
+	KindRule          = "rule"
+	KindTrustedServer = "trustedServer"
+)
+
+// Actions reported in Change.
+const (
+	Created = "CREATED"
+	Updated = "UPDATED"
+)
+
+// Spec describes an authorization server.
+type Spec struct {
+	// Name identifies the authorization server, it must be unique in the org.
+	Name        string
+	Description string
+	// Audiences are the aud claim of the access tokens, Okta supports one.
+	Audiences []string
+	// IssuerMode is ORG_URL, CUSTOM_URL or DYNAMIC, the default of the org
+	// when empty.
+	IssuerMode string
+	Scopes     []Scope
+	Claims     []Claim
+	Policies   []Policy
+	// TrustedServers are the ids of the authorization servers whose tokens
+	// the server accepts.
+	TrustedServers []string
+}
+
+// Scope is a scope of the authorization server.
+type Scope struct {
+	Name        string
+	DisplayName string
+	Description string
+	// Consent is REQUIRED, IMPLICIT or FLEXIBLE, IMPLICIT when empty.
+	Consent string
+	// Default makes the scope granted when a client requests none.
+	Default bool
+	// MetadataPublish is ALL_CLIENTS or NO_CLIENTS, NO_CLIENTS when empty.
+	MetadataPublish string
+}
+
+// Claim is a claim of the tokens of the authorization server.
+type Claim struct {
+	Name string
+	// ClaimType is RESOURCE for access tokens, the default, or IDENTITY for
+	// ID tokens.
+	ClaimType string
+	// ValueType is EXPRESSION, the default, or GROUPS.
+	ValueType string
+	// Value is an Okta expression, or the group filter of GROUPS claims.
+	Value string
+	// GroupFilterType filters the groups of GROUPS claims: STARTS_WITH,
+	// EQUALS, CONTAINS or REGEX.
+	GroupFilterType string
+	// Scopes restricts the claim to tokens with one of the scopes.
+	Scopes []string
+	// AlwaysIncludeInToken includes an IDENTITY claim in the ID token, rather
+	// than only in the userinfo response. Access token claims are always
+	// included.
+	AlwaysIncludeInToken bool
+}
+
+// Policy is an access policy of the authorization server.
+type Policy struct {
+	Name        string
+	Description string
+	// Priority orders the policies, starting at 1.
+	Priority int32
+	// Clients are the ids of the OAuth clients the policy applies to, all
+	// clients when empty.
+	Clients []string
+	Rules   []Rule
+}
+
+// Rule is a rule of an access policy.
+type Rule struct {
+	Name     string
+	Priority int32
+	// GrantTypes are the grant types the rule allows, client_credentials when
+	// empty.
+	GrantTypes []string
+	// Scopes are the scopes the rule grants, all scopes when empty.
+	Scopes []string
+	// Groups and Users are the ids of the groups and users the rule applies
+	// to, everyone when both are empty.
+	Groups []string
+	Users  []string
+	// The lifetimes of the tokens in minutes, the defaults of Okta when zero.
+	AccessTokenLifetimeMinutes  int32
+	RefreshTokenLifetimeMinutes int32
+	RefreshTokenWindowMinutes   int32
+}
+
+// Change is a resource written by Provision.
+type Change struct {
+	Kind   string
+	Name   string
+	Action string
+}
+
+// Result is the outcome of Provision.
+type Result struct {
+	Server okta.AuthorizationServer
+	// Changes lists the resources created or updated, none when the org
+	// already matched the spec.
+	Changes []Change
+}
+
+// Provision creates or updates the authorization server of spec and its
+// resources in the org of client.
+func Provision(ctx context.Context, client *okta.APIClient, spec Spec) (*Result, error) {
+	if spec.Name == "" {
+		return nil, errors.New("authorization server spec requires a name")
+	}
+	p := &provisioner{client: client, result: &Result{}}
+	serverID, err := p.server(ctx, spec)
+	if err != nil {
+		return p.result, err
+	}
+	if err := p.scopes(ctx, serverID, spec.Scopes); err != nil {
+		return p.result, err
+	}
+	if err := p.claims(ctx, serverID, spec.Claims); err != nil {
+		return p.result, err
+	}
+	for _, policy := range spec.Policies {
+		if err := p.policy(ctx, serverID, policy); err != nil {
+			return p.result, err
+		}
+	}
+	return p.result, p.trust(ctx, serverID, spec.TrustedServers)
+}
+
+type provisioner struct {
+	client *okta.APIClient
+	result *Result
+}
+
+func (p *provisioner) record(kind, name, action string) {
+	p.result.Changes = append(p.result.Changes, Change{Kind: kind, Name: name, Action: action})
+}
+
+func (p *provisioner) server(ctx context.Context, spec Spec) (string, error) {
+	api := p.client.AuthorizationServerAPI
+	desired := okta.AuthorizationServer{Name: okta.PtrString(spec.Name), Audiences: spec.Audiences}
+	if spec.Description != "" {
+		desired.Description = okta.PtrString(spec.Description)
+	}
+	if spec.IssuerMode != "" {
+		desired.IssuerMode = okta.PtrString(spec.IssuerMode)
+	}
+	servers, err := p.client.ListAllAuthorizationServers(api.ListAuthorizationServers(ctx).Q(spec.Name))
+	if err != nil {
+		return "", fmt.Errorf("failed to list authorization servers: %w", err)
+	}
+	var server *okta.AuthorizationServer
+	for i := range servers {
+		if servers[i].GetName() == spec.Name {
+			server = &servers[i]
+			break
+		}
+	}
+	switch {
+	case server == nil:
+		if server, _, err = api.CreateAuthorizationServer(ctx).AuthorizationServer(desired).Execute(); err != nil {
+			return "", fmt.Errorf("failed to create authorization server %s: %w", spec.Name, err)
+		}
+		p.record(KindServer, spec.Name, Created)
+	case !covers(server, desired):
+		if server, _, err = api.ReplaceAuthorizationServer(ctx, server.GetId()).AuthorizationServer(desired).Execute(); err != nil {
+			return "", fmt.Errorf("failed to update authorization server %s: %w", spec.Name, err)
+		}
+		p.record(KindServer, spec.Name, Updated)
+	}
+	p.result.Server = *server
+	return server.GetId(), nil
+}
+
+func (p *provisioner) scopes(ctx context.Context, serverID string, scopes []Scope) error {
+	if len(scopes) == 0 {
+		return nil
+	}
+	api := p.client.AuthorizationServerScopesAPI
+	existing, err := p.client.ListAllOAuth2Scopes(api.ListOAuth2Scopes(ctx, serverID))
+	if err != nil {
+		return fmt.Errorf("failed to list scopes: %w", err)
+	}
+	byName := map[string]okta.OAuth2Scope{}
+	for _, scope := range existing {
+		byName[scope.GetName()] = scope
+	}
+	for _, scope := range scopes {
+		desired := okta.OAuth2Scope{
+			Name:            okta.PtrString(scope.Name),
+			Consent:         okta.PtrString(orDefault(scope.Consent, "IMPLICIT")),
+			Default:         okta.PtrBool(scope.Default),
+			MetadataPublish: okta.PtrString(orDefault(scope.MetadataPublish, "NO_CLIENTS")),
+		}
+		if scope.DisplayName != "" {
+			desired.DisplayName = okta.PtrString(scope.DisplayName)
+		}
+		if scope.Description != "" {
+			desired.Description = okta.PtrString(scope.Description)
+		}
+		current, found := byName[scope.Name]
+		switch {
+		case !found:
+			if _, _, err := api.CreateOAuth2Scope(ctx, serverID).OAuth2Scope(desired).Execute(); err != nil {
+				return fmt.Errorf("failed to create scope %s: %w", scope.Name, err)
+			}
+			p.record(KindScope, scope.Name, Created)
+		case !covers(current, desired):
+			if _, _, err := api.ReplaceOAuth2Scope(ctx, serverID, current.GetId()).OAuth2Scope(desired).Execute(); err != nil {
+				return fmt.Errorf("failed to update scope %s: %w", scope.Name, err)
+			}
+			p.record(KindScope, scope.Name, Updated)
+		}
+	}
+	return nil
+}
+
+func (p *provisioner) claims(ctx context.Context, serverID string, claims []Claim) error {
+	if len(claims) == 0 {
+		return nil
+	}
+	api := p.client.AuthorizationServerClaimsAPI
+	existing, err := p.client.ListAllOAuth2Claims(api.ListOAuth2Claims(ctx, serverID))
+	if err != nil {
+		return fmt.Errorf("failed to list claims: %w", err)
+	}
+	// a name can be used by a claim of each type
+	byKey := map[string]okta.OAuth2Claim{}
+	for _, claim := range existing {
+		byKey[claim.GetClaimType()+" "+claim.GetName()] = claim
+	}
+	for _, claim := range claims {
+		claimType := orDefault(claim.ClaimType, "RESOURCE")
+		desired := okta.OAuth2Claim{
+			Name:      okta.PtrString(claim.Name),
+			ClaimType: okta.PtrString(claimType),
+			ValueType: okta.PtrString(orDefault(claim.ValueType, "EXPRESSION")),
+			Value:     okta.PtrString(claim.Value),
+			Status:    okta.PtrString("ACTIVE"),
+		}
+		if claim.GroupFilterType != "" {
+			desired.GroupFilterType = okta.PtrString(claim.GroupFilterType)
+		}
+		if len(claim.Scopes) > 0 {
+			desired.Conditions = &okta.OAuth2ClaimConditions{Scopes: claim.Scopes}
+		}
+		if claimType == "IDENTITY" {
+			desired.AlwaysIncludeInToken = okta.PtrBool(claim.AlwaysIncludeInToken)
+		}
+		current, found := byKey[claimType+" "+claim.Name]
+		switch {
+		case !found:
+			if _, _, err := api.CreateOAuth2Claim(ctx, serverID).OAuth2Claim(desired).Execute(); err != nil {
+				return fmt.Errorf("failed to create claim %s: %w", claim.Name, err)
+			}
+			p.record(KindClaim, claim.Name, Created)
+		case !covers(current, desired):
+			if _, _, err := api.ReplaceOAuth2Claim(ctx, serverID, current.GetId()).OAuth2Claim(desired).Execute(); err != nil {
+				return fmt.Errorf("failed to update claim %s: %w", claim.Name, err)
+			}
+			p.record(KindClaim, claim.Name, Updated)
+		}
+	}
+	return nil
+}
+
+func (p *provisioner) policy(ctx context.Context, serverID string, policy Policy) error {
+	api := p.client.AuthorizationServerPoliciesAPI
+	clients := policy.Clients
+	if len(clients) == 0 {
+		clients = []string{"ALL_CLIENTS"}
+	}
+	// the model of the spec only declares the conditions of the policy
+	desired := okta.AuthorizationServerPolicy{
+		Conditions: &okta.AuthorizationServerPolicyConditions{Clients: &okta.ClientPolicyCondition{Include: clients}},
+		AdditionalProperties: map[string]interface{}{
+			"type":        "OAUTH_AUTHORIZATION_POLICY",
+			"name":        policy.Name,
+			"description": orDefault(policy.Description, policy.Name),
+		},
+	}
+	if policy.Priority > 0 {
+		desired.AdditionalProperties["priority"] = policy.Priority
+	}
+	existing, err := p.client.ListAllAuthorizationServerPolicies(api.ListAuthorizationServerPolicies(ctx, serverID))
+	if err != nil {
+		return fmt.Errorf("failed to list policies: %w", err)
+	}
+	var current *okta.AuthorizationServerPolicy
+	for i := range existing {
+		if name, _ := existing[i].AdditionalProperties["name"].(string); name == policy.Name {
+			current = &existing[i]
+			break
+		}
+	}
+	switch {
+	case current == nil:
+		if current, _, err = api.CreateAuthorizationServerPolicy(ctx, serverID).Policy(desired).Execute(); err != nil {
+			return fmt.Errorf("failed to create policy %s: %w", policy.Name, err)
+		}
+		p.record(KindPolicy, policy.Name, Created)
+	case !covers(current, desired):
+		id, _ := current.AdditionalProperties["id"].(string)
+		if current, _, err = api.ReplaceAuthorizationServerPolicy(ctx, serverID, id).Policy(desired).Execute(); err != nil {
+			return fmt.Errorf("failed to update policy %s: %w", policy.Name, err)
+		}
+		p.record(KindPolicy, policy.Name, Updated)
+	}
+	policyID, _ := current.AdditionalProperties["id"].(string)
+	if policyID == "" {
+		return fmt.Errorf("policy %s has no id", policy.Name)
+	}
+	return p.rules(ctx, serverID, policyID, policy.Rules)
+}
+
+func (p *provisioner) rules(ctx context.Context, serverID, policyID string, rules []Rule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	api := p.client.AuthorizationServerRulesAPI
+	existing, err := p.client.ListAllAuthorizationServerPolicyRules(api.ListAuthorizationServerPolicyRules(ctx, serverID, policyID))
+	if err != nil {
+		return fmt.Errorf("failed to list policy rules: %w", err)
+	}
+	byName := map[string]okta.AuthorizationServerPolicyRule{}
+	for _, rule := range existing {
+		byName[rule.GetName()] = rule
+	}
+	for _, rule := range rules {
+		desired := desiredRule(rule)
+		current, found := byName[rule.Name]
+		switch {
+		case !found:
+			if _, _, err := api.CreateAuthorizationServerPolicyRule(ctx, serverID, policyID).PolicyRule(desired).Execute(); err != nil {
+				return fmt.Errorf("failed to create policy rule %s: %w", rule.Name, err)
+			}
+			p.record(KindRule, rule.Name, Created)
+		case !covers(current, desired):
+			if _, _, err := api.ReplaceAuthorizationServerPolicyRule(ctx, serverID, policyID, current.GetId()).PolicyRule(desired).Execute(); err != nil {
+				return fmt.Errorf("failed to update policy rule %s: %w", rule.Name, err)
+			}
+			p.record(KindRule, rule.Name, Updated)
+		}
+	}
+	return nil
+}
+
+// desiredRule returns the policy rule of rule.
+func desiredRule(rule Rule) okta.AuthorizationServerPolicyRule {
+	grantTypes := rule.GrantTypes
+	if len(grantTypes) == 0 {
+		grantTypes = []string{"client_credentials"}
+	}
+	scopes := rule.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"*"}
+	}
+	people := &okta.AuthorizationServerPolicyPeopleCondition{}
+	if len(rule.Groups) > 0 || len(rule.Users) == 0 {
+		groups := rule.Groups
+		if len(groups) == 0 {
+			groups = []string{"EVERYONE"}
+		}
+		people.Groups = &okta.AuthorizationServerPolicyRuleGroupCondition{Include: groups}
+	}
+	if len(rule.Users) > 0 {
+		people.Users = &okta.AuthorizationServerPolicyRuleUserCondition{Include: rule.Users}
+	}
+	token := &okta.TokenAuthorizationServerPolicyRuleAction{}
+	if rule.AccessTokenLifetimeMinutes > 0 {
+		token.AccessTokenLifetimeMinutes = okta.PtrInt32(rule.AccessTokenLifetimeMinutes)
+	}
+	if rule.RefreshTokenLifetimeMinutes > 0 {
+		token.RefreshTokenLifetimeMinutes = okta.PtrInt32(rule.RefreshTokenLifetimeMinutes)
+	}
+	if rule.RefreshTokenWindowMinutes > 0 {
+		token.RefreshTokenWindowMinutes = okta.PtrInt32(rule.RefreshTokenWindowMinutes)
+	}
+	desired := okta.AuthorizationServerPolicyRule{
+		Conditions: &okta.AuthorizationServerPolicyRuleConditions{
+			GrantTypes: &okta.GrantTypePolicyRuleCondition{Include: grantTypes},
+			People:     people,
+			Scopes:     &okta.OAuth2ScopesMediationPolicyRuleCondition{Include: scopes},
+		},
+		Actions: &okta.AuthorizationServerPolicyRuleActions{Token: token},
+	}
+	desired.Name = okta.PtrString(rule.Name)
+	desired.Type = okta.PtrString("RESOURCE_ACCESS")
+	if rule.Priority > 0 {
+		desired.Priority = okta.PtrInt32(rule.Priority)
+	}
+	return desired
+}
+
+func (p *provisioner) trust(ctx context.Context, serverID string, trusted []string) error {
+	if len(trusted) == 0 {
+		return nil
+	}
+	api := p.client.AuthorizationServerAssocAPI
+	existing, err := p.client.ListAllAssociatedServersByTrustedType(api.ListAssociatedServersByTrustedType(ctx, serverID).Trusted(true))
+	if err != nil {
+		return fmt.Errorf("failed to list trusted servers: %w", err)
+	}
+	known := map[string]bool{}
+	for _, server := range existing {
+		known[server.GetId()] = true
+	}
+	var missing []string
+	for _, id := range trusted {
+		if !known[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	if _, _, err := api.CreateAssociatedServers(ctx, serverID).AssociatedServerMediated(okta.AssociatedServerMediated{Trusted: missing}).Execute(); err != nil {
+		return fmt.Errorf("failed to trust authorization servers: %w", err)
+	}
+	for _, id := range missing {
+		p.record(KindTrustedServer, id, Created)
+	}
+	return nil
+}
+
+// covers reports whether the fields set in desired have the same values in
+// current, in their JSON form. Lists are compared regardless of their order.
+func covers(current, desired interface{}) bool {
+	var c, d interface{}
+	if err := roundTrip(current, &c); err != nil {
+		return false
+	}
+	if err := roundTrip(desired, &d); err != nil {
+		return false
+	}
+	return subset(c, d)
+}
+
+func roundTrip(v interface{}, out *interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func subset(current, desired interface{}) bool {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		fields, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for name, value := range desired {
+			if !subset(fields[name], value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		items, ok := current.([]interface{})
+		if !ok || len(items) != len(desired) {
+			return false
+		}
+		for _, want := range desired {
+			found := false
+			for _, item := range items {
+				if subset(item, want) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(current, desired)
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
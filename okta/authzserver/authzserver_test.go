@@ -0,0 +1,138 @@
+package authzserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta/internal/oktatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOrg keeps the resources of the authorization server endpoints, keyed by
+// the path of their collection.
+type fakeOrg struct {
+	mu          sync.Mutex
+	collections map[string][]map[string]interface{}
+	writes      []string
+	ids         int
+}
+
+func (o *fakeOrg) respond(req *http.Request) (*http.Response, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	path := strings.TrimPrefix(req.URL.Path, "/api/v1")
+	if req.Method == http.MethodGet {
+		items := o.collections[path]
+		if items == nil {
+			items = []map[string]interface{}{}
+		}
+		return httpmock.NewJsonResponse(200, items)
+	}
+	var body map[string]interface{}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	o.writes = append(o.writes, req.Method+" "+path)
+	if strings.HasSuffix(path, "/associatedServers") {
+		for _, id := range body["trusted"].([]interface{}) {
+			o.collections[path] = append(o.collections[path], map[string]interface{}{"id": id})
+		}
+		return httpmock.NewJsonResponse(200, o.collections[path])
+	}
+	if req.Method == http.MethodPost {
+		o.ids++
+		body["id"] = fmt.Sprintf("id%d", o.ids)
+		o.collections[path] = append(o.collections[path], body)
+		return httpmock.NewJsonResponse(201, body)
+	}
+	collection, id := path[:strings.LastIndex(path, "/")], path[strings.LastIndex(path, "/")+1:]
+	for i, item := range o.collections[collection] {
+		if item["id"] == id {
+			body["id"] = id
+			o.collections[collection][i] = body
+			return httpmock.NewJsonResponse(200, body)
+		}
+	}
+	return httpmock.NewJsonResponse(404, map[string]string{"errorCode": "E0000007"})
+}
+
+func Test_Provision(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	org := &fakeOrg{collections: map[string][]map[string]interface{}{
+		"/authorizationServers": {{"id": "ausOther", "name": "Acme Staging"}},
+	}}
+	httpmock.RegisterResponder("GET", `=~^/api/v1/authorizationServers`, org.respond)
+	httpmock.RegisterResponder("POST", `=~^/api/v1/authorizationServers`, org.respond)
+	httpmock.RegisterResponder("PUT", `=~^/api/v1/authorizationServers`, org.respond)
+
+	client := oktatest.NewClient(t)
+
+	spec := Spec{
+		Name:      "Acme",
+		Audiences: []string{"api://acme"},
+		Scopes:    []Scope{{Name: "invoices:read", Description: "Read invoices"}},
+		Claims:    []Claim{{Name: "tenant", Value: `"acme"`, Scopes: []string{"invoices:read"}}},
+		Policies: []Policy{{
+			Name:     "Services",
+			Priority: 1,
+			Rules:    []Rule{{Name: "Client credentials", Scopes: []string{"invoices:read"}, AccessTokenLifetimeMinutes: 30}},
+		}},
+		TrustedServers: []string{"ausOther"},
+	}
+	result, err := Provision(context.Background(), client, spec)
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", result.Server.GetName())
+	assert.Equal(t, []Change{
+		{Kind: KindServer, Name: "Acme", Action: Created},
+		{Kind: KindScope, Name: "invoices:read", Action: Created},
+		{Kind: KindClaim, Name: "tenant", Action: Created},
+		{Kind: KindPolicy, Name: "Services", Action: Created},
+		{Kind: KindRule, Name: "Client credentials", Action: Created},
+		{Kind: KindTrustedServer, Name: "ausOther", Action: Created},
+	}, result.Changes)
+
+	serverID := result.Server.GetId()
+	policy := org.collections["/authorizationServers/"+serverID+"/policies"][0]
+	assert.Equal(t, "OAUTH_AUTHORIZATION_POLICY", policy["type"])
+	assert.Equal(t, map[string]interface{}{"clients": map[string]interface{}{"include": []interface{}{"ALL_CLIENTS"}}}, policy["conditions"])
+	rule := org.collections[fmt.Sprintf("/authorizationServers/%s/policies/%s/rules", serverID, policy["id"])][0]
+	assert.Equal(t, "RESOURCE_ACCESS", rule["type"])
+	assert.Equal(t, map[string]interface{}{
+		"grantTypes": map[string]interface{}{"include": []interface{}{"client_credentials"}},
+		"people":     map[string]interface{}{"groups": map[string]interface{}{"include": []interface{}{"EVERYONE"}}},
+		"scopes":     map[string]interface{}{"include": []interface{}{"invoices:read"}},
+	}, rule["conditions"])
+
+	// a second run with the same spec writes nothing
+	org.writes = nil
+	result, err = Provision(context.Background(), client, spec)
+	require.NoError(t, err)
+	assert.Empty(t, result.Changes)
+	assert.Empty(t, org.writes)
+	assert.Equal(t, serverID, result.Server.GetId())
+
+	// a changed spec updates only what changed
+	spec.Scopes[0].Description = "Read the invoices"
+	spec.Policies[0].Rules[0].AccessTokenLifetimeMinutes = 60
+	result, err = Provision(context.Background(), client, spec)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Kind: KindScope, Name: "invoices:read", Action: Updated},
+		{Kind: KindRule, Name: "Client credentials", Action: Updated},
+	}, result.Changes)
+	assert.Len(t, org.collections["/authorizationServers/"+serverID+"/scopes"], 1)
+	assert.Equal(t, "Read the invoices", org.collections["/authorizationServers/"+serverID+"/scopes"][0]["description"])
+}
+
+func Test_Provision_Requires_Name(t *testing.T) {
+	_, err := Provision(context.Background(), nil, Spec{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta/internal/oktatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Backup_And_Restore(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	target := false
+	failSecondPage := true
+	httpmock.RegisterResponder("GET", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		if target {
+			return oktatest.JSONResponse(200, `[{"id":"00gNewEveryone","type":"BUILT_IN","profile":{"name":"Everyone"}}]`), nil
+		}
+		if req.URL.Query().Get("after") == "" {
+			resp := oktatest.JSONResponse(200, `[{"id":"00gEveryone","type":"BUILT_IN","profile":{"name":"Everyone"}}]`)
+			resp.Header.Set("Link", `<`+oktatest.OrgUrl+`/api/v1/groups?after=00gEveryone&limit=1>; rel="next"`)
+			return resp, nil
+		}
+		if failSecondPage {
+			failSecondPage = false
+			return nil, errors.New("connection reset")
+		}
+		return oktatest.JSONResponse(200, `[{"id":"00gSales","type":"OKTA_GROUP","profile":{"name":"Sales"},"lastUpdated":"2024-01-01T00:00:00.000Z"}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/policies", func(req *http.Request) (*http.Response, error) {
+		if target {
+			return oktatest.JSONResponse(200, `[]`), nil
+		}
+		return oktatest.JSONResponse(200, `[{"id":"00pSales","type":"OKTA_SIGN_ON","name":"Sales","conditions":{"people":{"groups":{"include":["00gSales"]}}}}]`), nil
+	})
+	var createdGroup, createdPolicy map[string]interface{}
+	httpmock.RegisterResponder("POST", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&createdGroup))
+		return oktatest.JSONResponse(200, `{"id":"00gNewSales","type":"OKTA_GROUP","profile":{"name":"Sales"}}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/policies", func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&createdPolicy))
+		return oktatest.JSONResponse(200, `{"id":"00pNewSales","type":"OKTA_SIGN_ON","name":"Sales"}`), nil
+	})
+
+	client := oktatest.NewClient(t)
+	ctx := context.Background()
+	root := t.TempDir()
+
+	m, err := Backup(ctx, client, root, Options{Families: []string{FamilyGroups, FamilySignOnPolicies}, ChunkSize: 1})
+	require.Error(t, err, "The second page of groups fails")
+	dir := filepath.Join(root, m.Version)
+	_, err = Verify(dir)
+	assert.Error(t, err, "An interrupted backup is incomplete")
+
+	m, err = Resume(ctx, client, dir)
+	require.NoError(t, err)
+	require.True(t, m.Complete())
+	require.Len(t, m.Families[0].Chunks, 2)
+	assert.Equal(t, "groups-00001.jsonl", m.Families[0].Chunks[1].File)
+	_, err = Verify(dir)
+	require.NoError(t, err)
+
+	target = true
+	report, err := Restore(ctx, client, dir, RestoreOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, report.Errors)
+	assert.Equal(t, map[string]string{
+		"00gEveryone": "00gNewEveryone",
+		"00gSales":    "00gNewSales",
+		"00pSales":    "00pNewSales",
+	}, report.IDMap)
+	assert.Equal(t, 1, report.Matched[FamilyGroups])
+	assert.Equal(t, 1, report.Created[FamilyGroups])
+	assert.NotContains(t, createdGroup, "id")
+	assert.NotContains(t, createdGroup, "lastUpdated")
+	assert.Equal(t, []interface{}{"00gNewSales"}, createdPolicy["conditions"].(map[string]interface{})["people"].(map[string]interface{})["groups"].(map[string]interface{})["include"])
+
+	chunk := filepath.Join(dir, m.Families[0].Chunks[0].File)
+	require.NoError(t, os.WriteFile(chunk, []byte(`{"id":"00gTampered"}`+"\n"), 0o600))
+	_, err = Restore(ctx, client, dir, RestoreOptions{})
+	assert.ErrorContains(t, err, "checksum mismatch")
+}
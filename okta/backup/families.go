@@ -0,0 +1,180 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// Resource families that can be backed up, in the order they are restored so
+// that references between them can be mapped.
+const (
+	FamilyNetworkZones              = "networkZones"
+	FamilyGroups                    = "groups"
+	FamilyApplications              = "applications"
+	FamilySignOnPolicies            = "policies-OKTA_SIGN_ON"
+	FamilyPasswordPolicies          = "policies-PASSWORD"
+	FamilyMFAEnrollPolicies         = "policies-MFA_ENROLL"
+	FamilyAccessPolicies            = "policies-ACCESS_POLICY"
+	FamilyIdPDiscoveryPolicies      = "policies-IDP_DISCOVERY"
+	FamilyProfileEnrollmentPolicies = "policies-PROFILE_ENROLLMENT"
+)
+
+// DefaultFamilies are the families backed up when Options.Families is empty.
+var DefaultFamilies = []string{
+	FamilyNetworkZones,
+	FamilyGroups,
+	FamilyApplications,
+	FamilySignOnPolicies,
+	FamilyPasswordPolicies,
+	FamilyMFAEnrollPolicies,
+	FamilyAccessPolicies,
+	FamilyIdPDiscoveryPolicies,
+	FamilyProfileEnrollmentPolicies,
+}
+
+// family describes how the resources of a family are listed, matched and
+// created.
+type family struct {
+	// list returns a page of resources starting at the cursor after, and the
+	// cursor of the next page, empty after the last page.
+	list func(ctx context.Context, c *okta.APIClient, after string, limit int32) ([]interface{}, string, error)
+	// key returns the natural key resources of an org are matched by when
+	// their ids differ, such as the name of a group.
+	key func(fields map[string]interface{}) string
+	// creatable reports whether a resource can be created, built-in
+	// resources only exist once per org.
+	creatable func(fields map[string]interface{}) bool
+	create    func(ctx context.Context, c *okta.APIClient, data []byte) (interface{}, error)
+}
+
+var families = map[string]family{
+	FamilyNetworkZones: {
+		list: func(ctx context.Context, c *okta.APIClient, after string, limit int32) ([]interface{}, string, error) {
+			req := c.NetworkZoneAPI.ListNetworkZones(ctx).Limit(limit)
+			if after != "" {
+				req = req.After(after)
+			}
+			return page(req.Execute())
+		},
+		key: stringField("name"),
+		creatable: func(fields map[string]interface{}) bool {
+			return fields["system"] != true
+		},
+		create: func(ctx context.Context, c *okta.APIClient, data []byte) (interface{}, error) {
+			var zone okta.ListNetworkZones200ResponseInner
+			if err := json.Unmarshal(data, &zone); err != nil {
+				return nil, err
+			}
+			created, _, err := c.NetworkZoneAPI.CreateNetworkZone(ctx).Zone(zone).Execute()
+			return created, err
+		},
+	},
+	FamilyGroups: {
+		list: func(ctx context.Context, c *okta.APIClient, after string, limit int32) ([]interface{}, string, error) {
+			req := c.GroupAPI.ListGroups(ctx).Limit(limit)
+			if after != "" {
+				req = req.After(after)
+			}
+			return page(req.Execute())
+		},
+		key: func(fields map[string]interface{}) string {
+			profile, _ := fields["profile"].(map[string]interface{})
+			return stringField("name")(profile)
+		},
+		creatable: func(fields map[string]interface{}) bool {
+			return fields["type"] == nil || fields["type"] == "OKTA_GROUP"
+		},
+		create: func(ctx context.Context, c *okta.APIClient, data []byte) (interface{}, error) {
+			var group okta.Group
+			if err := json.Unmarshal(data, &group); err != nil {
+				return nil, err
+			}
+			created, _, err := c.GroupAPI.CreateGroup(ctx).Group(group).Execute()
+			return created, err
+		},
+	},
+	FamilyApplications: {
+		list: func(ctx context.Context, c *okta.APIClient, after string, limit int32) ([]interface{}, string, error) {
+			req := c.ApplicationAPI.ListApplications(ctx).Limit(limit)
+			if after != "" {
+				req = req.After(after)
+			}
+			return page(req.Execute())
+		},
+		key: stringField("label"),
+		creatable: func(fields map[string]interface{}) bool {
+			return true
+		},
+		create: func(ctx context.Context, c *okta.APIClient, data []byte) (interface{}, error) {
+			var app okta.ListApplications200ResponseInner
+			if err := json.Unmarshal(data, &app); err != nil {
+				return nil, err
+			}
+			created, _, err := c.ApplicationAPI.CreateApplication(ctx).Application(app).Execute()
+			return created, err
+		},
+	},
+}
+
+func init() {
+	for _, name := range DefaultFamilies {
+		if _, ok := families[name]; !ok {
+			families[name] = policyFamily(name[len("policies-"):])
+		}
+	}
+}
+
+func policyFamily(policyType string) family {
+	return family{
+		list: func(ctx context.Context, c *okta.APIClient, after string, limit int32) ([]interface{}, string, error) {
+			req := c.PolicyAPI.ListPolicies(ctx).Type_(policyType).Limit(strconv.Itoa(int(limit)))
+			if after != "" {
+				req = req.After(after)
+			}
+			return page(req.Execute())
+		},
+		key: stringField("name"),
+		creatable: func(fields map[string]interface{}) bool {
+			return fields["system"] != true
+		},
+		create: func(ctx context.Context, c *okta.APIClient, data []byte) (interface{}, error) {
+			var policy okta.ListPolicies200ResponseInner
+			if err := json.Unmarshal(data, &policy); err != nil {
+				return nil, err
+			}
+			created, _, err := c.PolicyAPI.CreatePolicy(ctx).Policy(policy).Execute()
+			return created, err
+		},
+	}
+}
+
+// page converts the result of a list call into resources and the cursor of
+// the next page.
+func page[T any](items []T, resp *okta.APIResponse, err error) ([]interface{}, string, error) {
+	if err != nil {
+		return nil, "", err
+	}
+	out := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		out = append(out, item)
+	}
+	if !resp.HasNextPage() {
+		return out, "", nil
+	}
+	next, err := url.Parse(resp.NextPage())
+	if err != nil {
+		return nil, "", err
+	}
+	return out, next.Query().Get("after"), nil
+}
+
+func stringField(name string) func(map[string]interface{}) string {
+	return func(fields map[string]interface{}) string {
+		s, _ := fields[name].(string)
+		return s
+	}
+}
@@ -0,0 +1,189 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// readOnlyFields are left out of the resources sent to create them.
+var readOnlyFields = []string{"id", "created", "lastUpdated", "lastMembershipUpdated", "system", "_links", "_embedded"}
+
+// RestoreOptions configures Restore.
+type RestoreOptions struct {
+	// Families limits the restore to some of the families of the backup.
+	Families []string
+	// DryRun maps the ids of existing resources without creating the
+	// missing ones.
+	DryRun bool
+}
+
+// RestoreReport is the outcome of Restore.
+type RestoreReport struct {
+	// IDMap maps the ids of the backed up resources to their ids in the
+	// target org.
+	IDMap map[string]string
+	// Matched counts per family the resources that already existed, by id or
+	// by name.
+	Matched map[string]int
+	// Created counts per family the resources that were created, or would be
+	// in a dry run.
+	Created map[string]int
+	// Errors lists the resources that could not be restored.
+	Errors []RestoreError
+}
+
+// RestoreError is a resource that could not be restored.
+type RestoreError struct {
+	Family string
+	ID     string
+	Err    error
+}
+
+func (e RestoreError) Error() string {
+	return fmt.Sprintf("failed to restore %s %s: %v", e.Family, e.ID, e.Err)
+}
+
+// Restore restores the backup in dir into the org of client, family by family
+// in the order of the manifest. Resources that exist with the same id, or the
+// same name, are mapped to the existing ones. Missing resources are created
+// with the ids of the resources they reference mapped to the target org.
+// Built-in resources that do not exist are reported in Errors.
+func Restore(ctx context.Context, client *okta.APIClient, dir string, opts RestoreOptions) (*RestoreReport, error) {
+	m, err := Verify(dir)
+	if err != nil {
+		return nil, err
+	}
+	selected := map[string]bool{}
+	for _, name := range opts.Families {
+		selected[name] = true
+	}
+	report := &RestoreReport{IDMap: map[string]string{}, Matched: map[string]int{}, Created: map[string]int{}}
+	for _, f := range m.Families {
+		if len(selected) > 0 && !selected[f.Name] {
+			continue
+		}
+		if err := restoreFamily(ctx, client, dir, f, opts, report); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+func restoreFamily(ctx context.Context, client *okta.APIClient, dir string, f *FamilyManifest, opts RestoreOptions, report *RestoreReport) error {
+	fam := families[f.Name]
+	ids, keys, err := existing(ctx, client, fam)
+	if err != nil {
+		return fmt.Errorf("failed to list %s: %w", f.Name, err)
+	}
+	for _, chunk := range f.Chunks {
+		lines, err := readChunk(dir, chunk)
+		if err != nil {
+			return err
+		}
+		for _, line := range lines {
+			var fields map[string]interface{}
+			if err := json.Unmarshal(line, &fields); err != nil {
+				return fmt.Errorf("failed to read %s: %w", chunk.File, err)
+			}
+			id, _ := fields["id"].(string)
+			if ids[id] {
+				report.IDMap[id] = id
+				report.Matched[f.Name]++
+				continue
+			}
+			if match, ok := keys[fam.key(fields)]; ok {
+				report.IDMap[id] = match
+				report.Matched[f.Name]++
+				continue
+			}
+			if !fam.creatable(fields) {
+				report.Errors = append(report.Errors, RestoreError{Family: f.Name, ID: id, Err: fmt.Errorf("built-in resource %q does not exist", fam.key(fields))})
+				continue
+			}
+			if opts.DryRun {
+				report.Created[f.Name]++
+				continue
+			}
+			for _, name := range readOnlyFields {
+				delete(fields, name)
+			}
+			data, err := json.Marshal(remap(fields, report.IDMap))
+			if err != nil {
+				return err
+			}
+			created, err := fam.create(ctx, client, data)
+			if err != nil {
+				report.Errors = append(report.Errors, RestoreError{Family: f.Name, ID: id, Err: err})
+				continue
+			}
+			newFields, err := fieldsOf(created)
+			if err != nil {
+				return err
+			}
+			newID, _ := newFields["id"].(string)
+			report.IDMap[id] = newID
+			report.Created[f.Name]++
+		}
+	}
+	return nil
+}
+
+// existing returns the ids and the natural keys of the resources of a family
+// in the target org.
+func existing(ctx context.Context, client *okta.APIClient, fam family) (map[string]bool, map[string]string, error) {
+	ids, keys := map[string]bool{}, map[string]string{}
+	var cursor string
+	for {
+		items, next, err := fam.list(ctx, client, cursor, defaultChunkSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, item := range items {
+			fields, err := fieldsOf(item)
+			if err != nil {
+				return nil, nil, err
+			}
+			id, _ := fields["id"].(string)
+			ids[id] = true
+			if key := fam.key(fields); key != "" {
+				keys[key] = id
+			}
+		}
+		if next == "" || len(items) == 0 {
+			return ids, keys, nil
+		}
+		cursor = next
+	}
+}
+
+func fieldsOf(resource interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	return fields, json.Unmarshal(data, &fields)
+}
+
+// remap replaces every string of a resource that is the id of a restored
+// resource with its id in the target org.
+func remap(value interface{}, ids map[string]string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			v[k] = remap(field, ids)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = remap(item, ids)
+		}
+	case string:
+		if id, ok := ids[v]; ok {
+			return id
+		}
+	}
+	return value
+}
@@ -0,0 +1,155 @@
+package bench
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"testing"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// BenchmarkRequestBuild measures the client overhead of a call, the transport
+// answers with a canned response without any network I/O.
+func BenchmarkRequestBuild(b *testing.B) {
+	body, err := json.Marshal(user(1))
+	require.NoError(b, err)
+	httpClient := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Request:    req,
+		}, nil
+	})}
+	configuration, err := okta.NewConfiguration(
+		okta.WithOrgUrl("https://example.okta.com"),
+		okta.WithToken("bench"),
+		okta.WithCache(false),
+		okta.WithHttpClientPtr(httpClient),
+	)
+	require.NoError(b, err)
+	client := okta.NewAPIClient(configuration)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := client.UserAPI.GetUser(ctx, "00u1").Execute(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkDecodeUserPage measures decoding a full page of users.
+func BenchmarkDecodeUserPage(b *testing.B) {
+	for _, size := range []int{200, 1000} {
+		page := UserPage(0, size)
+		b.Run(fmt.Sprintf("users=%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(page)))
+			for i := 0; i < b.N; i++ {
+				var users []okta.User
+				if err := json.Unmarshal(page, &users); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkTokenChurn measures acquiring a new OAuth 2.0 access token with a
+// private key, each iteration starts with an empty token cache.
+func BenchmarkTokenChurn(b *testing.B) {
+	server := NewServer(1, 1)
+	defer server.Close()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(b, err)
+	signer, err := okta.NewCryptoSigner(key, "bench")
+	require.NoError(b, err)
+	configuration, err := server.Configuration(
+		okta.WithAuthorizationMode("PrivateKey"),
+		okta.WithClientId("bench"),
+		okta.WithScopes([]string{"okta.users.read"}),
+		okta.WithPrivateKeySigner(signer),
+	)
+	require.NoError(b, err)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := okta.NewAPIClient(configuration).AccessToken(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkCacheContention measures the response cache under 1k concurrent
+// goroutines reading and writing a small set of hot keys.
+func BenchmarkCacheContention(b *testing.B) {
+	cache := okta.NewGoCache(300, 300)
+	keys := make([]string, 64)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("https://example.okta.com/api/v1/users/00u%d", i)
+		cache.SetString(keys[i], string(UserPage(i, 1)))
+	}
+	b.SetParallelism(max(1, 1000/runtime.GOMAXPROCS(0)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := keys[i%len(keys)]
+			if i%10 == 0 {
+				cache.SetString(key, cache.GetString(key))
+			} else if cache.GetString(key) == "" {
+				b.Error("cache miss")
+			}
+			i++
+		}
+	})
+}
+
+func Test_Load_Against_Fake_Server(t *testing.T) {
+	server := NewServer(500, 200)
+	defer server.Close()
+	configuration, err := server.Configuration()
+	require.NoError(t, err)
+	client := okta.NewAPIClient(configuration)
+
+	result := RunLoad(context.Background(), LoadOptions{Concurrency: 16, Operations: 200}, func(ctx context.Context) error {
+		_, _, err := client.UserAPI.GetUser(ctx, "00u42").Execute()
+		return err
+	})
+	assert.Equal(t, 200, result.Operations)
+	assert.Zero(t, result.Errors)
+	assert.True(t, result.P50 <= result.P99)
+	assert.Positive(t, result.Throughput())
+
+	users, resp, err := client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err)
+	for resp.HasNextPage() {
+		var page []okta.User
+		resp, err = resp.Next(&page)
+		require.NoError(t, err)
+		users = append(users, page...)
+	}
+	assert.Len(t, users, 500)
+	assert.Equal(t, "00u499", users[499].GetId())
+}
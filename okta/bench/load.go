@@ -0,0 +1,93 @@
+package bench
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LoadOptions configures a load run.
+type LoadOptions struct {
+	// Concurrency is the number of goroutines issuing operations.
+	Concurrency int
+	// Operations is the total number of operations to run.
+	Operations int
+}
+
+// LoadResult summarizes a load run.
+type LoadResult struct {
+	Operations int
+	Errors     int
+	Duration   time.Duration
+	P50        time.Duration
+	P95        time.Duration
+	P99        time.Duration
+}
+
+// Throughput returns the number of operations per second.
+func (r LoadResult) Throughput() float64 {
+	if r.Duration == 0 {
+		return 0
+	}
+	return float64(r.Operations) / r.Duration.Seconds()
+}
+
+// RunLoad runs op opts.Operations times from opts.Concurrency goroutines and
+// reports latency percentiles. It stops early when ctx is done.
+func RunLoad(ctx context.Context, opts LoadOptions, op func(ctx context.Context) error) LoadResult {
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+	work := make(chan struct{})
+	go func() {
+		defer close(work)
+		for i := 0; i < opts.Operations; i++ {
+			select {
+			case work <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		mu        sync.Mutex
+		latencies = make([]time.Duration, 0, opts.Operations)
+		errors    int
+		wg        sync.WaitGroup
+	)
+	start := time.Now()
+	for i := 0; i < opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range work {
+				opStart := time.Now()
+				err := op(ctx)
+				latency := time.Since(opStart)
+				mu.Lock()
+				latencies = append(latencies, latency)
+				if err != nil {
+					errors++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	result := LoadResult{Operations: len(latencies), Errors: errors, Duration: time.Since(start)}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	result.P50 = percentile(latencies, 0.50)
+	result.P95 = percentile(latencies, 0.95)
+	result.P99 = percentile(latencies, 0.99)
+	return result
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	return sorted[int(float64(len(sorted)-1)*p)]
+}
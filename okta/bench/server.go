@@ -0,0 +1,136 @@
+// Package bench contains a fake Okta server, a load harness and a benchmark
+// suite used to compare the performance of the SDK across releases.
+//
+// Run the benchmarks with
+//
+//	go test -run ^$ -bench . -benchmem ./okta/bench
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// Server is a fake Okta org serving a fixed, deterministic set of users. It
+// implements the endpoints exercised by the benchmarks only.
+type Server struct {
+	*httptest.Server
+
+	users    int
+	pageSize int
+	requests atomic.Int64
+}
+
+// NewServer starts a fake org with the given number of users, listed in pages
+// of pageSize users. Close must be called when done.
+func NewServer(users, pageSize int) *Server {
+	s := &Server{users: users, pageSize: pageSize}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/users", s.listUsers)
+	mux.HandleFunc("GET /api/v1/users/{id}", s.getUser)
+	mux.HandleFunc("POST /oauth2/v1/token", s.token)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		mux.ServeHTTP(w, r)
+	}))
+	return s
+}
+
+// Requests returns the number of requests served so far.
+func (s *Server) Requests() int64 {
+	return s.requests.Load()
+}
+
+// Configuration returns a client configuration pointing at the fake org using
+// SSWS authorization and no response cache. conf is applied last.
+func (s *Server) Configuration(conf ...okta.ConfigSetter) (*okta.Configuration, error) {
+	return okta.NewConfiguration(append([]okta.ConfigSetter{
+		okta.WithOrgUrl(s.URL),
+		okta.WithToken("bench"),
+		okta.WithCache(false),
+		okta.WithHttpClientPtr(s.Client()),
+		okta.WithTestingDisableHttpsCheck(true),
+	}, conf...)...)
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	start := 0
+	if after := r.URL.Query().Get("after"); after != "" {
+		start, _ = strconv.Atoi(after)
+	}
+	end := min(start+s.pageSize, s.users)
+	if end < s.users {
+		w.Header().Add("Link", fmt.Sprintf(`<%s/api/v1/users?after=%d&limit=%d>; rel="next"`, s.URL, end, s.pageSize))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(UserPage(start, end-start))
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	n, err := strconv.Atoi(strings.TrimPrefix(r.PathValue("id"), "00u"))
+	if err != nil || n >= s.users {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errorCode":"E0000007","errorSummary":"Not found: Resource not found"}`))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(user(n))
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"bench","scope":"okta.users.read"}`))
+}
+
+// epoch is the fixed creation time of all generated users so the fixtures
+// are identical between runs.
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func user(n int) map[string]interface{} {
+	created := epoch.Add(time.Duration(n) * time.Minute).Format(time.RFC3339)
+	return map[string]interface{}{
+		"id":              fmt.Sprintf("00u%d", n),
+		"status":          "ACTIVE",
+		"created":         created,
+		"activated":       created,
+		"statusChanged":   created,
+		"lastLogin":       created,
+		"lastUpdated":     created,
+		"passwordChanged": created,
+		"type":            map[string]interface{}{"id": "oty1"},
+		"profile": map[string]interface{}{
+			"firstName":   "User",
+			"lastName":    strconv.Itoa(n),
+			"email":       fmt.Sprintf("user%d@example.com", n),
+			"login":       fmt.Sprintf("user%d@example.com", n),
+			"mobilePhone": nil,
+			"department":  fmt.Sprintf("Department %d", n%20),
+		},
+		"credentials": map[string]interface{}{
+			"password": map[string]interface{}{},
+			"provider": map[string]interface{}{"type": "OKTA", "name": "OKTA"},
+		},
+		"_links": map[string]interface{}{
+			"self": map[string]interface{}{"href": fmt.Sprintf("https://example.okta.com/api/v1/users/00u%d", n)},
+		},
+	}
+}
+
+// UserPage returns the JSON encoded page of count users starting at start.
+func UserPage(start, count int) []byte {
+	users := make([]map[string]interface{}, 0, count)
+	for i := start; i < start+count; i++ {
+		users = append(users, user(i))
+	}
+	b, _ := json.Marshal(users)
+	return b
+}
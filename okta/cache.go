@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"strings"
 )
 
 type Cache interface {
@@ -18,12 +19,20 @@ type Cache interface {
 
 func CreateCacheKey(req *http.Request) string {
 	s := req.URL.Scheme + "://" + req.URL.Host + req.URL.RequestURI()
+	// minimal representations requested with okta-response are cached apart
+	// from the full resource
+	if ct := req.Header.Get("Content-Type"); strings.Contains(ct, oktaResponseParam+"=") {
+		s += "#" + ct
+	}
 	return s
 }
 
+// CopyResponse returns a copy of resp with a body of its own, leaving the body
+// of resp readable.
 func CopyResponse(resp *http.Response) *http.Response {
 	c := *resp
-	respBody, err := ioutil.ReadAll(resp.Body)
+	c.Header = resp.Header.Clone()
+	respBody, err := readAndRestoreBody(resp)
 	if err != nil {
 		return resp
 	}
@@ -32,3 +41,42 @@ func CopyResponse(resp *http.Response) *http.Response {
 
 	return &c
 }
+
+// cachedBody is the body of the responses handed to the cache. Caches keeping
+// the responses in memory may return the same response to several callers, so
+// cachedResponse gives each of them a reader of its own over data.
+type cachedBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+func newCachedBody(data []byte) cachedBody {
+	return cachedBody{Reader: bytes.NewReader(data), data: data}
+}
+
+func (cachedBody) Close() error {
+	return nil
+}
+
+// cacheableResponse returns the copy of resp, whose body is buffered, that is
+// handed to the cache, so that the cache and the caller never share a body.
+func cacheableResponse(resp *http.Response) *http.Response {
+	c := *resp
+	c.Header = resp.Header.Clone()
+	c.Request = nil
+	if data, err := readAndRestoreBody(resp); err == nil {
+		c.Body = newCachedBody(data)
+	}
+	return &c
+}
+
+// cachedResponse returns the copy of a response returned by the cache that is
+// handed to a caller, with a body and headers of its own.
+func cachedResponse(resp *http.Response) *http.Response {
+	c := *resp
+	c.Header = resp.Header.Clone()
+	if body, ok := resp.Body.(cachedBody); ok {
+		c.Body = newCachedBody(body.data)
+	}
+	return &c
+}
@@ -0,0 +1,58 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type noCacheKey struct{}
+
+type cacheTTLKey struct{}
+
+// WithNoCache returns a context whose requests skip the request cache. The
+// fresh response of a GET replaces the cached one. Unlike RefreshNext it only
+// affects the calls made with the context, so it is safe to use from
+// concurrent goroutines sharing a client.
+func WithNoCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// WithCacheTTL returns a context whose GET responses are cached for d instead
+// of the default TTL of the cache, or not cached at all when d is not
+// positive. Caches that do not implement TTLCache use their default TTL.
+func WithCacheTTL(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, cacheTTLKey{}, d)
+}
+
+// TTLCache is implemented by caches that can store a response for a duration
+// other than their default, as requested with WithCacheTTL.
+type TTLCache interface {
+	Cache
+	SetWithTTL(key string, value *http.Response, ttl time.Duration)
+}
+
+func noCache(ctx context.Context) bool {
+	skip, _ := ctx.Value(noCacheKey{}).(bool)
+	return skip
+}
+
+// cacheResponse stores the response of req in the cache, honoring the TTL of
+// the context and of the cache rules. The cache is handed a copy with a
+// buffered body, so it never holds the connection or the body of resp.
+func (c *APIClient) cacheResponse(ctx context.Context, req *http.Request, key string, resp *http.Response) {
+	resp = cacheableResponse(resp)
+	ttl, ok := c.cacheTTL(ctx, req)
+	if !ok {
+		c.cache.Set(key, resp)
+		return
+	}
+	if ttl <= 0 {
+		return
+	}
+	if ttlCache, ok := c.cache.(TTLCache); ok {
+		ttlCache.SetWithTTL(key, resp, ttl)
+		return
+	}
+	c.cache.Set(key, resp)
+}
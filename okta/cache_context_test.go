@@ -0,0 +1,115 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Cache_Context_Options(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/abc", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"abc"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups/xyz", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"xyz"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(true))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	getUser := func(ctx context.Context) {
+		_, _, err := client.UserAPI.GetUser(ctx, "abc").Execute()
+		require.NoError(t, err)
+	}
+	getUser(ctx)
+	getUser(ctx)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	getUser(WithNoCache(ctx))
+	assert.Equal(t, 2, httpmock.GetTotalCallCount(), "WithNoCache should skip the cache")
+	getUser(ctx)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount(), "The fresh response should be cached")
+
+	getGroup := func(ctx context.Context) {
+		_, _, err := client.GroupAPI.GetGroup(ctx, "xyz").Execute()
+		require.NoError(t, err)
+	}
+	getGroup(WithCacheTTL(ctx, 0))
+	getGroup(ctx)
+	assert.Equal(t, 4, httpmock.GetTotalCallCount(), "A TTL of zero should not cache the response")
+
+	getGroup(WithCacheTTL(WithNoCache(ctx), 50*time.Millisecond))
+	getGroup(ctx)
+	assert.Equal(t, 5, httpmock.GetTotalCallCount())
+	time.Sleep(100 * time.Millisecond)
+	getGroup(ctx)
+	assert.Equal(t, 6, httpmock.GetTotalCallCount(), "The response should expire after the TTL of the context")
+}
+
+func Test_Cache_Bypass_Concurrent_Callers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/cached", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"cached"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users/fresh", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"fresh"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(true))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+	_, _, err = client.UserAPI.GetUser(ctx, "cached").Execute()
+	require.NoError(t, err)
+
+	const callers = 20
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, _, err := client.UserAPI.GetUser(ctx, "cached").Execute()
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, _, err := client.UserAPI.GetUser(WithNoCache(ctx), "fresh").Execute()
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	calls := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, calls["GET /api/v1/users/cached"], "Cached calls should not be affected by concurrent calls skipping the cache")
+	assert.Equal(t, callers, calls["GET /api/v1/users/fresh"], "Every call skipping the cache should reach the server")
+
+	// the deprecated RefreshNext is safe to call concurrently and applies to
+	// a single request
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.RefreshNext()
+		}()
+	}
+	wg.Wait()
+	for i := 0; i < 2; i++ {
+		_, _, err = client.UserAPI.GetUser(ctx, "cached").Execute()
+		require.NoError(t, err)
+	}
+	calls = httpmock.GetCallCountInfo()
+	assert.Equal(t, 2, calls["GET /api/v1/users/cached"])
+}
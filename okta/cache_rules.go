@@ -0,0 +1,108 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// CacheRule sets how long the GET responses of the paths matching Pattern are
+// cached, instead of the default TTL of the cache.
+type CacheRule struct {
+	// Pattern is a path such as "/api/v1/logs". Segments in braces, as in
+	// "/api/v1/users/{id}", match any single segment, and a last "*"
+	// segment, as in "/api/v1/meta/schemas/*", matches one or more segments.
+	Pattern string
+	// TTL is how long the responses are cached. They are not cached when it
+	// is not positive.
+	TTL time.Duration
+}
+
+// matches reports whether the path of a request matches the pattern of r.
+func (r CacheRule) matches(path string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	parts := strings.Split(strings.Trim(r.Pattern, "/"), "/")
+	for i, part := range parts {
+		if i >= len(segments) || segments[i] == "" {
+			return false
+		}
+		switch {
+		case part == "*" && i == len(parts)-1:
+			return true
+		case strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"):
+		case part != segments[i]:
+			return false
+		}
+	}
+	return len(parts) == len(segments)
+}
+
+// CacheInvalidation returns the paths whose cached responses are evicted
+// after a successful write with req, in addition to the written path. A path
+// evicts the responses of that path with any query; a path ending in "/*"
+// also evicts every path below it. Caches not implementing PrefixCache cannot
+// evict the queries of a path and are cleared instead.
+type CacheInvalidation func(req *http.Request) []string
+
+// InvalidateParentPaths is a CacheInvalidation evicting the paths above the
+// written one, so that a write to /api/v1/users/00u1/lifecycle/suspend evicts
+// /api/v1/users/00u1 and the lists of /api/v1/users.
+func InvalidateParentPaths(req *http.Request) []string {
+	var paths []string
+	path := strings.TrimSuffix(req.URL.Path, "/")
+	for {
+		i := strings.LastIndex(path, "/")
+		if i <= 0 {
+			return paths
+		}
+		path = path[:i]
+		paths = append(paths, path)
+	}
+}
+
+// PrefixCache is implemented by caches that can delete every key starting
+// with a prefix, as required to evict the paths below a path.
+type PrefixCache interface {
+	Cache
+	DeletePrefix(prefix string)
+}
+
+// cacheTTL returns the TTL of the response of req set with WithCacheTTL or by
+// the cache rules of the configuration, and false for the default TTL of the
+// cache.
+func (c *APIClient) cacheTTL(ctx context.Context, req *http.Request) (time.Duration, bool) {
+	if ttl, ok := ctx.Value(cacheTTLKey{}).(time.Duration); ok {
+		return ttl, true
+	}
+	for _, rule := range c.cfg.CacheRules {
+		if rule.matches(req.URL.Path) {
+			return rule.TTL, true
+		}
+	}
+	return 0, false
+}
+
+// invalidateCache evicts the cached responses of the paths returned by the
+// CacheInvalidation of the configuration for a successful write with req.
+func (c *APIClient) invalidateCache(req *http.Request) {
+	if c.cfg.CacheInvalidation == nil {
+		return
+	}
+	base := req.URL.Scheme + "://" + req.URL.Host
+	prefixCache, ok := c.cache.(PrefixCache)
+	if !ok {
+		c.cache.Clear()
+		return
+	}
+	for _, path := range c.cfg.CacheInvalidation(req) {
+		if subtree := strings.TrimSuffix(path, "*"); subtree != path {
+			prefixCache.DeletePrefix(base + subtree)
+			path = strings.TrimSuffix(subtree, "/")
+		}
+		prefixCache.Delete(base + path)
+		// the keys of the queries and representations of the path
+		prefixCache.DeletePrefix(base + path + "?")
+		prefixCache.DeletePrefix(base + path + "#")
+	}
+}
@@ -0,0 +1,115 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Cache_Rule_Patterns(t *testing.T) {
+	schemas := CacheRule{Pattern: "/api/v1/meta/schemas/*"}
+	assert.True(t, schemas.matches("/api/v1/meta/schemas/user/default"))
+	assert.True(t, schemas.matches("/api/v1/meta/schemas/user"))
+	assert.False(t, schemas.matches("/api/v1/meta/schemas"))
+
+	user := CacheRule{Pattern: "/api/v1/users/{id}"}
+	assert.True(t, user.matches("/api/v1/users/00u1"))
+	assert.False(t, user.matches("/api/v1/users"))
+	assert.False(t, user.matches("/api/v1/users/00u1/groups"))
+}
+
+func Test_Invalidate_Parent_Paths(t *testing.T) {
+	req := &http.Request{URL: &url.URL{Path: "/api/v1/users/00u1/lifecycle/suspend"}}
+	assert.Equal(t, []string{"/api/v1/users/00u1/lifecycle", "/api/v1/users/00u1", "/api/v1/users", "/api/v1", "/api"}, InvalidateParentPaths(req))
+}
+
+func Test_Cache_Rules_And_Invalidation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[{"id":"00u1"}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/logs", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[]`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1/lifecycle/suspend", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{}`), nil
+	})
+
+	store := newMemoryStore()
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("token"),
+		WithCache(true),
+		WithCacheTtl(60),
+		WithCacheStore(store),
+		WithCacheRules(CacheRule{Pattern: "/api/v1/logs", TTL: 0}, CacheRule{Pattern: "/api/v1/users", TTL: time.Hour}),
+		WithCacheInvalidation(InvalidateParentPaths),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	_, _, err = client.SystemLogAPI.ListLogEvents(ctx).Execute()
+	require.NoError(t, err)
+	assert.Empty(t, store.values, "Paths of a rule without TTL should not be cached")
+
+	_, _, err = client.UserAPI.ListUsers(ctx).Limit(10).Execute()
+	require.NoError(t, err)
+	require.Len(t, store.values, 1)
+	for _, ttl := range store.ttls {
+		assert.Equal(t, time.Hour, ttl)
+	}
+
+	_, err = client.UserAPI.SuspendUser(ctx, "00u1").Execute()
+	require.NoError(t, err)
+	assert.Empty(t, store.values, "A write should evict the lists of the parent paths")
+}
+
+func Test_Cache_Invalidation_Without_Prefix_Store(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[{"id":"00u1"}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00g1", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"00g1"}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1/lifecycle/suspend", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{}`), nil
+	})
+
+	store := newMemoryStore()
+	// a store that cannot delete the keys with a prefix
+	plain := struct{ CacheStore }{store}
+	assert.Implements(t, (*PrefixCache)(nil), newStoreCache(store, time.Minute))
+	assert.NotImplements(t, (*PrefixCache)(nil), newStoreCache(plain, time.Minute), "A StoreCache should not claim to delete prefixes")
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("token"),
+		WithCache(true),
+		WithCacheTtl(60),
+		WithCacheStore(plain),
+		WithCacheInvalidation(InvalidateParentPaths),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	_, _, err = client.UserAPI.ListUsers(ctx).Limit(10).Execute()
+	require.NoError(t, err)
+	_, _, err = client.GroupAPI.GetGroup(ctx, "00g1").Execute()
+	require.NoError(t, err)
+	require.Len(t, store.values, 2)
+
+	_, err = client.UserAPI.SuspendUser(ctx, "00u1").Execute()
+	require.NoError(t, err)
+	assert.Empty(t, store.values, "A cache that cannot evict the queries of a path should be cleared")
+}
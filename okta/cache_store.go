@@ -0,0 +1,291 @@
+package okta
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// CacheStore stores serialized values, so that a cache can live outside the
+// process and be shared by the replicas of a service, see the rediscache
+// package. Errors of the store are treated as cache misses.
+type CacheStore interface {
+	// Get returns the value of key, and false when it is not stored.
+	Get(key string) ([]byte, bool, error)
+	// Set stores value for ttl, or without expiration when ttl is not
+	// positive.
+	Set(key string, value []byte, ttl time.Duration) error
+	Delete(key string) error
+	// Clear deletes every value the store holds for the SDK.
+	Clear() error
+}
+
+// PrefixStore is implemented by the stores that can delete every key starting
+// with a prefix, which lets a PrefixStoreCache implement PrefixCache.
+type PrefixStore interface {
+	CacheStore
+	DeletePrefix(prefix string) error
+}
+
+// CacheEntry is the serializable form of a cached response.
+type CacheEntry struct {
+	StatusCode int         `json:"status"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+// NewCacheEntry buffers the body of resp, which can still be read afterwards,
+// into a CacheEntry.
+func NewCacheEntry(resp *http.Response) (*CacheEntry, error) {
+	body, err := readAndRestoreBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &CacheEntry{StatusCode: resp.StatusCode, Header: resp.Header.Clone(), Body: body}, nil
+}
+
+// Response returns a new response with the status, headers and body of e.
+func (e *CacheEntry) Response() *http.Response {
+	header := e.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+	}
+}
+
+// MarshalBinary encodes e for a CacheStore.
+func (e *CacheEntry) MarshalBinary() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// UnmarshalBinary decodes an entry encoded by MarshalBinary.
+func (e *CacheEntry) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, e)
+}
+
+// StoreCache is a Cache keeping its responses as CacheEntry values in a
+// CacheStore.
+type StoreCache struct {
+	store CacheStore
+	ttl   time.Duration
+}
+
+// NewStoreCache returns a Cache storing responses in store for ttl.
+func NewStoreCache(store CacheStore, ttl time.Duration) *StoreCache {
+	return &StoreCache{store: store, ttl: ttl}
+}
+
+func (c *StoreCache) Get(key string) *http.Response {
+	data, found, err := c.store.Get(key)
+	if err != nil || !found {
+		return nil
+	}
+	var entry CacheEntry
+	if err := entry.UnmarshalBinary(data); err != nil {
+		return nil
+	}
+	return entry.Response()
+}
+
+func (c *StoreCache) Set(key string, value *http.Response) {
+	c.SetWithTTL(key, value, c.ttl)
+}
+
+// SetWithTTL caches value for ttl instead of the default TTL.
+func (c *StoreCache) SetWithTTL(key string, value *http.Response, ttl time.Duration) {
+	entry, err := NewCacheEntry(value)
+	if err != nil {
+		return
+	}
+	data, err := entry.MarshalBinary()
+	if err != nil {
+		return
+	}
+	_ = c.store.Set(key, data, ttl)
+}
+
+func (c *StoreCache) GetString(key string) string {
+	data, _, _ := c.store.Get(key)
+	return string(data)
+}
+
+func (c *StoreCache) SetString(key string, value string) {
+	_ = c.store.Set(key, []byte(value), c.ttl)
+}
+
+func (c *StoreCache) Delete(key string) {
+	_ = c.store.Delete(key)
+}
+
+func (c *StoreCache) Clear() {
+	_ = c.store.Clear()
+}
+
+func (c *StoreCache) Has(key string) bool {
+	_, found, err := c.store.Get(key)
+	return err == nil && found
+}
+
+// PrefixStoreCache is a StoreCache of a PrefixStore, which implements
+// PrefixCache.
+type PrefixStoreCache struct {
+	*StoreCache
+	store PrefixStore
+}
+
+// NewPrefixStoreCache returns a PrefixCache storing responses in store for
+// ttl.
+func NewPrefixStoreCache(store PrefixStore, ttl time.Duration) *PrefixStoreCache {
+	return &PrefixStoreCache{StoreCache: NewStoreCache(store, ttl), store: store}
+}
+
+// DeletePrefix deletes every key starting with prefix.
+func (c *PrefixStoreCache) DeletePrefix(prefix string) {
+	_ = c.store.DeletePrefix(prefix)
+}
+
+// newStoreCache returns a PrefixStoreCache when store implements PrefixStore,
+// and a StoreCache otherwise.
+func newStoreCache(store CacheStore, ttl time.Duration) Cache {
+	if prefixStore, ok := store.(PrefixStore); ok {
+		return NewPrefixStoreCache(prefixStore, ttl)
+	}
+	return NewStoreCache(store, ttl)
+}
+
+// sharedToken is an access token of the token store.
+type sharedToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	// Nonce is the DPoP nonce of a DPoP-bound token, and DPoPKey the PKCS #8
+	// form of its ephemeral key, shared with WithShareDPoPTokens. Tokens bound
+	// to the key of WithDPoPSigner are shared without it.
+	Nonce   string `json:"nonce,omitempty"`
+	DPoPKey []byte `json:"dpopKey,omitempty"`
+}
+
+// tokenAuth serializes the requests of access tokens of the OAuth 2.0
+// authorization modes, so that concurrent calls of a client missing a token
+// share a single request, and shares the tokens through the TokenStore of the
+// configuration when there is one, so that the replicas of a service do not
+// each request their own.
+type tokenAuth struct {
+	Authorization
+	c *APIClient
+}
+
+func (a tokenAuth) Authorize(method, URL string) error {
+	if _, found := a.c.tokenCache.Get(AccessTokenCacheKey); found {
+		return a.Authorization.Authorize(method, URL)
+	}
+	a.c.tokenLock.Lock()
+	defer a.c.tokenLock.Unlock()
+	if a.c.cfg.TokenStore == nil {
+		return a.Authorization.Authorize(method, URL)
+	}
+	a.c.loadSharedToken()
+	_, before, _ := a.c.tokenCache.GetWithExpiration(AccessTokenCacheKey)
+	if err := a.Authorization.Authorize(method, URL); err != nil {
+		return err
+	}
+	if _, after, found := a.c.tokenCache.GetWithExpiration(AccessTokenCacheKey); found && !after.Equal(before) {
+		a.c.shareToken()
+	}
+	return nil
+}
+
+// tokenStoreKey is the key of the access token of the client in the token
+// store, apart from the tokens of other orgs, applications and scopes.
+func (c *APIClient) tokenStoreKey() string {
+	client := c.cfg.Okta.Client
+	return "okta-access-token:" + strings.Join([]string{client.OrgUrl, client.AuthorizationMode, client.ClientId, strings.Join(client.Scopes, " ")}, "|")
+}
+
+// loadSharedToken copies the access token of the token store into the token
+// cache of the client, unless it already holds one.
+func (c *APIClient) loadSharedToken() {
+	if _, found := c.tokenCache.Get(AccessTokenCacheKey); found {
+		return
+	}
+	data, found, err := c.cfg.TokenStore.Get(c.tokenStoreKey())
+	if err != nil || !found {
+		return
+	}
+	var token sharedToken
+	if err := json.Unmarshal(data, &token); err != nil || token.Token == "" {
+		return
+	}
+	ttl := time.Until(token.ExpiresAt)
+	if ttl <= 0 {
+		return
+	}
+	if token.Nonce != "" {
+		if !c.cfg.ShareDPoPTokens {
+			return
+		}
+		key := c.cfg.DPoPSigner
+		if token.DPoPKey != nil {
+			parsed, err := x509.ParsePKCS8PrivateKey(token.DPoPKey)
+			if err != nil {
+				return
+			}
+			key, _ = parsed.(crypto.Signer)
+		}
+		if key == nil {
+			return
+		}
+		c.tokenCache.Set(DpopAccessTokenNonce, token.Nonce, ttl)
+		c.tokenCache.Set(DpopAccessTokenPrivateKey, key, ttl)
+	}
+	c.tokenCache.Set(AccessTokenCacheKey, token.Token, ttl)
+}
+
+// shareToken publishes the access token of the token cache to the token store.
+// DPoP tokens are bound to a key of the process and are only shared, with the
+// key, when WithShareDPoPTokens allows it.
+func (c *APIClient) shareToken() {
+	token, expiresAt, found := c.tokenCache.GetWithExpiration(AccessTokenCacheKey)
+	if !found {
+		return
+	}
+	value, _ := token.(string)
+	ttl := time.Until(expiresAt)
+	if value == "" || ttl <= 0 {
+		return
+	}
+	shared := sharedToken{Token: value, ExpiresAt: expiresAt}
+	if nonce, _ := c.tokenCache.Get(DpopAccessTokenNonce); nonce != nil && nonce != "" {
+		if !c.cfg.ShareDPoPTokens {
+			return
+		}
+		shared.Nonce, _ = nonce.(string)
+		if c.cfg.DPoPSigner == nil {
+			key, _ := c.tokenCache.Get(DpopAccessTokenPrivateKey)
+			der, err := x509.MarshalPKCS8PrivateKey(key)
+			if err != nil {
+				return
+			}
+			shared.DPoPKey = der
+		}
+	}
+	data, err := json.Marshal(shared)
+	if err != nil {
+		return
+	}
+	_ = c.cfg.TokenStore.Set(c.tokenStoreKey(), data, ttl)
+}
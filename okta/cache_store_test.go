@@ -0,0 +1,159 @@
+package okta
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore is a CacheStore standing for a store shared by processes.
+type memoryStore struct {
+	mu     sync.Mutex
+	values map[string][]byte
+	ttls   map[string]time.Duration
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{values: map[string][]byte{}, ttls: map[string]time.Duration{}}
+}
+
+func (s *memoryStore) Get(key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.values[key]
+	return value, ok, nil
+}
+
+func (s *memoryStore) Set(key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = append([]byte(nil), value...)
+	s.ttls[key] = ttl
+	return nil
+}
+
+func (s *memoryStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+	return nil
+}
+
+func (s *memoryStore) DeletePrefix(prefix string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.values {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.values, key)
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values = map[string][]byte{}
+	return nil
+}
+
+func Test_Cache_Entry_Round_Trip(t *testing.T) {
+	resp := mockJSONResponse(200, `{"id":"00u1"}`)
+	resp.Header.Set("ETag", `W/"1"`)
+	entry, err := NewCacheEntry(resp)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, `{"id":"00u1"}`, string(body), "The body of the response should still be readable")
+
+	data, err := entry.MarshalBinary()
+	require.NoError(t, err)
+	var decoded CacheEntry
+	require.NoError(t, decoded.UnmarshalBinary(data))
+	cached := decoded.Response()
+	assert.Equal(t, 200, cached.StatusCode)
+	assert.Equal(t, `W/"1"`, cached.Header.Get("ETag"))
+	body, _ = io.ReadAll(cached.Body)
+	assert.Equal(t, `{"id":"00u1"}`, string(body))
+}
+
+func Test_Cache_Store_Shared_By_Clients(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"00u1","profile":{"login":"bob@example.com"}}`), nil
+	})
+
+	store := newMemoryStore()
+	newClient := func() *APIClient {
+		configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(true), WithCacheTtl(60), WithCacheStore(store))
+		require.NoError(t, err, "Creating a new config should not error")
+		return NewAPIClient(configuration)
+	}
+
+	user, _, err := newClient().UserAPI.GetUser(context.Background(), "00u1").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "00u1", user.GetId())
+	require.Len(t, store.values, 1)
+	for _, ttl := range store.ttls {
+		assert.Equal(t, time.Minute, ttl)
+	}
+
+	user, _, err = newClient().UserAPI.GetUser(context.Background(), "00u1").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "bob@example.com", user.Profile.GetLogin())
+	assert.Equal(t, 1, httpmock.GetTotalCallCount(), "Another client should use the shared cache")
+}
+
+func Test_Token_Store_Shared_By_Clients(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := NewCryptoSigner(key, "")
+	require.NoError(t, err)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"token_type":"Bearer","expires_in":3600,"access_token":"abc","scope":"okta.users.read"}`), nil
+	})
+
+	store := newMemoryStore()
+	newClient := func(scopes ...string) *APIClient {
+		configuration, err := NewConfiguration(
+			WithOrgUrl("https://example.okta.com"),
+			WithAuthorizationMode("PrivateKey"),
+			WithClientId("client"),
+			WithScopes(scopes),
+			WithPrivateKeySigner(signer),
+			WithCache(false),
+			WithTokenStore(store),
+		)
+		require.NoError(t, err, "Creating a new config should not error")
+		return NewAPIClient(configuration)
+	}
+
+	token, err := newClient("okta.users.read").AccessToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "abc", token.Token)
+	require.Len(t, store.values, 1)
+
+	token, err = newClient("okta.users.read").AccessToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer abc", token.Header())
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, 5*time.Second)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount(), "Another client should use the shared token")
+
+	_, err = newClient("okta.groups.read").AccessToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount(), "Tokens of other scopes should not be shared")
+}
@@ -0,0 +1,87 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Call describes an API call made by the client, as reported to a
+// CallObserver.
+type Call struct {
+	// Operation is the HTTP method and the path template of the operation in
+	// the API spec, such as "GET /api/v1/users/{id}".
+	Operation string
+	Method    string
+	// Route is the path template of the operation. Paths that are not part
+	// of the spec have their resource ids replaced with {id}.
+	Route string
+	// Purpose is the purpose the context of the call was tagged with by
+	// WithCallPurpose.
+	Purpose string
+	// Request is the request as passed to the HTTP client.
+	Request *http.Request
+
+	// The fields below are set once the call completed. StatusCode is 0
+	// when no response was received.
+	StatusCode int
+	// Duration is the time until the response was received, including
+	// retries.
+	Duration time.Duration
+	// Retries is the number of times the request was retried.
+	Retries int
+	// RateLimit holds the rate limit headers of the response, nil when it
+	// had none.
+	RateLimit *RateLimit
+	// RequestID is the X-Okta-Request-Id of the response.
+	RequestID string
+	// SchemaMismatches are the differences between the response and its
+	// schema found by the ResponseValidator of the configuration.
+	SchemaMismatches []SchemaMismatch
+	Err              error
+}
+
+// CallObserver is notified of every API call made through the client, for
+// example to trace them. See the oteltrace package for an OpenTelemetry
+// implementation.
+type CallObserver interface {
+	// StartCall is called before a call is sent. The call is made with the
+	// returned context, which can carry a span.
+	StartCall(ctx context.Context, call *Call) context.Context
+	// EndCall is called with the context returned by StartCall once the call
+	// completed.
+	EndCall(ctx context.Context, call *Call)
+}
+
+// observeCall makes a call through send, reporting it to the CallObserver of
+// the configuration.
+func (c *APIClient) observeCall(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	observer := c.cfg.CallObserver
+	if observer == nil {
+		return send(req)
+	}
+	call := &Call{Method: req.Method, Purpose: CallPurpose(req.Context()), Request: req}
+	if op := matchOperation(req.Method, req.URL.Path); op.path != "" {
+		call.Route = op.path
+	} else {
+		call.Route = genericPath(req.URL.Path)
+	}
+	call.Operation = req.Method + " " + call.Route
+	ctx := observer.StartCall(withCall(req.Context(), call), call)
+	req = req.WithContext(ctx)
+	call.Request = req
+
+	start := time.Now()
+	resp, err := send(req)
+	call.Duration = time.Since(start)
+	call.Err = err
+	call.Retries, _ = strconv.Atoi(req.Header.Get("X-Okta-Retry-Count"))
+	if resp != nil {
+		call.StatusCode = resp.StatusCode
+		call.RequestID = resp.Header.Get("X-Okta-Request-Id")
+		call.RateLimit, _ = c.parseLimitHeaders(resp)
+	}
+	observer.EndCall(ctx, call)
+	return resp, err
+}
@@ -0,0 +1,74 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type callKey struct{}
+
+type recordingObserver struct {
+	calls []*Call
+}
+
+func (o *recordingObserver) StartCall(ctx context.Context, call *Call) context.Context {
+	return context.WithValue(ctx, callKey{}, call)
+}
+
+func (o *recordingObserver) EndCall(ctx context.Context, call *Call) {
+	if ctx.Value(callKey{}) == call {
+		o.calls = append(o.calls, call)
+	}
+}
+
+func Test_Call_Observer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var sawContext bool
+	ok := mockJSONResponse(200, `{"id":"00u1a2b3c4d5e6f7g8h9"}`)
+	ok.Header.Set("X-Okta-Request-Id", "req-2")
+	ok.Header.Set("X-Rate-Limit-Limit", "600")
+	ok.Header.Set("X-Rate-Limit-Remaining", "599")
+	ok.Header.Set("X-Rate-Limit-Reset", strconv.FormatInt(time.Now().Unix()+60, 10))
+	ok.Header.Set("Date", time.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05 GMT"))
+	responses := []*http.Response{Mock429Response(), ok}
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1a2b3c4d5e6f7g8h9", func(req *http.Request) (*http.Response, error) {
+		sawContext = req.Context().Value(callKey{}) != nil
+		assert.Equal(t, "login-flow", req.Header.Get("X-Call-Purpose"))
+		resp := responses[0]
+		responses = responses[1:]
+		return resp, nil
+	})
+
+	observer := &recordingObserver{}
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false), WithCallObserver(observer), WithCallPurposeHeader("X-Call-Purpose"))
+	require.NoError(t, err, "Creating a new config should not error")
+	configuration.Okta.Client.RateLimit.MaxRetries = 1
+	client := NewAPIClient(configuration)
+
+	ctx := WithCallPurpose(context.Background(), "login-flow")
+	_, _, err = client.UserAPI.GetUser(ctx, "00u1a2b3c4d5e6f7g8h9").Execute()
+	require.NoError(t, err)
+	assert.True(t, sawContext, "The call is made with the context of the observer")
+
+	require.Len(t, observer.calls, 1)
+	call := observer.calls[0]
+	assert.Equal(t, "GET /api/v1/users/{userId}", call.Operation)
+	assert.Equal(t, "/api/v1/users/{userId}", call.Route)
+	assert.Equal(t, "login-flow", call.Purpose)
+	assert.Positive(t, call.Duration)
+	assert.Equal(t, 200, call.StatusCode)
+	assert.Equal(t, 1, call.Retries)
+	assert.Equal(t, "req-2", call.RequestID)
+	require.NotNil(t, call.RateLimit)
+	assert.Equal(t, 599, call.RateLimit.Remaining)
+	assert.NoError(t, call.Err)
+}
@@ -0,0 +1,59 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type callTimeoutKey struct{}
+
+type callHeaderKey struct{}
+
+type callMaxRetriesKey struct{}
+
+// WithCallTimeout returns a copy of ctx whose API calls time out after d,
+// overriding the timeout of their operation class, or have no timeout when d
+// is not positive. Unlike context.WithTimeout the clock starts with each
+// call, so the context can be reused for several calls. A deadline of ctx
+// still applies.
+func WithCallTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, callTimeoutKey{}, d)
+}
+
+// WithCallHeader returns a copy of ctx whose API calls send the header key
+// with value, in addition to the headers added by earlier calls of
+// WithCallHeader. It replaces a header of the same name set by the SDK.
+func WithCallHeader(ctx context.Context, key, value string) context.Context {
+	header := http.Header{}
+	if parent, ok := ctx.Value(callHeaderKey{}).(http.Header); ok {
+		header = parent.Clone()
+	}
+	header.Set(key, value)
+	return context.WithValue(ctx, callHeaderKey{}, header)
+}
+
+// WithCallMaxRetries returns a copy of ctx whose API calls are retried at most
+// n times, for example 0 for calls on a latency sensitive path. The Retryer
+// of the configuration still decides which responses are retried.
+func WithCallMaxRetries(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, callMaxRetriesKey{}, n)
+}
+
+func callTimeout(ctx context.Context) (time.Duration, bool) {
+	d, ok := ctx.Value(callTimeoutKey{}).(time.Duration)
+	return d, ok
+}
+
+func callMaxRetries(ctx context.Context) (int, bool) {
+	n, ok := ctx.Value(callMaxRetriesKey{}).(int)
+	return n, ok
+}
+
+// setCallHeaders adds the headers of WithCallHeader to req.
+func setCallHeaders(req *http.Request) {
+	header, _ := req.Context().Value(callHeaderKey{}).(http.Header)
+	for key, values := range header {
+		req.Header[key] = append([]string(nil), values...)
+	}
+}
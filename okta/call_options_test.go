@@ -0,0 +1,70 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Call_Options(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var calls int
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		calls++
+		return mockJSONResponse(503, `{"errorCode":"E0000009","errorSummary":"Internal Server Error"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "tenant-a", req.Header.Get("X-Tenant"))
+		assert.Equal(t, "checkout", req.Header.Get("X-Feature"))
+		return mockJSONResponse(200, `[{"id":"00u1"}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(50 * time.Millisecond):
+			return mockJSONResponse(200, `[]`), nil
+		}
+	})
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("token"),
+		WithCache(false),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 3, Statuses: []int{503}, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, _, err = client.UserAPI.GetUser(context.Background(), "00u1").Execute()
+	require.Error(t, err)
+	assert.Equal(t, 4, calls, "The configured retries should apply by default")
+
+	calls = 0
+	_, _, err = client.UserAPI.GetUser(WithCallMaxRetries(context.Background(), 0), "00u1").Execute()
+	require.Error(t, err)
+	assert.Equal(t, 1, calls, "A call without retries should be made once")
+
+	calls = 0
+	_, _, err = client.UserAPI.GetUser(WithCallMaxRetries(context.Background(), 1), "00u1").Execute()
+	require.Error(t, err)
+	assert.Equal(t, 2, calls)
+
+	ctx := WithCallHeader(WithCallHeader(context.Background(), "X-Tenant", "tenant-a"), "X-Feature", "checkout")
+	users, _, err := client.UserAPI.ListUsers(ctx).Execute()
+	require.NoError(t, err)
+	assert.Len(t, users, 1)
+
+	ctx = WithCallTimeout(context.Background(), 10*time.Millisecond)
+	_, _, err = client.GroupAPI.ListGroups(ctx).Execute()
+	require.Error(t, err, "The call should time out")
+	assert.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+	_, _, err = client.GroupAPI.ListGroups(WithCallTimeout(context.Background(), time.Second)).Execute()
+	require.NoError(t, err)
+}
@@ -0,0 +1,35 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+)
+
+type callPurposeKey struct{}
+
+// WithCallPurpose returns a copy of ctx tagging the API calls made with it
+// with purpose, such as the product feature they serve. The purpose is
+// reported to the CallObserver in Call.Purpose, and sent in the header set
+// with WithCallPurposeHeader, so rate limit spending and latency can be
+// attributed to features.
+func WithCallPurpose(ctx context.Context, purpose string) context.Context {
+	return context.WithValue(ctx, callPurposeKey{}, purpose)
+}
+
+// CallPurpose returns the purpose ctx was tagged with by WithCallPurpose, or
+// an empty string.
+func CallPurpose(ctx context.Context) string {
+	purpose, _ := ctx.Value(callPurposeKey{}).(string)
+	return purpose
+}
+
+// setCallPurpose sends the purpose of a request in the configured header.
+func (c *APIClient) setCallPurpose(req *http.Request) {
+	header := c.cfg.CallPurposeHeader
+	if header == "" {
+		return
+	}
+	if purpose := CallPurpose(req.Context()); purpose != "" {
+		req.Header.Set(header, purpose)
+	}
+}
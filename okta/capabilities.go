@@ -0,0 +1,174 @@
+package okta
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// capabilitiesTTL is how long the capabilities of an org are reused before
+// they are checked again.
+const capabilitiesTTL = time.Hour
+
+// capabilityProbes are the list endpoints requested to find out whether the
+// features providing them are available.
+var capabilityProbes = []string{
+	"/api/v1/realms",
+	"/api/v1/device-assurances",
+	"/api/v1/devices",
+	"/api/v1/iam/resource-sets",
+	"/api/v1/captchas",
+	"/api/v1/behaviors",
+	"/api/v1/logStreams",
+	"/api/v1/push-providers",
+	"/api/v1/email-domains",
+	"/api/v1/security-events-providers",
+	"/api/v1/authorizationServers",
+}
+
+// Capabilities reports which features an org can use.
+type Capabilities struct {
+	// Features holds the self-service features of the org by name, true when
+	// they are enabled.
+	Features map[string]bool
+	// Endpoints holds the features providing API endpoints, such as
+	// FeatureRealms, true when their endpoints answered and false when Okta
+	// reported them as not enabled.
+	Endpoints map[string]bool
+	// CheckedAt is when the features were last listed and probed.
+	CheckedAt time.Time
+}
+
+// Known reports whether the availability of a feature is known.
+func (c *Capabilities) Known(feature string) bool {
+	_, endpoint := c.Endpoints[feature]
+	_, selfService := c.Features[feature]
+	return endpoint || selfService
+}
+
+// Usable reports whether a feature is known to be available to the org.
+func (c *Capabilities) Usable(feature string) bool {
+	if usable, ok := c.Endpoints[feature]; ok {
+		return usable
+	}
+	return c.Features[feature]
+}
+
+type capabilityState struct {
+	refresh   sync.Mutex
+	mu        sync.Mutex
+	features  map[string]bool
+	endpoints map[string]bool
+	checkedAt time.Time
+}
+
+// orgCapabilities holds a *capabilityState per org and principal, shared by
+// the clients calling an org with the same credentials. What an org can use
+// depends on the admin roles and scopes of the principal, so clients of an org
+// with different credentials don't share it.
+var orgCapabilities sync.Map
+
+func (c *APIClient) capabilityState() *capabilityState {
+	state, _ := orgCapabilities.LoadOrStore(c.capabilitiesKey(), &capabilityState{endpoints: map[string]bool{}})
+	return state.(*capabilityState)
+}
+
+// capabilitiesKey identifies the org and principal of the client. The API
+// token of the SSWS and Bearer modes is hashed rather than kept in the key.
+func (c *APIClient) capabilitiesKey() string {
+	key := c.tokenStoreKey()
+	switch c.cfg.Okta.Client.AuthorizationMode {
+	case "SSWS", "Bearer":
+		sum := sha256.Sum256([]byte(c.cfg.Okta.Client.Token))
+		key += "|" + hex.EncodeToString(sum[:])
+	}
+	return key
+}
+
+func (s *capabilityState) snapshot() *Capabilities {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	caps := &Capabilities{
+		Features:  make(map[string]bool, len(s.features)),
+		Endpoints: make(map[string]bool, len(s.endpoints)),
+		CheckedAt: s.checkedAt,
+	}
+	for k, v := range s.features {
+		caps.Features[k] = v
+	}
+	for k, v := range s.endpoints {
+		caps.Endpoints[k] = v
+	}
+	return caps
+}
+
+// observeFeature records whether the feature of a request's endpoint turned
+// out to be available, so Capabilities reflects what regular calls found.
+func (c *APIClient) observeFeature(req *http.Request, resp *http.Response, err error) {
+	feature := featureOf(req.URL.Path)
+	if feature == "" {
+		return
+	}
+	var usable bool
+	switch {
+	case errors.Is(err, ErrFeatureNotEnabled):
+	case err == nil && resp.StatusCode >= 200 && resp.StatusCode <= 299:
+		usable = true
+	default:
+		return
+	}
+	state := c.capabilityState()
+	state.mu.Lock()
+	state.endpoints[feature] = usable
+	state.mu.Unlock()
+}
+
+// Capabilities reports which features the org can use, so tools working with
+// many orgs can branch without trial and error. The self-service features are
+// listed with FeatureAPI, and a list endpoint of every feature providing API
+// endpoints is probed. The result is cached per org and principal for an hour, while the outcome of
+// every later call to a feature endpoint is reflected immediately.
+func (c *APIClient) Capabilities(ctx context.Context) (*Capabilities, error) {
+	state := c.capabilityState()
+	state.refresh.Lock()
+	defer state.refresh.Unlock()
+	if caps := state.snapshot(); !caps.CheckedAt.IsZero() && time.Since(caps.CheckedAt) < capabilitiesTTL {
+		return caps, nil
+	}
+
+	list, _, err := c.FeatureAPI.ListFeatures(ctx).Execute()
+	if err != nil && !errors.Is(err, ErrFeatureNotEnabled) {
+		return nil, err
+	}
+	features := make(map[string]bool, len(list))
+	for _, f := range list {
+		features[f.GetName()] = f.GetStatus() == "ENABLED"
+	}
+	query := url.Values{}
+	query.Set("limit", "1")
+	for _, path := range capabilityProbes {
+		req, err := c.prepareRequest(ctx, path, http.MethodGet, nil, map[string]string{"Accept": "application/json"}, query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		// the outcome is recorded by observeFeature
+		resp, err := c.do(ctx, req)
+		if resp == nil {
+			return nil, err
+		}
+		if resp.Body != nil {
+			_ = tryDrainBody(resp.Body)
+		}
+	}
+
+	state.mu.Lock()
+	state.features = features
+	state.checkedAt = time.Now()
+	state.mu.Unlock()
+	return state.snapshot(), nil
+}
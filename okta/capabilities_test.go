@@ -0,0 +1,70 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Capabilities(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errorCode":"E0000007"}`))
+	httpmock.RegisterResponder("GET", "/api/v1/features", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[{"name":"Okta Identity Engine","status":"ENABLED"},{"name":"Early Access Feature","status":"DISABLED"}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/realms", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/device-assurances", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(403, `{"errorCode":"E0000015","errorSummary":"You do not have permission to access the feature you are requesting"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/security-events-providers", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(501, `{"errorCode":"E0000060","errorSummary":"Unsupported operation."}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://capabilities.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	caps, err := client.Capabilities(ctx)
+	require.NoError(t, err)
+	assert.True(t, caps.Usable("Okta Identity Engine"))
+	assert.False(t, caps.Usable("Early Access Feature"))
+	assert.True(t, caps.Usable(FeatureRealms))
+	assert.True(t, caps.Known(FeatureDeviceAssurance))
+	assert.False(t, caps.Usable(FeatureDeviceAssurance))
+	assert.False(t, caps.Usable(FeatureSharedSignals))
+	assert.False(t, caps.Known(FeatureCAPTCHA), "Probes that failed for other reasons leave the feature unknown")
+
+	calls := httpmock.GetTotalCallCount()
+	httpmock.RegisterResponder("GET", "/api/v1/captchas", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[]`), nil
+	})
+	_, _, err = client.CAPTCHAAPI.ListCaptchaInstances(ctx).Execute()
+	require.NoError(t, err)
+
+	caps, err = client.Capabilities(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, calls+1, httpmock.GetTotalCallCount(), "Capabilities should be cached per org")
+	assert.True(t, caps.Usable(FeatureCAPTCHA), "Calls made after probing should be observed")
+
+	other, err := NewConfiguration(WithOrgUrl("https://capabilities.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err)
+	caps, err = NewAPIClient(other).Capabilities(ctx)
+	require.NoError(t, err)
+	assert.True(t, caps.Usable(FeatureRealms), "Clients of the same org should share capabilities")
+	assert.Equal(t, calls+1, httpmock.GetTotalCallCount())
+
+	other, err = NewConfiguration(WithOrgUrl("https://capabilities.okta.com"), WithToken("other token"), WithCache(false))
+	require.NoError(t, err)
+	_, err = NewAPIClient(other).Capabilities(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, httpmock.GetTotalCallCount(), calls+1, "Clients with other credentials should not share capabilities")
+}
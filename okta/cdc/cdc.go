@@ -0,0 +1,158 @@
+// Package cdc translates System Log events into typed deltas of users, group
+// memberships and app assignments, so that consumers maintain replicas of
+// these resources from a stream of changes rather than by polling list
+// endpoints.
+//
+//	events := client.SystemLogAPI.Stream(ctx, okta.LogStreamOptions{Filter: cdc.Filter})
+//	for delta, err := range cdc.Deltas(events) {
+//		if err != nil {
+//			return err
+//		}
+//		replica.Apply(delta)
+//	}
+package cdc
+
+import (
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// Operations of deltas.
+const (
+	Create = "create"
+	Update = "update"
+	Delete = "delete"
+)
+
+// Resources deltas are about.
+const (
+	// ResourceUser is a user, identified by UserID.
+	ResourceUser = "user"
+	// ResourceGroupMembership is the membership of the user UserID in the
+	// group GroupID.
+	ResourceGroupMembership = "groupMembership"
+	// ResourceAppAssignment is the assignment of the user UserID to the app
+	// AppID.
+	ResourceAppAssignment = "appAssignment"
+)
+
+// Filter selects the System Log events Translate turns into deltas, for
+// okta.LogStreamOptions.Filter.
+const Filter = `eventType sw "user.lifecycle." or eventType eq "user.account.update_profile" or ` +
+	`eventType sw "group.user_membership." or eventType sw "application.user_membership."`
+
+// Delta is a change of a resource.
+type Delta struct {
+	Operation string
+	Resource  string
+	UserID    string
+	GroupID   string
+	AppID     string
+	// Status is the status of a user after the change, such as SUSPENDED,
+	// when the event implies it.
+	Status string
+	// EventType, EventID and Published identify the event the delta comes
+	// from; EventID is its uuid, to deduplicate deltas.
+	EventType string
+	EventID   string
+	Published time.Time
+	// ActorID is the id of who or what made the change.
+	ActorID string
+}
+
+// userStatuses are the statuses of users after lifecycle events.
+var userStatuses = map[string]string{
+	"user.lifecycle.activate":   "ACTIVE",
+	"user.lifecycle.reactivate": "PROVISIONED",
+	"user.lifecycle.deactivate": "DEPROVISIONED",
+	"user.lifecycle.suspend":    "SUSPENDED",
+	"user.lifecycle.unsuspend":  "ACTIVE",
+}
+
+// Translate returns the delta of event, and false for events that change none
+// of the resources or that failed. A user is deleted on
+// user.lifecycle.delete.initiated, from which on it can't be used;
+// user.lifecycle.delete.completed is skipped.
+func Translate(event *okta.LogEvent) (Delta, bool) {
+	if event.Outcome != nil && event.Outcome.GetResult() != "SUCCESS" {
+		return Delta{}, false
+	}
+	eventType := event.GetEventType()
+	delta := Delta{EventType: eventType, EventID: event.GetUuid(), Published: event.GetPublished()}
+	if event.Actor != nil {
+		delta.ActorID = event.Actor.GetId()
+	}
+	if user := event.TargetOfType("User"); user != nil {
+		delta.UserID = user.GetId()
+	}
+	if delta.UserID == "" {
+		return Delta{}, false
+	}
+	switch {
+	case eventType == "user.lifecycle.delete.completed":
+		return Delta{}, false
+	case strings.HasPrefix(eventType, "user.lifecycle.") || eventType == "user.account.update_profile":
+		delta.Resource = ResourceUser
+		delta.Operation = Update
+		switch eventType {
+		case "user.lifecycle.create":
+			delta.Operation = Create
+		case "user.lifecycle.delete.initiated":
+			delta.Operation = Delete
+		}
+		delta.Status = userStatuses[eventType]
+	case strings.HasPrefix(eventType, "group.user_membership."):
+		group := event.TargetOfType("UserGroup")
+		if group == nil {
+			return Delta{}, false
+		}
+		delta.Resource = ResourceGroupMembership
+		delta.GroupID = group.GetId()
+		delta.Operation = membershipOperation(eventType)
+	case strings.HasPrefix(eventType, "application.user_membership."):
+		app := event.TargetOfType("AppInstance")
+		if app == nil {
+			return Delta{}, false
+		}
+		delta.Resource = ResourceAppAssignment
+		delta.AppID = app.GetId()
+		delta.Operation = membershipOperation(eventType)
+	default:
+		return Delta{}, false
+	}
+	return delta, true
+}
+
+// membershipOperation returns the operation of a membership event: add
+// creates, remove deletes, and the others, such as change_username, update.
+func membershipOperation(eventType string) string {
+	switch eventType[strings.LastIndex(eventType, ".")+1:] {
+	case "add":
+		return Create
+	case "remove":
+		return Delete
+	}
+	return Update
+}
+
+// Deltas returns the deltas of events, such as those of
+// client.SystemLogAPI.Stream, skipping the events without one. The errors of
+// events are passed on.
+func Deltas(events iter.Seq2[okta.LogEvent, error]) iter.Seq2[Delta, error] {
+	return func(yield func(Delta, error) bool) {
+		for event, err := range events {
+			if err != nil {
+				if !yield(Delta{}, err) {
+					return
+				}
+				continue
+			}
+			if delta, ok := Translate(&event); ok && !yield(delta, nil) {
+				return
+			}
+		}
+	}
+}
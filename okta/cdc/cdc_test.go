@@ -0,0 +1,55 @@
+package cdc
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Translate(t *testing.T) {
+	var events []okta.LogEvent
+	require.NoError(t, json.Unmarshal([]byte(`[
+	  {"uuid":"e1","eventType":"user.lifecycle.create","published":"2024-08-13T09:30:00Z","actor":{"id":"00u9"},"outcome":{"result":"SUCCESS"},
+	   "target":[{"id":"00u1","type":"User"}]},
+	  {"uuid":"e2","eventType":"user.lifecycle.suspend","outcome":{"result":"SUCCESS"},"target":[{"id":"00u1","type":"User"}]},
+	  {"uuid":"e3","eventType":"user.lifecycle.suspend","outcome":{"result":"FAILURE"},"target":[{"id":"00u1","type":"User"}]},
+	  {"uuid":"e4","eventType":"group.user_membership.add","target":[{"id":"00u1","type":"User"},{"id":"00g1","type":"UserGroup"}]},
+	  {"uuid":"e5","eventType":"application.user_membership.change_username","target":[{"id":"0ua1","type":"AppUser"},{"id":"0oa1","type":"AppInstance"},{"id":"00u1","type":"User"}]},
+	  {"uuid":"e6","eventType":"application.user_membership.remove","target":[{"id":"0oa1","type":"AppInstance"},{"id":"00u1","type":"User"}]},
+	  {"uuid":"e7","eventType":"user.session.start","target":[{"id":"00u1","type":"User"}]},
+	  {"uuid":"e8","eventType":"user.lifecycle.delete.initiated","target":[{"id":"00u1","type":"User"}]},
+	  {"uuid":"e9","eventType":"user.lifecycle.delete.completed","target":[{"id":"00u1","type":"User"}]}
+	]`), &events))
+
+	failure := errors.New("poll failed")
+	var deltas []Delta
+	var errs []error
+	for delta, err := range Deltas(func(yield func(okta.LogEvent, error) bool) {
+		for _, event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+		yield(okta.LogEvent{}, failure)
+	}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		deltas = append(deltas, delta)
+	}
+	assert.Equal(t, []error{failure}, errs)
+	assert.Equal(t, []Delta{
+		{Operation: Create, Resource: ResourceUser, UserID: "00u1", EventType: "user.lifecycle.create", EventID: "e1", Published: time.Date(2024, 8, 13, 9, 30, 0, 0, time.UTC), ActorID: "00u9"},
+		{Operation: Update, Resource: ResourceUser, UserID: "00u1", Status: "SUSPENDED", EventType: "user.lifecycle.suspend", EventID: "e2"},
+		{Operation: Create, Resource: ResourceGroupMembership, UserID: "00u1", GroupID: "00g1", EventType: "group.user_membership.add", EventID: "e4"},
+		{Operation: Update, Resource: ResourceAppAssignment, UserID: "00u1", AppID: "0oa1", EventType: "application.user_membership.change_username", EventID: "e5"},
+		{Operation: Delete, Resource: ResourceAppAssignment, UserID: "00u1", AppID: "0oa1", EventType: "application.user_membership.remove", EventID: "e6"},
+		{Operation: Delete, Resource: ResourceUser, UserID: "00u1", EventType: "user.lifecycle.delete.initiated", EventID: "e8"},
+	}, deltas)
+}
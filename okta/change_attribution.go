@@ -0,0 +1,75 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ChangeAttribution is a successful change of a resource recorded in the
+// System Log.
+type ChangeAttribution struct {
+	// Actor is who performed the change.
+	Actor          LogActor
+	EventType      string
+	DisplayMessage string
+	Published      time.Time
+	// IPAddress is the address of the client the change was made from.
+	IPAddress     string
+	TransactionID string
+	// Target is the entry of the resource in the event. Its ChangeDetails
+	// hold the previous and new values when Okta records them.
+	Target LogTarget
+	// Event is the System Log event the attribution was taken from.
+	Event LogEvent
+}
+
+// AttributeChanges returns who changed a resource in [since, until), in order
+// of publication, joining its id with the targets of System Log events. until
+// defaults to now.
+func (c *APIClient) AttributeChanges(ctx context.Context, resourceID string, since, until time.Time) ([]ChangeAttribution, error) {
+	if resourceID == "" {
+		return nil, errors.New("resource id is required")
+	}
+	if until.IsZero() {
+		until = time.Now()
+	}
+	if !since.Before(until) {
+		return nil, nil
+	}
+	var attributions []ChangeAttribution
+	opts := LogBackfillOptions{
+		Since:         since,
+		Until:         until,
+		ShardDuration: until.Sub(since),
+		Concurrency:   1,
+		Filter:        `target.id eq "` + resourceID + `" and outcome.result eq "SUCCESS"`,
+	}
+	err := c.BackfillLogEvents(ctx, opts, func(ctx context.Context, shard LogShard, events []LogEvent) error {
+		for _, e := range events {
+			a := ChangeAttribution{
+				Actor:          e.GetActor(),
+				EventType:      e.GetEventType(),
+				DisplayMessage: e.GetDisplayMessage(),
+				Published:      e.GetPublished(),
+				Event:          e,
+			}
+			client := e.GetClient()
+			a.IPAddress = client.GetIpAddress()
+			transaction := e.GetTransaction()
+			a.TransactionID = transaction.GetId()
+			for _, t := range e.Target {
+				if t.GetId() == resourceID {
+					a.Target = t
+					break
+				}
+			}
+			attributions = append(attributions, a)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return attributions, nil
+}
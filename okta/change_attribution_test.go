@@ -0,0 +1,50 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Attribute_Changes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var filter string
+	httpmock.RegisterResponder("GET", "/api/v1/logs", func(req *http.Request) (*http.Response, error) {
+		filter = req.URL.Query().Get("filter")
+		return mockJSONResponse(200, `[
+		  {"uuid":"1","eventType":"group.profile.update","published":"2024-01-01T10:00:00.000Z",
+		   "actor":{"id":"00u1","alternateId":"admin@example.com","type":"User"},
+		   "client":{"ipAddress":"203.0.113.7"},"transaction":{"id":"tx1"},
+		   "target":[{"id":"00u2","type":"User"},{"id":"00g1","type":"UserGroup","changeDetails":{"from":{"name":"Sales"},"to":{"name":"Sales EMEA"}}}]},
+		  {"uuid":"2","eventType":"group.user_membership.add","published":"2024-01-01T11:00:00.000Z",
+		   "actor":{"id":"0oa1","displayName":"provisioning","type":"PublicClientApp"},
+		   "target":[{"id":"00g1","type":"UserGroup"}]}
+		]`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	attributions, err := client.AttributeChanges(context.Background(), "00g1", since, since.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, `target.id eq "00g1" and outcome.result eq "SUCCESS"`, filter)
+	require.Len(t, attributions, 2)
+
+	first := attributions[0]
+	assert.Equal(t, "admin@example.com", first.Actor.GetAlternateId())
+	assert.Equal(t, "group.profile.update", first.EventType)
+	assert.Equal(t, "203.0.113.7", first.IPAddress)
+	assert.Equal(t, "tx1", first.TransactionID)
+	changes := first.Target.GetChangeDetails()
+	assert.Equal(t, "Sales EMEA", changes.To["name"])
+	assert.Equal(t, "PublicClientApp", attributions[1].Actor.GetType())
+}
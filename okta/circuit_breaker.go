@@ -0,0 +1,186 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling Okta while the circuit breaker is
+// open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets requests through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails requests with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen lets a few probe requests through to tell whether
+	// Okta recovered.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// CircuitBreakerSettings configure a CircuitBreaker.
+type CircuitBreakerSettings struct {
+	// FailureThreshold is the number of consecutive failed requests opening
+	// the circuit, 5 by default.
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before probing Okta
+	// again, 30 seconds by default.
+	OpenDuration time.Duration
+	// HalfOpenProbes is the number of requests let through once the circuit
+	// is half-open, 1 by default. The circuit closes once they all
+	// succeeded and opens again as soon as one fails.
+	HalfOpenProbes int
+	// IsFailure tells the failed requests, by default network errors and 5xx
+	// responses. Canceled requests never count.
+	IsFailure func(resp *http.Response, err error) bool
+	// OnStateChange is called whenever the state of the circuit changes. It
+	// must not call the CircuitBreaker.
+	OnStateChange func(from, to CircuitState)
+	// Now returns the current time, time.Now by default.
+	Now func() time.Time
+}
+
+// CircuitBreaker stops sending requests to Okta after consecutive failures,
+// so that a degraded org is not hammered by the retries of every goroutine of
+// an application. While open, requests fail with ErrCircuitOpen. Pass it to
+// WithCircuitBreaker; it may be shared by the clients of the same org.
+type CircuitBreaker struct {
+	settings CircuitBreakerSettings
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probes   int // in flight while half-open
+	passed   int // probes that succeeded while half-open
+}
+
+// NewCircuitBreaker returns a closed CircuitBreaker.
+func NewCircuitBreaker(settings CircuitBreakerSettings) *CircuitBreaker {
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = 5
+	}
+	if settings.OpenDuration <= 0 {
+		settings.OpenDuration = 30 * time.Second
+	}
+	if settings.HalfOpenProbes <= 0 {
+		settings.HalfOpenProbes = 1
+	}
+	if settings.IsFailure == nil {
+		settings.IsFailure = defaultCircuitFailure
+	}
+	if settings.Now == nil {
+		settings.Now = time.Now
+	}
+	return &CircuitBreaker{settings: settings}
+}
+
+// State returns the current state of the circuit.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.halfOpenIfDue()
+	return b.state
+}
+
+// allow reports whether a request may be sent, counting it as a probe while
+// the circuit is half-open. Every allowed request must be followed by a call
+// to record. A nil CircuitBreaker allows everything.
+func (b *CircuitBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.halfOpenIfDue()
+	switch b.state {
+	case CircuitOpen:
+		retryIn := b.openedAt.Add(b.settings.OpenDuration).Sub(b.settings.Now())
+		return fmt.Errorf("%w, retry in %s", ErrCircuitOpen, retryIn.Round(time.Millisecond))
+	case CircuitHalfOpen:
+		if b.probes+b.passed >= b.settings.HalfOpenProbes {
+			return fmt.Errorf("%w, waiting for probe requests", ErrCircuitOpen)
+		}
+		b.probes++
+	}
+	return nil
+}
+
+// record updates the circuit with the outcome of an allowed request.
+func (b *CircuitBreaker) record(resp *http.Response, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	probe := b.state == CircuitHalfOpen && b.probes > 0
+	if probe {
+		b.probes--
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+	if b.settings.IsFailure(resp, err) {
+		b.failures++
+		if b.state == CircuitHalfOpen || b.state == CircuitClosed && b.failures >= b.settings.FailureThreshold {
+			b.setState(CircuitOpen)
+		}
+		return
+	}
+	b.failures = 0
+	if probe {
+		b.passed++
+		if b.passed >= b.settings.HalfOpenProbes {
+			b.setState(CircuitClosed)
+		}
+	}
+}
+
+func (b *CircuitBreaker) halfOpenIfDue() {
+	if b.state == CircuitOpen && !b.settings.Now().Before(b.openedAt.Add(b.settings.OpenDuration)) {
+		b.setState(CircuitHalfOpen)
+	}
+}
+
+func (b *CircuitBreaker) setState(state CircuitState) {
+	from := b.state
+	b.state = state
+	b.probes = 0
+	b.passed = 0
+	if state == CircuitOpen {
+		b.openedAt = b.settings.Now()
+	}
+	if state == CircuitClosed {
+		b.failures = 0
+	}
+	if from != state && b.settings.OnStateChange != nil {
+		b.settings.OnStateChange(from, state)
+	}
+}
+
+func defaultCircuitFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,87 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Circuit_Breaker(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	status := http.StatusServiceUnavailable
+	var calls int
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		calls++
+		return mockJSONResponse(status, "[]"), nil
+	})
+
+	now := time.Unix(1700000000, 0)
+	var changes []string
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("token"),
+		WithCache(false),
+		WithRateLimitMaxRetries(0),
+		WithCircuitBreaker(CircuitBreakerSettings{
+			FailureThreshold: 2,
+			OpenDuration:     time.Minute,
+			Now:              func() time.Time { return now },
+			OnStateChange: func(from, to CircuitState) {
+				changes = append(changes, from.String()+" -> "+to.String())
+			},
+		}),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	breaker := configuration.CircuitBreaker
+
+	for i := 0; i < 2; i++ {
+		_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrCircuitOpen)
+	}
+	assert.Equal(t, CircuitOpen, breaker.State())
+
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.ErrorIs(t, err, ErrCircuitOpen, "An open circuit should fail fast")
+	assert.Equal(t, 2, calls, "Okta should not be called while the circuit is open")
+
+	// a failed probe opens the circuit again
+	now = now.Add(time.Minute)
+	assert.Equal(t, CircuitHalfOpen, breaker.State())
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.Error(t, err)
+	assert.Equal(t, CircuitOpen, breaker.State())
+
+	status = http.StatusOK
+	now = now.Add(time.Minute)
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, CircuitClosed, breaker.State())
+	assert.Equal(t, 4, calls)
+	assert.Equal(t, []string{
+		"closed -> open",
+		"open -> half-open",
+		"half-open -> open",
+		"open -> half-open",
+		"half-open -> closed",
+	}, changes)
+}
+
+func Test_Circuit_Breaker_Ignores_Client_Errors(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerSettings{FailureThreshold: 1})
+	require.NoError(t, breaker.allow())
+	breaker.record(&http.Response{StatusCode: http.StatusNotFound}, nil)
+	require.NoError(t, breaker.allow())
+	breaker.record(nil, context.Canceled)
+	assert.Equal(t, CircuitClosed, breaker.State())
+	require.NoError(t, breaker.allow())
+	breaker.record(&http.Response{StatusCode: http.StatusTooManyRequests}, nil)
+	assert.Equal(t, CircuitClosed, breaker.State(), "Rate limiting is not a failure of Okta")
+}
@@ -26,7 +26,10 @@ package okta
 import (
 	"bytes"
 	"context"
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
@@ -51,13 +54,13 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unicode/utf8"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/go-jose/go-jose/v3"
 	"github.com/go-jose/go-jose/v3/jwt"
-	"github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/v3/jwk"
 	goCache "github.com/patrickmn/go-cache"
 	"golang.org/x/oauth2"
@@ -73,6 +76,11 @@ const (
 	AccessTokenCacheKey       = "OKTA_ACCESS_TOKEN"
 	DpopAccessTokenNonce      = "DPOP_OKTA_ACCESS_TOKEN_NONCE"
 	DpopAccessTokenPrivateKey = "DPOP_OKTA_ACCESS_TOKEN_PRIVATE_KEY"
+	DpopRequiredCacheKey      = "DPOP_OKTA_REQUIRED"
+
+	// SpecVersion is the version of the Okta management API spec the services
+	// and models in this package were generated from.
+	SpecVersion = "2024.06.1"
 )
 
 type RateLimit struct {
@@ -85,12 +93,19 @@ type RateLimit struct {
 // In most cases there should be only one, shared, APIClient.
 type APIClient struct {
 	cfg           *Configuration
-	common        service // Reuse a single struct instead of allocating one for each service on the heap.
+	httpClient    *http.Client // cfg.HTTPClient with the alternate IP dialer and fault injector
+	common        service      // Reuse a single struct instead of allocating one for each service on the heap.
 	cache         Cache
 	tokenCache    *goCache.Cache
-	freshcache    bool
-	rateLimit     *RateLimit
+	freshcache    atomic.Bool
+	rateLimits    map[string]*bucketLimit // by rateLimitBucket
 	rateLimitLock sync.Mutex
+	tokenLock     sync.Mutex // held while requesting an access token
+	ssws          *sswsTokenState
+	userAgent     atomic.Pointer[userAgentValue]
+	// groupResolvers holds the open GroupResolvers, evicting the groups
+	// written through the client
+	groupResolvers sync.Map
 
 	// API Services
 
@@ -284,49 +299,67 @@ func (a *BearerAuth) Authorize(method, URL string) error {
 }
 
 type PrivateKeyAuth struct {
-	tokenCache       *goCache.Cache
-	httpClient       *http.Client
-	privateKeySigner jose.Signer
-	privateKey       string
-	privateKeyId     string
-	clientId         string
-	orgURL           string
-	userAgent        string
-	scopes           []string
-	maxRetries       int32
-	maxBackoff       int64
-	req              *http.Request
+	tokenCache         *goCache.Cache
+	httpClient         *http.Client
+	privateKeySigner   jose.Signer
+	privateKey         string
+	privateKeyId       string
+	passphrase         string
+	passphraseProvider PassphraseProvider
+	dpopSigner         crypto.Signer
+	dpopKeyAlgorithm   string
+	clientId           string
+	orgURL             string
+	userAgent          string
+	scopes             []string
+	maxRetries         int32
+	maxBackoff         int64
+	tokenRetryStatuses []int
+	idGenerator        IDGenerator
+	req                *http.Request
 }
 
 type PrivateKeyAuthConfig struct {
-	TokenCache       *goCache.Cache
-	HttpClient       *http.Client
-	PrivateKeySigner jose.Signer
-	PrivateKey       string
-	PrivateKeyId     string
-	ClientId         string
-	OrgURL           string
-	UserAgent        string
-	Scopes           []string
-	MaxRetries       int32
-	MaxBackoff       int64
-	Req              *http.Request
+	TokenCache         *goCache.Cache
+	HttpClient         *http.Client
+	PrivateKeySigner   jose.Signer
+	PrivateKey         string
+	PrivateKeyId       string
+	Passphrase         string
+	PassphraseProvider PassphraseProvider
+	DPoPSigner         crypto.Signer
+	DPoPKeyAlgorithm   string
+	ClientId           string
+	OrgURL             string
+	UserAgent          string
+	Scopes             []string
+	MaxRetries         int32
+	MaxBackoff         int64
+	TokenRetryStatuses []int
+	IDGenerator        IDGenerator
+	Req                *http.Request
 }
 
 func NewPrivateKeyAuth(config PrivateKeyAuthConfig) *PrivateKeyAuth {
 	return &PrivateKeyAuth{
-		tokenCache:       config.TokenCache,
-		httpClient:       config.HttpClient,
-		privateKeySigner: config.PrivateKeySigner,
-		privateKey:       config.PrivateKey,
-		privateKeyId:     config.PrivateKeyId,
-		clientId:         config.ClientId,
-		orgURL:           config.OrgURL,
-		userAgent:        config.UserAgent,
-		scopes:           config.Scopes,
-		maxRetries:       config.MaxRetries,
-		maxBackoff:       config.MaxBackoff,
-		req:              config.Req,
+		tokenCache:         config.TokenCache,
+		httpClient:         config.HttpClient,
+		privateKeySigner:   config.PrivateKeySigner,
+		privateKey:         config.PrivateKey,
+		privateKeyId:       config.PrivateKeyId,
+		passphrase:         config.Passphrase,
+		passphraseProvider: config.PassphraseProvider,
+		dpopSigner:         config.DPoPSigner,
+		dpopKeyAlgorithm:   config.DPoPKeyAlgorithm,
+		clientId:           config.ClientId,
+		orgURL:             config.OrgURL,
+		userAgent:          config.UserAgent,
+		scopes:             config.Scopes,
+		maxRetries:         config.MaxRetries,
+		maxBackoff:         config.MaxBackoff,
+		tokenRetryStatuses: config.TokenRetryStatuses,
+		idGenerator:        config.IDGenerator,
+		req:                config.Req,
 	}
 }
 
@@ -343,7 +376,7 @@ func (a *PrivateKeyAuth) Authorize(method, URL string) error {
 				if len(res) != 2 {
 					return errors.New("Unidentified access token")
 				}
-				dpopJWT, err := generateDpopJWT(privateKey.(*rsa.PrivateKey), method, URL, nonce.(string), res[1])
+				dpopJWT, err := generateDpopJWT(privateKey.(crypto.Signer), method, URL, nonce.(string), res[1], a.idGenerator)
 				if err != nil {
 					return err
 				}
@@ -355,19 +388,22 @@ func (a *PrivateKeyAuth) Authorize(method, URL string) error {
 		}
 	} else {
 		if a.privateKeySigner == nil {
-			var err error
-			a.privateKeySigner, err = createKeySigner(a.privateKey, a.privateKeyId)
+			privateKey, err := decryptPrivateKey(a.privateKey, a.passphrase, a.passphraseProvider)
+			if err != nil {
+				return err
+			}
+			a.privateKeySigner, err = createKeySigner(privateKey, a.privateKeyId)
 			if err != nil {
 				return err
 			}
 		}
 
-		clientAssertion, err := createClientAssertion(a.orgURL, a.clientId, a.privateKeySigner)
+		clientAssertion, err := createClientAssertion(a.orgURL, a.clientId, a.privateKeySigner, a.idGenerator)
 		if err != nil {
 			return err
 		}
 
-		accessToken, nonce, privateKey, err := getAccessTokenForPrivateKey(a.httpClient, a.orgURL, clientAssertion, a.userAgent, a.scopes, a.maxRetries, a.maxBackoff, a.clientId, a.privateKeySigner)
+		accessToken, nonce, privateKey, err := getAccessTokenForPrivateKey(a.req.Context(), a.tokenCache, a.httpClient, a.orgURL, clientAssertion, a.userAgent, a.scopes, a.maxRetries, a.maxBackoff, a.tokenRetryStatuses, a.clientId, a.privateKeySigner, a.dpopSigner, a.dpopKeyAlgorithm, a.idGenerator)
 		if err != nil {
 			return err
 		}
@@ -378,7 +414,7 @@ func (a *PrivateKeyAuth) Authorize(method, URL string) error {
 
 		a.req.Header.Set("Authorization", fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken))
 		if accessToken.TokenType == "DPoP" {
-			dpopJWT, err := generateDpopJWT(privateKey, method, URL, nonce, accessToken.AccessToken)
+			dpopJWT, err := generateDpopJWT(privateKey, method, URL, nonce, accessToken.AccessToken, a.idGenerator)
 			if err != nil {
 				return err
 			}
@@ -389,48 +425,61 @@ func (a *PrivateKeyAuth) Authorize(method, URL string) error {
 		// Trim a couple of seconds off calculated expiry so cache expiry
 		// occures before Okta server side expiry.
 		expiration := accessToken.ExpiresIn - 2
-		a.tokenCache.Set(AccessTokenCacheKey, fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken), time.Second*time.Duration(expiration))
+		// the token last, as concurrent calls use it without a lock once set
 		a.tokenCache.Set(DpopAccessTokenNonce, nonce, time.Second*time.Duration(expiration))
 		a.tokenCache.Set(DpopAccessTokenPrivateKey, privateKey, time.Second*time.Duration(expiration))
+		a.tokenCache.Set(AccessTokenCacheKey, fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken), time.Second*time.Duration(expiration))
 	}
 	return nil
 }
 
 type JWTAuth struct {
-	tokenCache      *goCache.Cache
-	httpClient      *http.Client
-	orgURL          string
-	userAgent       string
-	scopes          []string
-	clientAssertion string
-	maxRetries      int32
-	maxBackoff      int64
-	req             *http.Request
+	tokenCache         *goCache.Cache
+	httpClient         *http.Client
+	orgURL             string
+	userAgent          string
+	scopes             []string
+	clientAssertion    string
+	dpopSigner         crypto.Signer
+	dpopKeyAlgorithm   string
+	maxRetries         int32
+	maxBackoff         int64
+	tokenRetryStatuses []int
+	idGenerator        IDGenerator
+	req                *http.Request
 }
 
 type JWTAuthConfig struct {
-	TokenCache      *goCache.Cache
-	HttpClient      *http.Client
-	OrgURL          string
-	UserAgent       string
-	Scopes          []string
-	ClientAssertion string
-	MaxRetries      int32
-	MaxBackoff      int64
-	Req             *http.Request
+	TokenCache         *goCache.Cache
+	HttpClient         *http.Client
+	OrgURL             string
+	UserAgent          string
+	Scopes             []string
+	ClientAssertion    string
+	DPoPSigner         crypto.Signer
+	DPoPKeyAlgorithm   string
+	MaxRetries         int32
+	MaxBackoff         int64
+	TokenRetryStatuses []int
+	IDGenerator        IDGenerator
+	Req                *http.Request
 }
 
 func NewJWTAuth(config JWTAuthConfig) *JWTAuth {
 	return &JWTAuth{
-		tokenCache:      config.TokenCache,
-		httpClient:      config.HttpClient,
-		orgURL:          config.OrgURL,
-		userAgent:       config.UserAgent,
-		scopes:          config.Scopes,
-		clientAssertion: config.ClientAssertion,
-		maxRetries:      config.MaxRetries,
-		maxBackoff:      config.MaxBackoff,
-		req:             config.Req,
+		tokenCache:         config.TokenCache,
+		httpClient:         config.HttpClient,
+		orgURL:             config.OrgURL,
+		userAgent:          config.UserAgent,
+		scopes:             config.Scopes,
+		clientAssertion:    config.ClientAssertion,
+		dpopSigner:         config.DPoPSigner,
+		dpopKeyAlgorithm:   config.DPoPKeyAlgorithm,
+		maxRetries:         config.MaxRetries,
+		maxBackoff:         config.MaxBackoff,
+		tokenRetryStatuses: config.TokenRetryStatuses,
+		idGenerator:        config.IDGenerator,
+		req:                config.Req,
 	}
 }
 
@@ -447,7 +496,7 @@ func (a *JWTAuth) Authorize(method, URL string) error {
 				if len(res) != 2 {
 					return errors.New("Unidentified access token")
 				}
-				dpopJWT, err := generateDpopJWT(privateKey.(*rsa.PrivateKey), method, URL, nonce.(string), res[1])
+				dpopJWT, err := generateDpopJWT(privateKey.(crypto.Signer), method, URL, nonce.(string), res[1], a.idGenerator)
 				if err != nil {
 					return err
 				}
@@ -458,7 +507,7 @@ func (a *JWTAuth) Authorize(method, URL string) error {
 			}
 		}
 	} else {
-		accessToken, nonce, privateKey, err := getAccessTokenForPrivateKey(a.httpClient, a.orgURL, a.clientAssertion, a.userAgent, a.scopes, a.maxRetries, a.maxBackoff, "", nil)
+		accessToken, nonce, privateKey, err := getAccessTokenForPrivateKey(a.req.Context(), a.tokenCache, a.httpClient, a.orgURL, a.clientAssertion, a.userAgent, a.scopes, a.maxRetries, a.maxBackoff, a.tokenRetryStatuses, "", nil, a.dpopSigner, a.dpopKeyAlgorithm, a.idGenerator)
 		if err != nil {
 			return err
 		}
@@ -469,7 +518,7 @@ func (a *JWTAuth) Authorize(method, URL string) error {
 
 		a.req.Header.Set("Authorization", fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken))
 		if accessToken.TokenType == "DPoP" {
-			dpopJWT, err := generateDpopJWT(privateKey, method, URL, nonce, accessToken.AccessToken)
+			dpopJWT, err := generateDpopJWT(privateKey, method, URL, nonce, accessToken.AccessToken, a.idGenerator)
 			if err != nil {
 				return err
 			}
@@ -480,61 +529,80 @@ func (a *JWTAuth) Authorize(method, URL string) error {
 		// Trim a couple of seconds off calculated expiry so cache expiry
 		// occures before Okta server side expiry.
 		expiration := accessToken.ExpiresIn - 2
-		a.tokenCache.Set(AccessTokenCacheKey, fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken), time.Second*time.Duration(expiration))
+		// the token last, as concurrent calls use it without a lock once set
 		a.tokenCache.Set(DpopAccessTokenNonce, nonce, time.Second*time.Duration(expiration))
 		a.tokenCache.Set(DpopAccessTokenPrivateKey, privateKey, time.Second*time.Duration(expiration))
+		a.tokenCache.Set(AccessTokenCacheKey, fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken), time.Second*time.Duration(expiration))
 	}
 	return nil
 }
 
 type JWKAuth struct {
-	tokenCache       *goCache.Cache
-	httpClient       *http.Client
-	jwk              string
-	encryptionType   string
-	privateKeySigner jose.Signer
-	privateKey       string
-	privateKeyId     string
-	clientId         string
-	orgURL           string
-	userAgent        string
-	scopes           []string
-	maxRetries       int32
-	maxBackoff       int64
-	req              *http.Request
+	tokenCache         *goCache.Cache
+	httpClient         *http.Client
+	jwk                string
+	encryptionType     string
+	privateKeySigner   jose.Signer
+	privateKey         string
+	privateKeyId       string
+	passphrase         string
+	passphraseProvider PassphraseProvider
+	dpopSigner         crypto.Signer
+	dpopKeyAlgorithm   string
+	clientId           string
+	orgURL             string
+	userAgent          string
+	scopes             []string
+	maxRetries         int32
+	maxBackoff         int64
+	tokenRetryStatuses []int
+	idGenerator        IDGenerator
+	req                *http.Request
 }
 
 type JWKAuthConfig struct {
-	TokenCache       *goCache.Cache
-	HttpClient       *http.Client
-	JWK              string
-	EncryptionType   string
-	PrivateKeySigner jose.Signer
-	PrivateKeyId     string
-	ClientId         string
-	OrgURL           string
-	UserAgent        string
-	Scopes           []string
-	MaxRetries       int32
-	MaxBackoff       int64
-	Req              *http.Request
+	TokenCache         *goCache.Cache
+	HttpClient         *http.Client
+	JWK                string
+	EncryptionType     string
+	PrivateKeySigner   jose.Signer
+	PrivateKeyId       string
+	Passphrase         string
+	PassphraseProvider PassphraseProvider
+	DPoPSigner         crypto.Signer
+	DPoPKeyAlgorithm   string
+	ClientId           string
+	OrgURL             string
+	UserAgent          string
+	Scopes             []string
+	MaxRetries         int32
+	MaxBackoff         int64
+	TokenRetryStatuses []int
+	IDGenerator        IDGenerator
+	Req                *http.Request
 }
 
 func NewJWKAuth(config JWKAuthConfig) *JWKAuth {
 	return &JWKAuth{
-		tokenCache:       config.TokenCache,
-		httpClient:       config.HttpClient,
-		jwk:              config.JWK,
-		encryptionType:   config.EncryptionType,
-		privateKeySigner: config.PrivateKeySigner,
-		privateKeyId:     config.PrivateKeyId,
-		clientId:         config.ClientId,
-		orgURL:           config.OrgURL,
-		userAgent:        config.UserAgent,
-		scopes:           config.Scopes,
-		maxRetries:       config.MaxRetries,
-		maxBackoff:       config.MaxBackoff,
-		req:              config.Req,
+		tokenCache:         config.TokenCache,
+		httpClient:         config.HttpClient,
+		jwk:                config.JWK,
+		encryptionType:     config.EncryptionType,
+		privateKeySigner:   config.PrivateKeySigner,
+		privateKeyId:       config.PrivateKeyId,
+		passphrase:         config.Passphrase,
+		passphraseProvider: config.PassphraseProvider,
+		dpopSigner:         config.DPoPSigner,
+		dpopKeyAlgorithm:   config.DPoPKeyAlgorithm,
+		clientId:           config.ClientId,
+		orgURL:             config.OrgURL,
+		userAgent:          config.UserAgent,
+		scopes:             config.Scopes,
+		maxRetries:         config.MaxRetries,
+		maxBackoff:         config.MaxBackoff,
+		tokenRetryStatuses: config.TokenRetryStatuses,
+		idGenerator:        config.IDGenerator,
+		req:                config.Req,
 	}
 }
 
@@ -551,7 +619,7 @@ func (a *JWKAuth) Authorize(method, URL string) error {
 				if len(res) != 2 {
 					return errors.New("Unidentified access token")
 				}
-				dpopJWT, err := generateDpopJWT(privateKey.(*rsa.PrivateKey), method, URL, nonce.(string), res[1])
+				dpopJWT, err := generateDpopJWT(privateKey.(crypto.Signer), method, URL, nonce.(string), res[1], a.idGenerator)
 				if err != nil {
 					return err
 				}
@@ -562,24 +630,28 @@ func (a *JWKAuth) Authorize(method, URL string) error {
 			}
 		}
 	} else {
-		privateKey, err := convertJWKToPrivateKey(a.jwk, a.encryptionType)
+		jwks, err := decryptJWK(a.jwk, a.passphrase, a.passphraseProvider)
+		if err != nil {
+			return err
+		}
+		privateKey, keyID, err := convertJWKToPrivateKey(jwks, a.encryptionType, a.privateKeyId)
 		if err != nil {
 			return err
 		}
 		if a.privateKeySigner == nil {
 			var err error
-			a.privateKeySigner, err = createKeySigner(privateKey, a.privateKeyId)
+			a.privateKeySigner, err = createKeySigner(privateKey, keyID)
 			if err != nil {
 				return err
 			}
 		}
 
-		clientAssertion, err := createClientAssertion(a.orgURL, a.clientId, a.privateKeySigner)
+		clientAssertion, err := createClientAssertion(a.orgURL, a.clientId, a.privateKeySigner, a.idGenerator)
 		if err != nil {
 			return err
 		}
 
-		accessToken, nonce, dpopPrivateKey, err := getAccessTokenForPrivateKey(a.httpClient, a.orgURL, clientAssertion, a.userAgent, a.scopes, a.maxRetries, a.maxBackoff, "", nil)
+		accessToken, nonce, dpopPrivateKey, err := getAccessTokenForPrivateKey(a.req.Context(), a.tokenCache, a.httpClient, a.orgURL, clientAssertion, a.userAgent, a.scopes, a.maxRetries, a.maxBackoff, a.tokenRetryStatuses, a.clientId, a.privateKeySigner, a.dpopSigner, a.dpopKeyAlgorithm, a.idGenerator)
 		if err != nil {
 			return err
 		}
@@ -590,7 +662,7 @@ func (a *JWKAuth) Authorize(method, URL string) error {
 
 		a.req.Header.Set("Authorization", fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken))
 		if accessToken.TokenType == "DPoP" {
-			dpopJWT, err := generateDpopJWT(dpopPrivateKey, method, URL, nonce, accessToken.AccessToken)
+			dpopJWT, err := generateDpopJWT(dpopPrivateKey, method, URL, nonce, accessToken.AccessToken, a.idGenerator)
 			if err != nil {
 				return err
 			}
@@ -601,42 +673,99 @@ func (a *JWKAuth) Authorize(method, URL string) error {
 		// Trim a couple of seconds off calculated expiry so cache expiry
 		// occures before Okta server side expiry.
 		expiration := accessToken.ExpiresIn - 2
-		a.tokenCache.Set(AccessTokenCacheKey, fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken), time.Second*time.Duration(expiration))
+		// the token last, as concurrent calls use it without a lock once set
 		a.tokenCache.Set(DpopAccessTokenNonce, nonce, time.Second*time.Duration(expiration))
 		a.tokenCache.Set(DpopAccessTokenPrivateKey, dpopPrivateKey, time.Second*time.Duration(expiration))
+		a.tokenCache.Set(AccessTokenCacheKey, fmt.Sprintf("%v %v", accessToken.TokenType, accessToken.AccessToken), time.Second*time.Duration(expiration))
 	}
 	return nil
 }
 
-func convertJWKToPrivateKey(jwks, encryptionType string) (string, error) {
+// convertJWKToPrivateKey selects a private key from a JWK or JWK set and
+// returns it PEM encoded along with its key id. When kid is empty the newest
+// active key is used, based on the "created" attribute Okta adds to service app
+// keys, falling back to the first key of the set.
+func convertJWKToPrivateKey(jwks, encryptionType, kid string) (string, string, error) {
 	set, err := jwk.Parse([]byte(jwks))
 	if err != nil {
-		return "", err
+		return "", "", err
+	}
+	if set.Len() == 0 {
+		return "", "", errors.New("JWK set does not contain any keys")
 	}
 
-	for i := range set.Keys() {
-		key, ok := set.Key(i)
+	var key jwk.Key
+	if kid != "" {
+		var ok bool
+		key, ok = set.LookupKeyID(kid)
 		if !ok {
-			return "", fmt.Errorf("failed to get key at index %d", i)
+			return "", "", fmt.Errorf("JWK set does not contain a key with kid %q", kid)
 		}
-		var rawkey interface{} // This is the raw key, like *rsa.PrivateKey or *ecdsa.PrivateKey
-		err := jwk.Export(key, &rawkey)
-		if err != nil {
-			return "", err
+	} else {
+		key = newestJWK(set)
+	}
+
+	var rawkey interface{} // This is the raw key, like *rsa.PrivateKey or *ecdsa.PrivateKey
+	if err := jwk.Export(key, &rawkey); err != nil {
+		return "", "", err
+	}
+	keyID, _ := key.KeyID()
+
+	switch encryptionType {
+	case "RSA":
+		rsaPrivateKey, ok := rawkey.(*rsa.PrivateKey)
+		if !ok {
+			return "", "", fmt.Errorf("expected rsa key, got %T", rawkey)
 		}
+		return string(privateKeyToBytes(rsaPrivateKey)), keyID, nil
+	case "EC":
+		if _, ok := rawkey.(*ecdsa.PrivateKey); !ok {
+			return "", "", fmt.Errorf("expected ec key, got %T", rawkey)
+		}
+	case "OKP":
+		if _, ok := rawkey.(ed25519.PrivateKey); !ok {
+			return "", "", fmt.Errorf("expected okp key, got %T", rawkey)
+		}
+	default:
+		return "", "", fmt.Errorf("unknown encryptionType %v", encryptionType)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(rawkey)
+	if err != nil {
+		return "", "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), keyID, nil
+}
 
-		switch encryptionType {
-		case "RSA":
-			rsaPrivateKey, ok := rawkey.(*rsa.PrivateKey)
-			if !ok {
-				return "", fmt.Errorf("expected rsa key, got %T", rawkey)
-			}
-			return string(privateKeyToBytes(rsaPrivateKey)), nil
-		default:
-			return "", fmt.Errorf("unknown encryptionType %v", encryptionType)
+// newestJWK returns the active key with the most recent "created" attribute,
+// or the first key of the set when none carry one.
+func newestJWK(set jwk.Set) jwk.Key {
+	first, _ := set.Key(0)
+	var (
+		newest  jwk.Key
+		created time.Time
+	)
+	for i := 0; i < set.Len(); i++ {
+		key, _ := set.Key(i)
+		var status string
+		if key.Get("status", &status) == nil && status == "INACTIVE" {
+			continue
 		}
+		var rawCreated string
+		if key.Get("created", &rawCreated) != nil {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, rawCreated)
+		if err != nil {
+			continue
+		}
+		if newest == nil || t.After(created) {
+			newest, created = key, t
+		}
+	}
+	if newest == nil {
+		return first
 	}
-	return "", fmt.Errorf("unknown encryptionType %v", encryptionType)
+	return newest
 }
 
 func createKeySigner(privateKey, privateKeyID string) (jose.Signer, error) {
@@ -664,15 +793,21 @@ func createKeySigner(privateKey, privateKeyID string) (jose.Signer, error) {
 			return nil, err
 		}
 		var alg jose.SignatureAlgorithm
-		switch parsedKey.(type) {
+		switch k := parsedKey.(type) {
 		case *rsa.PrivateKey:
 			alg = jose.RS256
 		case *ecdsa.PrivateKey:
-			alg = jose.ES256 // TODO handle ES384 or ES512 ?
+			switch k.Curve.Params().BitSize {
+			case 384:
+				alg = jose.ES384
+			case 521:
+				alg = jose.ES512
+			default:
+				alg = jose.ES256
+			}
+		case ed25519.PrivateKey:
+			alg = jose.EdDSA
 		default:
-			// TODO are either of these also valid?
-			// ed25519.PrivateKey:
-			// *ecdh.PrivateKey
 			return nil, fmt.Errorf("private key %q is unknown pkcs#8 format type", privPem.Type)
 		}
 		return jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: parsedKey}, signerOptions)
@@ -681,20 +816,20 @@ func createKeySigner(privateKey, privateKeyID string) (jose.Signer, error) {
 	return nil, fmt.Errorf("private key %q is not pkcs#1 or pkcs#8 format", privPem.Type)
 }
 
-func createClientAssertion(orgURL, clientID string, privateKeySinger jose.Signer) (clientAssertion string, err error) {
+func createClientAssertion(orgURL, clientID string, privateKeySinger jose.Signer, newID IDGenerator) (clientAssertion string, err error) {
 	claims := ClientAssertionClaims{
 		Subject:  clientID,
 		IssuedAt: jwt.NewNumericDate(time.Now()),
 		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour * time.Duration(1))),
 		Issuer:   clientID,
 		Audience: orgURL + "/oauth2/v1/token",
-		ID:       uuid.New().String(),
+		ID:       newID.newID(),
 	}
 	jwtBuilder := jwt.Signed(privateKeySinger).Claims(claims)
 	return jwtBuilder.CompactSerialize()
 }
 
-func getAccessTokenForPrivateKey(httpClient *http.Client, orgURL, clientAssertion, userAgent string, scopes []string, maxRetries int32, maxBackoff int64, clientID string, signer jose.Signer) (*RequestAccessToken, string, *rsa.PrivateKey, error) {
+func getAccessTokenForPrivateKey(ctx context.Context, tokenCache *goCache.Cache, httpClient *http.Client, orgURL, clientAssertion, userAgent string, scopes []string, maxRetries int32, maxBackoff int64, retryStatuses []int, clientID string, signer jose.Signer, dpopSigner crypto.Signer, dpopKeyAlgorithm string, newID IDGenerator) (*RequestAccessToken, string, crypto.Signer, error) {
 	query := url.Values{}
 	tokenRequestURL := orgURL + "/oauth2/v1/token"
 
@@ -703,47 +838,43 @@ func getAccessTokenForPrivateKey(httpClient *http.Client, orgURL, clientAssertio
 	query.Add("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
 	query.Add("client_assertion", clientAssertion)
 
-	tokenRequest, err := http.NewRequest("POST", tokenRequestURL, strings.NewReader(query.Encode()))
+	tokenRequest, err := http.NewRequestWithContext(ctx, "POST", tokenRequestURL, strings.NewReader(query.Encode()))
 	if err != nil {
 		return nil, "", nil, err
 	}
 	tokenRequest.Header.Add("Accept", "application/json")
 	tokenRequest.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 	tokenRequest.Header.Add("User-Agent", userAgent)
-	bOff := &oktaBackoff{
-		ctx:             context.TODO(),
-		maxRetries:      maxRetries,
-		backoffDuration: time.Duration(maxBackoff),
-	}
-	var tokenResponse *http.Response
-	operation := func() error {
-		tokenResponse, err = httpClient.Do(tokenRequest)
-		bOff.retryCount++
-		return err
-	}
-	err = backoff.Retry(operation, bOff)
-	if err != nil {
-		return nil, "", nil, err
-	}
 
-	respBody, err := io.ReadAll(tokenResponse.Body)
-	origResp := io.NopCloser(bytes.NewBuffer(respBody))
-	tokenResponse.Body = origResp
-	var accessToken *RequestAccessToken
+	// Orgs known to require DPoP get a DPoP proof on the first attempt instead
+	// of a round trip that fails with invalid_dpop_proof.
+	dpopRequiredKey := DpopRequiredCacheKey + ":" + orgURL
+	if _, required := tokenCache.Get(dpopRequiredKey); required {
+		accessToken, nonce, privateKey, err := getAccessTokenForDpopPrivateKey(tokenRequest, httpClient, orgURL, "", maxRetries, maxBackoff, retryStatuses, clientAssertion, strings.Join(scopes, " "), clientID, signer, dpopSigner, dpopKeyAlgorithm, newID)
+		var tokenErr *TokenEndpointError
+		if !errors.As(err, &tokenErr) || tokenErr.Retryable || errors.Is(err, ErrInvalidClient) || errors.Is(err, ErrInvalidScope) {
+			return accessToken, nonce, privateKey, err
+		}
+		// DPoP may have been turned off for the app, fall back to a plain request.
+		tokenCache.Delete(dpopRequiredKey)
+		tokenRequest.Header.Del("DPoP")
+		setTokenRequestBody(tokenRequest, query.Encode())
+	}
 
-	newClientAssertion, err := createClientAssertion(orgURL, clientID, signer)
+	tokenResponse, respBody, err := doTokenRequest(httpClient, tokenRequest, maxRetries, maxBackoff, retryStatuses)
 	if err != nil {
 		return nil, "", nil, err
 	}
 
 	if tokenResponse.StatusCode >= 300 {
 		if strings.Contains(string(respBody), "invalid_dpop_proof") {
-			return getAccessTokenForDpopPrivateKey(tokenRequest, httpClient, orgURL, "", maxRetries, maxBackoff, newClientAssertion, strings.Join(scopes, " "), clientID, signer)
-		} else {
-			return nil, "", nil, err
+			tokenCache.Set(dpopRequiredKey, true, goCache.NoExpiration)
+			return getAccessTokenForDpopPrivateKey(tokenRequest, httpClient, orgURL, "", maxRetries, maxBackoff, retryStatuses, clientAssertion, strings.Join(scopes, " "), clientID, signer, dpopSigner, dpopKeyAlgorithm, newID)
 		}
+		return nil, "", nil, newTokenEndpointError(tokenResponse, respBody, false)
 	}
 
+	var accessToken *RequestAccessToken
 	_, err = buildResponse(tokenResponse, nil, &accessToken)
 	if err != nil {
 		return nil, "", nil, err
@@ -751,71 +882,74 @@ func getAccessTokenForPrivateKey(httpClient *http.Client, orgURL, clientAssertio
 	return accessToken, "", nil, nil
 }
 
-func getAccessTokenForDpopPrivateKey(tokenRequest *http.Request, httpClient *http.Client, orgURL, nonce string, maxRetries int32, maxBackoff int64, clientAssertion string, scopes string, clientID string, signer jose.Signer) (*RequestAccessToken, string, *rsa.PrivateKey, error) {
-	privateKey, err := generatePrivateKey(2048)
-	if err != nil {
-		return nil, "", nil, err
+func getAccessTokenForDpopPrivateKey(tokenRequest *http.Request, httpClient *http.Client, orgURL, nonce string, maxRetries int32, maxBackoff int64, retryStatuses []int, clientAssertion string, scopes string, clientID string, signer jose.Signer, dpopSigner crypto.Signer, dpopKeyAlgorithm string, newID IDGenerator) (*RequestAccessToken, string, crypto.Signer, error) {
+	// Use the configured DPoP key, which may live in hardware, otherwise
+	// generate an ephemeral one.
+	privateKey := dpopSigner
+	if privateKey == nil {
+		generated, err := generateDpopKey(dpopKeyAlgorithm)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		privateKey = generated
 	}
-	dpopJWT, err := generateDpopJWT(privateKey, http.MethodPost, fmt.Sprintf("%v%v", orgURL, "/oauth2/v1/token"), nonce, "")
+	dpopJWT, err := generateDpopJWT(privateKey, http.MethodPost, fmt.Sprintf("%v%v", orgURL, "/oauth2/v1/token"), nonce, "", newID)
 	if err != nil {
 		return nil, "", nil, err
 	}
-	newClientAssertion, err := createClientAssertion(orgURL, clientID, signer)
-	if err != nil {
-		return nil, "", nil, err
+	// A client assertion can only be used once, sign a new one unless it was
+	// provided as is with the JWT authorization mode.
+	if signer != nil {
+		clientAssertion, err = createClientAssertion(orgURL, clientID, signer, newID)
+		if err != nil {
+			return nil, "", nil, err
+		}
 	}
 
 	query := url.Values{}
 	query.Add("grant_type", "client_credentials")
 	query.Add("scope", scopes)
 	query.Add("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
-	query.Add("client_assertion", newClientAssertion)
-	tokenRequest.Body = io.NopCloser(strings.NewReader(query.Encode()))
+	query.Add("client_assertion", clientAssertion)
+	setTokenRequestBody(tokenRequest, query.Encode())
 	tokenRequest.Header.Set("DPoP", dpopJWT)
 
-	bOff := &oktaBackoff{
-		ctx:             context.TODO(),
-		maxRetries:      maxRetries,
-		backoffDuration: time.Duration(maxBackoff),
-	}
-	var tokenResponse *http.Response
-	operation := func() error {
-		tokenResponse, err = httpClient.Do(tokenRequest)
-		bOff.retryCount++
-		return err
-	}
-	err = backoff.Retry(operation, bOff)
-	if err != nil {
-		return nil, "", nil, err
-	}
-	respBody, err := io.ReadAll(tokenResponse.Body)
+	tokenResponse, respBody, err := doTokenRequest(httpClient, tokenRequest, maxRetries, maxBackoff, retryStatuses)
 	if err != nil {
 		return nil, "", nil, err
 	}
 
 	if tokenResponse.StatusCode >= 300 {
-		if strings.Contains(string(respBody), "use_dpop_nonce") {
+		if strings.Contains(string(respBody), "use_dpop_nonce") && nonce == "" {
 			newNonce := tokenResponse.Header.Get("Dpop-Nonce")
-			return getAccessTokenForDpopPrivateKey(tokenRequest, httpClient, orgURL, newNonce, maxRetries, maxBackoff, clientAssertion, scopes, clientID, signer)
-		} else {
-			return nil, "", nil, err
+			return getAccessTokenForDpopPrivateKey(tokenRequest, httpClient, orgURL, newNonce, maxRetries, maxBackoff, retryStatuses, clientAssertion, scopes, clientID, signer, dpopSigner, dpopKeyAlgorithm, newID)
 		}
+		return nil, "", nil, newTokenEndpointError(tokenResponse, respBody, false)
 	}
-	origResp := io.NopCloser(bytes.NewBuffer(respBody))
-	tokenResponse.Body = origResp
 	var accessToken *RequestAccessToken
 	_, err = buildResponse(tokenResponse, nil, &accessToken)
+	if err != nil {
+		return nil, "", nil, err
+	}
 	return accessToken, nonce, privateKey, nil
 }
 
 // NewAPIClient creates a new API client. Requires a userAgent string describing your application.
 // optionally a custom http.Client to allow for advanced features such as caching.
 func NewAPIClient(cfg *Configuration) *APIClient {
+	if cfg.Transport != nil {
+		var httpClient http.Client
+		if cfg.HTTPClient != nil {
+			httpClient = *cfg.HTTPClient
+		}
+		httpClient.Transport = cfg.Transport
+		cfg.HTTPClient = &httpClient
+	}
 	if cfg.HTTPClient == nil {
 		cfg.HTTPClient = http.DefaultClient
 	}
 
-	if cfg.Okta.Client.Proxy.Host != "" {
+	if cfg.Okta.Client.Proxy.Host != "" && cfg.Transport == nil {
 		var proxyURL url.URL
 		proxyURL.Host = fmt.Sprintf("%v:%v", cfg.Okta.Client.Proxy.Host, cfg.Okta.Client.Proxy.Port)
 		up := url.UserPassword(cfg.Okta.Client.Proxy.Username, cfg.Okta.Client.Proxy.Password)
@@ -823,12 +957,37 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 		transport := http.Transport{Proxy: http.ProxyURL(&proxyURL)}
 		cfg.HTTPClient = &http.Client{Transport: &transport}
 	}
+	// the alternate IP dialer and the fault injector wrap a copy of the HTTP
+	// client, so that cfg can be shared by clients without wrapping it again
+	httpClient := cfg.HTTPClient
+	if cfg.AlternateIPDialing != nil && cfg.Transport == nil {
+		var transport *http.Transport
+		switch t := httpClient.Transport.(type) {
+		case *http.Transport:
+			transport = t.Clone()
+		case nil:
+			transport = http.DefaultTransport.(*http.Transport).Clone()
+		}
+		if transport != nil {
+			transport.DialContext = NewAlternateIPDialer(*cfg.AlternateIPDialing).DialContext
+			dialing := *httpClient
+			dialing.Transport = transport
+			httpClient = &dialing
+		}
+	}
+	if cfg.FaultInjection != nil {
+		injecting := *httpClient
+		injecting.Transport = NewFaultInjector(injecting.Transport, *cfg.FaultInjection)
+		httpClient = &injecting
+	}
 
 	var oktaCache Cache
 	if !cfg.Okta.Client.Cache.Enabled {
 		oktaCache = NewNoOpCache()
 	} else {
-		if cfg.CacheManager == nil {
+		if cfg.CacheManager == nil && cfg.CacheStore != nil {
+			oktaCache = newStoreCache(cfg.CacheStore, time.Duration(cfg.Okta.Client.Cache.DefaultTtl)*time.Second)
+		} else if cfg.CacheManager == nil {
 			oktaCache = NewGoCache(cfg.Okta.Client.Cache.DefaultTtl,
 				cfg.Okta.Client.Cache.DefaultTti)
 		} else {
@@ -838,8 +997,11 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 
 	c := &APIClient{}
 	c.cfg = cfg
+	c.httpClient = httpClient
 	c.cache = oktaCache
 	c.tokenCache = goCache.New(5*time.Minute, 10*time.Minute)
+	c.rateLimits = map[string]*bucketLimit{}
+	c.ssws = newSSWSTokenState(cfg)
 	c.common.client = c
 
 	// API Services
@@ -1018,7 +1180,7 @@ func (c *APIClient) callAPI(request *http.Request) (*http.Response, error) {
 		log.Printf("\n%s\n", string(dump))
 	}
 
-	resp, err := c.cfg.HTTPClient.Do(request)
+	resp, err := c.httpClient.Do(request)
 	if err != nil {
 		return resp, err
 	}
@@ -1107,9 +1269,6 @@ func (c *APIClient) prepareRequest(
 
 		// Set the Boundary in the Content-Type
 		headerParams["Content-Type"] = w.FormDataContentType()
-
-		// Set Content-Length
-		headerParams["Content-Length"] = fmt.Sprintf("%d", body.Len())
 		w.Close()
 	}
 
@@ -1119,8 +1278,6 @@ func (c *APIClient) prepareRequest(
 		}
 		body = &bytes.Buffer{}
 		body.WriteString(formParams.Encode())
-		// Set Content-Length
-		headerParams["Content-Length"] = fmt.Sprintf("%d", body.Len())
 	}
 
 	// Setup path and query parameters
@@ -1152,7 +1309,8 @@ func (c *APIClient) prepareRequest(
 	// Encode the parameters.
 	URL.RawQuery = query.Encode()
 
-	// Generate a new request
+	// Generate a new request. The Content-Length is left to the transport,
+	// so interceptors compressing or streaming the body don't send a stale one.
 	if body != nil {
 		localVarRequest, err = http.NewRequest(method, URL.String(), body)
 	} else {
@@ -1172,7 +1330,7 @@ func (c *APIClient) prepareRequest(
 	}
 
 	// Add the user agent to the request.
-	localVarRequest.Header.Add("User-Agent", NewUserAgent(c.cfg).String())
+	localVarRequest.Header.Add("User-Agent", c.UserAgent())
 
 	if ctx != nil {
 		// add context to the request
@@ -1180,8 +1338,13 @@ func (c *APIClient) prepareRequest(
 
 		// Walk through any authentication.
 
+		tok, basicAuth, accessToken, err := contextAuth(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		// OAuth2 authentication
-		if tok, ok := ctx.Value(ContextOAuth2).(oauth2.TokenSource); ok {
+		if tok != nil {
 			// We were able to grab an oauth2 token from the context
 			var latestToken *oauth2.Token
 			if latestToken, err = tok.Token(); err != nil {
@@ -1192,79 +1355,135 @@ func (c *APIClient) prepareRequest(
 		}
 
 		// Basic HTTP Authentication
-		if auth, ok := ctx.Value(ContextBasicAuth).(BasicAuth); ok {
-			localVarRequest.SetBasicAuth(auth.UserName, auth.Password)
+		if basicAuth != nil {
+			localVarRequest.SetBasicAuth(basicAuth.UserName, basicAuth.Password)
 		}
 
 		// AccessToken Authentication
-		if auth, ok := ctx.Value(ContextAccessToken).(string); ok {
-			localVarRequest.Header.Add("Authorization", "Bearer "+auth)
+		if accessToken != "" {
+			localVarRequest.Header.Add("Authorization", "Bearer "+accessToken)
+		}
+
+	}
+
+	// The configured authorization mode applies unless the context set the
+	// Authorization header
+	if localVarRequest.Header.Get("Authorization") == "" {
+		auth, err := c.authorization(ctx, localVarRequest)
+		if err != nil {
+			return nil, err
+		}
+		err = auth.Authorize(method, urlWithoutQuery.String())
+		if err != nil {
+			return nil, err
 		}
+	}
+
+	for header, value := range c.cfg.DefaultHeader {
+		localVarRequest.Header.Add(header, value)
+	}
+	return localVarRequest, nil
+}
 
+// authorization returns the Authorization implementation of the configured
+// authorization mode for req. The access tokens of the OAuth 2.0 modes are
+// requested once for concurrent calls and shared through the TokenStore when
+// there is one.
+func (c *APIClient) authorization(ctx context.Context, req *http.Request) (Authorization, error) {
+	auth, err := c.modeAuthorization(ctx, req)
+	if err != nil {
+		return nil, err
 	}
+	switch c.cfg.Okta.Client.AuthorizationMode {
+	case "PrivateKey", "JWT", "JWK":
+		return tokenAuth{Authorization: auth, c: c}, nil
+	}
+	return auth, nil
+}
 
-	// This will override the auth in context
-	var auth Authorization
+func (c *APIClient) modeAuthorization(ctx context.Context, req *http.Request) (Authorization, error) {
 	switch c.cfg.Okta.Client.AuthorizationMode {
 	case "SSWS":
-		auth = NewSSWSAuth(c.cfg.Okta.Client.Token, localVarRequest)
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		token, err := c.ssws.current(ctx, c.cfg)
+		if err != nil {
+			return nil, err
+		}
+		return NewSSWSAuth(token, req), nil
 	case "Bearer":
-		auth = NewBearerAuth(c.cfg.Okta.Client.Token, localVarRequest)
+		return NewBearerAuth(c.cfg.Okta.Client.Token, req), nil
 	case "PrivateKey":
-		auth = NewPrivateKeyAuth(PrivateKeyAuthConfig{
-			TokenCache:       c.tokenCache,
-			HttpClient:       c.cfg.HTTPClient,
-			PrivateKeySigner: c.cfg.PrivateKeySigner,
-			PrivateKey:       c.cfg.Okta.Client.PrivateKey,
-			PrivateKeyId:     c.cfg.Okta.Client.PrivateKeyId,
-			ClientId:         c.cfg.Okta.Client.ClientId,
-			OrgURL:           c.cfg.Okta.Client.OrgUrl,
-			UserAgent:        NewUserAgent(c.cfg).String(),
-			Scopes:           c.cfg.Okta.Client.Scopes,
-			MaxRetries:       c.cfg.Okta.Client.RateLimit.MaxRetries,
-			MaxBackoff:       c.cfg.Okta.Client.RateLimit.MaxBackoff,
-			Req:              localVarRequest,
-		})
+		signer, err := c.privateKeySigner()
+		if err != nil {
+			return nil, err
+		}
+		return NewPrivateKeyAuth(PrivateKeyAuthConfig{
+			TokenCache:         c.tokenCache,
+			HttpClient:         c.httpClient,
+			PrivateKeySigner:   signer,
+			PrivateKey:         c.cfg.Okta.Client.PrivateKey,
+			PrivateKeyId:       c.cfg.Okta.Client.PrivateKeyId,
+			Passphrase:         c.cfg.Okta.Client.PrivateKeyPassphrase,
+			PassphraseProvider: c.cfg.PassphraseProvider,
+			DPoPSigner:         c.cfg.DPoPSigner,
+			DPoPKeyAlgorithm:   c.cfg.Okta.Client.DPoPKeyAlgorithm,
+			ClientId:           c.cfg.Okta.Client.ClientId,
+			OrgURL:             c.cfg.Okta.Client.OrgUrl,
+			UserAgent:          c.UserAgent(),
+			Scopes:             c.cfg.Okta.Client.Scopes,
+			MaxRetries:         c.cfg.Okta.Client.RateLimit.MaxRetries,
+			MaxBackoff:         c.cfg.Okta.Client.RateLimit.MaxBackoff,
+			TokenRetryStatuses: c.cfg.Okta.Client.RateLimit.TokenRetryStatuses,
+			IDGenerator:        c.cfg.IDGenerator,
+			Req:                req,
+		}), nil
 	case "JWT":
-		auth = NewJWTAuth(JWTAuthConfig{
-			TokenCache:      c.tokenCache,
-			HttpClient:      c.cfg.HTTPClient,
-			OrgURL:          c.cfg.Okta.Client.OrgUrl,
-			UserAgent:       NewUserAgent(c.cfg).String(),
-			Scopes:          c.cfg.Okta.Client.Scopes,
-			ClientAssertion: c.cfg.Okta.Client.ClientAssertion,
-			MaxRetries:      c.cfg.Okta.Client.RateLimit.MaxRetries,
-			MaxBackoff:      c.cfg.Okta.Client.RateLimit.MaxBackoff,
-			Req:             localVarRequest,
-		})
+		return NewJWTAuth(JWTAuthConfig{
+			TokenCache:         c.tokenCache,
+			HttpClient:         c.httpClient,
+			OrgURL:             c.cfg.Okta.Client.OrgUrl,
+			UserAgent:          c.UserAgent(),
+			Scopes:             c.cfg.Okta.Client.Scopes,
+			ClientAssertion:    c.cfg.Okta.Client.ClientAssertion,
+			DPoPSigner:         c.cfg.DPoPSigner,
+			DPoPKeyAlgorithm:   c.cfg.Okta.Client.DPoPKeyAlgorithm,
+			MaxRetries:         c.cfg.Okta.Client.RateLimit.MaxRetries,
+			MaxBackoff:         c.cfg.Okta.Client.RateLimit.MaxBackoff,
+			TokenRetryStatuses: c.cfg.Okta.Client.RateLimit.TokenRetryStatuses,
+			IDGenerator:        c.cfg.IDGenerator,
+			Req:                req,
+		}), nil
 	case "JWK":
-		auth = NewJWKAuth(JWKAuthConfig{
-			TokenCache:       c.tokenCache,
-			HttpClient:       c.cfg.HTTPClient,
-			JWK:              c.cfg.Okta.Client.JWK,
-			EncryptionType:   c.cfg.Okta.Client.EncryptionType,
-			PrivateKeySigner: c.cfg.PrivateKeySigner,
-			PrivateKeyId:     c.cfg.Okta.Client.PrivateKeyId,
-			ClientId:         c.cfg.Okta.Client.ClientId,
-			OrgURL:           c.cfg.Okta.Client.OrgUrl,
-			UserAgent:        NewUserAgent(c.cfg).String(),
-			Scopes:           c.cfg.Okta.Client.Scopes,
-			MaxRetries:       c.cfg.Okta.Client.RateLimit.MaxRetries,
-			MaxBackoff:       c.cfg.Okta.Client.RateLimit.MaxBackoff,
-			Req:              localVarRequest,
-		})
+		signer, err := c.privateKeySigner()
+		if err != nil {
+			return nil, err
+		}
+		return NewJWKAuth(JWKAuthConfig{
+			TokenCache:         c.tokenCache,
+			HttpClient:         c.httpClient,
+			JWK:                c.cfg.Okta.Client.JWK,
+			EncryptionType:     c.cfg.Okta.Client.EncryptionType,
+			PrivateKeySigner:   signer,
+			PrivateKeyId:       c.cfg.Okta.Client.PrivateKeyId,
+			Passphrase:         c.cfg.Okta.Client.PrivateKeyPassphrase,
+			PassphraseProvider: c.cfg.PassphraseProvider,
+			DPoPSigner:         c.cfg.DPoPSigner,
+			DPoPKeyAlgorithm:   c.cfg.Okta.Client.DPoPKeyAlgorithm,
+			ClientId:           c.cfg.Okta.Client.ClientId,
+			OrgURL:             c.cfg.Okta.Client.OrgUrl,
+			UserAgent:          c.UserAgent(),
+			Scopes:             c.cfg.Okta.Client.Scopes,
+			MaxRetries:         c.cfg.Okta.Client.RateLimit.MaxRetries,
+			MaxBackoff:         c.cfg.Okta.Client.RateLimit.MaxBackoff,
+			TokenRetryStatuses: c.cfg.Okta.Client.RateLimit.TokenRetryStatuses,
+			IDGenerator:        c.cfg.IDGenerator,
+			Req:                req,
+		}), nil
 	default:
 		return nil, fmt.Errorf("unknown authorization mode %v", c.cfg.Okta.Client.AuthorizationMode)
 	}
-	err = auth.Authorize(method, urlWithoutQuery.String())
-	if err != nil {
-		return nil, err
-	}
-
-	for header, value := range c.cfg.DefaultHeader {
-		localVarRequest.Header.Add(header, value)
-	}
-	return localVarRequest, nil
 }
 
 func (c *APIClient) decode(v interface{}, b []byte, contentType string) (err error) {
@@ -1275,17 +1494,8 @@ func (c *APIClient) decode(v interface{}, b []byte, contentType string) (err err
 		*s = string(b)
 		return nil
 	}
-	if f, ok := v.(**os.File); ok {
-		*f, err = ioutil.TempFile("", "HttpClientFile")
-		if err != nil {
-			return
-		}
-		_, err = (*f).Write(b)
-		if err != nil {
-			return
-		}
-		_, err = (*f).Seek(0, io.SeekStart)
-		return
+	if ok, err := c.decodeFile(v, b); ok {
+		return err
 	}
 	if xmlCheck.MatchString(contentType) {
 		if err = xml.Unmarshal(b, v); err != nil {
@@ -1310,57 +1520,129 @@ func (c *APIClient) decode(v interface{}, b []byte, contentType string) (err err
 	return errors.New("undefined response type")
 }
 
+// RefreshNext makes the next request of the client skip the request cache.
+//
+// Deprecated: the next request may be made by any goroutine sharing the
+// client. Use WithNoCache for the context of the call instead.
 func (c *APIClient) RefreshNext() *APIClient {
-	c.freshcache = true
+	c.freshcache.Store(true)
 	return c
 }
 
 func (c *APIClient) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return c.observeCall(req, c.send)
+}
+
+// send makes a request of an API call and turns the responses Okta reports
+// specific errors with into typed errors.
+func (c *APIClient) send(req *http.Request) (*http.Response, error) {
+	req, cancel := c.withOperationTimeout(req)
+	c.setCallPurpose(req)
+	setCallHeaders(req)
+	ifMatch := setIfMatch(req)
+	resp, err := c.doRequest(req.Context(), req)
+	if resp != nil && resp.Request == nil {
+		// cached responses and some transports don't set the request
+		resp.Request = req
+	}
+	if err == nil && resp != nil {
+		err = c.conflictError(req.Context(), req, resp, ifMatch)
+		if err == nil {
+			err = c.insufficientScopeError(req, resp)
+		}
+		if err == nil {
+			err = featureNotEnabledError(req, resp)
+		}
+		if err == nil {
+			err = c.validateResponse(req, resp)
+		}
+		c.observeFeature(req, resp, err)
+	}
+	if err != nil || resp == nil || resp.Body == nil {
+		if err != nil {
+			releaseBody(resp, c.cfg.ClosedBodyPolicy)
+		}
+		cancel()
+		return resp, err
+	}
+	resp.Body = cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (c *APIClient) doRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
 	cacheKey := CreateCacheKey(req)
 	if req.Method != http.MethodGet {
 		c.cache.Delete(cacheKey)
 	}
-	inCache := c.cache.Has(cacheKey)
-	if c.freshcache {
+	// a single Get rather than Has and Get, as the entry of a shared cache
+	// may expire in between
+	var cached *http.Response
+	if noCache(ctx) || c.freshcache.CompareAndSwap(true, false) {
 		c.cache.Delete(cacheKey)
-		inCache = false
-		c.freshcache = false
-	}
-	if !inCache {
-		if c.cfg.Okta.Client.RateLimit.Enable {
-			c.rateLimitLock.Lock()
-			limit := c.rateLimit
-			c.rateLimitLock.Unlock()
-			if limit != nil && limit.Remaining <= 0 {
-				timer := time.NewTimer(time.Second * time.Duration(limit.Reset))
-				select {
-				case <-ctx.Done():
-					if !timer.Stop() {
-						<-timer.C
-					}
-					return nil, ctx.Err()
-				case <-timer.C:
-				}
+	} else {
+		cached = c.cache.Get(cacheKey)
+	}
+	if cached == nil {
+		if c.rateLimitTracked() {
+			if err := c.waitForRateLimit(ctx, rateLimitBucket(req)); err != nil {
+				return nil, err
 			}
 		}
 		resp, err := c.doWithRetries(ctx, req)
 		if err != nil {
 			return nil, err
 		}
-		if resp.StatusCode >= 200 && resp.StatusCode <= 299 && req.Method == http.MethodGet {
-			if c.cfg.Okta.Client.RateLimit.Enable {
-				c.rateLimitLock.Lock()
-				newLimit, err := c.parseLimitHeaders(resp)
-				if err == nil {
-					c.rateLimit = newLimit
-				}
-				c.rateLimitLock.Unlock()
+		retry, err := c.observeSSWSResponse(req, resp)
+		if err != nil {
+			return nil, err
+		}
+		if retry {
+			resp, err = c.retryWithRotatedToken(ctx, req, resp)
+			if err != nil {
+				return resp, err
 			}
-			c.cache.Set(cacheKey, resp)
+		}
+		if resp.StatusCode >= 200 && resp.StatusCode <= 299 && req.Method == http.MethodGet {
+			c.cacheResponse(ctx, req, cacheKey, resp)
+		}
+		if resp.StatusCode >= 200 && resp.StatusCode <= 299 && req.Method != http.MethodGet {
+			c.invalidateCache(req)
+			c.invalidateGroupResolvers(req)
 		}
 		return resp, err
 	}
-	return c.cache.Get(cacheKey), nil
+	return cachedResponse(cached), nil
+}
+
+// retryWithRotatedToken replays req once with a token obtained from the
+// configured TokenRotator after Okta rejected the previous one. When the
+// token cannot be rotated, the rejected response is returned with a
+// TokenRotationError.
+func (c *APIClient) retryWithRotatedToken(ctx context.Context, req *http.Request, resp *http.Response) (*http.Response, error) {
+	token, err := c.ssws.current(ctx, c.cfg)
+	if err != nil {
+		body, readErr := readAndRestoreBody(resp)
+		if readErr != nil {
+			return nil, readErr
+		}
+		return resp, &TokenRotationError{Rejected: newAPIError(resp, body), Err: err}
+	}
+	if req.GetBody != nil {
+		if req.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	resp.Body.Close()
+	req.Header.Set("Authorization", "SSWS "+token)
+	resp, err = c.doWithRetries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// a rejection of the rotated token is recorded, without retrying again
+	if _, err := c.observeSSWSResponse(req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 func (c *APIClient) doWithRetries(ctx context.Context, req *http.Request) (*http.Response, error) {
@@ -1373,49 +1655,49 @@ func (c *APIClient) doWithRetries(ctx context.Context, req *http.Request) (*http
 		bodyReader = func() io.ReadCloser {
 			return ioutil.NopCloser(bytes.NewReader(buf))
 		}
+		// the length of the body as sent by the SDK, interceptors may change
+		// it on a copy of the request
+		req.ContentLength = int64(len(buf))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return bodyReader(), nil
+		}
 	}
-	var (
-		resp *http.Response
-		err  error
-	)
-	bOff := &oktaBackoff{
-		ctx:        ctx,
-		maxRetries: c.cfg.Okta.Client.RateLimit.MaxRetries,
+	retryer := c.cfg.Retryer
+	if retryer == nil {
+		retryer = oktaRetryer{cfg: c.cfg}
 	}
-	operation := func() error {
+	shouldRetry := retryer.Start(req)
+	maxRetries, capped := callMaxRetries(ctx)
+	for retryCount := 1; ; retryCount++ {
 		// Always rewind the request body when non-nil.
 		if bodyReader != nil {
 			req.Body = bodyReader()
 		}
-		resp, err = c.callAPI(req)
-		if errors.Is(err, io.EOF) {
-			// retry on EOF errors, which might be caused by network connectivity issues
-			return fmt.Errorf("network error: %w", err)
-		} else if err != nil {
-			// this is error is considered to be permanent and should not be retried
-			return backoff.Permanent(err)
-		}
-		if !tooManyRequests(resp) {
-			return nil
+		if err := c.cfg.CircuitBreaker.allow(); err != nil {
+			return nil, err
 		}
-		if err = tryDrainBody(resp.Body); err != nil {
-			return err
+		resp, err := c.callAPI(req)
+		c.cfg.CircuitBreaker.record(resp, err)
+		c.recordRateLimit(ctx, req, resp)
+		wait, retry := shouldRetry(resp, err)
+		if !retry || (capped && retryCount > maxRetries) {
+			return resp, err
 		}
-		backoffDuration, err := Get429BackoffTime(resp)
-		if err != nil {
-			return err
+		if resp != nil {
+			if err = tryDrainBody(resp.Body); err != nil {
+				return nil, err
+			}
+			req.Header.Set("X-Okta-Retry-For", resp.Header.Get("X-Okta-Request-Id"))
+			req.Header.Set("X-Okta-Retry-Count", fmt.Sprint(retryCount))
 		}
-		if c.cfg.Okta.Client.RateLimit.MaxBackoff < backoffDuration {
-			backoffDuration = c.cfg.Okta.Client.RateLimit.MaxBackoff
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
 		}
-		bOff.backoffDuration = time.Second * time.Duration(backoffDuration)
-		bOff.retryCount++
-		req.Header.Add("X-Okta-Retry-For", resp.Header.Get("X-Okta-Request-Id"))
-		req.Header.Add("X-Okta-Retry-Count", fmt.Sprint(bOff.retryCount))
-		return errors.New("too many requests")
 	}
-	err = backoff.Retry(operation, bOff)
-	return resp, err
 }
 
 // Add a file to the multipart request
@@ -1560,6 +1842,7 @@ type GenericOpenAPIError struct {
 	body  []byte
 	error string
 	model interface{}
+	err   error
 }
 
 // Error returns non-empty string if there was an error.
@@ -1577,6 +1860,12 @@ func (e GenericOpenAPIError) Model() interface{} {
 	return e.model
 }
 
+// Unwrap returns the error the request failed with, if any, so errors.Is and
+// errors.As see typed errors such as ErrConflict.
+func (e GenericOpenAPIError) Unwrap() error {
+	return e.err
+}
+
 // Okta Backoff
 type oktaBackoff struct {
 	retryCount, maxRetries int32
@@ -1611,6 +1900,18 @@ func tryDrainBody(body io.ReadCloser) error {
 	return err
 }
 
+// readAndRestoreBody reads the response body and replaces it with an in-memory
+// copy so it can be read again.
+func readAndRestoreBody(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return body, err
+}
+
 func Get429BackoffTime(resp *http.Response) (int64, error) {
 	requestDate, err := time.Parse("Mon, 02 Jan 2006 15:04:05 GMT", resp.Header.Get("Date"))
 	if err != nil {
@@ -1653,6 +1954,36 @@ func generatePrivateKey(bitSize int) (*rsa.PrivateKey, error) {
 	return privateKey, nil
 }
 
+// generateDpopKey generates an ephemeral key signing DPoP proofs with
+// algorithm, RS256 when it is empty.
+func generateDpopKey(algorithm string) (crypto.Signer, error) {
+	switch algorithm {
+	case "", "RS256":
+		key, err := generatePrivateKey(2048)
+		if err != nil {
+			return nil, err
+		}
+		return key, nil
+	case "ES256", "ES384":
+		curve := elliptic.P256()
+		if algorithm == "ES384" {
+			curve = elliptic.P384()
+		}
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return key, nil
+	case "EdDSA":
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported DPoP key algorithm %q, expected RS256, ES256, ES384 or EdDSA", algorithm)
+}
+
 func privateKeyToBytes(priv *rsa.PrivateKey) []byte {
 	privBytes := pem.EncodeToMemory(
 		&pem.Block{
@@ -1682,8 +2013,8 @@ type DpopClaims struct {
 	AccessToken string           `json:"ath,omitempty"`
 }
 
-func generateDpopJWT(privateKey *rsa.PrivateKey, httpMethod, URL, nonce, accessToken string) (string, error) {
-	set, err := jwk.Import(privateKey.PublicKey)
+func generateDpopJWT(privateKey crypto.Signer, httpMethod, URL, nonce, accessToken string, newID IDGenerator) (string, error) {
+	set, err := jwk.Import(privateKey.Public())
 	if err != nil {
 		return "", err
 	}
@@ -1691,16 +2022,15 @@ func generateDpopJWT(privateKey *rsa.PrivateKey, httpMethod, URL, nonce, accessT
 	if err != nil {
 		return "", err
 	}
-	key := jose.SigningKey{Algorithm: jose.RS256, Key: privateKey}
 	signerOpts := jose.SignerOptions{}
 	signerOpts.WithType("dpop+jwt")
 	signerOpts.WithHeader("jwk", set)
-	rsaSigner, err := jose.NewSigner(key, &signerOpts)
+	dpopSigner, err := newOpaqueSigner(privateKey, &signerOpts)
 	if err != nil {
 		return "", err
 	}
 	dpopClaims := DpopClaims{
-		ID:         uuid.New().String(),
+		ID:         newID.newID(),
 		HTTPMethod: httpMethod,
 		HTTPURI:    URL,
 		IssuedAt:   jwt.NewNumericDate(time.Now()),
@@ -1711,7 +2041,7 @@ func generateDpopJWT(privateKey *rsa.PrivateKey, httpMethod, URL, nonce, accessT
 		h.Write(StringToAsciiBytes(accessToken))
 		dpopClaims.AccessToken = base64.RawURLEncoding.EncodeToString(h.Sum(nil))
 	}
-	jwtBuilder := jwt.Signed(rsaSigner).Claims(dpopClaims)
+	jwtBuilder := jwt.Signed(dpopSigner).Claims(dpopClaims)
 	return jwtBuilder.CompactSerialize()
 }
 
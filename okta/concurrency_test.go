@@ -0,0 +1,181 @@
+package okta
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// These tests share a client between goroutines the way applications do and
+// are meant to be run with -race.
+
+const concurrentCallers = 16
+
+// concurrently runs f from concurrentCallers goroutines and waits for them.
+func concurrently(f func(i int)) {
+	var wg sync.WaitGroup
+	for i := 0; i < concurrentCallers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			f(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func Test_Concurrent_Cached_Reads_And_Writes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		resp := mockJSONResponse(200, `{"id":"00u1","profile":{"login":"bob@example.com"}}`)
+		resp.Header.Set("X-Rate-Limit-Limit", "600")
+		resp.Header.Set("X-Rate-Limit-Remaining", "599")
+		resp.Header.Set("X-Rate-Limit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		resp.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+		return resp, nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[{"id":"00u1"},{"id":"00u2"}]`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"00u1","profile":{"login":"bob@example.com"}}`), nil
+	})
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("token"),
+		WithCache(true),
+		WithRateLimitPrevent(true),
+		WithCacheInvalidation(InvalidateParentPaths),
+		WithCircuitBreaker(CircuitBreakerSettings{}),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	concurrently(func(i int) {
+		for j := 0; j < 10; j++ {
+			switch (i + j) % 5 {
+			case 0:
+				_, _, err := client.PatchUser(ctx, "00u1", MergePatch{"profile": map[string]interface{}{"nickName": "Bob"}})
+				assert.NoError(t, err)
+			case 1:
+				users, err := client.ListAllUsers(client.UserAPI.ListUsers(ctx))
+				assert.NoError(t, err)
+				assert.Len(t, users, 2)
+			case 2:
+				_, _, err := client.UserAPI.GetUser(WithNoCache(ctx), "00u1").Execute()
+				assert.NoError(t, err)
+			case 3:
+				client.RefreshNext()
+				fallthrough
+			default:
+				user, _, err := client.UserAPI.GetUser(ctx, "00u1").Execute()
+				if assert.NoError(t, err) {
+					assert.Equal(t, "bob@example.com", user.Profile.GetLogin(), "Every caller should read the whole body")
+				}
+			}
+		}
+	})
+}
+
+func Test_Concurrent_Access_Token_Requests(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := NewCryptoSigner(key, "")
+	require.NoError(t, err)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var tokens int32
+	httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&tokens, 1)
+		return mockJSONResponse(200, fmt.Sprintf(`{"token_type":"Bearer","expires_in":3600,"access_token":"token%d","scope":"okta.users.read"}`, n)), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"00u1"}`), nil
+	})
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithAuthorizationMode("PrivateKey"),
+		WithClientId("client"),
+		WithScopes([]string{"okta.users.read"}),
+		WithPrivateKeySigner(signer),
+		WithCache(false),
+		WithTokenStore(newMemoryStore()),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	concurrently(func(i int) {
+		_, _, err := client.UserAPI.GetUser(context.Background(), "00u1").Execute()
+		assert.NoError(t, err)
+		token, err := client.AccessToken(context.Background())
+		if assert.NoError(t, err) {
+			assert.Equal(t, "Bearer token1", token.Header())
+		}
+	})
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tokens), "Concurrent callers should share a single access token request")
+}
+
+// countingObserver counts the calls that ended.
+type countingObserver struct {
+	ended *int32
+}
+
+func (o *countingObserver) StartCall(ctx context.Context, call *Call) context.Context {
+	return ctx
+}
+
+func (o *countingObserver) EndCall(ctx context.Context, call *Call) {
+	atomic.AddInt32(o.ended, 1)
+}
+
+func Test_Concurrent_Observed_And_Retried_Calls(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var calls int32
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00g1", func(req *http.Request) (*http.Response, error) {
+		// every other first attempt fails, so that each call succeeds within
+		// its retries
+		if atomic.AddInt32(&calls, 1)%2 == 0 && req.Header.Get("X-Okta-Retry-Count") == "" {
+			return mockJSONResponse(503, `{"errorCode":"E0000009"}`), nil
+		}
+		return mockJSONResponse(200, `{"id":"00g1"}`), nil
+	})
+
+	var observed int32
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("token"),
+		WithCache(false),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 3, Statuses: []int{503}, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+		WithCallObserver(&countingObserver{ended: &observed}),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	concurrently(func(i int) {
+		ctx := WithCallPurpose(context.Background(), "sync")
+		group, _, err := client.GroupAPI.GetGroup(ctx, "00g1").Execute()
+		if assert.NoError(t, err) {
+			assert.Equal(t, "00g1", group.GetId())
+		}
+	})
+	assert.Equal(t, int32(concurrentCallers), atomic.LoadInt32(&observed))
+	assert.Greater(t, atomic.LoadInt32(&calls), int32(concurrentCallers), "Some calls should have been retried")
+}
@@ -25,6 +25,7 @@ package okta
 
 import (
 	"context"
+	"crypto"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -37,6 +38,7 @@ import (
 	"runtime"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/go-jose/go-jose/v3"
 	"github.com/kelseyhightower/envconfig"
@@ -55,12 +57,18 @@ func (c contextKey) String() string {
 
 var (
 	// ContextOAuth2 takes an oauth2.TokenSource as authentication for the request.
+	//
+	// Deprecated: use WithOAuth2TokenSource instead of setting this key directly.
 	ContextOAuth2 = contextKey("token")
 
 	// ContextBasicAuth takes BasicAuth as authentication for the request.
+	//
+	// Deprecated: use WithBasicAuth instead of setting this key directly.
 	ContextBasicAuth = contextKey("basic")
 
 	// ContextAccessToken takes a string oauth2 access token as authentication for the request.
+	//
+	// Deprecated: use WithAccessToken instead of setting this key directly.
 	ContextAccessToken = contextKey("accesstoken")
 
 	// ContextAPIKeys takes a string apikey as authentication for the request
@@ -121,6 +129,7 @@ type Configuration struct {
 	Servers          ServerConfigurations
 	OperationServers map[string]ServerConfigurations
 	HTTPClient       *http.Client
+	Transport        http.RoundTripper
 	UserAgentExtra   string
 	Context          context.Context
 	Okta             struct {
@@ -139,31 +148,107 @@ type Configuration struct {
 			ConnectionTimeout int64 `yaml:"connectionTimeout" envconfig:"OKTA_CLIENT_CONNECTION_TIMEOUT"`
 			RequestTimeout    int64 `yaml:"requestTimeout" envconfig:"OKTA_CLIENT_REQUEST_TIMEOUT"`
 			RateLimit         struct {
-				MaxRetries int32 `yaml:"maxRetries" envconfig:"OKTA_CLIENT_RATE_LIMIT_MAX_RETRIES"`
-				MaxBackoff int64 `yaml:"maxBackoff" envconfig:"OKTA_CLIENT_RATE_LIMIT_MAX_BACKOFF"`
-				Enable     bool  `yaml:"enable" envconfig:"OKTA_CLIENT_RATE_LIMIT_ENABLE"`
+				MaxRetries         int32   `yaml:"maxRetries" envconfig:"OKTA_CLIENT_RATE_LIMIT_MAX_RETRIES"`
+				MaxBackoff         int64   `yaml:"maxBackoff" envconfig:"OKTA_CLIENT_RATE_LIMIT_MAX_BACKOFF"`
+				Enable             bool    `yaml:"enable" envconfig:"OKTA_CLIENT_RATE_LIMIT_ENABLE"`
+				TokenRetryStatuses []int   `yaml:"tokenRetryStatuses" envconfig:"OKTA_CLIENT_RATE_LIMIT_TOKEN_RETRY_STATUSES"`
+				Headroom           float64 `yaml:"headroom" envconfig:"OKTA_CLIENT_RATE_LIMIT_HEADROOM"`
 			} `yaml:"rateLimit"`
-			OrgUrl            string   `yaml:"orgUrl" envconfig:"OKTA_CLIENT_ORGURL"`
-			Token             string   `yaml:"token" envconfig:"OKTA_CLIENT_TOKEN"`
-			AuthorizationMode string   `yaml:"authorizationMode" envconfig:"OKTA_CLIENT_AUTHORIZATIONMODE"`
-			ClientId          string   `yaml:"clientId" envconfig:"OKTA_CLIENT_CLIENTID"`
-			ClientAssertion   string   `yaml:"clientAssertion" envconfig:"OKTA_CLIENT_CLIENTASSERTION"`
-			Scopes            []string `yaml:"scopes" envconfig:"OKTA_CLIENT_SCOPES"`
-			PrivateKey        string   `yaml:"privateKey" envconfig:"OKTA_CLIENT_PRIVATEKEY"`
-			PrivateKeyId      string   `yaml:"privateKeyId" envconfig:"OKTA_CLIENT_PRIVATEKEYID"`
-			JWK               string   `yaml:"jwk" envconfig:"OKTA_CLIENT_JWK"`
-			EncryptionType    string   `yaml:"encryptionType" envconfig:"OKTA_CLIENT_ENCRYPTION_TYPE"`
+			OrgUrl               string   `yaml:"orgUrl" envconfig:"OKTA_CLIENT_ORGURL"`
+			Token                string   `yaml:"token" envconfig:"OKTA_CLIENT_TOKEN"`
+			AuthorizationMode    string   `yaml:"authorizationMode" envconfig:"OKTA_CLIENT_AUTHORIZATIONMODE"`
+			ClientId             string   `yaml:"clientId" envconfig:"OKTA_CLIENT_CLIENTID"`
+			ClientAssertion      string   `yaml:"clientAssertion" envconfig:"OKTA_CLIENT_CLIENTASSERTION"`
+			Scopes               []string `yaml:"scopes" envconfig:"OKTA_CLIENT_SCOPES"`
+			PrivateKey           string   `yaml:"privateKey" envconfig:"OKTA_CLIENT_PRIVATEKEY"`
+			PrivateKeyId         string   `yaml:"privateKeyId" envconfig:"OKTA_CLIENT_PRIVATEKEYID"`
+			JWK                  string   `yaml:"jwk" envconfig:"OKTA_CLIENT_JWK"`
+			EncryptionType       string   `yaml:"encryptionType" envconfig:"OKTA_CLIENT_ENCRYPTION_TYPE"`
+			DPoPKeyAlgorithm     string   `yaml:"dpopKeyAlgorithm" envconfig:"OKTA_CLIENT_DPOP_KEY_ALGORITHM"`
+			APIVersion           string   `yaml:"apiVersion" envconfig:"OKTA_CLIENT_API_VERSION"`
+			PrivateKeyPassphrase string   `yaml:"privateKeyPassphrase" envconfig:"OKTA_CLIENT_PRIVATEKEYPASSPHRASE"`
 		} `yaml:"client"`
 		Testing struct {
 			DisableHttpsCheck bool `yaml:"disableHttpsCheck" envconfig:"OKTA_TESTING_DISABLE_HTTPS_CHECK"`
 		} `yaml:"testing"`
 	} `yaml:"okta"`
-	PrivateKeySigner jose.Signer
-	CacheManager     Cache
+	PrivateKeySigner      jose.Signer
+	ClientAssertionSigner ClientAssertionSigner
+	CacheManager          Cache
+	CacheStore            CacheStore
+	TokenStore            CacheStore
+	ShareDPoPTokens       bool
+	CacheRules            []CacheRule `ignored:"true"`
+	CacheInvalidation     CacheInvalidation
+	TokenRotator          TokenRotator
+	TokenExpiresAt        time.Time
+	TokenExpiryWarning    time.Duration
+	PassphraseProvider    PassphraseProvider
+	DPoPSigner            crypto.Signer
+	PageRetry             PageRetry
+	ListAllMaxPages       int
+	CallObserver          CallObserver
+	RateLimitObserver     RateLimitObserver
+	PlanCeilings          map[string]int `ignored:"true"`
+	CallPurposeHeader     string
+	FaultInjection        *FaultInjection
+	AlternateIPDialing    *AlternateIPDialing `ignored:"true"`
+	Retryer               Retryer
+	CircuitBreaker        *CircuitBreaker `ignored:"true"`
+	ResponseValidator     ResponseValidator
+	FailOnSchemaMismatch  bool
+	OperationTimeouts     OperationTimeouts
+	ClosedBodyPolicy      ClosedBodyPolicy
+	TempFiles             TempFileOptions
+	IDGenerator           IDGenerator
+}
+
+// NewConfiguration returns a new Configuration object. Every setting is taken
+// from the first of these sources that sets it:
+//
+//  1. the conf setters, applied in order
+//  2. the OKTA_CLIENT_* environment variables
+//  3. the .okta.yaml file of the application
+//  4. the ~/.okta/okta.yaml file of the user
+//  5. the defaults of the SDK
+//
+// Missing or invalid files and variables are ignored, use
+// NewConfigurationFromFile and NewConfigurationFromEnv to fail on them.
+func NewConfiguration(conf ...ConfigSetter) (*Configuration, error) {
+	cfg := defaultConfiguration()
+	cfg = readConfigFromSystem(*cfg)
+	cfg = readConfigFromApplication(*cfg)
+	cfg = readConfigFromEnvironment(*cfg)
+	return finishConfiguration(cfg, conf)
 }
 
-// NewConfiguration returns a new Configuration object
-func NewConfiguration(conf ...ConfigSetter) (*Configuration, error) {
+// NewConfigurationFromFile returns a Configuration read from the okta.yaml
+// file at path, with the settings of conf applied over it. Environment
+// variables and the other okta.yaml files are ignored, and an error is
+// returned when the file cannot be read or parsed.
+func NewConfigurationFromFile(path string, conf ...ConfigSetter) (*Configuration, error) {
+	cfg, err := readConfigFromFile(path, *defaultConfiguration())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file %s: %w", path, err)
+	}
+	return finishConfiguration(cfg, conf)
+}
+
+// NewConfigurationFromEnv returns a Configuration read from the OKTA_CLIENT_*
+// environment variables, with the settings of conf applied over it. The
+// okta.yaml files are ignored, and an error is returned when a variable cannot
+// be parsed. Lists, such as OKTA_CLIENT_SCOPES, are separated by commas.
+func NewConfigurationFromEnv(conf ...ConfigSetter) (*Configuration, error) {
+	cfg := defaultConfiguration()
+	if err := envconfig.Process("okta", cfg); err != nil {
+		return nil, fmt.Errorf("failed to read configuration from the environment: %w", err)
+	}
+	return finishConfiguration(cfg, conf)
+}
+
+// defaultConfiguration returns the configuration of the settings no source
+// sets.
+func defaultConfiguration() *Configuration {
 	cfg := &Configuration{
 		DefaultHeader: make(map[string]string),
 		UserAgent:     fmt.Sprintf("okta-sdk-golang/%s golang/%s %s/%s", "5.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH),
@@ -181,24 +266,33 @@ func NewConfiguration(conf ...ConfigSetter) (*Configuration, error) {
 			},
 		},
 		OperationServers: map[string]ServerConfigurations{},
+		PageRetry:        PageRetry{MaxRetries: 3, Backoff: 500 * time.Millisecond},
 	}
 
 	cfg.Okta.Testing.DisableHttpsCheck = false
 	cfg.Okta.Client.AuthorizationMode = "SSWS"
+	return cfg
+}
 
-	cfg = readConfigFromSystem(*cfg)
-	cfg = readConfigFromApplication(*cfg)
-	cfg = readConfigFromEnvironment(*cfg)
-
+// finishConfiguration applies conf to cfg and derives the settings depending
+// on others.
+func finishConfiguration(cfg *Configuration, conf []ConfigSetter) (*Configuration, error) {
 	for _, confSetter := range conf {
 		confSetter(cfg)
 	}
 
+	if err := checkAPIVersion(cfg.Okta.Client.APIVersion); err != nil {
+		return nil, err
+	}
+	if headroom := cfg.Okta.Client.RateLimit.Headroom; headroom < 0 || headroom >= 1 {
+		return nil, fmt.Errorf("rate limit headroom %v is not between 0 and 1", headroom)
+	}
+
 	purl, err := url.Parse(cfg.Okta.Client.OrgUrl)
 	if err != nil {
 		return nil, err
 	}
-	cfg.Host = purl.Hostname()
+	cfg.Host = purl.Host
 	cfg.Scheme = purl.Scheme
 
 	if cfg.UserAgentExtra != "" {
@@ -395,6 +489,56 @@ func WithCacheManager(cacheManager Cache) ConfigSetter {
 	}
 }
 
+// WithCacheStore caches the GET responses as CacheEntry values in store, which
+// may be shared by several processes, for the TTL of WithCacheTtl. A cache set
+// with WithCacheManager takes precedence.
+func WithCacheStore(store CacheStore) ConfigSetter {
+	return func(c *Configuration) {
+		c.CacheStore = store
+	}
+}
+
+// WithTokenStore shares the access tokens of the PrivateKey, JWT and JWK
+// authorization modes through store, so that the processes of a service using
+// the same application and scopes request a single token, and a restarted
+// process reuses it when the store is persistent, such as a FileStore.
+// DPoP-bound tokens are only shared with WithShareDPoPTokens.
+func WithTokenStore(store CacheStore) ConfigSetter {
+	return func(c *Configuration) {
+		c.TokenStore = store
+	}
+}
+
+// WithShareDPoPTokens also shares DPoP-bound access tokens through the token
+// store, together with their nonce and the ephemeral key they are bound to, so
+// that restarted processes skip the DPoP handshake. The key is stored as is,
+// so the store must be as protected as the private key of the application.
+// Tokens bound to the key of WithDPoPSigner are shared without the key, for
+// processes using the same signer.
+func WithShareDPoPTokens(share bool) ConfigSetter {
+	return func(c *Configuration) {
+		c.ShareDPoPTokens = share
+	}
+}
+
+// WithCacheRules caches the GET responses of the paths matching the pattern
+// of a rule for its TTL instead of the default TTL, or not at all. The first
+// matching rule applies, and WithCacheTTL takes precedence.
+func WithCacheRules(rules ...CacheRule) ConfigSetter {
+	return func(c *Configuration) {
+		c.CacheRules = append(c.CacheRules, rules...)
+	}
+}
+
+// WithCacheInvalidation evicts the cached responses of the paths returned by
+// invalidation after every successful write, for example
+// InvalidateParentPaths.
+func WithCacheInvalidation(invalidation CacheInvalidation) ConfigSetter {
+	return func(c *Configuration) {
+		c.CacheInvalidation = invalidation
+	}
+}
+
 func WithCacheTtl(i int32) ConfigSetter {
 	return func(c *Configuration) {
 		c.Okta.Client.Cache.DefaultTtl = i
@@ -413,6 +557,17 @@ func WithHttpClientPtr(httpClient *http.Client) ConfigSetter {
 	}
 }
 
+// WithTransport sends the requests of the client with transport, keeping the
+// other settings of the HTTP client. Use it where the default transport cannot
+// open connections, such as GOOS=wasip1 where the host runtime provides the
+// HTTP stack. The proxy settings are ignored, as the transport is responsible
+// for them.
+func WithTransport(transport http.RoundTripper) ConfigSetter {
+	return func(c *Configuration) {
+		c.Transport = transport
+	}
+}
+
 func WithConnectionTimeout(i int64) ConfigSetter {
 	return func(c *Configuration) {
 		c.Okta.Client.ConnectionTimeout = i
@@ -485,6 +640,171 @@ func WithRateLimitMaxBackOff(maxBackoff int64) ConfigSetter {
 	}
 }
 
+// WithRateLimitHeadroom paces the requests of an endpoint once its remaining
+// requests fall below the fraction headroom of its limit, such as 0.2,
+// spreading them until the limit resets instead of spending them at once.
+// This leaves requests of the org's limit to other clients, such as admins
+// using the Admin Console during a long sync. It implies
+// WithRateLimitPrevent.
+func WithRateLimitHeadroom(headroom float64) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.RateLimit.Headroom = headroom
+	}
+}
+
+// WithTokenRetryStatuses sets the HTTP statuses of the OAuth 2.0 token
+// endpoint that are retried, by default 429, 500, 502, 503 and 504. Other
+// errors are returned immediately as a *TokenEndpointError.
+func WithTokenRetryStatuses(statuses ...int) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.RateLimit.TokenRetryStatuses = statuses
+	}
+}
+
+// WithPageRetry sets how often a page that failed with a network error or a
+// 5xx response is retried when following pagination links, 3 times starting
+// with a 500ms backoff by default. A maxRetries of 0 disables page retries.
+func WithPageRetry(maxRetries int, backoff time.Duration) ConfigSetter {
+	return func(c *Configuration) {
+		c.PageRetry = PageRetry{MaxRetries: maxRetries, Backoff: backoff}
+	}
+}
+
+// WithListAllMaxPages sets the number of pages the ListAll methods follow
+// before failing with ErrTooManyPages, 1000 by default.
+func WithListAllMaxPages(maxPages int) ConfigSetter {
+	return func(c *Configuration) {
+		c.ListAllMaxPages = maxPages
+	}
+}
+
+// WithCallObserver reports every API call made by the client to observer, for
+// example to trace them with OpenTelemetry, see the oteltrace package.
+func WithCallObserver(observer CallObserver) ConfigSetter {
+	return func(c *Configuration) {
+		c.CallObserver = observer
+	}
+}
+
+// WithRateLimitObserver reports the rate limit headers of every response to
+// observer, whether or not the client waits for rate limits.
+func WithRateLimitObserver(observer RateLimitObserver) ConfigSetter {
+	return func(c *Configuration) {
+		c.RateLimitObserver = observer
+	}
+}
+
+// WithPlanCeilings sets the number of users, apps and API tokens the plan of
+// the org allows, keyed by LimitUsers, LimitApps and LimitAPITokens, which
+// Limits reports usage against.
+func WithPlanCeilings(ceilings map[string]int) ConfigSetter {
+	return func(c *Configuration) {
+		c.PlanCeilings = ceilings
+	}
+}
+
+// WithCallPurposeHeader sends the purpose of API calls, as tagged with
+// WithCallPurpose, in the given request header, such as X-Call-Purpose.
+func WithCallPurposeHeader(header string) ConfigSetter {
+	return func(c *Configuration) {
+		c.CallPurposeHeader = header
+	}
+}
+
+// WithFaultInjection injects the faults into the requests of the client, on
+// top of its transport. It is meant for staging environments, to validate how
+// an application copes with Okta incidents.
+func WithFaultInjection(faults FaultInjection) ConfigSetter {
+	return func(c *Configuration) {
+		c.FaultInjection = &faults
+	}
+}
+
+// WithAlternateIPDialing connects to the org with an AlternateIPDialer, which
+// quickly tries the other addresses of its host when one fails to connect and
+// remembers the failing ones. It replaces the DialContext of the
+// *http.Transport of the client; other transports are left as they are.
+func WithAlternateIPDialing(opts AlternateIPDialing) ConfigSetter {
+	return func(c *Configuration) {
+		c.AlternateIPDialing = &opts
+	}
+}
+
+// WithRetryer replaces the retry engine of API requests, see NewBackOffRetryer
+// to use a github.com/cenkalti/backoff BackOff. The default retries 429
+// responses according to the rate limit settings.
+func WithRetryer(retryer Retryer) ConfigSetter {
+	return func(c *Configuration) {
+		c.Retryer = retryer
+	}
+}
+
+// WithRetryPolicy retries API requests according to policy, e.g. a copy of
+// DefaultRetryPolicy retrying more statuses or reporting retries to OnRetry.
+func WithRetryPolicy(policy RetryPolicy) ConfigSetter {
+	return func(c *Configuration) {
+		c.Retryer = policy
+	}
+}
+
+// WithCircuitBreaker fails requests fast with ErrCircuitOpen after
+// consecutive failures of Okta, see CircuitBreakerSettings.
+func WithCircuitBreaker(settings CircuitBreakerSettings) ConfigSetter {
+	return func(c *Configuration) {
+		c.CircuitBreaker = NewCircuitBreaker(settings)
+	}
+}
+
+// WithResponseValidator checks the successful JSON responses of the API with
+// validator, reporting the differences to the CallObserver in
+// Call.SchemaMismatches. Calls do not fail unless WithFailOnSchemaMismatch is
+// set.
+func WithResponseValidator(validator ResponseValidator) ConfigSetter {
+	return func(c *Configuration) {
+		c.ResponseValidator = validator
+	}
+}
+
+// WithFailOnSchemaMismatch fails calls whose response does not match its
+// schema with a SchemaMismatchError, for example in tests.
+func WithFailOnSchemaMismatch(fail bool) ConfigSetter {
+	return func(c *Configuration) {
+		c.FailOnSchemaMismatch = fail
+	}
+}
+
+// WithOperationTimeouts sets the timeouts applied per operation class to
+// requests made with a context without deadline, e.g. DefaultOperationTimeouts.
+func WithOperationTimeouts(timeouts OperationTimeouts) ConfigSetter {
+	return func(c *Configuration) {
+		c.OperationTimeouts = timeouts
+	}
+}
+
+// WithClosedBodyPolicy sets what happens to the body of responses returned
+// together with an error, see ClosedBodyPolicy.
+func WithClosedBodyPolicy(policy ClosedBodyPolicy) ConfigSetter {
+	return func(c *Configuration) {
+		c.ClosedBodyPolicy = policy
+	}
+}
+
+// WithTempFileOptions sets the directory and name pattern of the temporary
+// files file responses are written to.
+func WithTempFileOptions(options TempFileOptions) ConfigSetter {
+	return func(c *Configuration) {
+		c.TempFiles = options
+	}
+}
+
+// WithIDGenerator sets the generator of the jti of client assertions and DPoP
+// proofs, random UUIDs by default.
+func WithIDGenerator(generator IDGenerator) ConfigSetter {
+	return func(c *Configuration) {
+		c.IDGenerator = generator
+	}
+}
+
 func WithAuthorizationMode(authzMode string) ConfigSetter {
 	return func(c *Configuration) {
 		c.Okta.Client.AuthorizationMode = authzMode
@@ -536,18 +856,71 @@ func WithPrivateKey(privateKey string) ConfigSetter {
 	}
 }
 
+// WithAPIVersion pins the management API version (e.g. "2024.06.1") the
+// caller was written against. Versions newer than SpecVersion are rejected.
+// Pinning is only checked when the configuration is built; requests are not
+// changed by it.
+func WithAPIVersion(version string) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.APIVersion = version
+	}
+}
+
 func WithPrivateKeyId(privateKeyId string) ConfigSetter {
 	return func(c *Configuration) {
 		c.Okta.Client.PrivateKeyId = privateKeyId
 	}
 }
 
+// WithPrivateKeyPassphrase sets the passphrase of an encrypted private key or JWK.
+func WithPrivateKeyPassphrase(passphrase string) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.PrivateKeyPassphrase = passphrase
+	}
+}
+
+// WithPrivateKeyPassphraseProvider sets a callback returning the passphrase of
+// an encrypted private key or JWK. It takes precedence over WithPrivateKeyPassphrase.
+func WithPrivateKeyPassphraseProvider(provider PassphraseProvider) ConfigSetter {
+	return func(c *Configuration) {
+		c.PassphraseProvider = provider
+	}
+}
+
 func WithPrivateKeySigner(signer jose.Signer) ConfigSetter {
 	return func(c *Configuration) {
 		c.PrivateKeySigner = signer
 	}
 }
 
+// WithClientAssertionSigner sets the external signer of the client assertions
+// of the PrivateKey and JWK authorization modes, so that the private key never
+// leaves KMS, an HSM or Vault. A signer set with WithPrivateKeySigner takes
+// precedence.
+func WithClientAssertionSigner(signer ClientAssertionSigner) ConfigSetter {
+	return func(c *Configuration) {
+		c.ClientAssertionSigner = signer
+	}
+}
+
+// WithDPoPSigner sets the key used to sign DPoP proofs instead of an ephemeral
+// RSA key generated by the client. Any crypto.Signer can be used, such as a key
+// held by a PKCS#11 token or a TPM 2.0.
+func WithDPoPSigner(signer crypto.Signer) ConfigSetter {
+	return func(c *Configuration) {
+		c.DPoPSigner = signer
+	}
+}
+
+// WithDPoPKeyAlgorithm sets the algorithm of the ephemeral keys the client
+// generates to sign DPoP proofs when no DPoP signer is set: RS256, the
+// default, ES256, ES384 or EdDSA.
+func WithDPoPKeyAlgorithm(algorithm string) ConfigSetter {
+	return func(c *Configuration) {
+		c.Okta.Client.DPoPKeyAlgorithm = algorithm
+	}
+}
+
 func fileExists(filename string) bool {
 	info, err := os.Stat(filename)
 	if err != nil {
@@ -560,6 +933,23 @@ func fileExists(filename string) bool {
 	return !info.IsDir()
 }
 
+// WithTokenRotator sets a callback that provides a replacement SSWS token when
+// Okta rejects the current one or it is about to expire.
+func WithTokenRotator(rotator TokenRotator) ConfigSetter {
+	return func(c *Configuration) {
+		c.TokenRotator = rotator
+	}
+}
+
+// WithTokenExpiry records when the SSWS token expires and how long before
+// that it should be reported as expiring soon.
+func WithTokenExpiry(expiresAt time.Time, warning time.Duration) ConfigSetter {
+	return func(c *Configuration) {
+		c.TokenExpiresAt = expiresAt
+		c.TokenExpiryWarning = warning
+	}
+}
+
 func WithRateLimitPrevent(enable bool) ConfigSetter {
 	return func(c *Configuration) {
 		c.Okta.Client.RateLimit.Enable = enable
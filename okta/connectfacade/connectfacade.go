@@ -0,0 +1,326 @@
+// Package connectfacade serves a curated subset of the SDK, user lookup,
+// group membership and factor status, to internal services over the Connect
+// protocol, so that they share the credentials and the rate limit budget of
+// one okta.APIClient instead of each embedding Okta credentials.
+//
+// It speaks the unary JSON flavor of Connect itself, so the SDK does not
+// depend on a Connect or gRPC runtime: Connect clients using the JSON codec,
+// such as connect-go with connect.WithProtoJSON, call it directly, and any
+// HTTP client can POST JSON to it. gRPC clients need a gateway translating
+// to Connect, such as Vanguard.
+package connectfacade
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// ServiceName is the fully qualified name of the service, the path prefix of
+// its procedures.
+const ServiceName = "okta.facade.v1.DirectoryService"
+
+// Procedures of the service.
+const (
+	GetUserProcedure              = "/" + ServiceName + "/GetUser"
+	ListUserGroupsProcedure       = "/" + ServiceName + "/ListUserGroups"
+	CheckGroupMembershipProcedure = "/" + ServiceName + "/CheckGroupMembership"
+	ListFactorsProcedure          = "/" + ServiceName + "/ListFactors"
+)
+
+// Error codes of the Connect protocol returned by the service.
+const (
+	CodeCanceled          = "canceled"
+	CodeInvalidArgument   = "invalid_argument"
+	CodeDeadlineExceeded  = "deadline_exceeded"
+	CodeNotFound          = "not_found"
+	CodePermissionDenied  = "permission_denied"
+	CodeResourceExhausted = "resource_exhausted"
+	CodeUnimplemented     = "unimplemented"
+	CodeInternal          = "internal"
+	CodeUnavailable       = "unavailable"
+	CodeUnauthenticated   = "unauthenticated"
+)
+
+// codeStatuses are the HTTP statuses of the error codes, as defined by the
+// Connect protocol.
+var codeStatuses = map[string]int{
+	CodeCanceled:          499,
+	CodeInvalidArgument:   http.StatusBadRequest,
+	CodeDeadlineExceeded:  http.StatusGatewayTimeout,
+	CodeNotFound:          http.StatusNotFound,
+	CodePermissionDenied:  http.StatusForbidden,
+	CodeResourceExhausted: http.StatusTooManyRequests,
+	CodeUnimplemented:     http.StatusNotImplemented,
+	CodeInternal:          http.StatusInternalServerError,
+	CodeUnavailable:       http.StatusServiceUnavailable,
+	CodeUnauthenticated:   http.StatusUnauthorized,
+}
+
+// GetUserRequest looks a user up by id or login.
+type GetUserRequest struct {
+	UserID string `json:"userId"`
+}
+
+// User is a user as returned by GetUser.
+type User struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	Login     string `json:"login"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+// ListUserGroupsRequest lists the groups of a user, by id or login.
+type ListUserGroupsRequest struct {
+	UserID string `json:"userId"`
+}
+
+// Group is a group of a user.
+type Group struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ListUserGroupsResponse holds the groups of a user.
+type ListUserGroupsResponse struct {
+	Groups []Group `json:"groups"`
+}
+
+// CheckGroupMembershipRequest checks whether a user, by id, is a direct
+// member of a group.
+type CheckGroupMembershipRequest struct {
+	UserID  string `json:"userId"`
+	GroupID string `json:"groupId"`
+}
+
+// CheckGroupMembershipResponse reports whether the user is a member.
+type CheckGroupMembershipResponse struct {
+	Member bool `json:"member"`
+}
+
+// ListFactorsRequest lists the factors a user, by id, enrolled.
+type ListFactorsRequest struct {
+	UserID string `json:"userId"`
+}
+
+// Factor is an enrolled factor and its status, such as ACTIVE or
+// PENDING_ACTIVATION.
+type Factor struct {
+	ID         string `json:"id"`
+	FactorType string `json:"factorType"`
+	Provider   string `json:"provider"`
+	Status     string `json:"status"`
+}
+
+// ListFactorsResponse holds the factors of a user.
+type ListFactorsResponse struct {
+	Factors []Factor `json:"factors"`
+}
+
+// Options configures the handler.
+type Options struct {
+	// Authorize is called before every call, to authenticate the calling
+	// service, for example from the client certificate of r. A call it
+	// returns an error for fails with CodeUnauthenticated.
+	Authorize func(r *http.Request) error
+}
+
+// Error is the error of a call, as sent in Connect error responses.
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+// NewHandler returns the path prefix of the service and the handler serving
+// its procedures with client, to mount on a mux:
+//
+//	mux.Handle(connectfacade.NewHandler(client, connectfacade.Options{}))
+func NewHandler(client *okta.APIClient, opts Options) (string, http.Handler) {
+	h := &handler{client: client, opts: opts}
+	h.procedures = map[string]func(context.Context, []byte) (interface{}, error){
+		GetUserProcedure:              unary(h.getUser),
+		ListUserGroupsProcedure:       unary(h.listUserGroups),
+		CheckGroupMembershipProcedure: unary(h.checkGroupMembership),
+		ListFactorsProcedure:          unary(h.listFactors),
+	}
+	return "/" + ServiceName + "/", h
+}
+
+type handler struct {
+	client     *okta.APIClient
+	opts       Options
+	procedures map[string]func(context.Context, []byte) (interface{}, error)
+}
+
+// unary decodes the JSON request of a procedure.
+func unary[Req any](call func(context.Context, *Req) (interface{}, error)) func(context.Context, []byte) (interface{}, error) {
+	return func(ctx context.Context, body []byte) (interface{}, error) {
+		req := new(Req)
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, req); err != nil {
+				return nil, &Error{Code: CodeInvalidArgument, Message: "invalid request: " + err.Error()}
+			}
+		}
+		return call(ctx, req)
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if contentType := r.Header.Get("Content-Type"); contentType != "application/json" && !strings.HasPrefix(contentType, "application/json;") {
+		w.Header().Set("Accept-Post", "application/json")
+		http.Error(w, "only the JSON codec is supported", http.StatusUnsupportedMediaType)
+		return
+	}
+	procedure, ok := h.procedures[r.URL.Path]
+	if !ok {
+		writeError(w, &Error{Code: CodeUnimplemented, Message: r.URL.Path + " is not implemented"})
+		return
+	}
+	if h.opts.Authorize != nil {
+		if err := h.opts.Authorize(r); err != nil {
+			writeError(w, &Error{Code: CodeUnauthenticated, Message: err.Error()})
+			return
+		}
+	}
+	ctx := r.Context()
+	if timeout := r.Header.Get("Connect-Timeout-Ms"); timeout != "" {
+		ms, err := strconv.ParseInt(timeout, 10, 64)
+		if err != nil || ms < 0 {
+			writeError(w, &Error{Code: CodeInvalidArgument, Message: "invalid Connect-Timeout-Ms " + timeout})
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(ms)*time.Millisecond)
+		defer cancel()
+	}
+	var body json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, &Error{Code: CodeInvalidArgument, Message: "invalid request: " + err.Error()})
+		return
+	}
+	resp, err := procedure(ctx, body)
+	if err != nil {
+		writeError(w, callError(ctx, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *handler) getUser(ctx context.Context, req *GetUserRequest) (interface{}, error) {
+	if req.UserID == "" {
+		return nil, &Error{Code: CodeInvalidArgument, Message: "userId is required"}
+	}
+	user, _, err := h.client.UserAPI.GetUser(ctx, req.UserID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	profile := user.GetProfile()
+	return &User{
+		ID:        user.GetId(),
+		Status:    user.GetStatus(),
+		Login:     profile.GetLogin(),
+		Email:     profile.GetEmail(),
+		FirstName: profile.GetFirstName(),
+		LastName:  profile.GetLastName(),
+	}, nil
+}
+
+func (h *handler) listUserGroups(ctx context.Context, req *ListUserGroupsRequest) (interface{}, error) {
+	if req.UserID == "" {
+		return nil, &Error{Code: CodeInvalidArgument, Message: "userId is required"}
+	}
+	groups, err := h.client.ListAllUserGroups(h.client.UserAPI.ListUserGroups(ctx, req.UserID))
+	if err != nil {
+		return nil, err
+	}
+	resp := &ListUserGroupsResponse{Groups: []Group{}}
+	for _, group := range groups {
+		var name string
+		if group.Profile != nil {
+			name = group.Profile.GetName()
+		}
+		resp.Groups = append(resp.Groups, Group{ID: group.GetId(), Name: name, Type: group.GetType()})
+	}
+	return resp, nil
+}
+
+func (h *handler) checkGroupMembership(ctx context.Context, req *CheckGroupMembershipRequest) (interface{}, error) {
+	if req.UserID == "" || req.GroupID == "" {
+		return nil, &Error{Code: CodeInvalidArgument, Message: "userId and groupId are required"}
+	}
+	member, err := h.client.IsMember(ctx, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &CheckGroupMembershipResponse{Member: member}, nil
+}
+
+func (h *handler) listFactors(ctx context.Context, req *ListFactorsRequest) (interface{}, error) {
+	if req.UserID == "" {
+		return nil, &Error{Code: CodeInvalidArgument, Message: "userId is required"}
+	}
+	// the fields shared by every factor type, without decoding the factor
+	// union
+	factors := []Factor{}
+	if _, err := h.client.Do(ctx, http.MethodGet, "/api/v1/users/"+url.PathEscape(req.UserID)+"/factors", nil, &factors); err != nil {
+		return nil, err
+	}
+	return &ListFactorsResponse{Factors: factors}, nil
+}
+
+// callError returns the Connect error of a failed call.
+func callError(ctx context.Context, err error) *Error {
+	var connectErr *Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return &Error{Code: CodeDeadlineExceeded, Message: err.Error()}
+	case errors.Is(ctx.Err(), context.Canceled):
+		return &Error{Code: CodeCanceled, Message: err.Error()}
+	case okta.IsNotFound(err):
+		return &Error{Code: CodeNotFound, Message: err.Error()}
+	case okta.IsRateLimited(err):
+		return &Error{Code: CodeResourceExhausted, Message: err.Error()}
+	}
+	var apiErr *okta.APIError
+	if errors.As(err, &apiErr) {
+		switch {
+		case apiErr.StatusCode == http.StatusBadRequest:
+			return &Error{Code: CodeInvalidArgument, Message: err.Error()}
+		case apiErr.StatusCode == http.StatusForbidden:
+			return &Error{Code: CodePermissionDenied, Message: err.Error()}
+		case apiErr.StatusCode >= 500:
+			return &Error{Code: CodeUnavailable, Message: err.Error()}
+		}
+	}
+	return &Error{Code: CodeInternal, Message: err.Error()}
+}
+
+func writeError(w http.ResponseWriter, err *Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(codeStatuses[err.Code])
+	json.NewEncoder(w).Encode(err)
+}
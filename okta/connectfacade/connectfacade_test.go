@@ -0,0 +1,95 @@
+package connectfacade
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta/internal/oktatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func call(t *testing.T, h http.Handler, procedure, body string) (int, map[string]interface{}) {
+	req := httptest.NewRequest(http.MethodPost, procedure, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Service", "billing")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	var out map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &out), rec.Body.String())
+	return rec.Code, out
+}
+
+func Test_Handler(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/alice@example.com", oktatest.JSONResponder(200, `{"id":"00u1","status":"ACTIVE",
+	  "profile":{"login":"alice@example.com","email":"alice@example.com","firstName":"Alice","lastName":"Smith"}}`))
+	httpmock.RegisterResponder("GET", "/api/v1/users/bob@example.com", oktatest.JSONResponder(404, `{"errorCode":"E0000007","errorSummary":"Not found: Resource not found: bob@example.com (User)"}`))
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1/groups", oktatest.JSONResponder(200, `[{"id":"00gEng","type":"OKTA_GROUP","profile":{"name":"Engineering"}}]`))
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00gEng/users", oktatest.JSONResponder(200, `[{"id":"00u1"}]`))
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1/factors", oktatest.JSONResponder(200, `[
+	  {"id":"ufs1","factorType":"sms","provider":"OKTA","status":"ACTIVE","profile":{"phoneNumber":"+15555550100"}},
+	  {"id":"opf1","factorType":"token:software:totp","provider":"GOOGLE","status":"PENDING_ACTIVATION"}
+	]`))
+
+	prefix, h := NewHandler(oktatest.NewClient(t), Options{
+		Authorize: func(r *http.Request) error {
+			if r.Header.Get("X-Service") == "" {
+				return errors.New("unknown service")
+			}
+			return nil
+		},
+	})
+	assert.Equal(t, "/okta.facade.v1.DirectoryService/", prefix)
+
+	status, out := call(t, h, GetUserProcedure, `{"userId":"alice@example.com"}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, map[string]interface{}{"id": "00u1", "status": "ACTIVE", "login": "alice@example.com", "email": "alice@example.com", "firstName": "Alice", "lastName": "Smith"}, out)
+
+	status, out = call(t, h, GetUserProcedure, `{"userId":"bob@example.com"}`)
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, CodeNotFound, out["code"])
+
+	status, out = call(t, h, GetUserProcedure, `{}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, CodeInvalidArgument, out["code"])
+
+	status, out = call(t, h, ListUserGroupsProcedure, `{"userId":"00u1"}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": "00gEng", "name": "Engineering", "type": "OKTA_GROUP"}}, out["groups"])
+
+	status, out = call(t, h, CheckGroupMembershipProcedure, `{"userId":"00u1","groupId":"00gEng"}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, true, out["member"])
+
+	status, out = call(t, h, ListFactorsProcedure, `{"userId":"00u1"}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": "ufs1", "factorType": "sms", "provider": "OKTA", "status": "ACTIVE"},
+		map[string]interface{}{"id": "opf1", "factorType": "token:software:totp", "provider": "GOOGLE", "status": "PENDING_ACTIVATION"},
+	}, out["factors"])
+
+	status, out = call(t, h, "/"+ServiceName+"/DeleteUser", `{"userId":"00u1"}`)
+	assert.Equal(t, http.StatusNotImplemented, status)
+	assert.Equal(t, CodeUnimplemented, out["code"], "Only the curated procedures should be served")
+
+	req := httptest.NewRequest(http.MethodPost, GetUserProcedure, strings.NewReader(`{"userId":"00u1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"code":"unauthenticated"`)
+
+	req = httptest.NewRequest(http.MethodPost, GetUserProcedure, strings.NewReader(`userId: 00u1`))
+	req.Header.Set("Content-Type", "application/proto")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+}
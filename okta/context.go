@@ -0,0 +1,61 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/oauth2"
+)
+
+// The helpers in this file attach request scoped authentication to a context
+// passed to any generated API method. The Authorization header they set
+// replaces the one of the client's configured AuthorizationMode.
+
+// WithAccessToken returns a copy of ctx carrying an OAuth 2.0 access token that
+// is sent as a Bearer Authorization header.
+func WithAccessToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, ContextAccessToken, token)
+}
+
+// WithBasicAuth returns a copy of ctx carrying HTTP basic credentials.
+func WithBasicAuth(ctx context.Context, username, password string) context.Context {
+	return context.WithValue(ctx, ContextBasicAuth, BasicAuth{UserName: username, Password: password})
+}
+
+// WithOAuth2TokenSource returns a copy of ctx carrying a token source whose
+// tokens are set as the Authorization header.
+func WithOAuth2TokenSource(ctx context.Context, ts oauth2.TokenSource) context.Context {
+	return context.WithValue(ctx, ContextOAuth2, ts)
+}
+
+// contextAuth validates the authentication values stored in ctx. Values of the
+// wrong type or empty credentials are reported instead of silently ignored.
+func contextAuth(ctx context.Context) (ts oauth2.TokenSource, basic *BasicAuth, token string, err error) {
+	if v := ctx.Value(ContextOAuth2); v != nil {
+		var ok bool
+		if ts, ok = v.(oauth2.TokenSource); !ok || ts == nil {
+			return nil, nil, "", fmt.Errorf("ctx value of %v has invalid type %T should be oauth2.TokenSource", ContextOAuth2, v)
+		}
+	}
+	if v := ctx.Value(ContextBasicAuth); v != nil {
+		auth, ok := v.(BasicAuth)
+		if !ok {
+			return nil, nil, "", fmt.Errorf("ctx value of %v has invalid type %T should be BasicAuth", ContextBasicAuth, v)
+		}
+		if auth.UserName == "" {
+			return nil, nil, "", errors.New("basic auth user name in context is empty")
+		}
+		basic = &auth
+	}
+	if v := ctx.Value(ContextAccessToken); v != nil {
+		var ok bool
+		if token, ok = v.(string); !ok {
+			return nil, nil, "", fmt.Errorf("ctx value of %v has invalid type %T should be string", ContextAccessToken, v)
+		}
+		if token == "" {
+			return nil, nil, "", errors.New("access token in context is empty")
+		}
+	}
+	return ts, basic, token, nil
+}
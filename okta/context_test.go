@@ -0,0 +1,31 @@
+package okta
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Context_Auth_Helpers(t *testing.T) {
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	ctx := WithBasicAuth(context.Background(), "user", "pass")
+	req, err := client.prepareRequest(ctx, "https://example.okta.com/api/v1/users", http.MethodGet, nil, map[string]string{}, url.Values{}, url.Values{}, nil)
+	require.NoError(t, err, "Preparing a request with basic auth should not error")
+	credentials := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+	assert.Equal(t, []string{"Basic " + credentials}, req.Header.Values("Authorization"), "Basic auth should override the SSWS token")
+
+	ctx = context.WithValue(context.Background(), ContextAccessToken, 42)
+	_, err = client.prepareRequest(ctx, "https://example.okta.com/api/v1/users", http.MethodGet, nil, map[string]string{}, url.Values{}, url.Values{}, nil)
+	require.Error(t, err, "An access token of the wrong type should be rejected")
+
+	_, err = client.prepareRequest(WithAccessToken(context.Background(), ""), "https://example.okta.com/api/v1/users", http.MethodGet, nil, map[string]string{}, url.Values{}, url.Values{}, nil)
+	require.Error(t, err, "An empty access token should be rejected")
+}
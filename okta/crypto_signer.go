@@ -0,0 +1,41 @@
+package okta
+
+import (
+	"crypto"
+	"errors"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/cryptosigner"
+)
+
+// NewCryptoSigner adapts a crypto.Signer to a jose.Signer that can be passed to
+// WithPrivateKeySigner to sign client assertions. This allows the private key
+// to stay in a PKCS#11 token, a TPM 2.0 or a cloud KMS: only the public key and
+// the Sign method of the crypto.Signer are used. RSA keys sign with RS256, EC
+// keys with the ES algorithm matching their curve and Ed25519 keys with EdDSA.
+// keyID, when not empty, is set as the "kid" header.
+func NewCryptoSigner(signer crypto.Signer, keyID string) (jose.Signer, error) {
+	opts := &jose.SignerOptions{}
+	if keyID != "" {
+		opts = opts.WithHeader("kid", keyID)
+	}
+	return newOpaqueSigner(signer, opts)
+}
+
+// newOpaqueSigner creates a jose.Signer backed by signer using the first
+// algorithm supported by its public key, or the algorithm of the external
+// signer of a signer returned by NewDPoPSigner.
+func newOpaqueSigner(signer crypto.Signer, opts *jose.SignerOptions) (jose.Signer, error) {
+	if signer == nil {
+		return nil, errors.New("crypto signer is nil")
+	}
+	if payloadSigner, ok := signer.(dpopPayloadSigner); ok {
+		return jose.NewSigner(jose.SigningKey{Algorithm: payloadSigner.alg, Key: assertionSigner{signer: payloadSigner.signer, alg: payloadSigner.alg}}, opts)
+	}
+	opaque := cryptosigner.Opaque(signer)
+	algs := opaque.Algs()
+	if len(algs) == 0 {
+		return nil, errors.New("crypto signer uses an unsupported key type, only RSA, EC and Ed25519 keys are supported")
+	}
+	return jose.NewSigner(jose.SigningKey{Algorithm: algs[0], Key: opaque}, opts)
+}
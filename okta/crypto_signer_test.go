@@ -0,0 +1,170 @@
+package okta
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hardwareSigner hides the concrete key type the way PKCS#11 and TPM backed
+// signers do, only the public key and Sign are available.
+type hardwareSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s hardwareSigner) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+func (s hardwareSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.key.Sign(rand, digest, opts)
+}
+
+func Test_Crypto_Signer_Signs_Assertions_And_DPoP_Proofs(t *testing.T) {
+	assertionKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	dpopKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := NewCryptoSigner(hardwareSigner{assertionKey}, "hsm-key")
+	require.NoError(t, err, "Creating a signer from a crypto.Signer should not error")
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var tokenCalls int
+	httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+		tokenCalls++
+		require.NoError(t, req.ParseForm())
+		assertion, err := jwt.ParseSigned(req.PostForm.Get("client_assertion"))
+		require.NoError(t, err)
+		assert.Equal(t, "hsm-key", assertion.Headers[0].KeyID)
+		assert.Equal(t, string(jose.ES256), assertion.Headers[0].Algorithm)
+		var claims jwt.Claims
+		require.NoError(t, assertion.Claims(&assertionKey.PublicKey, &claims), "Assertion should verify with the hardware public key")
+
+		if req.Header.Get("DPoP") == "" {
+			return mockJSONResponse(400, `{"error":"invalid_dpop_proof"}`), nil
+		}
+		requireDPoPProof(t, req.Header.Get("DPoP"), &dpopKey.PublicKey)
+		return mockJSONResponse(200, `{"token_type":"DPoP","expires_in":3600,"access_token":"token","scope":"okta.users.read"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DPoP token", req.Header.Get("Authorization"))
+		requireDPoPProof(t, req.Header.Get("Dpop"), &dpopKey.PublicKey)
+		return mockJSONResponse(200, "[]"), nil
+	})
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithAuthorizationMode("PrivateKey"),
+		WithClientId("client"),
+		WithScopes([]string{"okta.users.read"}),
+		WithPrivateKeySigner(signer),
+		WithDPoPSigner(hardwareSigner{dpopKey}),
+		WithCache(false),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err, "Request should succeed with hardware backed keys")
+	assert.Equal(t, 2, tokenCalls)
+}
+
+func requireDPoPProof(t *testing.T, proof string, key *ecdsa.PublicKey) {
+	t.Helper()
+	require.NotEmpty(t, proof, "DPoP proof should be set")
+	parsed, err := jwt.ParseSigned(proof)
+	require.NoError(t, err)
+	header := parsed.Headers[0]
+	assert.Equal(t, string(jose.ES384), header.Algorithm)
+	assert.Equal(t, "dpop+jwt", header.ExtraHeaders[jose.HeaderType])
+	var claims DpopClaims
+	require.NoError(t, parsed.Claims(key, &claims), "DPoP proof should verify with the hardware public key")
+	assert.NotEmpty(t, claims.ID)
+}
+
+func Test_DPoP_Key_Algorithms(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	tests := []struct {
+		algorithm string
+		signer    crypto.Signer
+		want      jose.SignatureAlgorithm
+	}{
+		{algorithm: "", want: jose.RS256},
+		{algorithm: "ES256", want: jose.ES256},
+		{algorithm: "ES384", want: jose.ES384},
+		{algorithm: "EdDSA", want: jose.EdDSA},
+		{signer: ed25519Key, want: jose.EdDSA},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.want)+tt.algorithm, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+				if req.Header.Get("DPoP") == "" {
+					return mockJSONResponse(400, `{"error":"invalid_dpop_proof"}`), nil
+				}
+				requireSelfSignedDPoPProof(t, req.Header.Get("DPoP"), tt.want)
+				return mockJSONResponse(200, `{"token_type":"DPoP","expires_in":3600,"access_token":"token","scope":"okta.users.read"}`), nil
+			})
+			httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+				requireSelfSignedDPoPProof(t, req.Header.Get("Dpop"), tt.want)
+				return mockJSONResponse(200, "[]"), nil
+			})
+
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			require.NoError(t, err)
+			signer, err := NewCryptoSigner(key, "")
+			require.NoError(t, err)
+			configuration, err := NewConfiguration(
+				WithOrgUrl("https://example.okta.com"),
+				WithAuthorizationMode("PrivateKey"),
+				WithClientId("client"),
+				WithScopes([]string{"okta.users.read"}),
+				WithPrivateKeySigner(signer),
+				WithDPoPKeyAlgorithm(tt.algorithm),
+				WithDPoPSigner(tt.signer),
+				WithCache(false),
+			)
+			require.NoError(t, err, "Creating a new config should not error")
+			client := NewAPIClient(configuration)
+
+			_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_DPoP_Key_Algorithm_Unsupported(t *testing.T) {
+	_, err := generateDpopKey("HS256")
+	assert.ErrorContains(t, err, `unsupported DPoP key algorithm "HS256"`)
+}
+
+// requireSelfSignedDPoPProof verifies a DPoP proof with the key of its jwk
+// header.
+func requireSelfSignedDPoPProof(t *testing.T, proof string, alg jose.SignatureAlgorithm) {
+	t.Helper()
+	require.NotEmpty(t, proof, "DPoP proof should be set")
+	parsed, err := jwt.ParseSigned(proof)
+	require.NoError(t, err)
+	header := parsed.Headers[0]
+	assert.Equal(t, string(alg), header.Algorithm)
+	require.NotNil(t, header.JSONWebKey, "DPoP proof should carry its public key")
+	var claims DpopClaims
+	require.NoError(t, parsed.Claims(header.JSONWebKey.Key, &claims), "DPoP proof should verify with its public key")
+}
@@ -0,0 +1,84 @@
+package okta
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// curlRedactedHeaders are the headers whose credentials are left out of curl
+// commands.
+var curlRedactedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Dpop":                true,
+	"Cookie":              true,
+	"X-Api-Client-Token":  true,
+}
+
+// CurlCommand returns a curl command reproducing req, to run a failing call
+// outside the SDK, for example when working with Okta support. Credentials
+// are redacted, the scheme of the Authorization header is kept.
+func CurlCommand(req *http.Request) (string, error) {
+	if req == nil || req.URL == nil {
+		return "", errors.New("no request to reproduce")
+	}
+	var b strings.Builder
+	b.WriteString("curl")
+	if req.Method != "" && req.Method != http.MethodGet {
+		b.WriteString(" -X " + req.Method)
+	}
+	b.WriteString(" " + shellQuote(req.URL.String()))
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range req.Header[name] {
+			if curlRedactedHeaders[http.CanonicalHeaderKey(name)] {
+				value = redactCredential(value)
+			}
+			b.WriteString(" -H " + shellQuote(name+": "+value))
+		}
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		defer body.Close()
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return "", err
+		}
+		if len(data) > 0 {
+			b.WriteString(" --data-binary " + shellQuote(string(data)))
+		}
+	}
+	return b.String(), nil
+}
+
+// AsCurl returns a curl command reproducing the request of the response, see
+// CurlCommand.
+func (r *APIResponse) AsCurl() (string, error) {
+	if r == nil || r.Response == nil {
+		return "", errors.New("no request to reproduce")
+	}
+	return CurlCommand(r.Request)
+}
+
+func redactCredential(value string) string {
+	if scheme, _, ok := strings.Cut(value, " "); ok {
+		return scheme + " REDACTED"
+	}
+	return "REDACTED"
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,36 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_As_Curl(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(400, `{"errorCode":"E0000001","errorSummary":"Api validation failed: name"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("secret-token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, resp, err := client.GroupAPI.CreateGroup(context.Background()).
+		Group(Group{Profile: &GroupProfile{Name: PtrString("It's a group")}}).
+		Execute()
+	require.Error(t, err)
+	curl, err := resp.AsCurl()
+	require.NoError(t, err)
+	assert.Contains(t, curl, "curl -X POST 'https://example.okta.com/api/v1/groups'")
+	assert.Contains(t, curl, "-H 'Authorization: SSWS REDACTED'")
+	assert.Contains(t, curl, "-H 'Content-Type: application/json'")
+	assert.Contains(t, curl, `--data-binary '{"profile":{"name":"It'\''s a group"}}`)
+	assert.NotContains(t, curl, "secret-token")
+}
@@ -0,0 +1,168 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultDeadLetterAttempts = 3
+	defaultDeadLetterBackoff  = time.Second
+	maxDeadLetterBackoff      = time.Minute
+
+	// DeadLetterSourceSystemLog and DeadLetterSourceEventHook are the sources
+	// of dead letters.
+	DeadLetterSourceSystemLog = "systemLog"
+	DeadLetterSourceEventHook = "eventHook"
+)
+
+// DeadLetter is an event that failed processing on every attempt.
+type DeadLetter struct {
+	// Source is DeadLetterSourceSystemLog or DeadLetterSourceEventHook.
+	Source   string
+	Event    LogEvent
+	Attempts int
+	// Err is the error of the last attempt.
+	Err      error
+	FailedAt time.Time
+}
+
+// MarshalJSON encodes the error of the letter as its message.
+func (l DeadLetter) MarshalJSON() ([]byte, error) {
+	letter := struct {
+		Source   string    `json:"source"`
+		Event    LogEvent  `json:"event"`
+		Attempts int       `json:"attempts"`
+		Error    string    `json:"error,omitempty"`
+		FailedAt time.Time `json:"failedAt"`
+	}{Source: l.Source, Event: l.Event, Attempts: l.Attempts, FailedAt: l.FailedAt}
+	if l.Err != nil {
+		letter.Error = l.Err.Error()
+	}
+	return json.Marshal(letter)
+}
+
+// DeadLetterSink stores dead letters, for example in a queue to replay them
+// once the downstream system is fixed.
+type DeadLetterSink func(ctx context.Context, letter DeadLetter) error
+
+// DeadLetterWriter returns a sink writing dead letters to w as JSON lines. It
+// is safe for concurrent use.
+func DeadLetterWriter(w io.Writer) DeadLetterSink {
+	var mu sync.Mutex
+	return func(ctx context.Context, letter DeadLetter) error {
+		line, err := json.Marshal(letter)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		_, err = w.Write(append(line, '\n'))
+		return err
+	}
+}
+
+// DeadLetterOptions configures a DeadLetterQueue.
+type DeadLetterOptions struct {
+	// MaxAttempts is the number of times an event is processed before it is
+	// dead lettered, 3 by default.
+	MaxAttempts int
+	// Backoff is the delay before the second attempt. It doubles for every
+	// following attempt, up to a minute, and is 1 second by default.
+	Backoff time.Duration
+	// Sink receives the events that failed every attempt. It is required.
+	Sink DeadLetterSink
+}
+
+// DeadLetterStats counts the events a DeadLetterQueue processed.
+type DeadLetterStats struct {
+	// Attempts is the number of times events were processed, Retries the
+	// number of attempts after a failure.
+	Attempts int64
+	Retries  int64
+	// DeadLettered is the number of events handed to the sink and SinkErrors
+	// the number of them the sink failed to store.
+	DeadLettered int64
+	SinkErrors   int64
+}
+
+// DeadLetterQueue retries the processing of events and hands those failing
+// every attempt to a sink, so that one bad event or a downstream outage does
+// not stop a stream. It is shared by BackfillLogEvents and
+// EventHookHandler and safe for concurrent use.
+type DeadLetterQueue struct {
+	opts DeadLetterOptions
+
+	attempts     atomic.Int64
+	retries      atomic.Int64
+	deadLettered atomic.Int64
+	sinkErrors   atomic.Int64
+}
+
+// NewDeadLetterQueue returns a queue with the defaults of opts applied.
+func NewDeadLetterQueue(opts DeadLetterOptions) (*DeadLetterQueue, error) {
+	if opts.Sink == nil {
+		return nil, errors.New("dead letter sink is required")
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultDeadLetterAttempts
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = defaultDeadLetterBackoff
+	}
+	return &DeadLetterQueue{opts: opts}, nil
+}
+
+// Stats returns the counters of the queue.
+func (q *DeadLetterQueue) Stats() DeadLetterStats {
+	return DeadLetterStats{
+		Attempts:     q.attempts.Load(),
+		Retries:      q.retries.Load(),
+		DeadLettered: q.deadLettered.Load(),
+		SinkErrors:   q.sinkErrors.Load(),
+	}
+}
+
+// process calls fn until it succeeds or MaxAttempts is reached, waiting the
+// backoff between attempts, or until ctx is done. It returns the number of
+// attempts made and the error of the last one.
+func (q *DeadLetterQueue) process(ctx context.Context, fn func(ctx context.Context) error) (int, error) {
+	backoff := q.opts.Backoff
+	for attempt := 1; ; attempt++ {
+		q.attempts.Add(1)
+		err := fn(ctx)
+		if err == nil || attempt >= q.opts.MaxAttempts {
+			return attempt, err
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempt, err
+		case <-timer.C:
+		}
+		q.retries.Add(1)
+		if backoff *= 2; backoff > maxDeadLetterBackoff {
+			backoff = maxDeadLetterBackoff
+		}
+	}
+}
+
+// deadLetter hands events that failed attempts times with err to the sink
+// and returns the error of the sink.
+func (q *DeadLetterQueue) deadLetter(ctx context.Context, source string, events []LogEvent, attempts int, err error) error {
+	now := time.Now()
+	for _, event := range events {
+		q.deadLettered.Add(1)
+		if sinkErr := q.opts.Sink(ctx, DeadLetter{Source: source, Event: event, Attempts: attempts, Err: err, FailedAt: now}); sinkErr != nil {
+			q.sinkErrors.Add(1)
+			return sinkErr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package okta
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	defaultDedupeTTL    = 7 * 24 * time.Hour
+	defaultDedupePrefix = "okta:delivered:"
+)
+
+// DedupeStore remembers the events a forwarder delivered downstream, by
+// uuid, so that a forwarder restarted in the middle of a stream skips the
+// events it already delivered. Okta may also deliver an event hook more than
+// once.
+type DedupeStore interface {
+	// Delivered reports whether the event id was marked as delivered.
+	Delivered(ctx context.Context, id string) (bool, error)
+	// MarkDelivered records that the event id was delivered.
+	MarkDelivered(ctx context.Context, id string) error
+}
+
+// StoreDedupe is a DedupeStore keeping the ids of delivered events in a
+// CacheStore, such as a FileStore or a Redis store, for a TTL. It is
+// "exactly once" only as far as the store is: an event handled by two
+// forwarders at the same time may still be delivered twice.
+type StoreDedupe struct {
+	store  CacheStore
+	prefix string
+	ttl    time.Duration
+}
+
+// NewStoreDedupe returns a DedupeStore remembering events in store under
+// prefix, "okta:delivered:" by default, for ttl, 7 days by default. The TTL
+// should exceed the time a forwarder may stay down.
+func NewStoreDedupe(store CacheStore, prefix string, ttl time.Duration) *StoreDedupe {
+	if prefix == "" {
+		prefix = defaultDedupePrefix
+	}
+	if ttl <= 0 {
+		ttl = defaultDedupeTTL
+	}
+	return &StoreDedupe{store: store, prefix: prefix, ttl: ttl}
+}
+
+// Delivered implements DedupeStore.
+func (s *StoreDedupe) Delivered(ctx context.Context, id string) (bool, error) {
+	_, ok, err := s.store.Get(s.prefix + id)
+	return ok, err
+}
+
+// MarkDelivered implements DedupeStore.
+func (s *StoreDedupe) MarkDelivered(ctx context.Context, id string) error {
+	return s.store.Set(s.prefix+id, []byte{1}, s.ttl)
+}
+
+// undelivered returns the events of a page the store has not seen, reusing
+// the page.
+func undelivered(ctx context.Context, store DedupeStore, events []LogEvent) ([]LogEvent, error) {
+	if store == nil {
+		return events, nil
+	}
+	kept := events[:0]
+	for i := range events {
+		delivered, err := store.Delivered(ctx, events[i].GetUuid())
+		if err != nil {
+			return nil, err
+		}
+		if !delivered {
+			kept = append(kept, events[i])
+		}
+	}
+	return kept, nil
+}
+
+// markDelivered records the events of a page in the store.
+func markDelivered(ctx context.Context, store DedupeStore, events []LogEvent) error {
+	if store == nil {
+		return nil
+	}
+	for i := range events {
+		if err := store.MarkDelivered(ctx, events[i].GetUuid()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
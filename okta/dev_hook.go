@@ -0,0 +1,214 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const hookVerificationHeader = "X-Okta-Verification-Challenge"
+
+// HookTunnel exposes a locally running hook handler, for example through
+// ngrok or inlets, and returns its public URL. stop is called when the
+// development hook is closed.
+type HookTunnel func(ctx context.Context) (publicURL string, stop func() error, err error)
+
+// DevHookOptions configures a hook registered for local development.
+type DevHookOptions struct {
+	// Name of the hook, "dev hook" by default.
+	Name string
+	// PublicURL is the address Okta calls. It is required unless Tunnel is
+	// set.
+	PublicURL string
+	// Tunnel is called to obtain the public URL when PublicURL is empty.
+	Tunnel HookTunnel
+	// AuthHeader and AuthValue are sent by Okta with every hook call so the
+	// handler can authenticate it.
+	AuthHeader string
+	AuthValue  string
+	// Events are the event types an event hook subscribes to.
+	Events []string
+	// Type is the type of an inline hook, such as
+	// com.okta.oauth2.tokens.transform.
+	Type string
+}
+
+// DevHook is an event or inline hook registered by StartDevEventHook or
+// StartDevInlineHook. Close deactivates and deletes it.
+type DevHook struct {
+	ID        string
+	PublicURL string
+
+	client *APIClient
+	inline bool
+	stop   func() error
+}
+
+// StartDevEventHook registers an event hook pointing at a local handler,
+// activates and verifies it. The handler must answer the verification
+// challenge, see HookVerificationHandler. The hook is removed again if any
+// step fails.
+func (c *APIClient) StartDevEventHook(ctx context.Context, opts DevHookOptions) (*DevHook, error) {
+	if len(opts.Events) == 0 {
+		return nil, errors.New("at least one event type is required")
+	}
+	h, err := c.startDevHook(ctx, &opts)
+	if err != nil {
+		return nil, err
+	}
+	config := NewEventHookChannelConfig(h.PublicURL)
+	if opts.AuthHeader != "" {
+		config.AuthScheme = &EventHookChannelConfigAuthScheme{
+			Key:   PtrString(opts.AuthHeader),
+			Type:  PtrString("HEADER"),
+			Value: PtrString(opts.AuthValue),
+		}
+	}
+	hook := NewEventHook(
+		*NewEventHookChannel(*config, "HTTP", "1.0.0"),
+		*NewEventSubscriptions(opts.Events, "EVENT_TYPE"),
+		opts.Name,
+	)
+	created, _, err := c.EventHookAPI.CreateEventHook(ctx).EventHook(*hook).Execute()
+	if err != nil {
+		return nil, h.abort(ctx, fmt.Errorf("create event hook: %w", err))
+	}
+	h.ID = created.GetId()
+	verified, _, err := c.EventHookAPI.VerifyEventHook(ctx, h.ID).Execute()
+	if err != nil {
+		return nil, h.abort(ctx, fmt.Errorf("verify event hook: %w", err))
+	}
+	if verified.GetVerificationStatus() != "VERIFIED" {
+		return nil, h.abort(ctx, fmt.Errorf("event hook %s is %s", h.ID, verified.GetVerificationStatus()))
+	}
+	if verified.GetStatus() != "ACTIVE" {
+		if _, _, err = c.EventHookAPI.ActivateEventHook(ctx, h.ID).Execute(); err != nil {
+			return nil, h.abort(ctx, fmt.Errorf("activate event hook: %w", err))
+		}
+	}
+	return h, nil
+}
+
+// StartDevInlineHook registers and activates an inline hook pointing at a
+// local handler. The hook is removed again if any step fails.
+func (c *APIClient) StartDevInlineHook(ctx context.Context, opts DevHookOptions) (*DevHook, error) {
+	if opts.Type == "" {
+		return nil, errors.New("inline hook type is required")
+	}
+	h, err := c.startDevHook(ctx, &opts)
+	if err != nil {
+		return nil, err
+	}
+	h.inline = true
+	hook, err := c.createHTTPInlineHook(ctx, opts.Name, opts.Type, h.PublicURL, opts.AuthHeader, opts.AuthValue)
+	if hook != nil {
+		h.ID = hook.GetId()
+	}
+	if err != nil {
+		return nil, h.abort(ctx, err)
+	}
+	return h, nil
+}
+
+// createHTTPInlineHook creates and activates an inline hook calling uri.
+func (c *APIClient) createHTTPInlineHook(ctx context.Context, name, hookType, uri, authHeader, authValue string) (*InlineHook, error) {
+	config := NewInlineHookChannelConfig()
+	config.SetUri(uri)
+	config.SetMethod(http.MethodPost)
+	if authHeader != "" {
+		config.AuthScheme = &InlineHookChannelConfigAuthScheme{
+			Key:   PtrString(authHeader),
+			Type:  PtrString("HEADER"),
+			Value: PtrString(authValue),
+		}
+	}
+	channel := NewInlineHookChannel()
+	channel.SetType("HTTP")
+	channel.SetVersion("1.0.0")
+	channel.AdditionalProperties = map[string]interface{}{"config": config}
+	hook := NewInlineHook()
+	hook.SetName(name)
+	hook.SetType(hookType)
+	hook.SetVersion("1.0.0")
+	hook.SetChannel(*channel)
+	created, _, err := c.InlineHookAPI.CreateInlineHook(ctx).InlineHook(*hook).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("create inline hook: %w", err)
+	}
+	if created.GetStatus() != "ACTIVE" {
+		if _, _, err = c.InlineHookAPI.ActivateInlineHook(ctx, created.GetId()).Execute(); err != nil {
+			return created, fmt.Errorf("activate inline hook: %w", err)
+		}
+	}
+	return created, nil
+}
+
+func (c *APIClient) startDevHook(ctx context.Context, opts *DevHookOptions) (*DevHook, error) {
+	if opts.Name == "" {
+		opts.Name = "dev hook"
+	}
+	h := &DevHook{client: c, PublicURL: opts.PublicURL}
+	if h.PublicURL != "" {
+		return h, nil
+	}
+	if opts.Tunnel == nil {
+		return nil, errors.New("a public URL or a tunnel is required")
+	}
+	publicURL, stop, err := opts.Tunnel(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("open tunnel: %w", err)
+	}
+	h.PublicURL, h.stop = publicURL, stop
+	return h, nil
+}
+
+func (h *DevHook) abort(ctx context.Context, err error) error {
+	return errors.Join(err, h.Close(ctx))
+}
+
+// Close deactivates and deletes the hook and stops the tunnel. It is safe to
+// call more than once.
+func (h *DevHook) Close(ctx context.Context) error {
+	var errs []error
+	if h.ID != "" {
+		if h.inline {
+			if _, _, err := h.client.InlineHookAPI.DeactivateInlineHook(ctx, h.ID).Execute(); err != nil {
+				errs = append(errs, fmt.Errorf("deactivate inline hook: %w", err))
+			} else if _, err := h.client.InlineHookAPI.DeleteInlineHook(ctx, h.ID).Execute(); err != nil {
+				errs = append(errs, fmt.Errorf("delete inline hook: %w", err))
+			}
+		} else {
+			if _, _, err := h.client.EventHookAPI.DeactivateEventHook(ctx, h.ID).Execute(); err != nil {
+				errs = append(errs, fmt.Errorf("deactivate event hook: %w", err))
+			} else if _, err := h.client.EventHookAPI.DeleteEventHook(ctx, h.ID).Execute(); err != nil {
+				errs = append(errs, fmt.Errorf("delete event hook: %w", err))
+			}
+		}
+		if len(errs) == 0 {
+			h.ID = ""
+		}
+	}
+	if h.stop != nil {
+		if err := h.stop(); err != nil {
+			errs = append(errs, fmt.Errorf("stop tunnel: %w", err))
+		}
+		h.stop = nil
+	}
+	return errors.Join(errs...)
+}
+
+// HookVerificationHandler answers the one time verification request Okta
+// sends to a new event hook and passes every other request to next.
+func HookVerificationHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		challenge := r.Header.Get(hookVerificationHeader)
+		if r.Method != http.MethodGet || challenge == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"verification": challenge})
+	})
+}
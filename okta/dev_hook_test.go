@@ -0,0 +1,79 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Dev_Event_Hook_Lifecycle(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var registered EventHook
+	var calls []string
+	record := func(status int, body string) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			calls = append(calls, req.Method+" "+req.URL.Path)
+			return mockJSONResponse(status, body), nil
+		}
+	}
+	httpmock.RegisterResponder("POST", "/api/v1/eventHooks", func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, req.Method+" "+req.URL.Path)
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&registered))
+		return mockJSONResponse(200, `{"id":"who1","status":"ACTIVE","verificationStatus":"UNVERIFIED"}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/eventHooks/who1/lifecycle/verify", record(200, `{"id":"who1","status":"ACTIVE","verificationStatus":"VERIFIED"}`))
+	httpmock.RegisterResponder("POST", "/api/v1/eventHooks/who1/lifecycle/deactivate", record(200, `{"id":"who1","status":"INACTIVE"}`))
+	httpmock.RegisterResponder("DELETE", "/api/v1/eventHooks/who1", record(204, ``))
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	var stopped bool
+	hook, err := client.StartDevEventHook(context.Background(), DevHookOptions{
+		Tunnel: func(ctx context.Context) (string, func() error, error) {
+			return "https://abc.tunnel.example/hooks", func() error { stopped = true; return nil }, nil
+		},
+		AuthHeader: "Authorization",
+		AuthValue:  "secret",
+		Events:     []string{"user.lifecycle.create"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "who1", hook.ID)
+	assert.Equal(t, "https://abc.tunnel.example/hooks", registered.Channel.Config.Uri)
+	assert.Equal(t, "secret", registered.Channel.Config.AuthScheme.GetValue())
+
+	require.NoError(t, hook.Close(context.Background()))
+	require.NoError(t, hook.Close(context.Background()), "Closing twice should be a no-op")
+	assert.True(t, stopped)
+	assert.Equal(t, []string{
+		"POST /api/v1/eventHooks/who1/lifecycle/verify",
+		"POST /api/v1/eventHooks/who1/lifecycle/deactivate",
+		"DELETE /api/v1/eventHooks/who1",
+	}, calls[1:])
+}
+
+func Test_Hook_Verification_Handler(t *testing.T) {
+	var served bool
+	handler := HookVerificationHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = true
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/hooks", nil)
+	req.Header.Set("X-Okta-Verification-Challenge", "challenge")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.JSONEq(t, `{"verification":"challenge"}`, rec.Body.String())
+	assert.False(t, served)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/hooks", nil))
+	assert.True(t, served)
+}
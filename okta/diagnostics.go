@@ -0,0 +1,163 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Areas of the org covered by Diagnose.
+const (
+	DiagnoseMFA          = "mfa"
+	DiagnoseProvisioning = "provisioning"
+	DiagnoseSSO          = "sso"
+)
+
+const (
+	diagnoseWindow   = 24 * time.Hour
+	diagnoseFailures = 100
+)
+
+// diagnoseFilters are the System Log filters selecting the events of an area.
+var diagnoseFilters = map[string]string{
+	DiagnoseMFA:          `eventType sw "user.mfa" or eventType eq "user.authentication.auth_via_mfa"`,
+	DiagnoseProvisioning: `eventType sw "application.provision" or eventType sw "application.user_membership"`,
+	DiagnoseSSO:          `eventType eq "user.authentication.sso" or eventType eq "user.authentication.auth_via_IDP" or eventType eq "user.authentication.auth_via_inbound_SAML"`,
+}
+
+// DiagnosticReport gathers what support investigations of an area of the org
+// usually start with.
+type DiagnosticReport struct {
+	Area string
+	// Since is the start of the window failures were collected in.
+	Since time.Time
+	Org   *OrgSetting
+	// Authenticators are the authenticators of the org, listed for the mfa
+	// area.
+	Authenticators []AuthenticatorBase
+	// Failures are the most recent failed System Log events of the area,
+	// newest first.
+	Failures []LogEvent
+	// FailureCounts counts Failures by event type and reason.
+	FailureCounts map[string]int
+	// Findings are the notable conditions found, in plain text.
+	Findings []string
+	// Errors holds the parts of the report that could not be retrieved, such
+	// as "org", "authenticators" or "logs". The rest of the report is still
+	// filled in.
+	Errors map[string]error
+}
+
+// Diagnose pulls the org settings, the status of the authenticators and the
+// System Log failures of the last 24 hours for an area, DiagnoseMFA,
+// DiagnoseProvisioning or DiagnoseSSO, into one report. Parts that cannot be
+// retrieved, for example for missing permissions, are recorded in Errors.
+func (c *APIClient) Diagnose(ctx context.Context, area string) (*DiagnosticReport, error) {
+	filter, ok := diagnoseFilters[area]
+	if !ok {
+		return nil, fmt.Errorf("unknown diagnostic area %q", area)
+	}
+	report := &DiagnosticReport{
+		Area:          area,
+		Since:         time.Now().Add(-diagnoseWindow),
+		FailureCounts: map[string]int{},
+		Errors:        map[string]error{},
+	}
+
+	org, _, err := c.OrgSettingAPI.GetOrgSettings(ctx).Execute()
+	if err != nil {
+		report.Errors["org"] = err
+	} else {
+		report.Org = org
+		if status := org.GetStatus(); status != "" && status != "ACTIVE" {
+			report.finding("org status is %s", status)
+		}
+	}
+
+	if area == DiagnoseMFA {
+		err := listPages(ctx, c, "/api/v1/authenticators", nil, map[string]string{"Accept": "application/json"}, func(page []AuthenticatorBase) error {
+			report.Authenticators = append(report.Authenticators, page...)
+			return nil
+		})
+		if err != nil {
+			report.Errors["authenticators"] = err
+		}
+		active := 0
+		for _, a := range report.Authenticators {
+			switch {
+			case a.GetStatus() != "ACTIVE":
+				report.finding("authenticator %s is %s", a.GetKey(), a.GetStatus())
+			case a.GetKey() != "okta_password":
+				active++
+			}
+		}
+		if err == nil && active == 0 {
+			report.finding("no authenticator besides the password is active")
+		}
+	}
+
+	events, _, err := c.SystemLogAPI.ListLogEvents(ctx).
+		Since(report.Since).
+		Filter(`outcome.result eq "FAILURE" and (` + filter + `)`).
+		SortOrder("DESCENDING").
+		Limit(diagnoseFailures).
+		Execute()
+	if err != nil {
+		report.Errors["logs"] = err
+	}
+	report.Failures = events
+	for _, e := range events {
+		outcome := e.GetOutcome()
+		report.FailureCounts[e.GetEventType()+" ("+outcome.GetReason()+")"]++
+	}
+	for _, key := range report.topFailures() {
+		report.finding("failures of %s: %d", key, report.FailureCounts[key])
+	}
+	return report, nil
+}
+
+func (r *DiagnosticReport) finding(format string, args ...interface{}) {
+	r.Findings = append(r.Findings, fmt.Sprintf(format, args...))
+}
+
+// topFailures returns the keys of FailureCounts, most frequent first.
+func (r *DiagnosticReport) topFailures() []string {
+	keys := make([]string, 0, len(r.FailureCounts))
+	for k := range r.FailureCounts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if r.FailureCounts[keys[i]] == r.FailureCounts[keys[j]] {
+			return keys[i] < keys[j]
+		}
+		return r.FailureCounts[keys[i]] > r.FailureCounts[keys[j]]
+	})
+	return keys
+}
+
+// String formats the report as plain text, to attach to support cases.
+func (r *DiagnosticReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "area: %s\n", r.Area)
+	if r.Org != nil {
+		fmt.Fprintf(&b, "org: %s (%s)\n", r.Org.GetSubdomain(), r.Org.GetStatus())
+	}
+	for _, a := range r.Authenticators {
+		fmt.Fprintf(&b, "authenticator: %s %s\n", a.GetKey(), a.GetStatus())
+	}
+	fmt.Fprintf(&b, "failures since %s: %d\n", r.Since.UTC().Format(time.RFC3339), len(r.Failures))
+	for _, f := range r.Findings {
+		fmt.Fprintf(&b, "finding: %s\n", f)
+	}
+	parts := make([]string, 0, len(r.Errors))
+	for part := range r.Errors {
+		parts = append(parts, part)
+	}
+	sort.Strings(parts)
+	for _, part := range parts {
+		fmt.Fprintf(&b, "error: %s: %v\n", part, r.Errors[part])
+	}
+	return b.String()
+}
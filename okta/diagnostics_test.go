@@ -0,0 +1,68 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Diagnose(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/org", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"00o1","subdomain":"example","status":"ACTIVE"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/authenticators", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[
+		  {"key":"okta_password","status":"ACTIVE"},
+		  {"key":"okta_verify","status":"INACTIVE"},
+		  {"key":"phone_number","status":"INACTIVE"}
+		]`), nil
+	})
+	var filter string
+	httpmock.RegisterResponder("GET", "/api/v1/logs", func(req *http.Request) (*http.Response, error) {
+		filter = req.URL.Query().Get("filter")
+		return mockJSONResponse(200, `[
+		  {"eventType":"user.mfa.factor.verify","outcome":{"result":"FAILURE","reason":"INVALID_CREDENTIALS"}},
+		  {"eventType":"user.mfa.factor.verify","outcome":{"result":"FAILURE","reason":"INVALID_CREDENTIALS"}},
+		  {"eventType":"user.authentication.auth_via_mfa","outcome":{"result":"FAILURE","reason":"VERIFICATION_ERROR"}}
+		]`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	report, err := client.Diagnose(context.Background(), DiagnoseMFA)
+	require.NoError(t, err)
+	assert.Empty(t, report.Errors)
+	assert.Equal(t, "example", report.Org.GetSubdomain())
+	assert.Len(t, report.Authenticators, 3)
+	assert.Contains(t, filter, `outcome.result eq "FAILURE"`)
+	assert.Contains(t, filter, `eventType sw "user.mfa"`)
+	assert.Equal(t, 2, report.FailureCounts["user.mfa.factor.verify (INVALID_CREDENTIALS)"])
+	assert.Equal(t, []string{
+		"authenticator okta_verify is INACTIVE",
+		"authenticator phone_number is INACTIVE",
+		"no authenticator besides the password is active",
+		"failures of user.mfa.factor.verify (INVALID_CREDENTIALS): 2",
+		"failures of user.authentication.auth_via_mfa (VERIFICATION_ERROR): 1",
+	}, report.Findings)
+
+	httpmock.RegisterResponder("GET", "/api/v1/org", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(403, `{"errorCode":"E0000006","errorSummary":"You do not have permission to perform the requested action"}`), nil
+	})
+	report, err = client.Diagnose(context.Background(), DiagnoseSSO)
+	require.NoError(t, err)
+	assert.Contains(t, report.Errors, "org")
+	assert.Nil(t, report.Authenticators)
+	assert.Contains(t, report.String(), "error: org:")
+
+	_, err = client.Diagnose(context.Background(), "billing")
+	assert.Error(t, err)
+}
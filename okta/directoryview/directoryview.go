@@ -0,0 +1,358 @@
+// Package directoryview keeps a local, queryable copy of the users, groups and
+// group memberships of an org, for read heavy services that can tolerate
+// seconds of staleness. A View lists the org once, then applies the changes
+// the System Log reports and reconciles periodically with a full listing.
+// Queries by id, profile attribute and group are answered from its Store
+// without calling the API; users and groups missing from it are read through
+// from the API.
+//
+// The Store is pluggable: MemoryStore keeps everything in memory, and a
+// persistent implementation, for example on bbolt or SQLite, lets a restarted
+// view resume from the cursor of its stream instead of listing the org again.
+// The SDK does not ship those to avoid their dependencies.
+package directoryview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+const (
+	defaultReconcileInterval = time.Hour
+	defaultPollInterval      = 5 * time.Second
+	streamName               = "directoryview"
+	// eventFilter selects the System Log events changing users, groups and
+	// memberships.
+	eventFilter = `eventType sw "user.lifecycle." or eventType eq "user.account.update_profile" or ` +
+		`eventType sw "group.lifecycle." or eventType eq "group.profile.update" or eventType sw "group.user_membership."`
+)
+
+// ErrNotFound is returned for users and groups that exist neither in the
+// view nor in the org.
+var ErrNotFound = errors.New("not found")
+
+// Options configures a View.
+type Options struct {
+	// Store keeps the view, a MemoryStore by default.
+	Store Store
+	// PollInterval is how often the System Log is polled for changes, 5
+	// seconds by default, which bounds the staleness of the view.
+	PollInterval time.Duration
+	// ReconcileInterval is how often the view is reconciled with a full
+	// listing of the org, catching changes the System Log does not report,
+	// 1 hour by default. Reconciling lists every user, every group and the
+	// members of every group.
+	ReconcileInterval time.Duration
+	// OnError is called with the errors of applying events and of periodic
+	// reconciliations, which do not stop the view.
+	OnError func(err error)
+}
+
+// View is a materialized view of the users and groups of an org.
+type View struct {
+	client *okta.APIClient
+	store  Store
+	opts   Options
+}
+
+// New returns a View of the org of client. Run keeps it fresh.
+func New(client *okta.APIClient, opts Options) *View {
+	if opts.Store == nil {
+		opts.Store = NewMemoryStore()
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.ReconcileInterval <= 0 {
+		opts.ReconcileInterval = defaultReconcileInterval
+	}
+	return &View{client: client, store: opts.Store, opts: opts}
+}
+
+// Run fills the view, unless its store holds the cursor of a previous run,
+// then applies the changes reported by the System Log and reconciles the
+// view periodically until ctx is done, returning its error.
+func (v *View) Run(ctx context.Context) error {
+	cursor, err := v.store.LoadCheckpoint(ctx, streamName)
+	if err != nil {
+		return err
+	}
+	since := time.Now()
+	if cursor == "" {
+		if err := v.Reconcile(ctx); err != nil {
+			return fmt.Errorf("fill the view: %w", err)
+		}
+	}
+	go v.reconcileEvery(ctx)
+	events := v.client.SystemLogAPI.Stream(ctx, okta.LogStreamOptions{
+		Name:         streamName,
+		Since:        since,
+		Filter:       eventFilter,
+		PollInterval: v.opts.PollInterval,
+		Checkpoints:  v.store,
+	})
+	for event, err := range events {
+		if err != nil {
+			return err
+		}
+		if err := v.Apply(ctx, &event); err != nil {
+			v.report(err)
+		}
+	}
+	return ctx.Err()
+}
+
+func (v *View) reconcileEvery(ctx context.Context) {
+	ticker := time.NewTicker(v.opts.ReconcileInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := v.Reconcile(ctx); err != nil && ctx.Err() == nil {
+				v.report(fmt.Errorf("reconcile the view: %w", err))
+			}
+		}
+	}
+}
+
+func (v *View) report(err error) {
+	if v.opts.OnError != nil {
+		v.opts.OnError(err)
+	}
+}
+
+// Reconcile replaces the view with a full listing of the users, groups and
+// memberships of the org.
+func (v *View) Reconcile(ctx context.Context) error {
+	users, err := listAll[User](ctx, v.client, "/api/v1/users?limit=200")
+	if err != nil {
+		return fmt.Errorf("list users: %w", err)
+	}
+	groups, err := listAll[Group](ctx, v.client, "/api/v1/groups?limit=10000")
+	if err != nil {
+		return fmt.Errorf("list groups: %w", err)
+	}
+	members := make(map[string][]string, len(groups))
+	for _, group := range groups {
+		if members[group.ID], err = v.client.ListGroupUserIDs(ctx, group.ID); err != nil {
+			return fmt.Errorf("list the members of group %s: %w", group.ID, err)
+		}
+	}
+
+	listedUsers := make(map[string]bool, len(users))
+	for _, user := range users {
+		listedUsers[user.ID] = true
+		if err := v.store.PutUser(ctx, user); err != nil {
+			return err
+		}
+	}
+	listedGroups := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		listedGroups[group.ID] = true
+		if err := v.store.PutGroup(ctx, group); err != nil {
+			return err
+		}
+		if err := v.store.SetGroupMembers(ctx, group.ID, members[group.ID]); err != nil {
+			return err
+		}
+	}
+	userIDs, err := v.store.UserIDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range userIDs {
+		if !listedUsers[id] {
+			if err := v.store.DeleteUser(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+	groupIDs, err := v.store.GroupIDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range groupIDs {
+		if !listedGroups[id] {
+			if err := v.store.DeleteGroup(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Apply updates the view with a System Log event. Events changing a user or
+// a group refetch it, and membership events update the memberships.
+func (v *View) Apply(ctx context.Context, event *okta.LogEvent) error {
+	eventType := event.GetEventType()
+	user := event.TargetOfType("User")
+	group := event.TargetOfType("UserGroup")
+	switch {
+	case strings.HasPrefix(eventType, "group.user_membership.") && user != nil && group != nil:
+		if strings.HasSuffix(eventType, ".remove") {
+			return v.store.RemoveGroupMember(ctx, group.GetId(), user.GetId())
+		}
+		return v.store.AddGroupMember(ctx, group.GetId(), user.GetId())
+	case (strings.HasPrefix(eventType, "user.lifecycle.") || eventType == "user.account.update_profile") && user != nil:
+		_, err := v.refreshUser(ctx, user.GetId())
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	case (strings.HasPrefix(eventType, "group.lifecycle.") || eventType == "group.profile.update") && group != nil:
+		_, err := v.refreshGroup(ctx, group.GetId())
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// User returns the user with id or login, reading it from the org when it
+// is not in the view.
+func (v *View) User(ctx context.Context, idOrLogin string) (User, error) {
+	if user, ok, err := v.store.User(ctx, idOrLogin); err != nil || ok {
+		return user, err
+	}
+	users, err := v.store.FindUsers(ctx, "login", idOrLogin)
+	if err != nil {
+		return User{}, err
+	}
+	if len(users) > 0 {
+		return users[0], nil
+	}
+	return v.refreshUser(ctx, idOrLogin)
+}
+
+// FindUsers returns the users of the view whose profile attribute, such as
+// "department", has value, without calling the API.
+func (v *View) FindUsers(ctx context.Context, attribute, value string) ([]User, error) {
+	return v.store.FindUsers(ctx, attribute, value)
+}
+
+// Group returns the group with id, reading it from the org when it is not in
+// the view.
+func (v *View) Group(ctx context.Context, id string) (Group, error) {
+	if group, ok, err := v.store.Group(ctx, id); err != nil || ok {
+		return group, err
+	}
+	return v.refreshGroup(ctx, id)
+}
+
+// FindGroups returns the groups of the view whose profile attribute, such as
+// "name", has value, without calling the API.
+func (v *View) FindGroups(ctx context.Context, attribute, value string) ([]Group, error) {
+	return v.store.FindGroups(ctx, attribute, value)
+}
+
+// GroupMembers returns the members of a group in the view.
+func (v *View) GroupMembers(ctx context.Context, groupID string) ([]User, error) {
+	ids, err := v.store.GroupMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]User, 0, len(ids))
+	for _, id := range ids {
+		user, ok, err := v.store.User(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// UserGroups returns the groups of a user in the view.
+func (v *View) UserGroups(ctx context.Context, userID string) ([]Group, error) {
+	ids, err := v.store.UserGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]Group, 0, len(ids))
+	for _, id := range ids {
+		group, ok, err := v.store.Group(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+// IsMember reports whether a user is a member of a group in the view.
+func (v *View) IsMember(ctx context.Context, groupID, userID string) (bool, error) {
+	ids, err := v.store.UserGroups(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range ids {
+		if id == groupID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// refreshUser reads a user from the org into the view, removing it when it
+// no longer exists. Deprovisioned users are kept, as Okta still returns
+// them.
+func (v *View) refreshUser(ctx context.Context, idOrLogin string) (User, error) {
+	var user User
+	_, err := v.client.Do(okta.WithNoCache(ctx), http.MethodGet, "/api/v1/users/"+url.PathEscape(idOrLogin), nil, &user)
+	if okta.IsNotFound(err) {
+		if err := v.store.DeleteUser(ctx, idOrLogin); err != nil {
+			return User{}, err
+		}
+		return User{}, fmt.Errorf("user %s: %w", idOrLogin, ErrNotFound)
+	}
+	if err != nil {
+		return User{}, err
+	}
+	return user, v.store.PutUser(ctx, user)
+}
+
+func (v *View) refreshGroup(ctx context.Context, id string) (Group, error) {
+	var group Group
+	_, err := v.client.Do(okta.WithNoCache(ctx), http.MethodGet, "/api/v1/groups/"+url.PathEscape(id), nil, &group)
+	if okta.IsNotFound(err) {
+		if err := v.store.DeleteGroup(ctx, id); err != nil {
+			return Group{}, err
+		}
+		return Group{}, fmt.Errorf("group %s: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return Group{}, err
+	}
+	if err := v.store.PutGroup(ctx, group); err != nil {
+		return Group{}, err
+	}
+	members, err := v.client.ListGroupUserIDs(ctx, id)
+	if err != nil {
+		return Group{}, err
+	}
+	return group, v.store.SetGroupMembers(ctx, id, members)
+}
+
+// listAll lists path and the following pages.
+func listAll[T any](ctx context.Context, client *okta.APIClient, path string) ([]T, error) {
+	ctx = okta.WithNoCache(ctx)
+	return okta.ListAll(ctx, client, func() ([]T, *okta.APIResponse, error) {
+		var page []T
+		resp, err := client.Do(ctx, http.MethodGet, path, nil, &page)
+		return page, resp, err
+	})
+}
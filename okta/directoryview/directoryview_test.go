@@ -0,0 +1,99 @@
+package directoryview
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/okta-sdk-golang/v5/okta/internal/oktatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func logEvent(t *testing.T, body string) *okta.LogEvent {
+	var event okta.LogEvent
+	require.NoError(t, json.Unmarshal([]byte(body), &event))
+	return &event
+}
+
+func userIDs(users []User) []string {
+	var ids []string
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+	return ids
+}
+
+func Test_View(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users", oktatest.JSONResponder(200, `[
+	  {"id":"00u1","status":"ACTIVE","profile":{"login":"alice@example.com","department":"Engineering","roles":["admin","dev"]}},
+	  {"id":"00u2","status":"ACTIVE","profile":{"login":"bob@example.com","department":"Sales"}}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/groups", oktatest.JSONResponder(200, `[{"id":"00g1","type":"OKTA_GROUP","profile":{"name":"Engineering"}}]`))
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00g1/users", oktatest.JSONResponder(200, `[{"id":"00u1"}]`))
+
+	client := oktatest.NewClient(t)
+	store := NewMemoryStore()
+	// a user deleted since the store was filled
+	require.NoError(t, store.PutUser(context.Background(), User{ID: "00u9"}))
+	require.NoError(t, store.AddGroupMember(context.Background(), "00g1", "00u9"))
+	view := New(client, Options{Store: store})
+	ctx := context.Background()
+
+	require.NoError(t, view.Reconcile(ctx))
+	listed := httpmock.GetTotalCallCount()
+
+	users, err := view.FindUsers(ctx, "department", "Engineering")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00u1"}, userIDs(users))
+	users, err = view.FindUsers(ctx, "roles", "dev")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00u1"}, userIDs(users))
+	user, err := view.User(ctx, "bob@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "00u2", user.ID)
+	members, err := view.GroupMembers(ctx, "00g1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00u1"}, userIDs(members), "Users no longer listed should be removed")
+	groups, err := view.FindGroups(ctx, "name", "Engineering")
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, []string{"00g1"}, []string{groups[0].ID})
+	assert.Equal(t, listed, httpmock.GetTotalCallCount(), "Queries should not call the API")
+
+	require.NoError(t, view.Apply(ctx, logEvent(t, `{"eventType":"group.user_membership.add","target":[
+	  {"id":"00u2","type":"User"},{"id":"00g1","type":"UserGroup"}]}`)))
+	member, err := view.IsMember(ctx, "00g1", "00u2")
+	require.NoError(t, err)
+	assert.True(t, member)
+	require.NoError(t, view.Apply(ctx, logEvent(t, `{"eventType":"group.user_membership.remove","target":[
+	  {"id":"00u1","type":"User"},{"id":"00g1","type":"UserGroup"}]}`)))
+	userGroups, err := view.UserGroups(ctx, "00u1")
+	require.NoError(t, err)
+	assert.Empty(t, userGroups)
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u2", oktatest.JSONResponder(200, `{"id":"00u2","status":"ACTIVE","profile":{"login":"bob@example.com","department":"Engineering"}}`))
+	require.NoError(t, view.Apply(ctx, logEvent(t, `{"eventType":"user.account.update_profile","target":[{"id":"00u2","type":"User"}]}`)))
+	users, err = view.FindUsers(ctx, "department", "Engineering")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"00u1", "00u2"}, userIDs(users), "Indexes should follow profile updates")
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1", oktatest.JSONResponder(404, `{"errorCode":"E0000007","errorSummary":"Not found"}`))
+	require.NoError(t, view.Apply(ctx, logEvent(t, `{"eventType":"user.lifecycle.delete.completed","target":[{"id":"00u1","type":"User"}]}`)))
+	_, err = view.User(ctx, "00u1")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// users missing from the view are read through
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u3", oktatest.JSONResponder(200, `{"id":"00u3","status":"STAGED","profile":{"login":"carol@example.com"}}`))
+	user, err = view.User(ctx, "00u3")
+	require.NoError(t, err)
+	assert.Equal(t, "STAGED", user.Status)
+	_, err = view.User(ctx, "00u3")
+	require.NoError(t, err)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET /api/v1/users/00u3"])
+}
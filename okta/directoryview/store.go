@@ -0,0 +1,357 @@
+package directoryview
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// User is a user as kept by a View.
+type User struct {
+	ID          string                 `json:"id"`
+	Status      string                 `json:"status"`
+	Profile     map[string]interface{} `json:"profile"`
+	LastUpdated time.Time              `json:"lastUpdated"`
+}
+
+// Group is a group as kept by a View.
+type Group struct {
+	ID          string                 `json:"id"`
+	Type        string                 `json:"type"`
+	Profile     map[string]interface{} `json:"profile"`
+	LastUpdated time.Time              `json:"lastUpdated"`
+}
+
+// Store keeps the users, groups and memberships of a View, and the cursor of
+// the System Log stream keeping them fresh. A persistent store, such as one
+// backed by bbolt or SQLite, lets a restarted View resume from its cursor
+// rather than list the org again. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	PutUser(ctx context.Context, user User) error
+	// DeleteUser removes a user and its memberships.
+	DeleteUser(ctx context.Context, id string) error
+	// User returns the user with id, and false when there is none.
+	User(ctx context.Context, id string) (User, bool, error)
+	// FindUsers returns the users whose profile attribute has value, or
+	// contains it for attributes holding arrays.
+	FindUsers(ctx context.Context, attribute, value string) ([]User, error)
+	UserIDs(ctx context.Context) ([]string, error)
+
+	PutGroup(ctx context.Context, group Group) error
+	// DeleteGroup removes a group and its memberships.
+	DeleteGroup(ctx context.Context, id string) error
+	Group(ctx context.Context, id string) (Group, bool, error)
+	FindGroups(ctx context.Context, attribute, value string) ([]Group, error)
+	GroupIDs(ctx context.Context) ([]string, error)
+
+	// SetGroupMembers replaces the members of a group.
+	SetGroupMembers(ctx context.Context, groupID string, userIDs []string) error
+	AddGroupMember(ctx context.Context, groupID, userID string) error
+	RemoveGroupMember(ctx context.Context, groupID, userID string) error
+	// GroupMembers returns the ids of the members of a group.
+	GroupMembers(ctx context.Context, groupID string) ([]string, error)
+	// UserGroups returns the ids of the groups of a user.
+	UserGroups(ctx context.Context, userID string) ([]string, error)
+
+	// LoadCheckpoint and SaveCheckpoint keep the cursor of the System Log
+	// stream, as an okta.LogCheckpointStore.
+	LoadCheckpoint(ctx context.Context, name string) (string, error)
+	SaveCheckpoint(ctx context.Context, name, cursor string) error
+}
+
+// MemoryStore is a Store keeping everything in memory, the default store of
+// a View. Profile attributes are indexed the first time they are queried.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	users    map[string]User
+	groups   map[string]Group
+	members  map[string]map[string]bool // user ids by group id
+	groupsOf map[string]map[string]bool // group ids by user id
+	// userIndexes and groupIndexes map a profile attribute to the ids of the
+	// users or groups by value of the attribute.
+	userIndexes  map[string]map[string]map[string]bool
+	groupIndexes map[string]map[string]map[string]bool
+	checkpoints  map[string]string
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		users:        map[string]User{},
+		groups:       map[string]Group{},
+		members:      map[string]map[string]bool{},
+		groupsOf:     map[string]map[string]bool{},
+		userIndexes:  map[string]map[string]map[string]bool{},
+		groupIndexes: map[string]map[string]map[string]bool{},
+		checkpoints:  map[string]string{},
+	}
+}
+
+// PutUser implements Store.
+func (s *MemoryStore) PutUser(ctx context.Context, user User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.users[user.ID]; ok {
+		unindex(s.userIndexes, old.ID, old.Profile)
+	}
+	s.users[user.ID] = user
+	index(s.userIndexes, user.ID, user.Profile)
+	return nil
+}
+
+// DeleteUser implements Store.
+func (s *MemoryStore) DeleteUser(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.users[id]; ok {
+		unindex(s.userIndexes, id, old.Profile)
+		delete(s.users, id)
+	}
+	for groupID := range s.groupsOf[id] {
+		delete(s.members[groupID], id)
+	}
+	delete(s.groupsOf, id)
+	return nil
+}
+
+// User implements Store.
+func (s *MemoryStore) User(ctx context.Context, id string) (User, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	user, ok := s.users[id]
+	return user, ok, nil
+}
+
+// FindUsers implements Store.
+func (s *MemoryStore) FindUsers(ctx context.Context, attribute, value string) ([]User, error) {
+	ids := s.find(s.userIndexes, attribute, value, func(index map[string]map[string]bool) {
+		for id, user := range s.users {
+			indexAttribute(index, id, user.Profile[attribute])
+		}
+	})
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	users := make([]User, 0, len(ids))
+	for _, id := range ids {
+		if user, ok := s.users[id]; ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// UserIDs implements Store.
+func (s *MemoryStore) UserIDs(ctx context.Context) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortedKeys(s.users), nil
+}
+
+// PutGroup implements Store.
+func (s *MemoryStore) PutGroup(ctx context.Context, group Group) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.groups[group.ID]; ok {
+		unindex(s.groupIndexes, old.ID, old.Profile)
+	}
+	s.groups[group.ID] = group
+	index(s.groupIndexes, group.ID, group.Profile)
+	return nil
+}
+
+// DeleteGroup implements Store.
+func (s *MemoryStore) DeleteGroup(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.groups[id]; ok {
+		unindex(s.groupIndexes, id, old.Profile)
+		delete(s.groups, id)
+	}
+	s.setMembers(id, nil)
+	return nil
+}
+
+// Group implements Store.
+func (s *MemoryStore) Group(ctx context.Context, id string) (Group, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	group, ok := s.groups[id]
+	return group, ok, nil
+}
+
+// FindGroups implements Store.
+func (s *MemoryStore) FindGroups(ctx context.Context, attribute, value string) ([]Group, error) {
+	ids := s.find(s.groupIndexes, attribute, value, func(index map[string]map[string]bool) {
+		for id, group := range s.groups {
+			indexAttribute(index, id, group.Profile[attribute])
+		}
+	})
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	groups := make([]Group, 0, len(ids))
+	for _, id := range ids {
+		if group, ok := s.groups[id]; ok {
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+// GroupIDs implements Store.
+func (s *MemoryStore) GroupIDs(ctx context.Context) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortedKeys(s.groups), nil
+}
+
+// SetGroupMembers implements Store.
+func (s *MemoryStore) SetGroupMembers(ctx context.Context, groupID string, userIDs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setMembers(groupID, userIDs)
+	return nil
+}
+
+func (s *MemoryStore) setMembers(groupID string, userIDs []string) {
+	for userID := range s.members[groupID] {
+		delete(s.groupsOf[userID], groupID)
+	}
+	delete(s.members, groupID)
+	for _, userID := range userIDs {
+		s.addMember(groupID, userID)
+	}
+}
+
+// AddGroupMember implements Store.
+func (s *MemoryStore) AddGroupMember(ctx context.Context, groupID, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addMember(groupID, userID)
+	return nil
+}
+
+func (s *MemoryStore) addMember(groupID, userID string) {
+	if s.members[groupID] == nil {
+		s.members[groupID] = map[string]bool{}
+	}
+	if s.groupsOf[userID] == nil {
+		s.groupsOf[userID] = map[string]bool{}
+	}
+	s.members[groupID][userID] = true
+	s.groupsOf[userID][groupID] = true
+}
+
+// RemoveGroupMember implements Store.
+func (s *MemoryStore) RemoveGroupMember(ctx context.Context, groupID, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.members[groupID], userID)
+	delete(s.groupsOf[userID], groupID)
+	return nil
+}
+
+// GroupMembers implements Store.
+func (s *MemoryStore) GroupMembers(ctx context.Context, groupID string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortedKeys(s.members[groupID]), nil
+}
+
+// UserGroups implements Store.
+func (s *MemoryStore) UserGroups(ctx context.Context, userID string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortedKeys(s.groupsOf[userID]), nil
+}
+
+// LoadCheckpoint implements Store.
+func (s *MemoryStore) LoadCheckpoint(ctx context.Context, name string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.checkpoints[name], nil
+}
+
+// SaveCheckpoint implements Store.
+func (s *MemoryStore) SaveCheckpoint(ctx context.Context, name, cursor string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[name] = cursor
+	return nil
+}
+
+// find returns the sorted ids with value for attribute in indexes, building
+// the index of the attribute with build first if needed.
+func (s *MemoryStore) find(indexes map[string]map[string]map[string]bool, attribute, value string, build func(map[string]map[string]bool)) []string {
+	s.mu.RLock()
+	index, ok := indexes[attribute]
+	if ok {
+		defer s.mu.RUnlock()
+		return sortedKeys(index[value])
+	}
+	s.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if index, ok = indexes[attribute]; !ok {
+		index = map[string]map[string]bool{}
+		build(index)
+		indexes[attribute] = index
+	}
+	return sortedKeys(index[value])
+}
+
+// index adds id to the indexes of the attributes of profile.
+func index(indexes map[string]map[string]map[string]bool, id string, profile map[string]interface{}) {
+	for attribute, index := range indexes {
+		indexAttribute(index, id, profile[attribute])
+	}
+}
+
+func unindex(indexes map[string]map[string]map[string]bool, id string, profile map[string]interface{}) {
+	for attribute, index := range indexes {
+		for _, value := range attributeValues(profile[attribute]) {
+			delete(index[value], id)
+			if len(index[value]) == 0 {
+				delete(index, value)
+			}
+		}
+	}
+}
+
+func indexAttribute(index map[string]map[string]bool, id string, attribute interface{}) {
+	for _, value := range attributeValues(attribute) {
+		if index[value] == nil {
+			index[value] = map[string]bool{}
+		}
+		index[value][id] = true
+	}
+}
+
+// attributeValues returns the values of a profile attribute as strings, an
+// attribute holding an array having a value per element.
+func attributeValues(attribute interface{}) []string {
+	switch v := attribute.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, element := range v {
+			values = append(values, attributeValues(element)...)
+		}
+		return values
+	}
+	return []string{fmt.Sprint(attribute)}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,297 @@
+// Package drift detects changes of Okta resources made outside of a known
+// baseline, as a building block for compliance monitoring.
+//
+// A Detector snapshots the resources of its sources, compares them against a
+// baseline snapshot and reports every resource that was added, removed or
+// modified to a handler, together with the actor the System Log records for
+// the change.
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// Types of drift reported in Event.
+const (
+	Added    = "ADDED"
+	Removed  = "REMOVED"
+	Modified = "MODIFIED"
+)
+
+// Snapshot holds the state of resources at a point in time. It can be
+// persisted as JSON to keep a baseline between runs.
+type Snapshot struct {
+	TakenAt time.Time `json:"takenAt"`
+	// Resources holds the fields of every resource by kind and id. Nested
+	// fields are flattened to dotted names, such as profile.name.
+	Resources map[string]map[string]map[string]interface{} `json:"resources"`
+}
+
+// Change is a field of a resource that differs from the baseline.
+type Change struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// Event describes a resource that drifted from the baseline.
+type Event struct {
+	Kind string
+	ID   string
+	// Type is Added, Removed or Modified.
+	Type string
+	// Changes lists the fields that differ, in the order of their names.
+	Changes []Change
+	// Actor is who last changed the resource according to the System Log, nil
+	// when no event was found.
+	Actor      *okta.LogActor
+	DetectedAt time.Time
+}
+
+// Take lists the resources of sources and returns their snapshot.
+func Take(ctx context.Context, sources ...Source) (*Snapshot, error) {
+	snapshot := &Snapshot{TakenAt: time.Now(), Resources: map[string]map[string]map[string]interface{}{}}
+	for _, source := range sources {
+		resources, err := source.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s resources: %w", source.Kind, err)
+		}
+		byID := map[string]map[string]interface{}{}
+		for _, resource := range resources {
+			fields, err := flatten(resource)
+			if err != nil {
+				return nil, fmt.Errorf("failed to snapshot %s resource: %w", source.Kind, err)
+			}
+			id, _ := fields["id"].(string)
+			if id == "" {
+				continue
+			}
+			for _, name := range source.Ignore {
+				delete(fields, name)
+			}
+			byID[id] = fields
+		}
+		snapshot.Resources[source.Kind] = byID
+	}
+	return snapshot, nil
+}
+
+// Compare returns the resources of current that drifted from baseline, ordered
+// by kind and id. Only the kinds present in both snapshots are compared.
+func Compare(baseline, current *Snapshot) []Event {
+	var events []Event
+	for _, kind := range sortedKeys(current.Resources) {
+		before, ok := baseline.Resources[kind]
+		if !ok {
+			continue
+		}
+		after := current.Resources[kind]
+		ids := sortedKeys(before)
+		for _, id := range sortedKeys(after) {
+			if _, ok := before[id]; !ok {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			was, existed := before[id]
+			is, exists := after[id]
+			e := Event{Kind: kind, ID: id, DetectedAt: current.TakenAt}
+			switch {
+			case !exists:
+				e.Type = Removed
+			case !existed:
+				e.Type = Added
+			default:
+				e.Type = Modified
+			}
+			e.Changes = changes(was, is)
+			if len(e.Changes) > 0 {
+				events = append(events, e)
+			}
+		}
+	}
+	return events
+}
+
+func changes(was, is map[string]interface{}) []Change {
+	names := sortedKeys(was)
+	for name := range is {
+		if _, ok := was[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var out []Change
+	for _, name := range names {
+		if !reflect.DeepEqual(was[name], is[name]) {
+			out = append(out, Change{Field: name, Old: was[name], New: is[name]})
+		}
+	}
+	return out
+}
+
+// flatten turns a resource into its JSON fields, with nested objects and
+// arrays flattened to dotted names.
+func flatten(resource interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	flattenInto(fields, "", value)
+	return fields, nil
+}
+
+func flattenInto(fields map[string]interface{}, prefix string, value interface{}) {
+	join := func(name string) string {
+		if prefix == "" {
+			return name
+		}
+		return prefix + "." + name
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			flattenInto(fields, join(name), field)
+		}
+	case []interface{}:
+		for i, item := range v {
+			flattenInto(fields, join(strconv.Itoa(i)), item)
+		}
+	default:
+		fields[prefix] = v
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Handler receives drift events.
+type Handler func(ctx context.Context, e Event)
+
+// Detector compares the resources of its sources against a baseline.
+type Detector struct {
+	client  *okta.APIClient
+	sources []Source
+	handle  Handler
+
+	mu       sync.Mutex
+	baseline *Snapshot
+	reported map[string]string
+}
+
+// NewDetector returns a Detector reporting the drift of sources to handle. The
+// actors of changes are looked up in the System Log with client.
+func NewDetector(client *okta.APIClient, handle Handler, sources ...Source) *Detector {
+	return &Detector{client: client, sources: sources, handle: handle, reported: map[string]string{}}
+}
+
+// Baseline returns the snapshot drift is detected against, nil until it was
+// set or taken by the first Check.
+func (d *Detector) Baseline() *Snapshot {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.baseline
+}
+
+// SetBaseline replaces the snapshot drift is detected against, for example
+// with a persisted one or after changes were approved.
+func (d *Detector) SetBaseline(baseline *Snapshot) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.baseline = baseline
+	d.reported = map[string]string{}
+}
+
+// Check snapshots the sources and reports the drift from the baseline to the
+// handler. The first Check without a baseline takes it and reports nothing. A
+// drifted resource is reported again only when its changes differ from those
+// last reported.
+func (d *Detector) Check(ctx context.Context) ([]Event, error) {
+	current, err := Take(ctx, d.sources...)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	if d.baseline == nil {
+		d.baseline = current
+		d.mu.Unlock()
+		return nil, nil
+	}
+	baseline := d.baseline
+	d.mu.Unlock()
+
+	var events []Event
+	drifted := map[string]string{}
+	for _, e := range Compare(baseline, current) {
+		key := e.Kind + "/" + e.ID
+		digest, _ := json.Marshal(e.Changes)
+		drifted[key] = string(digest)
+		d.mu.Lock()
+		seen := d.reported[key] == string(digest)
+		d.mu.Unlock()
+		if seen {
+			continue
+		}
+		e.Actor = d.actor(ctx, e.ID, baseline.TakenAt)
+		events = append(events, e)
+	}
+	d.mu.Lock()
+	d.reported = drifted
+	d.mu.Unlock()
+	if d.handle != nil {
+		for _, e := range events {
+			d.handle(ctx, e)
+		}
+	}
+	return events, nil
+}
+
+// actor returns the actor of the latest change of the resource since the
+// baseline was taken.
+func (d *Detector) actor(ctx context.Context, id string, since time.Time) *okta.LogActor {
+	if d.client == nil {
+		return nil
+	}
+	attributions, err := d.client.AttributeChanges(ctx, id, since, time.Time{})
+	if err != nil || len(attributions) == 0 {
+		return nil
+	}
+	return &attributions[len(attributions)-1].Actor
+}
+
+// Run checks for drift every interval until ctx is done or a check fails.
+func (d *Detector) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := d.Check(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
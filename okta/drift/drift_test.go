@@ -0,0 +1,74 @@
+package drift
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta/internal/oktatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Detector_Reports_Drift(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	groups := `[
+	  {"id":"00g1","profile":{"name":"Admins","description":"Administrators"},"lastUpdated":"2024-01-01T00:00:00.000Z"},
+	  {"id":"00g2","profile":{"name":"Sales"}}
+	]`
+	httpmock.RegisterResponder("GET", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		return oktatest.JSONResponse(200, groups), nil
+	})
+	var logFilter string
+	httpmock.RegisterResponder("GET", "/api/v1/logs", func(req *http.Request) (*http.Response, error) {
+		logFilter = req.URL.Query().Get("filter")
+		return oktatest.JSONResponse(200, `[{"eventType":"group.profile.update","actor":{"id":"00u1","alternateId":"admin@example.com","type":"User"}}]`), nil
+	})
+
+	client := oktatest.NewClient(t)
+	ctx := context.Background()
+
+	var reported []Event
+	detector := NewDetector(client, func(ctx context.Context, e Event) {
+		reported = append(reported, e)
+	}, Groups(client))
+
+	events, err := detector.Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, events, "The first check takes the baseline")
+	require.NotNil(t, detector.Baseline())
+
+	groups = `[
+	  {"id":"00g1","profile":{"name":"Admins","description":"Everyone"},"lastUpdated":"2024-02-01T00:00:00.000Z"},
+	  {"id":"00g3","profile":{"name":"Support"}}
+	]`
+	events, err = detector.Check(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, reported, events)
+
+	assert.Equal(t, "00g1", events[0].ID)
+	assert.Equal(t, Modified, events[0].Type)
+	assert.Equal(t, []Change{{Field: "profile.description", Old: "Administrators", New: "Everyone"}}, events[0].Changes, "lastUpdated is ignored")
+	require.NotNil(t, events[0].Actor)
+	assert.Equal(t, "admin@example.com", events[0].Actor.GetAlternateId())
+	assert.Equal(t, `target.id eq "00g3" and outcome.result eq "SUCCESS"`, logFilter)
+	assert.Equal(t, Removed, events[1].Type)
+	assert.Equal(t, "00g2", events[1].ID)
+	assert.Equal(t, Added, events[2].Type)
+	assert.Equal(t, "00g3", events[2].ID)
+
+	events, err = detector.Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, events, "Drift is reported once")
+
+	detector.SetBaseline(nil)
+	_, err = detector.Check(ctx)
+	require.NoError(t, err)
+	events, err = detector.Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
@@ -0,0 +1,94 @@
+package drift
+
+import (
+	"context"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// defaultIgnore are the fields that change with every update and are not
+// compared.
+var defaultIgnore = []string{"lastUpdated", "lastMembershipUpdated"}
+
+// Source lists the resources of one kind to snapshot.
+type Source struct {
+	// Kind names the resources, such as "group".
+	Kind string
+	// List returns the resources. They are snapshotted as their JSON
+	// representation and identified by its id field.
+	List func(ctx context.Context) ([]interface{}, error)
+	// Ignore lists the flattened fields that are not compared, such as
+	// lastUpdated.
+	Ignore []string
+}
+
+// listAll collects every page of a list call.
+func listAll[T any](first []T, resp *okta.APIResponse, err error) ([]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	all := make([]interface{}, 0, len(first))
+	for _, item := range first {
+		all = append(all, item)
+	}
+	for resp.HasNextPage() {
+		var page []T
+		if resp, err = resp.Next(&page); err != nil {
+			return nil, err
+		}
+		for _, item := range page {
+			all = append(all, item)
+		}
+	}
+	return all, nil
+}
+
+// Groups snapshots the groups of the org.
+func Groups(client *okta.APIClient) Source {
+	return Source{Kind: "group", Ignore: defaultIgnore, List: func(ctx context.Context) ([]interface{}, error) {
+		return listAll(client.GroupAPI.ListGroups(ctx).Execute())
+	}}
+}
+
+// GroupRules snapshots the group rules of the org.
+func GroupRules(client *okta.APIClient) Source {
+	return Source{Kind: "groupRule", Ignore: defaultIgnore, List: func(ctx context.Context) ([]interface{}, error) {
+		return listAll(client.GroupAPI.ListGroupRules(ctx).Execute())
+	}}
+}
+
+// Applications snapshots the app instances of the org.
+func Applications(client *okta.APIClient) Source {
+	return Source{Kind: "application", Ignore: defaultIgnore, List: func(ctx context.Context) ([]interface{}, error) {
+		return listAll(client.ApplicationAPI.ListApplications(ctx).Execute())
+	}}
+}
+
+// Policies snapshots the policies of a type, such as OKTA_SIGN_ON or
+// PASSWORD.
+func Policies(client *okta.APIClient, policyType string) Source {
+	return Source{Kind: "policy:" + policyType, Ignore: defaultIgnore, List: func(ctx context.Context) ([]interface{}, error) {
+		return listAll(client.PolicyAPI.ListPolicies(ctx).Type_(policyType).Execute())
+	}}
+}
+
+// NetworkZones snapshots the network zones of the org.
+func NetworkZones(client *okta.APIClient) Source {
+	return Source{Kind: "networkZone", Ignore: defaultIgnore, List: func(ctx context.Context) ([]interface{}, error) {
+		return listAll(client.NetworkZoneAPI.ListNetworkZones(ctx).Execute())
+	}}
+}
+
+// TrustedOrigins snapshots the trusted origins of the org.
+func TrustedOrigins(client *okta.APIClient) Source {
+	return Source{Kind: "trustedOrigin", Ignore: defaultIgnore, List: func(ctx context.Context) ([]interface{}, error) {
+		return listAll(client.TrustedOriginAPI.ListTrustedOrigins(ctx).Execute())
+	}}
+}
+
+// AuthorizationServers snapshots the custom authorization servers of the org.
+func AuthorizationServers(client *okta.APIClient) Source {
+	return Source{Kind: "authorizationServer", Ignore: defaultIgnore, List: func(ctx context.Context) ([]interface{}, error) {
+		return listAll(client.AuthorizationServerAPI.ListAuthorizationServers(ctx).Execute())
+	}}
+}
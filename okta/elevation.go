@@ -0,0 +1,155 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Elevation is an admin role assigned to a user until ExpiresAt.
+type Elevation struct {
+	UserID    string
+	RoleID    string
+	RoleType  string
+	ExpiresAt time.Time
+}
+
+// ElevationStore persists the elevations granted by an Elevator, so expired
+// ones are revoked even when the process that granted them restarted.
+type ElevationStore interface {
+	Put(ctx context.Context, e Elevation) error
+	Delete(ctx context.Context, e Elevation) error
+	List(ctx context.Context) ([]Elevation, error)
+}
+
+type memoryElevationStore struct {
+	mu         sync.Mutex
+	elevations map[string]Elevation
+}
+
+// NewMemoryElevationStore returns an ElevationStore that keeps elevations in
+// memory. Elevations are lost when the process exits.
+func NewMemoryElevationStore() ElevationStore {
+	return &memoryElevationStore{elevations: map[string]Elevation{}}
+}
+
+func (s *memoryElevationStore) Put(ctx context.Context, e Elevation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elevations[e.UserID+"/"+e.RoleID] = e
+	return nil
+}
+
+func (s *memoryElevationStore) Delete(ctx context.Context, e Elevation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.elevations, e.UserID+"/"+e.RoleID)
+	return nil
+}
+
+func (s *memoryElevationStore) List(ctx context.Context) ([]Elevation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]Elevation, 0, len(s.elevations))
+	for _, e := range s.elevations {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ExpiresAt.Before(list[j].ExpiresAt) })
+	return list, nil
+}
+
+// Elevator grants admin roles for a limited time, for just in time admin
+// access. Okta does not expire role assignments by itself, so the expiry is
+// tracked in an ElevationStore and enforced by Reap or RunReaper.
+type Elevator struct {
+	client *APIClient
+	store  ElevationStore
+	now    func() time.Time
+}
+
+// NewElevator returns an Elevator tracking elevations in store, or in memory
+// when store is nil.
+func NewElevator(client *APIClient, store ElevationStore) *Elevator {
+	if store == nil {
+		store = NewMemoryElevationStore()
+	}
+	return &Elevator{client: client, store: store, now: time.Now}
+}
+
+// Elevate assigns a standard admin role, such as USER_ADMIN, to a user for d.
+// Assigning a role the user already holds fails, so permanent assignments are
+// never revoked by the reaper.
+func (e *Elevator) Elevate(ctx context.Context, userID, roleType string, d time.Duration) (*Elevation, error) {
+	if d <= 0 {
+		return nil, errors.New("elevation duration must be positive")
+	}
+	role, _, err := e.client.RoleAssignmentAPI.AssignRoleToUser(ctx, userID).
+		AssignRoleRequest(AssignRoleRequest{Type: PtrString(roleType)}).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("assign role %s: %w", roleType, err)
+	}
+	el := Elevation{UserID: userID, RoleID: role.GetId(), RoleType: roleType, ExpiresAt: e.now().Add(d)}
+	if err := e.store.Put(ctx, el); err != nil {
+		// an untracked assignment would never expire, take it back
+		_, revokeErr := e.client.RoleAssignmentAPI.UnassignRoleFromUser(ctx, userID, el.RoleID).Execute()
+		return nil, errors.Join(fmt.Errorf("store elevation: %w", err), revokeErr)
+	}
+	return &el, nil
+}
+
+// Revoke removes an elevation before it expires. Roles that were already
+// unassigned are only removed from the store.
+func (e *Elevator) Revoke(ctx context.Context, el Elevation) error {
+	resp, err := e.client.RoleAssignmentAPI.UnassignRoleFromUser(ctx, el.UserID, el.RoleID).Execute()
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("unassign role %s from %s: %w", el.RoleType, el.UserID, err)
+	}
+	return e.store.Delete(ctx, el)
+}
+
+// Reap revokes every expired elevation and returns the revoked ones. It
+// carries on after a failed revocation and returns the errors joined.
+func (e *Elevator) Reap(ctx context.Context) ([]Elevation, error) {
+	elevations, err := e.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := e.now()
+	var (
+		revoked []Elevation
+		errs    []error
+	)
+	for _, el := range elevations {
+		if el.ExpiresAt.After(now) {
+			continue
+		}
+		if err := e.Revoke(ctx, el); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		revoked = append(revoked, el)
+	}
+	return revoked, errors.Join(errs...)
+}
+
+// RunReaper calls Reap every interval until ctx is done. Errors are passed to
+// onError, which may be nil.
+func (e *Elevator) RunReaper(ctx context.Context, interval time.Duration, onError func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := e.Reap(ctx); err != nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Elevator(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1/roles", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(201, `{"id":"ra1","type":"USER_ADMIN","status":"ACTIVE"}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u2/roles", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(201, `{"id":"ra2","type":"APP_ADMIN","status":"ACTIVE"}`), nil
+	})
+	var unassigned []string
+	httpmock.RegisterResponder("DELETE", "=~^/api/v1/users/\\w+/roles/\\w+$", func(req *http.Request) (*http.Response, error) {
+		unassigned = append(unassigned, req.URL.Path)
+		if req.URL.Path == "/api/v1/users/00u2/roles/ra2" {
+			return mockJSONResponse(404, `{"errorCode":"E0000007","errorSummary":"Not found"}`), nil
+		}
+		return mockJSONResponse(204, ``), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	store := NewMemoryElevationStore()
+	elevator := NewElevator(NewAPIClient(configuration), store)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	elevator.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	el, err := elevator.Elevate(ctx, "00u1", "USER_ADMIN", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, Elevation{UserID: "00u1", RoleID: "ra1", RoleType: "USER_ADMIN", ExpiresAt: now.Add(time.Hour)}, *el)
+	_, err = elevator.Elevate(ctx, "00u2", "APP_ADMIN", 2*time.Hour)
+	require.NoError(t, err)
+
+	revoked, err := elevator.Reap(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, revoked, "Nothing should be revoked before it expires")
+
+	now = now.Add(90 * time.Minute)
+	revoked, err = elevator.Reap(ctx)
+	require.NoError(t, err)
+	require.Len(t, revoked, 1)
+	assert.Equal(t, "ra1", revoked[0].RoleID)
+
+	now = now.Add(time.Hour)
+	revoked, err = elevator.Reap(ctx)
+	require.NoError(t, err, "Roles removed elsewhere should only be dropped from the store")
+	require.Len(t, revoked, 1)
+	assert.Equal(t, []string{"/api/v1/users/00u1/roles/ra1", "/api/v1/users/00u2/roles/ra2"}, unassigned)
+	remaining, err := store.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+}
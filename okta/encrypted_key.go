@@ -0,0 +1,211 @@
+package okta
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwe"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// PassphraseProvider returns the passphrase protecting the configured private
+// key or JWK. It is called every time the key has to be decrypted so the
+// passphrase does not need to be kept in the configuration.
+type PassphraseProvider func() ([]byte, error)
+
+var (
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidHMACWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	oidHMACWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+	oidAES128CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidDESEDE3CBC     = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+)
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// resolvePassphrase returns the passphrase from the provider when one is
+// configured, otherwise the static passphrase.
+func resolvePassphrase(passphrase string, provider PassphraseProvider) ([]byte, error) {
+	if provider != nil {
+		return provider()
+	}
+	return []byte(passphrase), nil
+}
+
+// decryptPrivateKey returns privateKey unchanged unless it is an encrypted
+// PEM block, in which case it is decrypted in memory with the passphrase and
+// returned as an unencrypted PEM block. Both PKCS#8 "ENCRYPTED PRIVATE KEY"
+// blocks (PBES2) and legacy OpenSSL encrypted PKCS#1 blocks are supported.
+func decryptPrivateKey(privateKey string, passphrase string, provider PassphraseProvider) (string, error) {
+	block, _ := pem.Decode([]byte(strings.ReplaceAll(privateKey, `\n`, "\n")))
+	if block == nil {
+		return privateKey, nil
+	}
+	// Legacy encrypted PEM blocks are deprecated but still produced by many tools.
+	legacy := x509.IsEncryptedPEMBlock(block)
+	if block.Type != "ENCRYPTED PRIVATE KEY" && !legacy {
+		return privateKey, nil
+	}
+	password, err := resolvePassphrase(passphrase, provider)
+	if err != nil {
+		return "", err
+	}
+	if len(password) == 0 {
+		return "", errors.New("private key is encrypted but no passphrase was provided")
+	}
+	if legacy {
+		der, err := x509.DecryptPEMBlock(block, password)
+		if err != nil {
+			return "", err
+		}
+		return string(pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: der})), nil
+	}
+	der, err := decryptPKCS8(block.Bytes, password)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// decryptPKCS8 decrypts a DER encoded EncryptedPrivateKeyInfo protected with
+// PBES2/PBKDF2 and returns the DER encoded PKCS#8 private key.
+func decryptPKCS8(der, password []byte) ([]byte, error) {
+	var info encryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("invalid encrypted private key: %w", err)
+	}
+	if !info.Algorithm.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("unsupported private key encryption %v, only PBES2 is supported", info.Algorithm.Algorithm)
+	}
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, fmt.Errorf("invalid PBES2 parameters: %w", err)
+	}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, fmt.Errorf("unsupported key derivation function %v", params.KeyDerivationFunc.Algorithm)
+	}
+	var kdf pbkdf2Params
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+		return nil, fmt.Errorf("invalid PBKDF2 parameters: %w", err)
+	}
+
+	var prf func() hash.Hash
+	switch {
+	case len(kdf.PRF.Algorithm) == 0, kdf.PRF.Algorithm.Equal(oidHMACWithSHA1):
+		prf = sha1.New
+	case kdf.PRF.Algorithm.Equal(oidHMACWithSHA256):
+		prf = sha256.New
+	case kdf.PRF.Algorithm.Equal(oidHMACWithSHA384):
+		prf = sha512.New384
+	case kdf.PRF.Algorithm.Equal(oidHMACWithSHA512):
+		prf = sha512.New
+	default:
+		return nil, fmt.Errorf("unsupported PBKDF2 pseudorandom function %v", kdf.PRF.Algorithm)
+	}
+
+	var (
+		keyLen   int
+		newBlock func([]byte) (cipher.Block, error)
+	)
+	scheme := params.EncryptionScheme.Algorithm
+	switch {
+	case scheme.Equal(oidAES128CBC):
+		keyLen, newBlock = 16, aes.NewCipher
+	case scheme.Equal(oidAES192CBC):
+		keyLen, newBlock = 24, aes.NewCipher
+	case scheme.Equal(oidAES256CBC):
+		keyLen, newBlock = 32, aes.NewCipher
+	case scheme.Equal(oidDESEDE3CBC):
+		keyLen, newBlock = 24, des.NewTripleDESCipher
+	default:
+		return nil, fmt.Errorf("unsupported encryption scheme %v", scheme)
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, fmt.Errorf("invalid encryption scheme parameters: %w", err)
+	}
+
+	key := pbkdf2.Key(password, kdf.Salt, kdf.IterationCount, keyLen, prf)
+	block, err := newBlock(key)
+	if err != nil {
+		return nil, err
+	}
+	data := info.EncryptedData
+	if len(iv) != block.BlockSize() || len(data) == 0 || len(data)%block.BlockSize() != 0 {
+		return nil, errors.New("invalid encrypted private key data")
+	}
+	plain := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, data)
+
+	// Remove the PKCS#7 padding; a bad passphrase almost always breaks it.
+	pad := int(plain[len(plain)-1])
+	if pad == 0 || pad > block.BlockSize() {
+		return nil, errors.New("failed to decrypt private key, the passphrase is probably wrong")
+	}
+	for _, b := range plain[len(plain)-pad:] {
+		if int(b) != pad {
+			return nil, errors.New("failed to decrypt private key, the passphrase is probably wrong")
+		}
+	}
+	return plain[:len(plain)-pad], nil
+}
+
+// decryptJWK returns jwks unchanged unless it is a JWE (compact or JSON
+// serialization) protected with a PBES2 passphrase, in which case the
+// decrypted JWK or JWK set is returned.
+func decryptJWK(jwks string, passphrase string, provider PassphraseProvider) (string, error) {
+	trimmed := strings.TrimSpace(jwks)
+	compact := strings.Count(trimmed, ".") == 4 && !strings.HasPrefix(trimmed, "{")
+	if !compact && !strings.Contains(trimmed, `"ciphertext"`) {
+		return jwks, nil
+	}
+	password, err := resolvePassphrase(passphrase, provider)
+	if err != nil {
+		return "", err
+	}
+	if len(password) == 0 {
+		return "", errors.New("JWK is encrypted but no passphrase was provided")
+	}
+	decrypted, err := jwe.Decrypt([]byte(trimmed),
+		jwe.WithKey(jwa.PBES2_HS256_A128KW(), password),
+		jwe.WithKey(jwa.PBES2_HS384_A192KW(), password),
+		jwe.WithKey(jwa.PBES2_HS512_A256KW(), password),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt JWK: %w", err)
+	}
+	return string(decrypted), nil
+}
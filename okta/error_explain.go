@@ -0,0 +1,101 @@
+package okta
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+)
+
+//go:embed error_codes.json
+var errorCodesJSON []byte
+
+// ErrorCodeInfo describes an Okta error code.
+type ErrorCodeInfo struct {
+	Summary string `json:"summary"`
+	// Hint is a common remediation, empty when there is none.
+	Hint string `json:"hint"`
+}
+
+var errorCodes = sync.OnceValue(func() map[string]ErrorCodeInfo {
+	codes := map[string]ErrorCodeInfo{}
+	if err := json.Unmarshal(errorCodesJSON, &codes); err != nil {
+		panic("okta: invalid error code catalog: " + err.Error())
+	}
+	return codes
+})
+
+// LookupErrorCode returns the description of an Okta error code, such as
+// E0000047.
+func LookupErrorCode(code string) (ErrorCodeInfo, bool) {
+	info, ok := errorCodes()[code]
+	return info, ok
+}
+
+// ErrorExplanation is an Okta error with the description of its code.
+type ErrorExplanation struct {
+	Code string
+	// Summary is the error summary of the response, or the description of
+	// the code when the response had none.
+	Summary string
+	Hint    string
+	// Causes are the summaries of the error causes of the response.
+	Causes []string
+	// ErrorID identifies the error for Okta support.
+	ErrorID string
+}
+
+func (e *ErrorExplanation) String() string {
+	var b strings.Builder
+	b.WriteString(e.Code + ": " + e.Summary)
+	for _, cause := range e.Causes {
+		b.WriteString("\n  - " + cause)
+	}
+	if e.Hint != "" {
+		b.WriteString("\nHint: " + e.Hint)
+	}
+	if e.ErrorID != "" {
+		b.WriteString("\nError ID: " + e.ErrorID)
+	}
+	return b.String()
+}
+
+// ExplainError returns the Okta error code carried by err with its
+// description and a common remediation, for tools to show their users. It
+// returns nil when err carries no Okta error code.
+func ExplainError(err error) *ErrorExplanation {
+	var oktaErr Error
+	var apiErr *GenericOpenAPIError
+	var featureErr *FeatureNotEnabledError
+	switch {
+	case errors.As(err, &featureErr):
+		oktaErr.ErrorCode, oktaErr.ErrorSummary = PtrString(featureErr.ErrorCode), PtrString(featureErr.ErrorSummary)
+	case errors.As(err, &apiErr):
+		if model, ok := apiErr.Model().(Error); ok {
+			oktaErr = model
+		} else if json.Unmarshal(apiErr.Body(), &oktaErr) != nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+	code := oktaErr.GetErrorCode()
+	if code == "" {
+		return nil
+	}
+	info, _ := LookupErrorCode(code)
+	e := &ErrorExplanation{
+		Code:    code,
+		Summary: oktaErr.GetErrorSummary(),
+		Hint:    info.Hint,
+		ErrorID: oktaErr.GetErrorId(),
+	}
+	if e.Summary == "" {
+		e.Summary = info.Summary
+	}
+	for _, cause := range oktaErr.ErrorCauses {
+		e.Causes = append(e.Causes, cause.GetErrorSummary())
+	}
+	return e
+}
@@ -0,0 +1,49 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Explain_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(400, `{"errorCode":"E0000001","errorSummary":"Api validation failed: login","errorId":"oae123","errorCauses":[{"errorSummary":"login: An object with this field already exists in the current organization"}]}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users/abc", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(404, `{"errorCode":"E0000007"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	_, _, err = client.UserAPI.CreateUser(ctx).Body(CreateUserRequest{Profile: UserProfile{Login: PtrString("john@example.com")}}).Execute()
+	e := ExplainError(err)
+	require.NotNil(t, e)
+	assert.Equal(t, "E0000001", e.Code)
+	assert.Equal(t, "Api validation failed: login", e.Summary)
+	assert.Equal(t, []string{"login: An object with this field already exists in the current organization"}, e.Causes)
+	assert.Equal(t, "oae123", e.ErrorID)
+	assert.Contains(t, e.String(), "Hint: Check errorCauses")
+
+	_, _, err = client.UserAPI.GetUser(ctx, "abc").Execute()
+	e = ExplainError(err)
+	require.NotNil(t, e)
+	assert.Equal(t, "Not found", e.Summary, "The catalog should describe codes without summary")
+
+	assert.Nil(t, ExplainError(errors.New("connection refused")))
+
+	info, ok := LookupErrorCode("E0000047")
+	require.True(t, ok)
+	assert.Contains(t, info.Hint, "WithRateLimitPrevent")
+}
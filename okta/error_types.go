@@ -0,0 +1,95 @@
+package okta
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is the error response of Okta to an API call. The errors returned
+// by the generated services wrap it, so it can be retrieved with errors.As:
+//
+//	var apiErr *okta.APIError
+//	if errors.As(err, &apiErr) && apiErr.ErrorCode == "E0000001" {
+//		// handle the validation errors of apiErr.ErrorCauses
+//	}
+type APIError struct {
+	StatusCode   int
+	ErrorCode    string
+	ErrorSummary string
+	ErrorLink    string
+	ErrorID      string
+	ErrorCauses  []ErrorCause
+	// RequestID is the X-Okta-Request-Id of the response, to quote in
+	// support requests.
+	RequestID string
+	// Body is the raw body of the response.
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "okta: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.ErrorCode != "" {
+		fmt.Fprintf(&b, ": %s: %s", e.ErrorCode, e.ErrorSummary)
+	}
+	for _, cause := range e.ErrorCauses {
+		b.WriteString(": " + cause.GetErrorSummary())
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request id %s)", e.RequestID)
+	}
+	return b.String()
+}
+
+// newAPIError returns the APIError of an error response. Bodies that are not
+// Okta errors leave the error fields empty.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Okta-Request-Id"),
+		Body:       body,
+	}
+	var model Error
+	if json.Unmarshal(body, &model) == nil {
+		e.ErrorCode = model.GetErrorCode()
+		e.ErrorSummary = model.GetErrorSummary()
+		e.ErrorLink = model.GetErrorLink()
+		e.ErrorID = model.GetErrorId()
+		e.ErrorCauses = model.ErrorCauses
+	}
+	return e
+}
+
+// IsNotFound reports whether err is the error of a call for a resource that
+// does not exist.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.ErrorCode == "E0000007") {
+		return true
+	}
+	return errors.Is(err, ErrUserNotFound) || errors.Is(err, ErrGroupNotFound)
+}
+
+// IsRateLimited reports whether err is the error of a call rejected because a
+// rate limit was exceeded, once the retries of the client were exhausted.
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusTooManyRequests || apiErr.ErrorCode == "E0000047") {
+		return true
+	}
+	return errors.Is(err, ErrTokenRateLimited)
+}
+
+// IsConflict reports whether err is the error of a call that conflicts with
+// the current state of a resource, such as a conditional update sent with
+// WithIfMatch after the resource changed.
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusConflict || apiErr.StatusCode == http.StatusPreconditionFailed) {
+		return true
+	}
+	return errors.Is(err, ErrConflict)
+}
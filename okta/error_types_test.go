@@ -0,0 +1,61 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_API_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/00uMissing", func(req *http.Request) (*http.Response, error) {
+		resp := mockJSONResponse(404, `{"errorCode":"E0000007","errorSummary":"Not found: Resource not found: 00uMissing (User)","errorLink":"E0000007","errorId":"oaeX","errorCauses":[]}`)
+		resp.Header.Set("X-Okta-Request-Id", "req-404")
+		return resp, nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(400, `{"errorCode":"E0000001","errorSummary":"Api validation failed: login","errorCauses":[{"errorSummary":"login: An object with this field already exists"}]}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(429, `{"errorCode":"E0000047","errorSummary":"API call exceeded rate limit due to too many requests."}`), nil
+	})
+	httpmock.RegisterResponder("PUT", "/api/v1/groups/00g1", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(409, `{}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false), WithRateLimitMaxRetries(0))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	_, _, err = client.UserAPI.GetUser(ctx, "00uMissing").Execute()
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 404, apiErr.StatusCode)
+	assert.Equal(t, "E0000007", apiErr.ErrorCode)
+	assert.Equal(t, "oaeX", apiErr.ErrorID)
+	assert.Equal(t, "req-404", apiErr.RequestID)
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsRateLimited(err))
+
+	_, _, err = client.UserAPI.CreateUser(ctx).Body(CreateUserRequest{}).Execute()
+	require.True(t, errors.As(err, &apiErr))
+	require.Len(t, apiErr.ErrorCauses, 1)
+	assert.Equal(t, "okta: 400 Bad Request: E0000001: Api validation failed: login: login: An object with this field already exists", apiErr.Error())
+
+	_, _, err = client.GroupAPI.ListGroups(ctx).Execute()
+	assert.True(t, IsRateLimited(err))
+	_, err = client.ListAllGroups(client.GroupAPI.ListGroups(ctx))
+	assert.True(t, IsRateLimited(err))
+
+	_, _, err = client.GroupAPI.ReplaceGroup(ctx, "00g1").Group(Group{}).Execute()
+	assert.True(t, IsConflict(err))
+	assert.False(t, IsNotFound(err))
+}
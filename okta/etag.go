@@ -0,0 +1,119 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ErrConflict is matched by errors.Is when an update sent with If-Match was
+// rejected because the resource changed since it was read.
+var ErrConflict = errors.New("resource was modified concurrently")
+
+// ConflictError is returned when Okta rejects a conditional update. ETag and
+// Latest describe the current version of the resource, when it could be
+// retrieved, so callers can merge their change and retry.
+type ConflictError struct {
+	StatusCode int
+	// IfMatch is the version the update was based on.
+	IfMatch string
+	// ETag is the current version of the resource.
+	ETag string
+	// Latest is the current representation of the resource.
+	Latest []byte
+}
+
+func (e *ConflictError) Error() string {
+	if e.ETag == "" {
+		return fmt.Sprintf("%s: version %s is outdated", ErrConflict, e.IfMatch)
+	}
+	return fmt.Sprintf("%s: version %s is outdated, latest is %s", ErrConflict, e.IfMatch, e.ETag)
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+type ifMatchKey struct{}
+
+// WithIfMatch returns a context that sends etag in the If-Match header of
+// updates, so they fail with a ConflictError instead of overwriting changes
+// made since the resource was read. Read the ETag of a resource with ETag.
+func WithIfMatch(ctx context.Context, etag string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, etag)
+}
+
+// ETag returns the version of the resource of a response, or an empty string
+// when Okta did not send one.
+func ETag(resp *APIResponse) string {
+	if resp == nil || resp.Response == nil {
+		return ""
+	}
+	return resp.Header.Get("ETag")
+}
+
+// setIfMatch adds the If-Match header to updates made with a context from
+// WithIfMatch.
+func setIfMatch(req *http.Request) string {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return ""
+	}
+	etag, _ := req.Context().Value(ifMatchKey{}).(string)
+	if etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
+	return etag
+}
+
+// conflictError turns the response to a conditional update that lost the race
+// into a ConflictError carrying the latest version of the resource. A 409 is
+// only a lost race for a PUT or PATCH of a resource, other conflicts such as a
+// duplicate login are left to the APIError.
+func (c *APIClient) conflictError(ctx context.Context, req *http.Request, resp *http.Response, ifMatch string) error {
+	if ifMatch == "" {
+		return nil
+	}
+	resource := matchOperation(http.MethodGet, req.URL.Path).path != ""
+	switch {
+	case resp.StatusCode == http.StatusPreconditionFailed:
+	case resp.StatusCode == http.StatusConflict && (req.Method == http.MethodPut || req.Method == http.MethodPatch) && resource:
+	default:
+		return nil
+	}
+	// free the connection for the request of the latest version
+	releaseBody(resp, c.cfg.ClosedBodyPolicy)
+	conflict := &ConflictError{StatusCode: resp.StatusCode, IfMatch: ifMatch, ETag: resp.Header.Get("ETag")}
+	if !resource {
+		return conflict
+	}
+	// a new request rather than a clone, so that it is authorized for GET and
+	// goes through the retries, rate limits and circuit breaker of the client,
+	// skipping the cache that may hold the version that lost the race
+	ctx = WithNoCache(ctx)
+	u := *req.URL
+	u.RawQuery = ""
+	latest, err := c.prepareRequest(ctx, u.String(), http.MethodGet, nil, map[string]string{"Accept": "application/json"}, url.Values{}, url.Values{}, nil)
+	if err != nil {
+		return conflict
+	}
+	current, err := c.do(ctx, latest)
+	if err != nil {
+		return conflict
+	}
+	defer releaseBody(current, DiscardClosedBody)
+	if current.StatusCode < 200 || current.StatusCode > 299 {
+		return conflict
+	}
+	body, err := io.ReadAll(current.Body)
+	if err != nil {
+		return conflict
+	}
+	conflict.Latest = body
+	if etag := current.Header.Get("ETag"); etag != "" {
+		conflict.ETag = etag
+	}
+	return conflict
+}
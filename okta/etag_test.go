@@ -0,0 +1,108 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_If_Match_Conflict(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	version := `W/"2"`
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00g1", func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("Authorization") != "SSWS token" || req.Header.Get("Content-Type") != "" {
+			return mockJSONResponse(400, `{"errorCode":"E0000001","errorSummary":"Unexpected request"}`), nil
+		}
+		resp := mockJSONResponse(200, `{"id":"00g1","profile":{"name":"Sales EMEA"}}`)
+		resp.Header.Set("ETag", version)
+		return resp, nil
+	})
+	httpmock.RegisterResponder("PUT", "/api/v1/groups/00g1", func(req *http.Request) (*http.Response, error) {
+		if match := req.Header.Get("If-Match"); match != "" && match != version {
+			return mockJSONResponse(412, `{"errorCode":"E0000000","errorSummary":"Precondition failed"}`), nil
+		}
+		return mockJSONResponse(200, `{"id":"00g1","profile":{"name":"Sales"}}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, resp, err := client.GroupAPI.GetGroup(context.Background(), "00g1").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, `W/"2"`, ETag(resp))
+
+	group := Group{Profile: &GroupProfile{Name: PtrString("Sales")}}
+	_, _, err = client.GroupAPI.ReplaceGroup(WithIfMatch(context.Background(), `W/"1"`), "00g1").Group(group).Execute()
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrConflict))
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+	assert.Equal(t, http.StatusPreconditionFailed, conflict.StatusCode)
+	assert.Equal(t, `W/"1"`, conflict.IfMatch)
+	assert.Equal(t, `W/"2"`, conflict.ETag)
+	assert.JSONEq(t, `{"id":"00g1","profile":{"name":"Sales EMEA"}}`, string(conflict.Latest))
+
+	_, _, err = client.GroupAPI.ReplaceGroup(WithIfMatch(context.Background(), ETag(resp)), "00g1").Group(group).Execute()
+	require.NoError(t, err, "An update of the latest version should succeed")
+
+	httpmock.RegisterResponder("POST", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(409, `{"errorCode":"E0000201","errorSummary":"A group with the same name already exists"}`), nil
+	})
+	calls := httpmock.GetTotalCallCount()
+	_, _, err = client.GroupAPI.CreateGroup(WithIfMatch(context.Background(), `W/"1"`)).Group(group).Execute()
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrConflict), "Conflicts other than lost updates should not be a ConflictError")
+	var apiErr *GenericOpenAPIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, calls+1, httpmock.GetTotalCallCount(), "The latest version should not be fetched")
+}
+
+func Test_If_Match_Conflict_Refetch(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	version := `W/"1"`
+	status := http.StatusOK
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		if status != http.StatusOK {
+			return mockJSONResponse(status, `{"errorCode":"E0000007","errorSummary":"Not found: Resource not found: 00u1 (User)"}`), nil
+		}
+		resp := mockJSONResponse(200, `{"id":"00u1","profile":{"login":"`+strings.Trim(version, `W/"`)+`"}}`)
+		resp.Header.Set("ETag", version)
+		return resp, nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(412, `{"errorCode":"E0000000","errorSummary":"Precondition failed"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(true))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	_, resp, err := client.UserAPI.GetUser(ctx, "00u1").Execute()
+	require.NoError(t, err)
+	version = `W/"2"`
+
+	update := UpdateUserRequest{Profile: &UserProfile{}}
+	_, _, err = client.UserAPI.UpdateUser(WithIfMatch(ctx, ETag(resp)), "00u1").User(update).Strict(true).Execute()
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+	assert.Equal(t, `W/"2"`, conflict.ETag, "The latest version should not come from the cache")
+	assert.JSONEq(t, `{"id":"00u1","profile":{"login":"2"}}`, string(conflict.Latest))
+
+	status = http.StatusNotFound
+	_, _, err = client.UserAPI.UpdateUser(WithIfMatch(ctx, ETag(resp)), "00u1").User(update).Strict(true).Execute()
+	require.True(t, errors.As(err, &conflict))
+	assert.Empty(t, conflict.ETag)
+	assert.Nil(t, conflict.Latest, "Error responses should not be reported as the latest version")
+}
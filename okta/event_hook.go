@@ -0,0 +1,111 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+// EventHookProcessor processes one event delivered by an event hook.
+type EventHookProcessor func(ctx context.Context, event LogEvent) error
+
+// EventHookHandler serves an event hook. It answers the verification
+// challenge, acknowledges deliveries right away, as Okta expects an answer
+// within 3 seconds, and processes their events in the background through a
+// dead letter queue: failing events are retried and then dead lettered.
+type EventHookHandler struct {
+	process EventHookProcessor
+	queue   *DeadLetterQueue
+	dedupe  DedupeStore
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewEventHookHandler returns a handler processing the events of deliveries
+// with process and handing those failing every attempt to queue.
+// Authentication of the request is left to a wrapping handler.
+func NewEventHookHandler(process EventHookProcessor, queue *DeadLetterQueue) *EventHookHandler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &EventHookHandler{process: process, queue: queue, ctx: ctx, cancel: cancel}
+}
+
+// WithDedupe makes the handler skip the events store marks as delivered and
+// mark those processed successfully, as Okta may deliver an event more than
+// once. Errors of the store are ignored, processing the event anyway. It must
+// be called before the handler serves requests.
+func (h *EventHookHandler) WithDedupe(store DedupeStore) *EventHookHandler {
+	h.dedupe = store
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *EventHookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	HookVerificationHandler(http.HandlerFunc(h.serveDelivery)).ServeHTTP(w, r)
+}
+
+func (h *EventHookHandler) serveDelivery(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	var delivery struct {
+		Data struct {
+			Events []LogEvent `json:"events"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&delivery); err != nil {
+		http.Error(w, "invalid event hook payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, event := range delivery.Data.Events {
+		h.wg.Add(1)
+		go func(event LogEvent) {
+			defer h.wg.Done()
+			h.processEvent(event)
+		}(event)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *EventHookHandler) processEvent(event LogEvent) {
+	if h.dedupe != nil {
+		if delivered, _ := h.dedupe.Delivered(h.ctx, event.GetUuid()); delivered {
+			return
+		}
+	}
+	attempts, err := h.queue.process(h.ctx, func(ctx context.Context) error {
+		return h.process(ctx, event)
+	})
+	if err == nil {
+		if h.dedupe != nil {
+			_ = h.dedupe.MarkDelivered(h.ctx, event.GetUuid())
+		}
+		return
+	}
+	// events still failing at shutdown are dead lettered rather than lost
+	_ = h.queue.deadLetter(context.WithoutCancel(h.ctx), DeadLetterSourceEventHook, []LogEvent{event}, attempts, err)
+}
+
+// Close waits for the events in flight to be processed or dead lettered.
+// Once ctx is done it stops retrying, dead letters the events that have not
+// succeeded yet and returns the error of ctx.
+func (h *EventHookHandler) Close(ctx context.Context) error {
+	defer h.cancel()
+	done := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		h.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,129 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const eventHookDelivery = `{
+  "eventType": "com.okta.event_hook",
+  "eventTypeVersion": "1.0",
+  "cloudEventsVersion": "0.1",
+  "source": "https://example.okta.com/api/v1/eventHooks/who8vt36qfNpCGz9H1e6",
+  "eventId": "b5a188b9-5ece-4636-b041-482ffda96311",
+  "data": {"events": [
+    {"uuid": "ok", "eventType": "user.lifecycle.create"},
+    {"uuid": "flaky", "eventType": "user.lifecycle.create"},
+    {"uuid": "poison", "eventType": "user.lifecycle.create"}
+  ]}
+}`
+
+func Test_Event_Hook_Handler(t *testing.T) {
+	var mu sync.Mutex
+	var letters []DeadLetter
+	queue, err := NewDeadLetterQueue(DeadLetterOptions{MaxAttempts: 2, Backoff: time.Millisecond, Sink: func(ctx context.Context, letter DeadLetter) error {
+		mu.Lock()
+		defer mu.Unlock()
+		letters = append(letters, letter)
+		return nil
+	}})
+	require.NoError(t, err)
+
+	calls := map[string]int{}
+	handler := NewEventHookHandler(func(ctx context.Context, event LogEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[event.GetUuid()]++
+		switch {
+		case event.GetUuid() == "poison":
+			return errors.New("cannot parse event")
+		case event.GetUuid() == "flaky" && calls["flaky"] == 1:
+			return errors.New("timeout")
+		}
+		return nil
+	}, queue)
+
+	req := httptest.NewRequest(http.MethodGet, "/hooks", nil)
+	req.Header.Set("X-Okta-Verification-Challenge", "challenge")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.JSONEq(t, `{"verification":"challenge"}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/hooks", strings.NewReader(eventHookDelivery)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, handler.Close(context.Background()))
+
+	assert.Equal(t, map[string]int{"ok": 1, "flaky": 2, "poison": 2}, calls)
+	require.Len(t, letters, 1)
+	assert.Equal(t, "poison", letters[0].Event.GetUuid())
+	assert.Equal(t, DeadLetterSourceEventHook, letters[0].Source)
+	assert.Equal(t, 2, letters[0].Attempts)
+	assert.EqualError(t, letters[0].Err, "cannot parse event")
+	assert.Equal(t, DeadLetterStats{Attempts: 5, Retries: 2, DeadLettered: 1}, queue.Stats())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/hooks", strings.NewReader("{")))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func Test_Event_Hook_Handler_Close(t *testing.T) {
+	var letters []DeadLetter
+	queue, err := NewDeadLetterQueue(DeadLetterOptions{MaxAttempts: 5, Backoff: time.Hour, Sink: func(ctx context.Context, letter DeadLetter) error {
+		letters = append(letters, letter)
+		return nil
+	}})
+	require.NoError(t, err)
+	failed := make(chan struct{})
+	handler := NewEventHookHandler(func(ctx context.Context, event LogEvent) error {
+		close(failed)
+		return errors.New("siem unavailable")
+	}, queue)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/hooks", strings.NewReader(`{"data":{"events":[{"uuid":"a"}]}}`)))
+	<-failed
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, handler.Close(ctx), context.Canceled)
+	require.Len(t, letters, 1, "Events still waiting for a retry should be dead lettered when closing is cut short")
+	assert.EqualError(t, letters[0].Err, "siem unavailable")
+	assert.Equal(t, 1, letters[0].Attempts)
+}
+
+func Test_Event_Hook_Handler_Dedupe(t *testing.T) {
+	queue, err := NewDeadLetterQueue(DeadLetterOptions{MaxAttempts: 1, Sink: func(ctx context.Context, letter DeadLetter) error {
+		return nil
+	}})
+	require.NoError(t, err)
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	calls := map[string]int{}
+	process := func(ctx context.Context, event LogEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[event.GetUuid()]++
+		if event.GetUuid() == "poison" {
+			return errors.New("cannot parse event")
+		}
+		return nil
+	}
+
+	// the second handler is a forwarder restarted before Okta redelivered
+	for i := 0; i < 2; i++ {
+		handler := NewEventHookHandler(process, queue).WithDedupe(NewStoreDedupe(store, "hooks:", time.Hour))
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/hooks", strings.NewReader(eventHookDelivery)))
+		require.NoError(t, handler.Close(context.Background()))
+	}
+	assert.Equal(t, map[string]int{"ok": 1, "flaky": 1, "poison": 2}, calls, "Only events processed successfully should be skipped")
+}
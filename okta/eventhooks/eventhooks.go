@@ -0,0 +1,288 @@
+// Package eventhooks serves Okta event hooks: it answers the one time
+// verification challenge, authenticates deliveries by their authorization
+// header or HMAC signature, decodes their events into the typed payloads of
+// okta.LogEvent.Payload and dispatches them to callbacks by event type.
+// Register creates or updates the hook in the org and verifies it, so a hook
+// is set up end to end from Go.
+//
+// Callbacks run before the delivery is answered, and Okta waits 3 seconds for
+// the answer. Slow or unreliable processing belongs behind
+// okta.NewEventHookHandler, which acknowledges deliveries right away and
+// retries events through a dead letter queue.
+package eventhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// defaultMaxBodySize bounds the size of deliveries read, Okta sending at most
+// 50 events per delivery.
+const defaultMaxBodySize = 4 << 20
+
+// Callback handles an event of a delivery. payload is the typed payload of
+// the event, nil for types without one, see okta.LogEvent.Payload.
+type Callback func(ctx context.Context, event *okta.LogEvent, payload interface{}) error
+
+// Options configures a Handler.
+type Options struct {
+	// AuthHeader and AuthValue are the header and value of the auth scheme of
+	// the hook, which Okta sends with every call. Calls without them are
+	// rejected.
+	AuthHeader string
+	AuthValue  string
+	// SignatureHeader and SignatureSecret check the HMAC-SHA256 of the body of
+	// deliveries with the secret, sent hex or base64 encoded in the header,
+	// as added by gateways relaying deliveries. Deliveries without a valid
+	// signature are rejected.
+	SignatureHeader string
+	SignatureSecret []byte
+	// OnError is called with the events a callback failed for. The delivery
+	// is answered successfully anyway, as Okta only delivers events again
+	// when the answer times out.
+	OnError func(ctx context.Context, event *okta.LogEvent, err error)
+	// MaxBodySize bounds the size of deliveries, 4 MiB by default.
+	MaxBodySize int64
+}
+
+// Handler is an http.Handler serving an event hook.
+type Handler struct {
+	opts Options
+
+	mu        sync.RWMutex
+	callbacks []registration
+}
+
+type registration struct {
+	eventType string
+	callback  Callback
+}
+
+// NewHandler returns a Handler configured with opts. Without AuthHeader nor
+// SignatureHeader authentication is left to a wrapping handler.
+func NewHandler(opts Options) *Handler {
+	if opts.MaxBodySize <= 0 {
+		opts.MaxBodySize = defaultMaxBodySize
+	}
+	return &Handler{opts: opts}
+}
+
+// On calls callback with the events of eventType. An eventType ending with
+// ".*", such as "user.lifecycle.*", matches every type with that prefix, and
+// "*" every type. Every matching callback is called, in the order they were
+// registered.
+func (h *Handler) On(eventType string, callback Callback) *Handler {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.callbacks = append(h.callbacks, registration{eventType: eventType, callback: callback})
+	return h
+}
+
+// Handle calls callback with the events of eventType and their payload of
+// type T, such as *okta.SessionStartEvent for okta.EventTypeSessionStart.
+// Events whose payload is not a T fail.
+func Handle[T any](h *Handler, eventType string, callback func(ctx context.Context, event *okta.LogEvent, payload T) error) *Handler {
+	return h.On(eventType, func(ctx context.Context, event *okta.LogEvent, payload interface{}) error {
+		typed, ok := payload.(T)
+		if !ok {
+			return fmt.Errorf("event %s of type %s has a %T payload, not a %T", event.GetUuid(), event.GetEventType(), payload, typed)
+		}
+		return callback(ctx, event, typed)
+	})
+}
+
+// EventTypes returns the event types the handler has callbacks for, without
+// those ending with a wildcard, as subscribed to by Register.
+func (h *Handler) EventTypes() []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	var types []string
+	seen := map[string]bool{}
+	for _, r := range h.callbacks {
+		if !strings.HasSuffix(r.eventType, "*") && !seen[r.eventType] {
+			seen[r.eventType] = true
+			types = append(types, r.eventType)
+		}
+	}
+	return types
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.opts.AuthHeader != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(h.opts.AuthHeader)), []byte(h.opts.AuthValue)) != 1 {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	okta.HookVerificationHandler(http.HandlerFunc(h.serveDelivery)).ServeHTTP(w, r)
+}
+
+func (h *Handler) serveDelivery(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.opts.MaxBodySize))
+	if err != nil {
+		http.Error(w, "invalid event hook payload: "+err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if h.opts.SignatureHeader != "" && !validSignature(r.Header.Get(h.opts.SignatureHeader), body, h.opts.SignatureSecret) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	var delivery struct {
+		Data struct {
+			Events []okta.LogEvent `json:"events"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &delivery); err != nil {
+		http.Error(w, "invalid event hook payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	for i := range delivery.Data.Events {
+		event := &delivery.Data.Events[i]
+		if err := h.dispatch(r.Context(), event); err != nil && h.opts.OnError != nil {
+			h.opts.OnError(r.Context(), event, err)
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch calls the callbacks matching the type of event, joining their
+// errors.
+func (h *Handler) dispatch(ctx context.Context, event *okta.LogEvent) error {
+	h.mu.RLock()
+	var matching []Callback
+	for _, r := range h.callbacks {
+		if matches(r.eventType, event.GetEventType()) {
+			matching = append(matching, r.callback)
+		}
+	}
+	h.mu.RUnlock()
+	if len(matching) == 0 {
+		return nil
+	}
+	payload, err := event.Payload()
+	if err != nil {
+		return fmt.Errorf("decode event %s: %w", event.GetUuid(), err)
+	}
+	var errs []error
+	for _, callback := range matching {
+		if err := callback(ctx, event, payload); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func matches(pattern, eventType string) bool {
+	if prefix, wildcard := strings.CutSuffix(pattern, "*"); wildcard {
+		return strings.HasPrefix(eventType, prefix)
+	}
+	return pattern == eventType
+}
+
+// validSignature reports whether signature is the hex or base64 encoded
+// HMAC-SHA256 of body with secret, optionally prefixed with "sha256=".
+func validSignature(signature string, body, secret []byte) bool {
+	signature = strings.TrimPrefix(signature, "sha256=")
+	if signature == "" {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	want := mac.Sum(nil)
+	if got, err := hex.DecodeString(signature); err == nil && hmac.Equal(got, want) {
+		return true
+	}
+	got, err := base64.StdEncoding.DecodeString(signature)
+	return err == nil && hmac.Equal(got, want)
+}
+
+// Registration describes an event hook for Register.
+type Registration struct {
+	// Name identifies the hook: Register updates the hook with that name
+	// rather than creating another one.
+	Name string
+	// URL is the address of the handler.
+	URL string
+	// Events are the event types the hook subscribes to, see
+	// Handler.EventTypes.
+	Events []string
+	// AuthHeader and AuthValue are sent by Okta with every call, as checked
+	// by Options.AuthHeader and Options.AuthValue.
+	AuthHeader string
+	AuthValue  string
+}
+
+// Register creates the event hook named reg.Name, or replaces the existing
+// one, then verifies and activates it. The handler must be reachable at
+// reg.URL, as Okta calls it to verify the hook.
+func Register(ctx context.Context, client *okta.APIClient, reg Registration) (*okta.EventHook, error) {
+	if reg.Name == "" || reg.URL == "" {
+		return nil, errors.New("the name and URL of the event hook are required")
+	}
+	if len(reg.Events) == 0 {
+		return nil, errors.New("at least one event type is required")
+	}
+	config := okta.NewEventHookChannelConfig(reg.URL)
+	if reg.AuthHeader != "" {
+		config.AuthScheme = &okta.EventHookChannelConfigAuthScheme{
+			Key:   okta.PtrString(reg.AuthHeader),
+			Type:  okta.PtrString("HEADER"),
+			Value: okta.PtrString(reg.AuthValue),
+		}
+	}
+	hook := okta.NewEventHook(
+		*okta.NewEventHookChannel(*config, "HTTP", "1.0.0"),
+		*okta.NewEventSubscriptions(reg.Events, "EVENT_TYPE"),
+		reg.Name,
+	)
+	hooks, err := client.ListAllEventHooks(client.EventHookAPI.ListEventHooks(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list event hooks: %w", err)
+	}
+	var id string
+	for _, existing := range hooks {
+		if existing.GetName() == reg.Name {
+			id = existing.GetId()
+			break
+		}
+	}
+	if id == "" {
+		created, _, err := client.EventHookAPI.CreateEventHook(ctx).EventHook(*hook).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("create event hook: %w", err)
+		}
+		id = created.GetId()
+	} else if _, _, err := client.EventHookAPI.ReplaceEventHook(ctx, id).EventHook(*hook).Execute(); err != nil {
+		return nil, fmt.Errorf("replace event hook %s: %w", id, err)
+	}
+	verified, _, err := client.EventHookAPI.VerifyEventHook(ctx, id).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("verify event hook %s: %w", id, err)
+	}
+	if verified.GetVerificationStatus() != "VERIFIED" {
+		return nil, fmt.Errorf("event hook %s is %s", id, verified.GetVerificationStatus())
+	}
+	if verified.GetStatus() != "ACTIVE" {
+		if verified, _, err = client.EventHookAPI.ActivateEventHook(ctx, id).Execute(); err != nil {
+			return nil, fmt.Errorf("activate event hook %s: %w", id, err)
+		}
+	}
+	return verified, nil
+}
@@ -0,0 +1,119 @@
+package eventhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/okta-sdk-golang/v5/okta/internal/oktatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const delivery = `{"eventType":"com.okta.event_hook","data":{"events":[
+  {"uuid":"e1","eventType":"user.lifecycle.create","target":[{"id":"00u1","type":"User","alternateId":"alice@example.com"}]},
+  {"uuid":"e2","eventType":"user.session.start","actor":{"id":"00u1","alternateId":"alice@example.com"}},
+  {"uuid":"e3","eventType":"group.user_membership.add"}
+]}}`
+
+func sign(body string) string {
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func Test_Handler(t *testing.T) {
+	var handled []string
+	var failed []string
+	h := NewHandler(Options{
+		AuthHeader:      "Authorization",
+		AuthValue:       "token",
+		SignatureHeader: "X-Signature",
+		SignatureSecret: []byte("secret"),
+		OnError: func(ctx context.Context, event *okta.LogEvent, err error) {
+			failed = append(failed, event.GetUuid()+": "+err.Error())
+		},
+	})
+	Handle(h, okta.EventTypeUserLifecycle, func(ctx context.Context, event *okta.LogEvent, payload *okta.UserLifecycleEvent) error {
+		handled = append(handled, payload.Action+" "+payload.UserLogin)
+		return nil
+	})
+	Handle(h, okta.EventTypeSessionStart, func(ctx context.Context, event *okta.LogEvent, payload *okta.SessionStartEvent) error {
+		handled = append(handled, "session "+payload.ActorLogin)
+		return errors.New("siem unavailable")
+	})
+	h.On("*", func(ctx context.Context, event *okta.LogEvent, payload interface{}) error {
+		handled = append(handled, "any "+event.GetUuid())
+		return nil
+	})
+	assert.Equal(t, []string{"user.session.start"}, h.EventTypes())
+
+	serve := func(method, body string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/hooks", strings.NewReader(body))
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(http.MethodGet, "", map[string]string{"Authorization": "token", "X-Okta-Verification-Challenge": "abc"})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"verification":"abc"}`, rec.Body.String())
+	rec = serve(http.MethodGet, "", map[string]string{"X-Okta-Verification-Challenge": "abc"})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code, "The verification should be authenticated")
+
+	rec = serve(http.MethodPost, delivery, map[string]string{"Authorization": "token", "X-Signature": "sha256=00"})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, handled)
+
+	rec = serve(http.MethodPost, delivery, map[string]string{"Authorization": "token", "X-Signature": sign(delivery)})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"create alice@example.com", "any e1", "session alice@example.com", "any e2", "any e3"}, handled)
+	assert.Equal(t, []string{"e2: siem unavailable"}, failed)
+
+	rec = serve(http.MethodPost, `{`, map[string]string{"Authorization": "token", "X-Signature": sign(`{`)})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func Test_Register(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var replaced okta.EventHook
+	httpmock.RegisterResponder("GET", "/api/v1/eventHooks", oktatest.JSONResponder(200, `[{"id":"who0","name":"other"},{"id":"who1","name":"provisioning"}]`))
+	httpmock.RegisterResponder("PUT", "/api/v1/eventHooks/who1", func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&replaced))
+		return oktatest.JSONResponse(200, `{"id":"who1","status":"INACTIVE","verificationStatus":"UNVERIFIED"}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/eventHooks/who1/lifecycle/verify", oktatest.JSONResponder(200, `{"id":"who1","status":"INACTIVE","verificationStatus":"VERIFIED"}`))
+	httpmock.RegisterResponder("POST", "/api/v1/eventHooks/who1/lifecycle/activate", oktatest.JSONResponder(200, `{"id":"who1","status":"ACTIVE","verificationStatus":"VERIFIED"}`))
+
+	client := oktatest.NewClient(t)
+
+	hook, err := Register(context.Background(), client, Registration{
+		Name:       "provisioning",
+		URL:        "https://hooks.example.com/okta",
+		Events:     []string{"user.lifecycle.create"},
+		AuthHeader: "Authorization",
+		AuthValue:  "token",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ACTIVE", hook.GetStatus())
+	assert.Equal(t, "https://hooks.example.com/okta", replaced.Channel.Config.Uri)
+	assert.Equal(t, []string{"user.lifecycle.create"}, replaced.Events.Items)
+	assert.Zero(t, httpmock.GetCallCountInfo()["POST /api/v1/eventHooks"], "The existing hook should be replaced")
+
+	_, err = Register(context.Background(), client, Registration{Name: "provisioning", URL: "https://hooks.example.com/okta"})
+	assert.Error(t, err)
+}
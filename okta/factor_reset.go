@@ -0,0 +1,130 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// FactorType is the type of a factor enrolled by a user.
+type FactorType string
+
+// Types of factors.
+const (
+	FactorTypeCall              FactorType = "call"
+	FactorTypeEmail             FactorType = "email"
+	FactorTypePush              FactorType = "push"
+	FactorTypeQuestion          FactorType = "question"
+	FactorTypeSignedNonce       FactorType = "signed_nonce"
+	FactorTypeSMS               FactorType = "sms"
+	FactorTypeToken             FactorType = "token"
+	FactorTypeTokenHardware     FactorType = "token:hardware"
+	FactorTypeTokenHOTP         FactorType = "token:hotp"
+	FactorTypeTokenSoftwareTOTP FactorType = "token:software:totp"
+	FactorTypeU2F               FactorType = "u2f"
+	FactorTypeWeb               FactorType = "web"
+	FactorTypeWebAuthn          FactorType = "webauthn"
+)
+
+// Reasons a factor is kept by ResetAll.
+const (
+	FactorKeptExcepted = "excepted"
+	FactorKeptRequired = "required by policy"
+)
+
+// ResetFactor is a factor of a user handled by ResetAll.
+type ResetFactor struct {
+	ID         string
+	FactorType FactorType
+	Provider   string
+	// Reason is why the factor was kept, empty for removed factors.
+	Reason string
+}
+
+// FactorResetSummary lists what ResetAll did with the factors of a user.
+type FactorResetSummary struct {
+	UserID  string
+	Removed []ResetFactor
+	Kept    []ResetFactor
+}
+
+// enrolledFactor only decodes the fields of a factor ResetAll needs.
+type enrolledFactor struct {
+	Id         string `json:"id"`
+	FactorType string `json:"factorType"`
+	Provider   string `json:"provider"`
+}
+
+// ResetAll removes the factors of a user, except those of the given types, so
+// helpdesk staff can let a user enroll again. Factors of a type the policies
+// of the user require are kept, so the user is never left without a factor
+// meeting policy; use ForceResetAll to remove them as well. Failed removals do
+// not stop the others, their errors are returned with the summary.
+func (a *UserFactorAPIService) ResetAll(ctx context.Context, userID string, except ...FactorType) (*FactorResetSummary, error) {
+	return a.resetAll(ctx, userID, false, except)
+}
+
+// ForceResetAll removes the factors of a user like ResetAll, including those
+// the policies of the user require.
+func (a *UserFactorAPIService) ForceResetAll(ctx context.Context, userID string, except ...FactorType) (*FactorResetSummary, error) {
+	return a.resetAll(ctx, userID, true, except)
+}
+
+func (a *UserFactorAPIService) resetAll(ctx context.Context, userID string, force bool, except []FactorType) (*FactorResetSummary, error) {
+	if userID == "" {
+		return nil, errors.New("user id is required")
+	}
+	c := a.client
+	headers := map[string]string{"Accept": "application/json"}
+	base := "/api/v1/users/" + url.PathEscape(userID) + "/factors"
+
+	required := map[FactorType]bool{}
+	if !force {
+		err := listPages(ctx, c, base+"/catalog", url.Values{}, headers, func(page []UserFactorSupported) error {
+			for _, f := range page {
+				if f.GetEnrollment() == "REQUIRED" {
+					required[FactorType(f.GetFactorType())] = true
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	var factors []enrolledFactor
+	err := listPages(ctx, c, base, url.Values{}, headers, func(page []enrolledFactor) error {
+		factors = append(factors, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	excepted := map[FactorType]bool{}
+	for _, t := range except {
+		excepted[t] = true
+	}
+	summary := &FactorResetSummary{UserID: userID}
+	var errs []error
+	for _, f := range factors {
+		factor := ResetFactor{ID: f.Id, FactorType: FactorType(f.FactorType), Provider: f.Provider}
+		switch {
+		case excepted[factor.FactorType]:
+			factor.Reason = FactorKeptExcepted
+		case required[factor.FactorType]:
+			factor.Reason = FactorKeptRequired
+		}
+		if factor.Reason != "" {
+			summary.Kept = append(summary.Kept, factor)
+			continue
+		}
+		if _, err := a.UnenrollFactor(ctx, userID, f.Id).Execute(); err != nil {
+			errs = append(errs, fmt.Errorf("factor %s (%s): %w", f.Id, f.FactorType, err))
+			continue
+		}
+		summary.Removed = append(summary.Removed, factor)
+	}
+	return summary, errors.Join(errs...)
+}
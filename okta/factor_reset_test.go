@@ -0,0 +1,57 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Reset_All_Factors(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1/factors/catalog", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[
+		  {"factorType":"push","provider":"OKTA","enrollment":"REQUIRED"},
+		  {"factorType":"sms","provider":"OKTA","enrollment":"OPTIONAL"}
+		]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1/factors", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[
+		  {"id":"opf1","factorType":"push","provider":"OKTA","status":"ACTIVE"},
+		  {"id":"mbl1","factorType":"sms","provider":"OKTA","status":"ACTIVE"},
+		  {"id":"emf1","factorType":"email","provider":"OKTA","status":"ACTIVE"},
+		  {"id":"ufs1","factorType":"question","provider":"OKTA","status":"ACTIVE"}
+		]`), nil
+	})
+	for _, id := range []string{"opf1", "mbl1", "emf1"} {
+		httpmock.RegisterResponder("DELETE", "/api/v1/users/00u1/factors/"+id, httpmock.NewStringResponder(204, ""))
+	}
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	summary, err := client.UserFactorAPI.ResetAll(ctx, "00u1", FactorTypeQuestion)
+	require.NoError(t, err)
+	assert.Equal(t, []ResetFactor{
+		{ID: "mbl1", FactorType: FactorTypeSMS, Provider: "OKTA"},
+		{ID: "emf1", FactorType: FactorTypeEmail, Provider: "OKTA"},
+	}, summary.Removed)
+	assert.Equal(t, []ResetFactor{
+		{ID: "opf1", FactorType: FactorTypePush, Provider: "OKTA", Reason: FactorKeptRequired},
+		{ID: "ufs1", FactorType: FactorTypeQuestion, Provider: "OKTA", Reason: FactorKeptExcepted},
+	}, summary.Kept)
+	assert.Equal(t, 0, httpmock.GetCallCountInfo()["DELETE /api/v1/users/00u1/factors/opf1"])
+
+	summary, err = client.UserFactorAPI.ForceResetAll(ctx, "00u1", FactorTypeQuestion)
+	require.NoError(t, err)
+	assert.Len(t, summary.Removed, 3)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["DELETE /api/v1/users/00u1/factors/opf1"])
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET /api/v1/users/00u1/factors/catalog"], "Forced resets ignore the policy")
+}
@@ -0,0 +1,169 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FaultInjectedHeader is set on the responses FaultInjector fabricated or
+// altered, naming the injected fault.
+const FaultInjectedHeader = "X-Okta-Fault-Injected"
+
+// Faults injected by FaultInjector, as reported in FaultInjectedHeader.
+const (
+	FaultRateLimit     = "rateLimit"
+	FaultServerError   = "serverError"
+	FaultTimeout       = "timeout"
+	FaultMalformedJSON = "malformedJSON"
+)
+
+// FaultInjection configures the faults a FaultInjector injects. Probabilities
+// range from 0 to 1, and at most one of the faults is injected per request.
+type FaultInjection struct {
+	// RateLimit is the probability of a 429 response with exhausted rate
+	// limit headers.
+	RateLimit float64
+	// ServerError is the probability of a 500 response.
+	ServerError float64
+	// Timeout is the probability of the request timing out. The request
+	// fails with a timeout error once TimeoutAfter passed, 30 seconds by
+	// default, or its context is done.
+	Timeout      float64
+	TimeoutAfter time.Duration
+	// MalformedJSON is the probability of the body of the response from Okta
+	// being cut off in the middle.
+	MalformedJSON float64
+	// Latency is added to the requests, or to the fraction
+	// LatencyProbability of them when it is set.
+	Latency            time.Duration
+	LatencyProbability float64
+	// Match limits the faults to the requests it returns true for.
+	Match func(req *http.Request) bool
+	// Rand returns random numbers in [0, 1), math/rand.Float64 by default.
+	// Set it to make the injected faults reproducible.
+	Rand func() float64
+}
+
+// FaultInjector is an http.RoundTripper injecting the failures of an Okta
+// incident into the requests it sends, to validate how an application copes
+// with them in a staging environment. Pass it to WithTransport, or use
+// WithFaultInjection.
+type FaultInjector struct {
+	// Base sends the requests that are not failed, http.DefaultTransport
+	// when nil.
+	Base   http.RoundTripper
+	Faults FaultInjection
+}
+
+// NewFaultInjector returns a FaultInjector sending the requests it does not
+// fail with base.
+func NewFaultInjector(base http.RoundTripper, faults FaultInjection) *FaultInjector {
+	return &FaultInjector{Base: base, Faults: faults}
+}
+
+// faultTimeoutError is returned for requests failed with FaultTimeout. Like the
+// errors of the net package it reports itself as a timeout.
+type faultTimeoutError struct{}
+
+func (faultTimeoutError) Error() string   { return "injected fault: request timed out" }
+func (faultTimeoutError) Timeout() bool   { return true }
+func (faultTimeoutError) Temporary() bool { return true }
+
+func (f *FaultInjector) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := f.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	faults := f.Faults
+	if faults.Match != nil && !faults.Match(req) {
+		return base.RoundTrip(req)
+	}
+	random := faults.Rand
+	if random == nil {
+		random = rand.Float64
+	}
+	if faults.Latency > 0 && (faults.LatencyProbability == 0 || random() < faults.LatencyProbability) {
+		if err := sleepContext(req.Context(), faults.Latency); err != nil {
+			return nil, err
+		}
+	}
+
+	draw := random()
+	switch {
+	case draw < faults.RateLimit:
+		return faultResponse(req, http.StatusTooManyRequests, FaultRateLimit, "E0000047", "API call exceeded rate limit due to too many requests."), nil
+	case draw < faults.RateLimit+faults.ServerError:
+		return faultResponse(req, http.StatusInternalServerError, FaultServerError, "E0000009", "Internal Server Error"), nil
+	case draw < faults.RateLimit+faults.ServerError+faults.Timeout:
+		after := faults.TimeoutAfter
+		if after <= 0 {
+			after = 30 * time.Second
+		}
+		if err := sleepContext(req.Context(), after); err != nil {
+			return nil, err
+		}
+		return nil, faultTimeoutError{}
+	case draw < faults.RateLimit+faults.ServerError+faults.Timeout+faults.MalformedJSON:
+		resp, err := base.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = body[:len(body)/2]
+		resp.Body = io.NopCloser(strings.NewReader(string(body)))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Del("Content-Length")
+		resp.Header.Set(FaultInjectedHeader, FaultMalformedJSON)
+		return resp, nil
+	}
+	return base.RoundTrip(req)
+}
+
+// faultResponse fabricates an Okta error response to req.
+func faultResponse(req *http.Request, status int, fault, code, summary string) *http.Response {
+	now := time.Now().UTC()
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set("Date", now.Format(http.TimeFormat))
+	header.Set("X-Okta-Request-Id", "fault-"+strconv.FormatInt(now.UnixNano(), 36))
+	header.Set(FaultInjectedHeader, fault)
+	if status == http.StatusTooManyRequests {
+		header.Set("X-Rate-Limit-Limit", "600")
+		header.Set("X-Rate-Limit-Remaining", "0")
+		header.Set("X-Rate-Limit-Reset", strconv.FormatInt(now.Add(time.Second).Unix(), 10))
+	}
+	body := fmt.Sprintf(`{"errorCode":%q,"errorSummary":%q,"errorLink":%q,"errorId":%q,"errorCauses":[]}`,
+		code, summary, code, header.Get("X-Okta-Request-Id"))
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,87 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Fault_Injection(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"00u1","status":"ACTIVE"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[]`), nil
+	})
+
+	// every matched request draws once for the latency and once for the fault
+	var draws []float64
+	faults := FaultInjection{
+		RateLimit:          0.1,
+		ServerError:        0.1,
+		Timeout:            0.1,
+		TimeoutAfter:       time.Millisecond,
+		MalformedJSON:      0.1,
+		Latency:            time.Millisecond,
+		LatencyProbability: 0.5,
+		Match: func(req *http.Request) bool {
+			return req.URL.Path != "/api/v1/groups"
+		},
+		Rand: func() float64 {
+			draw := draws[0]
+			draws = draws[1:]
+			return draw
+		},
+	}
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false),
+		WithRateLimitMaxRetries(0), WithFaultInjection(faults))
+	require.NoError(t, err, "Creating a new config should not error")
+	httpClient := configuration.HTTPClient
+	NewAPIClient(configuration)
+	client := NewAPIClient(configuration)
+	assert.Same(t, httpClient, configuration.HTTPClient, "The configuration should be left as is")
+	_, wrapped := client.httpClient.Transport.(*FaultInjector).Base.(*FaultInjector)
+	assert.False(t, wrapped, "Clients sharing the configuration should inject faults once")
+	ctx := context.Background()
+
+	draws = []float64{0, 0.05}
+	_, resp, err := client.UserAPI.GetUser(ctx, "00u1").Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, FaultRateLimit, resp.Header.Get(FaultInjectedHeader))
+	assert.Equal(t, "E0000047", ExplainError(err).Code)
+
+	draws = []float64{0, 0.15}
+	_, resp, err = client.UserAPI.GetUser(ctx, "00u1").Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	draws = []float64{0, 0.25}
+	_, _, err = client.UserAPI.GetUser(ctx, "00u1").Execute()
+	var netErr net.Error
+	require.True(t, errors.As(err, &netErr), "Injected timeouts are network errors")
+	assert.True(t, netErr.Timeout())
+
+	draws = []float64{0, 0.35}
+	_, _, err = client.UserAPI.GetUser(ctx, "00u1").Execute()
+	assert.Error(t, err, "A cut off body cannot be decoded")
+
+	draws = []float64{0, 0.5}
+	user, _, err := client.UserAPI.GetUser(ctx, "00u1").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "00u1", user.GetId())
+
+	_, _, err = client.GroupAPI.ListGroups(ctx).Execute()
+	require.NoError(t, err, "Requests Match rejects are not failed")
+	assert.Empty(t, draws)
+}
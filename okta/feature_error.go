@@ -0,0 +1,133 @@
+package okta
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrFeatureNotEnabled is matched by errors.Is when an endpoint is not
+// available to the org, because its SKU or feature flags do not include it.
+var ErrFeatureNotEnabled = errors.New("feature is not enabled for this org")
+
+// FeatureNotEnabledError is returned instead of a generic API error when Okta
+// answers that an endpoint is not available to the org.
+type FeatureNotEnabledError struct {
+	// Feature is the name of the feature the endpoint belongs to, or the
+	// error summary of Okta when the endpoint is not known.
+	Feature      string
+	StatusCode   int
+	ErrorCode    string
+	ErrorSummary string
+}
+
+func (e *FeatureNotEnabledError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", ErrFeatureNotEnabled, e.Feature, e.ErrorCode)
+}
+
+func (e *FeatureNotEnabledError) Is(target error) bool {
+	return target == ErrFeatureNotEnabled
+}
+
+// featureErrorCodes are the error codes Okta answers with when a feature is
+// missing from the org.
+var featureErrorCodes = map[string]bool{
+	"E0000015": true, // You do not have permission to access the feature you are requesting
+	"E0000022": true, // The endpoint does not support the provided HTTP method
+	"E0000060": true, // Unsupported operation
+}
+
+// Features of the org that provide API endpoints, as reported in
+// FeatureNotEnabledError and Capabilities.
+const (
+	FeatureCustomAdminRoles      = "Custom Admin Roles"
+	FeatureDeviceAssurance       = "Device Assurance"
+	FeatureDeviceManagement      = "Device Management"
+	FeatureRealms                = "Realms"
+	FeatureIdentitySources       = "Anything-as-a-Source"
+	FeatureCAPTCHA               = "CAPTCHA"
+	FeatureRiskScoring           = "Risk Scoring"
+	FeatureBehaviorDetection     = "Behavior Detection"
+	FeatureLogStreaming          = "Log Streaming"
+	FeatureCustomAuthenticator   = "Custom Authenticator"
+	FeatureCustomEmailDomains    = "Custom Email Domains"
+	FeaturePrincipalRateLimits   = "Principal Rate Limits"
+	FeatureDirectoryIntegrations = "Directory Integrations"
+	FeatureSharedSignals         = "Shared Signals Framework"
+	FeatureAPIAccessManagement   = "API Access Management"
+	FeatureOrgCreator            = "Org Creator"
+)
+
+// featurePaths maps API path prefixes to the feature that provides them.
+var featurePaths = []struct {
+	prefix  string
+	feature string
+}{
+	{"/api/v1/iam/resource-sets", FeatureCustomAdminRoles},
+	{"/api/v1/iam/roles", FeatureCustomAdminRoles},
+	{"/api/v1/device-assurances", FeatureDeviceAssurance},
+	{"/api/v1/devices", FeatureDeviceManagement},
+	{"/api/v1/realms", FeatureRealms},
+	{"/api/v1/realm-assignments", FeatureRealms},
+	{"/api/v1/identity-sources", FeatureIdentitySources},
+	{"/api/v1/captchas", FeatureCAPTCHA},
+	{"/api/v1/org/captcha", FeatureCAPTCHA},
+	{"/api/v1/risk", FeatureRiskScoring},
+	{"/api/v1/behaviors", FeatureBehaviorDetection},
+	{"/api/v1/logStreams", FeatureLogStreaming},
+	{"/api/v1/push-providers", FeatureCustomAuthenticator},
+	{"/api/v1/email-domains", FeatureCustomEmailDomains},
+	{"/api/v1/principal-rate-limits", FeaturePrincipalRateLimits},
+	{"/api/v1/directories", FeatureDirectoryIntegrations},
+	{"/api/v1/ssf", FeatureSharedSignals},
+	{"/api/v1/security-events-providers", FeatureSharedSignals},
+	{"/api/v1/authorizationServers", FeatureAPIAccessManagement},
+	{"/api/v1/orgs", FeatureOrgCreator},
+}
+
+// featureNotEnabledError returns a FeatureNotEnabledError for responses that
+// report an endpoint as unavailable to the org, and nil for any other
+// response.
+func featureNotEnabledError(req *http.Request, resp *http.Response) error {
+	switch resp.StatusCode {
+	case http.StatusForbidden, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+	default:
+		return nil
+	}
+	body, err := readAndRestoreBody(resp)
+	if err != nil {
+		return nil
+	}
+	var oktaErr Error
+	_ = json.Unmarshal(body, &oktaErr)
+	code := oktaErr.GetErrorCode()
+	if !featureErrorCodes[code] && resp.StatusCode != http.StatusNotImplemented {
+		return nil
+	}
+	// a forbidden feature code for an endpoint of the core API is a missing
+	// admin permission, not a missing feature
+	feature := featureOf(req.URL.Path)
+	if feature == "" && resp.StatusCode == http.StatusForbidden {
+		return nil
+	}
+	if feature == "" {
+		feature = oktaErr.GetErrorSummary()
+	}
+	return &FeatureNotEnabledError{
+		Feature:      feature,
+		StatusCode:   resp.StatusCode,
+		ErrorCode:    code,
+		ErrorSummary: oktaErr.GetErrorSummary(),
+	}
+}
+
+func featureOf(path string) string {
+	for _, f := range featurePaths {
+		if strings.HasPrefix(path, f.prefix) {
+			return f.feature
+		}
+	}
+	return ""
+}
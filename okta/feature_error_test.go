@@ -0,0 +1,49 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Feature_Not_Enabled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/realms", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(403, `{"errorCode":"E0000015","errorSummary":"You do not have permission to access the feature you are requesting"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(403, `{"errorCode":"E0000006","errorSummary":"You do not have permission to perform the requested action"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(501, `{"errorCode":"E0000060","errorSummary":"Unsupported operation."}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	_, _, err = client.RealmAPI.ListRealms(ctx).Execute()
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrFeatureNotEnabled))
+	var featureErr *FeatureNotEnabledError
+	require.True(t, errors.As(err, &featureErr))
+	assert.Equal(t, "Realms", featureErr.Feature)
+	assert.Equal(t, "E0000015", featureErr.ErrorCode)
+
+	_, _, err = client.GroupAPI.ListGroups(ctx).Execute()
+	require.True(t, errors.As(err, &featureErr))
+	assert.Equal(t, "Unsupported operation.", featureErr.Feature)
+	assert.Equal(t, http.StatusNotImplemented, featureErr.StatusCode)
+
+	_, _, err = client.UserAPI.ListUsers(ctx).Execute()
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrFeatureNotEnabled), "Missing permissions are not missing features")
+}
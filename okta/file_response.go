@@ -0,0 +1,79 @@
+package okta
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+)
+
+// TempFileOptions configures the temporary files file responses are written
+// to.
+type TempFileOptions struct {
+	// Dir is the directory of the files, the default directory for
+	// temporary files when empty.
+	Dir string
+	// Pattern is the name of the files as used by os.CreateTemp, where the
+	// last * is replaced by a random string. HttpClientFile when empty.
+	Pattern string
+}
+
+// TempFile is a file response written to a temporary file. Close removes it.
+type TempFile struct {
+	*os.File
+}
+
+// Close closes and removes the file.
+func (f *TempFile) Close() error {
+	err := f.File.Close()
+	if rmErr := os.Remove(f.Name()); err == nil && !errors.Is(rmErr, fs.ErrNotExist) {
+		err = rmErr
+	}
+	return err
+}
+
+// decodeFile decodes file responses into v. Besides *os.File, which the caller
+// has to remove, it supports a *TempFile removed on Close and the in-memory
+// []byte and io.Reader. It reports whether v is a file type.
+func (c *APIClient) decodeFile(v interface{}, b []byte) (bool, error) {
+	switch v := v.(type) {
+	case **os.File:
+		f, err := c.writeTempFile(b)
+		*v = f
+		return true, err
+	case **TempFile:
+		f, err := c.writeTempFile(b)
+		if f != nil {
+			*v = &TempFile{File: f}
+		}
+		return true, err
+	case *[]byte:
+		*v = b
+		return true, nil
+	case *io.Reader:
+		*v = bytes.NewReader(b)
+		return true, nil
+	}
+	return false, nil
+}
+
+func (c *APIClient) writeTempFile(b []byte) (*os.File, error) {
+	pattern := c.cfg.TempFiles.Pattern
+	if pattern == "" {
+		pattern = "HttpClientFile"
+	}
+	f, err := os.CreateTemp(c.cfg.TempFiles.Dir, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = f.Write(b); err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return f, nil
+}
@@ -0,0 +1,54 @@
+package okta
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Decode_File_Responses(t *testing.T) {
+	dir := t.TempDir()
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"),
+		WithTempFileOptions(TempFileOptions{Dir: dir, Pattern: "logo-*.png"}))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	content := []byte("file content")
+
+	var f *os.File
+	require.NoError(t, client.decode(&f, content, "application/octet-stream"))
+	defer os.Remove(f.Name())
+	assert.Equal(t, dir, filepath.Dir(f.Name()))
+	assert.True(t, strings.HasPrefix(filepath.Base(f.Name()), "logo-") && strings.HasSuffix(f.Name(), ".png"))
+	b, err := io.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, content, b)
+	f.Close()
+
+	var tf *TempFile
+	require.NoError(t, client.decode(&tf, content, "application/octet-stream"))
+	b, err = io.ReadAll(tf)
+	require.NoError(t, err)
+	assert.Equal(t, content, b)
+	require.NoError(t, tf.Close())
+	_, err = os.Stat(tf.Name())
+	assert.True(t, os.IsNotExist(err), "Closing a TempFile should remove it")
+
+	var raw []byte
+	require.NoError(t, client.decode(&raw, content, "application/octet-stream"))
+	assert.Equal(t, content, raw)
+
+	var r io.Reader
+	require.NoError(t, client.decode(&r, content, "application/octet-stream"))
+	b, err = io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, content, b)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "Only the *os.File should remain on disk")
+}
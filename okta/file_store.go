@@ -0,0 +1,135 @@
+package okta
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileStore is a CacheStore keeping its values in files of a directory, so
+// that the access tokens of a token store survive restarts of the process, or
+// are shared by the processes of a host. Values are written atomically with
+// mode 0600.
+type FileStore struct {
+	dir string
+}
+
+var _ PrefixStore = (*FileStore)(nil)
+
+// fileStoreEntry is the content of a file of a FileStore.
+type fileStoreEntry struct {
+	Key       string    `json:"key"`
+	Value     []byte    `json:"value"`
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+}
+
+// NewFileStore returns a FileStore keeping its values in dir, which is
+// created when missing.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Get implements CacheStore.
+func (s *FileStore) Get(key string) ([]byte, bool, error) {
+	entry, err := s.read(s.path(key))
+	if err != nil || entry == nil || entry.Key != key {
+		return nil, false, err
+	}
+	if !entry.ExpiresAt.IsZero() && !time.Now().Before(entry.ExpiresAt) {
+		return nil, false, s.Delete(key)
+	}
+	return entry.Value, true, nil
+}
+
+// Set implements CacheStore.
+func (s *FileStore) Set(key string, value []byte, ttl time.Duration) error {
+	entry := fileStoreEntry{Key: key, Value: value}
+	if ttl > 0 {
+		entry.ExpiresAt = time.Now().Add(ttl)
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path(key)); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// Delete implements CacheStore.
+func (s *FileStore) Delete(key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Clear implements CacheStore, deleting the values of the store.
+func (s *FileStore) Clear() error {
+	return s.DeletePrefix("")
+}
+
+// DeletePrefix implements PrefixStore.
+func (s *FileStore) DeletePrefix(prefix string) error {
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		entry, err := s.read(path)
+		if err != nil || entry == nil || !strings.HasPrefix(entry.Key, prefix) {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// path returns the file of key, named after its hash as keys are URLs.
+func (s *FileStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// read returns the entry of the file at path, or nil when there is none.
+func (s *FileStore) read(path string) (*fileStoreEntry, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entry fileStoreEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		// a file of another program, or a partial write of an old version
+		return nil, nil
+	}
+	return &entry, nil
+}
@@ -0,0 +1,131 @@
+package okta
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_File_Store(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tokens")
+	store, err := NewFileStore(dir)
+	require.NoError(t, err)
+
+	_, found, err := store.Get("missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, store.Set("https://example.okta.com/api/v1/users?limit=1", []byte("users"), time.Minute))
+	require.NoError(t, store.Set("https://example.okta.com/api/v1/groups", []byte("groups"), 0))
+	value, found, err := store.Get("https://example.okta.com/api/v1/users?limit=1")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "users", string(value))
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	require.Len(t, paths, 2, "Temporary files should be renamed")
+	info, err := os.Stat(paths[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm(), "Values should only be readable by the owner")
+
+	reopened, err := NewFileStore(dir)
+	require.NoError(t, err)
+	value, found, err = reopened.Get("https://example.okta.com/api/v1/groups")
+	require.NoError(t, err)
+	assert.True(t, found, "Values should survive the store")
+	assert.Equal(t, "groups", string(value))
+
+	require.NoError(t, store.DeletePrefix("https://example.okta.com/api/v1/users?"))
+	_, found, _ = store.Get("https://example.okta.com/api/v1/users?limit=1")
+	assert.False(t, found)
+	_, found, _ = store.Get("https://example.okta.com/api/v1/groups")
+	assert.True(t, found, "DeletePrefix should only delete the keys with the prefix")
+
+	require.NoError(t, store.Set("expired", []byte("old"), time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	_, found, err = store.Get("expired")
+	require.NoError(t, err)
+	assert.False(t, found, "Expired values should not be returned")
+
+	require.NoError(t, store.Delete("https://example.okta.com/api/v1/groups"))
+	require.NoError(t, store.Delete("https://example.okta.com/api/v1/groups"), "Deleting a missing key should not error")
+	require.NoError(t, store.Set("token", []byte("abc"), 0))
+	require.NoError(t, store.Clear())
+	paths, err = filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+}
+
+func Test_File_Token_Store_Survives_Restarts(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := NewCryptoSigner(key, "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		share         bool
+		tokenRequests int
+	}{
+		{name: "DPoP tokens shared", share: true, tokenRequests: 1},
+		{name: "DPoP tokens not shared", share: false, tokenRequests: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Activate()
+			defer httpmock.DeactivateAndReset()
+			var tokenRequests int
+			httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+				if req.Header.Get("DPoP") == "" {
+					return mockJSONResponse(400, `{"error":"invalid_dpop_proof"}`), nil
+				}
+				requireSelfSignedDPoPProof(t, req.Header.Get("DPoP"), "RS256")
+				tokenRequests++
+				// a handshake asks for a nonce first
+				resp := mockJSONResponse(400, `{"error":"use_dpop_nonce"}`)
+				if tokenRequests%2 == 0 {
+					resp = mockJSONResponse(200, `{"token_type":"DPoP","expires_in":3600,"access_token":"abc","scope":"okta.users.read"}`)
+				}
+				resp.Header.Set("DPoP-Nonce", "nonce")
+				return resp, nil
+			})
+			httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "DPoP abc", req.Header.Get("Authorization"))
+				requireSelfSignedDPoPProof(t, req.Header.Get("Dpop"), "RS256")
+				return mockJSONResponse(200, "[]"), nil
+			})
+
+			store, err := NewFileStore(t.TempDir())
+			require.NoError(t, err)
+			restart := func() {
+				configuration, err := NewConfiguration(
+					WithOrgUrl("https://example.okta.com"),
+					WithAuthorizationMode("PrivateKey"),
+					WithClientId("client"),
+					WithScopes([]string{"okta.users.read"}),
+					WithPrivateKeySigner(signer),
+					WithCache(false),
+					WithTokenStore(store),
+					WithShareDPoPTokens(tt.share),
+				)
+				require.NoError(t, err, "Creating a new config should not error")
+				_, _, err = NewAPIClient(configuration).UserAPI.ListUsers(context.Background()).Execute()
+				require.NoError(t, err)
+			}
+			restart()
+			restart()
+			assert.Equal(t, tt.tokenRequests*2, tokenRequests, "Restarted clients should only reuse shared DPoP tokens")
+		})
+	}
+}
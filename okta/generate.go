@@ -0,0 +1,18 @@
+package okta
+
+// Report operations from the bundled spec that have no generated service. Add
+// -stubs=api_uncovered.go to emit callable stubs for them.
+//go:generate go run ./internal/apicoverage -spec api/openapi.yaml -dir .
+
+// Write the OAuth 2.0 scopes required by every operation of the spec.
+//go:generate go run ./internal/opscopes -spec api/openapi.yaml -o operation_scopes.go
+
+// Write the schemas of the successful responses of every operation, checked by
+// the schemavalidate package.
+//go:generate go run ./internal/respschema -spec api/openapi.yaml -o schemavalidate/schemas.json
+
+// Write a ListAll method for every list operation of the generated services.
+//go:generate go run ./internal/listall -dir . -o list_all_methods.go
+
+// Write a Patch method for every partial update operation of the spec.
+//go:generate go run ./internal/patchops -spec api/openapi.yaml -dir . -o patch_methods.go
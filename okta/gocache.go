@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"net/http"
 	"net/http/httputil"
+	"strings"
 	"time"
 
 	patrickmnGoCache "github.com/patrickmn/go-cache"
@@ -45,6 +46,13 @@ func (c GoCache) Set(key string, value *http.Response) {
 	c.rootLibrary.Set(key, cacheableResponse, c.ttl)
 }
 
+// SetWithTTL caches value for ttl instead of the default TTL.
+func (c GoCache) SetWithTTL(key string, value *http.Response, ttl time.Duration) {
+	cacheableResponse, _ := httputil.DumpResponse(value, true)
+
+	c.rootLibrary.Set(key, cacheableResponse, ttl)
+}
+
 func (c GoCache) GetString(key string) string {
 	item, found := c.rootLibrary.Get(key)
 	if found {
@@ -62,6 +70,15 @@ func (c GoCache) Delete(key string) {
 	c.rootLibrary.Delete(key)
 }
 
+// DeletePrefix deletes every key starting with prefix.
+func (c GoCache) DeletePrefix(prefix string) {
+	for key := range c.rootLibrary.Items() {
+		if strings.HasPrefix(key, prefix) {
+			c.rootLibrary.Delete(key)
+		}
+	}
+}
+
 func (c GoCache) Clear() {
 	c.rootLibrary.Flush()
 }
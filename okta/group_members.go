@@ -0,0 +1,98 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	groupMembersPageSize = 1000
+	// groupMembershipChunk bounds the number of ids combined into one search
+	// expression by GroupMembership.
+	groupMembershipChunk = 50
+)
+
+// groupMemberID only decodes the id of a group member, which avoids
+// allocating the profile and links of every member of a large group.
+type groupMemberID struct {
+	Id string `json:"id"`
+}
+
+// ListGroupUserIDs returns the ids of all members of a group. Only the ids of
+// the members are decoded, so large groups can be enumerated without holding
+// their profiles in memory.
+func (c *APIClient) ListGroupUserIDs(ctx context.Context, groupID string) ([]string, error) {
+	var ids []string
+	err := c.listGroupMemberIDs(ctx, groupID, "", func(page []groupMemberID) {
+		for _, m := range page {
+			ids = append(ids, m.Id)
+		}
+	})
+	return ids, err
+}
+
+// IsMember reports whether a user is a direct member of a group without
+// enumerating the members of the group.
+func (c *APIClient) IsMember(ctx context.Context, groupID, userID string) (bool, error) {
+	members, err := c.GroupMembership(ctx, groupID, []string{userID})
+	if err != nil {
+		return false, err
+	}
+	return members[userID], nil
+}
+
+// GroupMembership checks which of the given users are direct members of a
+// group. The users are looked up in chunks with an id search on the members of
+// the group, the returned map contains an entry for every user.
+func (c *APIClient) GroupMembership(ctx context.Context, groupID string, userIDs []string) (map[string]bool, error) {
+	members := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		members[id] = false
+	}
+	for start := 0; start < len(userIDs); start += groupMembershipChunk {
+		end := min(start+groupMembershipChunk, len(userIDs))
+		terms := make([]string, 0, end-start)
+		for _, id := range userIDs[start:end] {
+			terms = append(terms, fmt.Sprintf("id eq %q", id))
+		}
+		err := c.listGroupMemberIDs(ctx, groupID, strings.Join(terms, " or "), func(page []groupMemberID) {
+			for _, m := range page {
+				if _, ok := members[m.Id]; ok {
+					members[m.Id] = true
+				}
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return members, nil
+}
+
+func (c *APIClient) listGroupMemberIDs(ctx context.Context, groupID, search string, handle func([]groupMemberID)) error {
+	query := url.Values{}
+	query.Set("limit", fmt.Sprint(groupMembersPageSize))
+	if search != "" {
+		query.Set("search", search)
+	}
+	path := "/api/v1/groups/" + url.PathEscape(groupID) + "/users"
+	return listPages(ctx, c, path, query, map[string]string{"Accept": "application/json"}, func(page []groupMemberID) error {
+		handle(page)
+		return nil
+	})
+}
+
+// userGroupIDs returns the ids of the groups a user is a member of.
+func (c *APIClient) userGroupIDs(ctx context.Context, userID string) ([]string, error) {
+	var ids []string
+	path := "/api/v1/users/" + url.PathEscape(userID) + "/groups"
+	err := listPages(ctx, c, path, url.Values{}, map[string]string{"Accept": "application/json"}, func(page []groupMemberID) error {
+		for _, g := range page {
+			ids = append(ids, g.Id)
+		}
+		return nil
+	})
+	return ids, err
+}
@@ -0,0 +1,70 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Group_Members(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	idTerm := regexp.MustCompile(`id eq "([^"]+)"`)
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00g1/users", func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		if search := q.Get("search"); search != "" {
+			var members []string
+			for _, m := range idTerm.FindAllStringSubmatch(search, -1) {
+				if strings.HasPrefix(m[1], "member") {
+					members = append(members, fmt.Sprintf(`{"id":%q,"profile":{"login":"x"}}`, m[1]))
+				}
+			}
+			return mockJSONResponse(200, "["+strings.Join(members, ",")+"]"), nil
+		}
+		if q.Get("after") == "" {
+			resp := mockJSONResponse(200, `[{"id":"member1","profile":{"login":"a"}},{"id":"member2"}]`)
+			resp.Header.Add("Link", `<https://example.okta.com/api/v1/groups/00g1/users?after=member2&limit=1000>; rel="next"`)
+			return resp, nil
+		}
+		return mockJSONResponse(200, `[{"id":"member3"}]`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	ids, err := client.ListGroupUserIDs(context.Background(), "00g1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"member1", "member2", "member3"}, ids)
+
+	ok, err := client.IsMember(context.Background(), "00g1", "member7")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = client.IsMember(context.Background(), "00g1", "other")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	var users []string
+	for i := 0; i < 120; i++ {
+		if i%2 == 0 {
+			users = append(users, fmt.Sprintf("member%d", i))
+		} else {
+			users = append(users, fmt.Sprintf("other%d", i))
+		}
+	}
+	httpmock.ZeroCallCounters()
+	members, err := client.GroupMembership(context.Background(), "00g1", users)
+	require.NoError(t, err)
+	require.Len(t, members, 120)
+	assert.True(t, members["member118"])
+	assert.False(t, members["other119"])
+	assert.Equal(t, 3, httpmock.GetTotalCallCount(), "Users should be checked in chunks")
+}
@@ -0,0 +1,165 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Types of group owners.
+const (
+	GroupOwnerTypeUser  = "USER"
+	GroupOwnerTypeGroup = "GROUP"
+)
+
+// defaultOwnedGroupsFilter selects the groups checked by OwnerlessGroups and
+// ReconcileGroupOwners unless a filter is given.
+const defaultOwnedGroupsFilter = `type eq "OKTA_GROUP"`
+
+// GroupOwnerAssignment is an owner assigned, or found already assigned, to a
+// group by AssignGroupOwners.
+type GroupOwnerAssignment struct {
+	GroupID string
+	OwnerID string
+	// Existing reports whether the owner was already assigned.
+	Existing bool
+}
+
+// AssignGroupOwners assigns every owner to every group, skipping owners a
+// group already has. Failed assignments do not stop the others, their errors
+// are returned together.
+func (c *APIClient) AssignGroupOwners(ctx context.Context, groupIDs []string, owners []AssignGroupOwnerRequestBody) ([]GroupOwnerAssignment, error) {
+	var (
+		assignments []GroupOwnerAssignment
+		errs        []error
+	)
+	for _, groupID := range groupIDs {
+		current, err := c.groupOwnerIDs(ctx, groupID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("group %s: %w", groupID, err))
+			continue
+		}
+		for _, owner := range owners {
+			ownerID := owner.GetId()
+			if _, ok := current[ownerID]; ok {
+				assignments = append(assignments, GroupOwnerAssignment{GroupID: groupID, OwnerID: ownerID, Existing: true})
+				continue
+			}
+			if _, _, err := c.GroupOwnerAPI.AssignGroupOwner(ctx, groupID).AssignGroupOwnerRequestBody(owner).Execute(); err != nil {
+				errs = append(errs, fmt.Errorf("group %s, owner %s: %w", groupID, ownerID, err))
+				continue
+			}
+			assignments = append(assignments, GroupOwnerAssignment{GroupID: groupID, OwnerID: ownerID})
+		}
+	}
+	return assignments, errors.Join(errs...)
+}
+
+// groupOwnerIDs returns the owners of a group by id, true for owners that
+// resolve to an active user or group.
+func (c *APIClient) groupOwnerIDs(ctx context.Context, groupID string) (map[string]bool, error) {
+	owners := map[string]bool{}
+	path := "/api/v1/groups/" + url.PathEscape(groupID) + "/owners"
+	err := listPages(ctx, c, path, url.Values{}, map[string]string{"Accept": "application/json"}, func(page []GroupOwner) error {
+		for _, o := range page {
+			owners[o.GetId()] = o.Resolved == nil || *o.Resolved
+		}
+		return nil
+	})
+	return owners, err
+}
+
+// OwnerlessGroups returns the groups matching filter that have no resolved
+// owner, with filter a group filter expression such as `type eq "APP_GROUP"`.
+// An empty filter selects the Okta groups.
+func (c *APIClient) OwnerlessGroups(ctx context.Context, filter string) ([]Group, error) {
+	var ownerless []Group
+	err := c.eachOwnedGroup(ctx, filter, func(group Group, owners int) error {
+		if owners == 0 {
+			ownerless = append(ownerless, group)
+		}
+		return nil
+	})
+	return ownerless, err
+}
+
+func (c *APIClient) eachOwnedGroup(ctx context.Context, filter string, handle func(group Group, owners int) error) error {
+	if filter == "" {
+		filter = defaultOwnedGroupsFilter
+	}
+	query := url.Values{}
+	query.Set("filter", filter)
+	return listPages(ctx, c, "/api/v1/groups", query, map[string]string{"Accept": "application/json"}, func(page []Group) error {
+		for _, group := range page {
+			owners, err := c.groupOwnerIDs(ctx, group.GetId())
+			if err != nil {
+				return err
+			}
+			resolved := 0
+			for _, ok := range owners {
+				if ok {
+					resolved++
+				}
+			}
+			if err := handle(group, resolved); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GroupOwnerPolicy is the policy enforced by ReconcileGroupOwners.
+type GroupOwnerPolicy struct {
+	// MinOwners is the number of resolved owners every group must have, 1 by
+	// default.
+	MinOwners int
+	// DefaultOwners are assigned to the groups with too few owners.
+	DefaultOwners []AssignGroupOwnerRequestBody
+	// Filter selects the groups the policy applies to, the Okta groups when
+	// empty.
+	Filter string
+	// DryRun reports the violations without assigning owners.
+	DryRun bool
+}
+
+// GroupOwnerReconciliation is the outcome of ReconcileGroupOwners.
+type GroupOwnerReconciliation struct {
+	// Checked is the number of groups the policy applies to.
+	Checked int
+	// Violations are the groups that had fewer owners than required.
+	Violations []Group
+	// Assigned are the owners assigned to the groups in violation.
+	Assigned []GroupOwnerAssignment
+}
+
+// ReconcileGroupOwners enforces that every group selected by the policy has
+// at least MinOwners resolved owners, assigning the default owners of the
+// policy to the groups that do not. Failed assignments are returned together
+// once every group was checked.
+func (c *APIClient) ReconcileGroupOwners(ctx context.Context, policy GroupOwnerPolicy) (*GroupOwnerReconciliation, error) {
+	if policy.MinOwners <= 0 {
+		policy.MinOwners = 1
+	}
+	if !policy.DryRun && len(policy.DefaultOwners) == 0 {
+		return nil, errors.New("group owner policy has no default owners to assign")
+	}
+	result := &GroupOwnerReconciliation{}
+	err := c.eachOwnedGroup(ctx, policy.Filter, func(group Group, owners int) error {
+		result.Checked++
+		if owners < policy.MinOwners {
+			result.Violations = append(result.Violations, group)
+		}
+		return nil
+	})
+	if err != nil || policy.DryRun {
+		return result, err
+	}
+	groupIDs := make([]string, 0, len(result.Violations))
+	for _, group := range result.Violations {
+		groupIDs = append(groupIDs, group.GetId())
+	}
+	result.Assigned, err = c.AssignGroupOwners(ctx, groupIDs, policy.DefaultOwners)
+	return result, err
+}
@@ -0,0 +1,68 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Reconcile_Group_Owners(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, `type eq "OKTA_GROUP"`, req.URL.Query().Get("filter"))
+		return mockJSONResponse(200, `[{"id":"00gOwned"},{"id":"00gOrphan"},{"id":"00gStale"}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00gOwned/owners", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[{"id":"00uOwner","type":"USER","resolved":true}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00gOrphan/owners", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00gStale/owners", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[{"id":"00uGone","type":"USER","resolved":false}]`), nil
+	})
+	assigned := map[string]string{}
+	for _, id := range []string{"00gOrphan", "00gStale"} {
+		groupID := id
+		httpmock.RegisterResponder("POST", "/api/v1/groups/"+groupID+"/owners", func(req *http.Request) (*http.Response, error) {
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assigned[groupID] = body["id"]
+			return mockJSONResponse(201, `{"id":"`+body["id"]+`","type":"USER","resolved":true}`), nil
+		})
+	}
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	ownerless, err := client.OwnerlessGroups(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, ownerless, 2)
+	assert.Equal(t, "00gOrphan", ownerless[0].GetId())
+	assert.Equal(t, "00gStale", ownerless[1].GetId(), "Unresolved owners do not count")
+
+	admin := AssignGroupOwnerRequestBody{Id: PtrString("00uAdmin"), Type: PtrString(GroupOwnerTypeUser)}
+	dry, err := client.ReconcileGroupOwners(ctx, GroupOwnerPolicy{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, 3, dry.Checked)
+	assert.Len(t, dry.Violations, 2)
+	assert.Empty(t, assigned)
+
+	result, err := client.ReconcileGroupOwners(ctx, GroupOwnerPolicy{DefaultOwners: []AssignGroupOwnerRequestBody{admin}})
+	require.NoError(t, err)
+	assert.Len(t, result.Assigned, 2)
+	assert.Equal(t, map[string]string{"00gOrphan": "00uAdmin", "00gStale": "00uAdmin"}, assigned)
+
+	assignments, err := client.AssignGroupOwners(ctx, []string{"00gOwned"}, []AssignGroupOwnerRequestBody{{Id: PtrString("00uOwner"), Type: PtrString(GroupOwnerTypeUser)}})
+	require.NoError(t, err)
+	assert.Equal(t, []GroupOwnerAssignment{{GroupID: "00gOwned", OwnerID: "00uOwner", Existing: true}}, assignments)
+}
@@ -0,0 +1,358 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultGroupResolverSize = 10000
+	defaultGroupResolverTTL  = 10 * time.Minute
+	// groupPreloadPageSize is the largest page of groups Okta returns.
+	groupPreloadPageSize = "10000"
+)
+
+var (
+	// ErrGroupNotFound is returned by a GroupResolver for an identifier no
+	// group has. IsNotFound reports it as well.
+	ErrGroupNotFound = errors.New("group not found")
+	// ErrAmbiguousGroupName is returned by a GroupResolver for a name several
+	// groups have, such as a group imported from an app named as an Okta
+	// group.
+	ErrAmbiguousGroupName = errors.New("several groups have the name")
+)
+
+// Kinds of identifiers resolved by a GroupResolver.
+const (
+	GroupIdentifierID         = "id"
+	GroupIdentifierName       = "name"
+	GroupIdentifierExternalID = "externalId"
+)
+
+// GroupIdentifiers are the identifiers of a group resolved by a
+// GroupResolver.
+type GroupIdentifiers struct {
+	ID   string
+	Name string
+	// Type is OKTA_GROUP, APP_GROUP or BUILT_IN.
+	Type string
+	// ExternalID is the profile attribute configured as external id, such as
+	// the id of a group imported from Active Directory, "" when it is not set.
+	ExternalID string
+}
+
+// GroupResolverOptions configures a GroupResolver.
+type GroupResolverOptions struct {
+	// Size is the number of groups kept in the cache, 10000 by default. The
+	// least recently used groups are evicted first.
+	Size int
+	// TTL is how long a group stays cached, 10 minutes by default, as groups
+	// can be renamed outside of the client.
+	TTL time.Duration
+	// ExternalIDAttribute is the profile attribute holding the external id of
+	// groups, "externalId" by default.
+	ExternalIDAttribute string
+}
+
+// GroupResolver resolves between the ids, names and external ids of groups,
+// caching what it resolved, like IDResolver does for users. Groups replaced
+// or deleted through the client it was created from are evicted as soon as
+// the call succeeds; Invalidate evicts groups changed elsewhere. Names are
+// not case sensitive. It is safe for concurrent use.
+type GroupResolver struct {
+	client *APIClient
+	opts   GroupResolverOptions
+	cache  *identifierCache[GroupIdentifiers]
+
+	mu sync.Mutex
+	// ambiguous holds the normalized names several groups have, which are
+	// not cached and resolve to ErrAmbiguousGroupName.
+	ambiguous map[string]bool
+}
+
+// GroupResolver returns a GroupResolver using the client. Close it once
+// unused, so that the client stops evicting groups from it.
+func (c *APIClient) GroupResolver(opts GroupResolverOptions) *GroupResolver {
+	if opts.Size <= 0 {
+		opts.Size = defaultGroupResolverSize
+	}
+	if opts.TTL <= 0 {
+		opts.TTL = defaultGroupResolverTTL
+	}
+	if opts.ExternalIDAttribute == "" {
+		opts.ExternalIDAttribute = defaultIDResolverExternalAttribute
+	}
+	r := &GroupResolver{client: c, opts: opts, ambiguous: map[string]bool{}}
+	r.cache = newIdentifierCache(opts.Size, opts.TTL, r.keys)
+	c.groupResolvers.Store(r, struct{}{})
+	return r
+}
+
+// Close stops the client from evicting the groups it writes from r.
+func (r *GroupResolver) Close() {
+	r.client.groupResolvers.Delete(r)
+}
+
+// Preload caches every group with a single paginated listing, for services
+// resolving groups on their hot path, and should be called at startup. Size
+// should exceed the number of groups of the org, or the groups listed first
+// are evicted.
+func (r *GroupResolver) Preload(ctx context.Context) error {
+	var groups []resolverGroup
+	query := url.Values{}
+	query.Set("limit", groupPreloadPageSize)
+	err := listPages(ctx, r.client, "/api/v1/groups", query, map[string]string{"Accept": "application/json"}, func(page []resolverGroup) error {
+		groups = append(groups, page...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	names := make(map[string]int, len(groups))
+	for _, group := range groups {
+		names[normalizeGroupName(group.name())]++
+	}
+	r.mu.Lock()
+	r.ambiguous = map[string]bool{}
+	for name, n := range names {
+		if n > 1 {
+			r.ambiguous[name] = true
+		}
+	}
+	r.mu.Unlock()
+	for _, group := range groups {
+		r.store(group)
+	}
+	return nil
+}
+
+// ByID returns the identifiers of the group with id.
+func (r *GroupResolver) ByID(ctx context.Context, id string) (GroupIdentifiers, error) {
+	return r.resolve(ctx, GroupIdentifierID, id)
+}
+
+// ByName returns the identifiers of the group named name, or
+// ErrAmbiguousGroupName when several groups have it.
+func (r *GroupResolver) ByName(ctx context.Context, name string) (GroupIdentifiers, error) {
+	return r.resolve(ctx, GroupIdentifierName, name)
+}
+
+// ByExternalID returns the identifiers of the group with externalID.
+func (r *GroupResolver) ByExternalID(ctx context.Context, externalID string) (GroupIdentifiers, error) {
+	return r.resolve(ctx, GroupIdentifierExternalID, externalID)
+}
+
+// Resolve returns the identifiers of the groups with the given identifiers
+// of kind, keyed by those identifiers. Identifiers no group has are left
+// out, and names several groups have fail with ErrAmbiguousGroupName. The
+// uncached ones are resolved with a search per 50 identifiers.
+func (r *GroupResolver) Resolve(ctx context.Context, kind string, values []string) (map[string]GroupIdentifiers, error) {
+	if _, ok := r.searchAttribute(kind); !ok {
+		return nil, fmt.Errorf("unknown group identifier kind %q", kind)
+	}
+	resolved := make(map[string]GroupIdentifiers, len(values))
+	var missing []string
+	for _, value := range values {
+		if group, ok := r.cached(kind, value); ok {
+			resolved[value] = group
+		} else {
+			missing = append(missing, value)
+		}
+	}
+	for start := 0; start < len(missing); start += idResolverChunk {
+		chunk := missing[start:min(start+idResolverChunk, len(missing))]
+		groups, err := r.search(ctx, kind, chunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range chunk {
+			var matches []GroupIdentifiers
+			for _, group := range groups {
+				if r.identifierOf(group, kind) == normalizeGroupIdentifier(kind, value) {
+					matches = append(matches, group)
+				}
+			}
+			if len(matches) > 1 {
+				return nil, fmt.Errorf("%w %q", ErrAmbiguousGroupName, value)
+			}
+			if len(matches) == 1 {
+				resolved[value] = matches[0]
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// Invalidate removes the group with id from the cache, for example when an
+// event hook reports that it was renamed.
+func (r *GroupResolver) Invalidate(id string) {
+	r.cache.delete(GroupIdentifierID + ":" + id)
+}
+
+func (r *GroupResolver) resolve(ctx context.Context, kind, value string) (GroupIdentifiers, error) {
+	if value == "" {
+		return GroupIdentifiers{}, fmt.Errorf("group %s is required", kind)
+	}
+	if group, ok := r.cached(kind, value); ok {
+		return group, nil
+	}
+	if kind == GroupIdentifierID {
+		var group resolverGroup
+		if _, err := r.client.Do(ctx, http.MethodGet, "/api/v1/groups/"+url.PathEscape(value), nil, &group); err != nil {
+			return GroupIdentifiers{}, err
+		}
+		return r.store(group), nil
+	}
+	groups, err := r.search(ctx, kind, []string{value})
+	if err != nil {
+		return GroupIdentifiers{}, err
+	}
+	switch len(groups) {
+	case 0:
+		attribute, _ := r.searchAttribute(kind)
+		return GroupIdentifiers{}, fmt.Errorf("%w: no group has the %s %s", ErrGroupNotFound, strings.TrimPrefix(attribute, "profile."), value)
+	case 1:
+		return groups[0], nil
+	}
+	return GroupIdentifiers{}, fmt.Errorf("%w %q", ErrAmbiguousGroupName, value)
+}
+
+// resolverGroup decodes the identifiers of a group.
+type resolverGroup struct {
+	Id      string                 `json:"id"`
+	Type    string                 `json:"type"`
+	Profile map[string]interface{} `json:"profile"`
+}
+
+func (g resolverGroup) name() string {
+	name, _ := g.Profile["name"].(string)
+	return name
+}
+
+// search returns the groups matching any of values, updating the names known
+// to be ambiguous.
+func (r *GroupResolver) search(ctx context.Context, kind string, values []string) ([]GroupIdentifiers, error) {
+	attribute, _ := r.searchAttribute(kind)
+	terms := make([]string, len(values))
+	for i, value := range values {
+		terms[i] = fmt.Sprintf("%s eq %q", attribute, value)
+	}
+	query := url.Values{}
+	query.Set("search", strings.Join(terms, " or "))
+	query.Set("limit", "200")
+	var found []resolverGroup
+	err := listPages(ctx, r.client, "/api/v1/groups", query, map[string]string{"Accept": "application/json"}, func(page []resolverGroup) error {
+		found = append(found, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if kind == GroupIdentifierName {
+		names := make(map[string]int, len(found))
+		for _, group := range found {
+			names[normalizeGroupName(group.name())]++
+		}
+		r.mu.Lock()
+		for _, value := range values {
+			name := normalizeGroupName(value)
+			if names[name] > 1 {
+				r.ambiguous[name] = true
+			} else {
+				delete(r.ambiguous, name)
+			}
+		}
+		r.mu.Unlock()
+	}
+	groups := make([]GroupIdentifiers, len(found))
+	for i, group := range found {
+		groups[i] = r.store(group)
+	}
+	return groups, nil
+}
+
+func (r *GroupResolver) searchAttribute(kind string) (string, bool) {
+	switch kind {
+	case GroupIdentifierID:
+		return "id", true
+	case GroupIdentifierName:
+		return "profile.name", true
+	case GroupIdentifierExternalID:
+		return "profile." + r.opts.ExternalIDAttribute, true
+	}
+	return "", false
+}
+
+func (r *GroupResolver) cached(kind, value string) (GroupIdentifiers, bool) {
+	return r.cache.get(kind + ":" + normalizeGroupIdentifier(kind, value))
+}
+
+// store caches group, replacing the entry of the same group.
+func (r *GroupResolver) store(g resolverGroup) GroupIdentifiers {
+	group := GroupIdentifiers{ID: g.Id, Name: g.name(), Type: g.Type}
+	group.ExternalID, _ = g.Profile[r.opts.ExternalIDAttribute].(string)
+	r.cache.put(group)
+	return group
+}
+
+// keys returns the cache keys of group, without its name when several groups
+// have it.
+func (r *GroupResolver) keys(group GroupIdentifiers) []string {
+	keys := []string{GroupIdentifierID + ":" + group.ID}
+	if name := normalizeGroupName(group.Name); name != "" {
+		r.mu.Lock()
+		ambiguous := r.ambiguous[name]
+		r.mu.Unlock()
+		if !ambiguous {
+			keys = append(keys, GroupIdentifierName+":"+name)
+		}
+	}
+	if group.ExternalID != "" {
+		keys = append(keys, GroupIdentifierExternalID+":"+group.ExternalID)
+	}
+	return keys
+}
+
+func (r *GroupResolver) identifierOf(group GroupIdentifiers, kind string) string {
+	switch kind {
+	case GroupIdentifierID:
+		return group.ID
+	case GroupIdentifierName:
+		return normalizeGroupName(group.Name)
+	default:
+		return group.ExternalID
+	}
+}
+
+func normalizeGroupIdentifier(kind, value string) string {
+	if kind == GroupIdentifierName {
+		return normalizeGroupName(value)
+	}
+	return value
+}
+
+func normalizeGroupName(name string) string {
+	return strings.ToLower(name)
+}
+
+// invalidateGroupResolvers evicts the group replaced or deleted by a
+// successful write with req from the resolvers of the client.
+func (c *APIClient) invalidateGroupResolvers(req *http.Request) {
+	if req.Method != http.MethodPut && req.Method != http.MethodDelete {
+		return
+	}
+	id, ok := strings.CutPrefix(req.URL.Path, "/api/v1/groups/")
+	if !ok || id == "" || strings.Contains(id, "/") {
+		return
+	}
+	c.groupResolvers.Range(func(r, _ interface{}) bool {
+		r.(*GroupResolver).Invalidate(id)
+		return true
+	})
+}
@@ -0,0 +1,92 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Group_Resolver(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var searches []string
+	httpmock.RegisterResponder("GET", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		search := req.URL.Query().Get("search")
+		switch search {
+		case "":
+			assert.Equal(t, "10000", req.URL.Query().Get("limit"))
+			if req.URL.Query().Get("after") == "" {
+				resp := mockJSONResponse(200, `[
+				  {"id":"00g1","type":"OKTA_GROUP","profile":{"name":"Engineering"}},
+				  {"id":"00g2","type":"APP_GROUP","profile":{"name":"Sales","externalId":"AD-2"}}
+				]`)
+				resp.Header.Add("Link", `<https://example.okta.com/api/v1/groups?after=00g2&limit=10000>; rel="next"`)
+				return resp, nil
+			}
+			return mockJSONResponse(200, `[{"id":"00g3","type":"OKTA_GROUP","profile":{"name":"sales"}}]`), nil
+		case `profile.name eq "Sales"`:
+			searches = append(searches, search)
+			return mockJSONResponse(200, `[
+			  {"id":"00g2","type":"APP_GROUP","profile":{"name":"Sales","externalId":"AD-2"}},
+			  {"id":"00g3","type":"OKTA_GROUP","profile":{"name":"sales"}}
+			]`), nil
+		case `profile.name eq "Finance"`:
+			searches = append(searches, search)
+			return mockJSONResponse(200, `[]`), nil
+		}
+		t.Errorf("unexpected search %s", search)
+		return mockJSONResponse(400, `{}`), nil
+	})
+	httpmock.RegisterResponder("PUT", "/api/v1/groups/00g1", httpmock.ResponderFromResponse(
+		mockJSONResponse(200, `{"id":"00g1","type":"OKTA_GROUP","profile":{"name":"Platform"}}`)))
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00g1", httpmock.ResponderFromResponse(
+		mockJSONResponse(200, `{"id":"00g1","type":"OKTA_GROUP","profile":{"name":"Platform"}}`)))
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	resolver := client.GroupResolver(GroupResolverOptions{})
+	defer resolver.Close()
+	ctx := context.Background()
+
+	require.NoError(t, resolver.Preload(ctx))
+	assert.Equal(t, 2, httpmock.GetTotalCallCount(), "Preloading should list every page of groups")
+
+	engineering, err := resolver.ByName(ctx, "engineering")
+	require.NoError(t, err)
+	assert.Equal(t, GroupIdentifiers{ID: "00g1", Name: "Engineering", Type: "OKTA_GROUP"}, engineering)
+	group, err := resolver.ByExternalID(ctx, "AD-2")
+	require.NoError(t, err)
+	assert.Equal(t, "00g2", group.ID)
+	group, err = resolver.ByID(ctx, "00g3")
+	require.NoError(t, err)
+	assert.Equal(t, "sales", group.Name)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount(), "Preloaded groups should be cached")
+
+	_, err = resolver.ByName(ctx, "Sales")
+	assert.ErrorIs(t, err, ErrAmbiguousGroupName)
+	_, err = resolver.ByName(ctx, "Finance")
+	assert.ErrorIs(t, err, ErrGroupNotFound)
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, []string{`profile.name eq "Sales"`, `profile.name eq "Finance"`}, searches)
+
+	groups, err := resolver.Resolve(ctx, GroupIdentifierName, []string{"Engineering", "Finance"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]GroupIdentifiers{"Engineering": engineering}, groups)
+
+	// renaming the group through the client evicts it
+	_, err = client.Do(ctx, http.MethodPut, "/api/v1/groups/00g1", map[string]interface{}{"profile": map[string]string{"name": "Platform"}}, nil)
+	require.NoError(t, err)
+	group, err = resolver.ByID(ctx, "00g1")
+	require.NoError(t, err)
+	assert.Equal(t, "Platform", group.Name)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET /api/v1/groups/00g1"])
+
+	_, err = resolver.Resolve(ctx, "description", []string{"x"})
+	assert.Error(t, err)
+}
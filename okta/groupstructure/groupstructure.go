@@ -0,0 +1,279 @@
+// Package groupstructure creates the groups of an org structure, such as its
+// departments and teams, with a naming convention, descriptions and optional
+// group rules assigning people to them.
+//
+// Okta groups are flat: the hierarchy of the structure is carried by the
+// names and descriptions of the groups. Apply is idempotent: groups and rules
+// are matched by name, missing ones are created and the descriptions and
+// expressions that differ from the structure are updated, so it can be run
+// again after the structure changed or a failure. Groups and rules of units
+// removed from the structure are left alone.
+package groupstructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+const (
+	defaultSeparator = " - "
+	// maxRuleName is the longest name Okta accepts for a group rule.
+	maxRuleName         = 50
+	groupRuleType       = "group_rule"
+	groupRuleExpression = "urn:okta:expression:1.0"
+)
+
+// Structure is a tree of units and the conventions of the names of their
+// groups.
+type Structure struct {
+	Units []Unit
+	// Prefix is prepended to the names of the groups, such as "org-".
+	Prefix string
+	// Separator joins the names of a unit and its parents, " - " by default,
+	// so that the group of the team Platform of Engineering is named
+	// "Engineering - Platform".
+	Separator string
+	// Name returns the name of the group of the unit at path, overriding
+	// Prefix and Separator.
+	Name func(path []string) string
+}
+
+// Unit is a department, team or any other node of the structure.
+type Unit struct {
+	Name string
+	// Description of the group, "Members of" the path of the unit by default.
+	Description string
+	// Rule is an Okta expression assigning people to the group, such as
+	// user.department=="Engineering". The group rule is named after the
+	// group, truncated to 50 characters.
+	Rule  string
+	Units []Unit
+}
+
+// Result holds the ids of the groups of the structure, created or found.
+type Result struct {
+	// GroupIDs maps the path of each unit, its names joined with "/", to the
+	// id of its group.
+	GroupIDs map[string]string
+	// Created and Updated list the groups and rules that were created or
+	// updated.
+	Created []string
+	Updated []string
+}
+
+// Apply creates or updates the groups and rules of structure in the org of
+// client. The structure is checked before anything is written.
+func Apply(ctx context.Context, client *okta.APIClient, structure Structure) (*Result, error) {
+	units, err := plan(structure)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := client.ListAllGroups(client.GroupAPI.ListGroups(ctx).Search(`type eq "OKTA_GROUP"`))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+	rules, err := client.ListAllGroupRules(client.GroupAPI.ListGroupRules(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group rules: %w", err)
+	}
+	a := &applier{
+		client: client,
+		groups: map[string]okta.Group{},
+		rules:  map[string]okta.GroupRule{},
+		result: &Result{GroupIDs: map[string]string{}},
+	}
+	for _, group := range groups {
+		if group.Profile != nil {
+			a.groups[group.Profile.GetName()] = group
+		}
+	}
+	for _, rule := range rules {
+		a.rules[rule.GetName()] = rule
+	}
+	for _, unit := range units {
+		groupID, err := a.applyGroup(ctx, unit.group, unit.description)
+		if err != nil {
+			return a.result, err
+		}
+		a.result.GroupIDs[unit.key] = groupID
+		if unit.expression != "" {
+			if err := a.applyRule(ctx, unit.rule, unit.expression, groupID); err != nil {
+				return a.result, err
+			}
+		}
+	}
+	return a.result, nil
+}
+
+// plannedUnit is a unit of the structure with the names of its group and
+// rule.
+type plannedUnit struct {
+	key         string
+	group       string
+	description string
+	rule        string
+	expression  string
+}
+
+// plan lists the units of structure, parents first, rejecting units without
+// a name and units whose groups or rules have the same name.
+func plan(structure Structure) ([]plannedUnit, error) {
+	separator := structure.Separator
+	if separator == "" {
+		separator = defaultSeparator
+	}
+	groupName := structure.Name
+	if groupName == nil {
+		groupName = func(path []string) string {
+			return structure.Prefix + strings.Join(path, separator)
+		}
+	}
+	var units []plannedUnit
+	groups, rules := map[string]string{}, map[string]string{}
+	var walk func(parent []string, children []Unit) error
+	walk = func(parent []string, children []Unit) error {
+		for _, unit := range children {
+			if unit.Name == "" {
+				return fmt.Errorf("unit of %q requires a name", strings.Join(parent, "/"))
+			}
+			path := append(append([]string(nil), parent...), unit.Name)
+			planned := plannedUnit{
+				key:         strings.Join(path, "/"),
+				group:       groupName(path),
+				description: unit.Description,
+				expression:  unit.Rule,
+			}
+			if other, ok := groups[planned.group]; ok {
+				return fmt.Errorf("units %s and %s are both named %q", other, planned.key, planned.group)
+			}
+			groups[planned.group] = planned.key
+			if planned.description == "" {
+				planned.description = "Members of " + strings.Join(path, " / ")
+			}
+			if planned.expression != "" {
+				planned.rule = ruleName(planned.group)
+				if other, ok := rules[planned.rule]; ok {
+					return fmt.Errorf("the rules of units %s and %s are both named %q", other, planned.key, planned.rule)
+				}
+				rules[planned.rule] = planned.key
+			}
+			units = append(units, planned)
+			if err := walk(path, unit.Units); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(nil, structure.Units); err != nil {
+		return nil, err
+	}
+	return units, nil
+}
+
+type applier struct {
+	client *okta.APIClient
+	groups map[string]okta.Group
+	rules  map[string]okta.GroupRule
+	result *Result
+}
+
+func (a *applier) applyGroup(ctx context.Context, name, description string) (string, error) {
+	group, ok := a.groups[name]
+	if !ok {
+		profile := okta.GroupProfile{}
+		profile.SetName(name)
+		profile.SetDescription(description)
+		created, _, err := a.client.GroupAPI.CreateGroup(ctx).Group(okta.Group{Profile: &profile}).Execute()
+		if err != nil {
+			return "", fmt.Errorf("failed to create group %s: %w", name, err)
+		}
+		a.result.Created = append(a.result.Created, "group "+name)
+		return created.GetId(), nil
+	}
+	if group.Profile.GetDescription() != description {
+		profile := *group.Profile
+		profile.SetDescription(description)
+		if _, _, err := a.client.GroupAPI.ReplaceGroup(ctx, group.GetId()).Group(okta.Group{Profile: &profile}).Execute(); err != nil {
+			return "", fmt.Errorf("failed to update group %s: %w", name, err)
+		}
+		a.result.Updated = append(a.result.Updated, "group "+name)
+	}
+	return group.GetId(), nil
+}
+
+func (a *applier) applyRule(ctx context.Context, name, expression, groupID string) error {
+	wanted := okta.GroupRule{
+		Name: okta.PtrString(name),
+		Type: okta.PtrString(groupRuleType),
+		Conditions: &okta.GroupRuleConditions{Expression: &okta.GroupRuleExpression{
+			Type:  okta.PtrString(groupRuleExpression),
+			Value: okta.PtrString(expression),
+		}},
+		Actions: &okta.GroupRuleAction{AssignUserToGroups: &okta.GroupRuleGroupAssignment{GroupIds: []string{groupID}}},
+	}
+	rule, ok := a.rules[name]
+	if !ok {
+		created, _, err := a.client.GroupAPI.CreateGroupRule(ctx).GroupRule(wanted).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to create group rule %s: %w", name, err)
+		}
+		// rules are created inactive
+		if _, err := a.client.GroupAPI.ActivateGroupRule(ctx, created.GetId()).Execute(); err != nil {
+			return fmt.Errorf("failed to activate group rule %s: %w", name, err)
+		}
+		a.result.Created = append(a.result.Created, "rule "+name)
+		return nil
+	}
+	if ruleMatches(rule, expression, groupID) {
+		if rule.GetStatus() == "INACTIVE" {
+			if _, err := a.client.GroupAPI.ActivateGroupRule(ctx, rule.GetId()).Execute(); err != nil {
+				return fmt.Errorf("failed to activate group rule %s: %w", name, err)
+			}
+			a.result.Updated = append(a.result.Updated, "rule "+name)
+		}
+		return nil
+	}
+	if rule.GetStatus() == "INVALID" {
+		return errors.New("group rule " + name + " is invalid, fix or delete it in the Admin Console")
+	}
+	// only inactive rules can be updated
+	if rule.GetStatus() == "ACTIVE" {
+		if _, err := a.client.GroupAPI.DeactivateGroupRule(ctx, rule.GetId()).Execute(); err != nil {
+			return fmt.Errorf("failed to deactivate group rule %s: %w", name, err)
+		}
+	}
+	wanted.Id = rule.Id
+	if _, _, err := a.client.GroupAPI.ReplaceGroupRule(ctx, rule.GetId()).GroupRule(wanted).Execute(); err != nil {
+		return fmt.Errorf("failed to update group rule %s: %w", name, err)
+	}
+	if _, err := a.client.GroupAPI.ActivateGroupRule(ctx, rule.GetId()).Execute(); err != nil {
+		return fmt.Errorf("failed to activate group rule %s: %w", name, err)
+	}
+	a.result.Updated = append(a.result.Updated, "rule "+name)
+	return nil
+}
+
+// ruleMatches reports whether rule assigns the people matching expression to
+// the group groupID and nothing else.
+func ruleMatches(rule okta.GroupRule, expression, groupID string) bool {
+	if rule.Conditions == nil || rule.Conditions.Expression == nil || rule.Conditions.Expression.GetValue() != expression {
+		return false
+	}
+	if rule.Actions == nil || rule.Actions.AssignUserToGroups == nil {
+		return false
+	}
+	ids := rule.Actions.AssignUserToGroups.GroupIds
+	return len(ids) == 1 && ids[0] == groupID
+}
+
+func ruleName(groupName string) string {
+	runes := []rune(groupName)
+	if len(runes) > maxRuleName {
+		runes = runes[:maxRuleName]
+	}
+	return string(runes)
+}
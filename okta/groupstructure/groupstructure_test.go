@@ -0,0 +1,139 @@
+package groupstructure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/okta-sdk-golang/v5/okta/internal/oktatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var structure = Structure{
+	Prefix: "org-",
+	Units: []Unit{{
+		Name: "Engineering",
+		Rule: `user.department=="Engineering"`,
+		Units: []Unit{
+			{Name: "Platform", Description: "Platform team", Rule: `user.team=="Platform"`},
+			{Name: "Mobile"},
+		},
+	}},
+}
+
+func Test_Apply(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, `type eq "OKTA_GROUP"`, req.URL.Query().Get("search"))
+		return oktatest.JSONResponse(200, `[]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups/rules", oktatest.JSONResponder(200, `[]`))
+	var groups []map[string]interface{}
+	httpmock.RegisterResponder("POST", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		var body map[string]map[string]interface{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		groups = append(groups, body["profile"])
+		id := map[string]string{"org-Engineering": "00gEng", "org-Engineering - Platform": "00gPlat", "org-Engineering - Mobile": "00gMob"}[body["profile"]["name"].(string)]
+		return oktatest.JSONResponse(200, `{"id":"`+id+`","type":"OKTA_GROUP"}`), nil
+	})
+	var rules []string
+	httpmock.RegisterResponder("POST", "/api/v1/groups/rules", func(req *http.Request) (*http.Response, error) {
+		var rule okta.GroupRule
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&rule))
+		assert.Equal(t, "urn:okta:expression:1.0", rule.Conditions.Expression.GetType())
+		rules = append(rules, rule.GetName()+": "+rule.Conditions.Expression.GetValue()+" -> "+rule.Actions.AssignUserToGroups.GroupIds[0])
+		return oktatest.JSONResponse(200, `{"id":"0pr`+rule.Actions.AssignUserToGroups.GroupIds[0]+`","status":"INACTIVE"}`), nil
+	})
+	httpmock.RegisterResponder("POST", `=~^/api/v1/groups/rules/\w+/lifecycle/activate\z`, httpmock.NewStringResponder(204, ""))
+
+	result, err := Apply(context.Background(), oktatest.NewClient(t), structure)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Engineering": "00gEng", "Engineering/Platform": "00gPlat", "Engineering/Mobile": "00gMob"}, result.GroupIDs)
+	assert.Equal(t, []map[string]interface{}{
+		{"name": "org-Engineering", "description": "Members of Engineering"},
+		{"name": "org-Engineering - Platform", "description": "Platform team"},
+		{"name": "org-Engineering - Mobile", "description": "Members of Engineering / Mobile"},
+	}, groups)
+	assert.Equal(t, []string{
+		`org-Engineering: user.department=="Engineering" -> 00gEng`,
+		`org-Engineering - Platform: user.team=="Platform" -> 00gPlat`,
+	}, rules)
+	assert.Equal(t, []string{
+		"group org-Engineering",
+		"rule org-Engineering",
+		"group org-Engineering - Platform",
+		"rule org-Engineering - Platform",
+		"group org-Engineering - Mobile",
+	}, result.Created)
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()[`POST =~^/api/v1/groups/rules/\w+/lifecycle/activate\z`], "New rules should be activated")
+}
+
+func Test_Apply_Existing(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/groups", oktatest.JSONResponder(200, `[
+	  {"id":"00gEng","type":"OKTA_GROUP","profile":{"name":"org-Engineering","description":"Members of Engineering"}},
+	  {"id":"00gPlat","type":"OKTA_GROUP","profile":{"name":"org-Engineering - Platform","description":"Platform"}},
+	  {"id":"00gMob","type":"OKTA_GROUP","profile":{"name":"org-Engineering - Mobile","description":"Members of Engineering / Mobile"}}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/groups/rules", oktatest.JSONResponder(200, `[
+	  {"id":"0prEng","name":"org-Engineering","status":"ACTIVE","type":"group_rule",
+	   "conditions":{"expression":{"type":"urn:okta:expression:1.0","value":"user.department==\"Engineering\""}},
+	   "actions":{"assignUserToGroups":{"groupIds":["00gEng"]}}},
+	  {"id":"0prPlat","name":"org-Engineering - Platform","status":"ACTIVE","type":"group_rule",
+	   "conditions":{"expression":{"type":"urn:okta:expression:1.0","value":"user.team==\"Core\""}},
+	   "actions":{"assignUserToGroups":{"groupIds":["00gPlat"]}}}
+	]`))
+	httpmock.RegisterResponder("PUT", "/api/v1/groups/00gPlat", func(req *http.Request) (*http.Response, error) {
+		var body map[string]map[string]interface{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, "Platform team", body["profile"]["description"])
+		return oktatest.JSONResponse(200, `{"id":"00gPlat"}`), nil
+	})
+	var calls []string
+	httpmock.RegisterResponder("POST", "/api/v1/groups/rules/0prPlat/lifecycle/deactivate", func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, "deactivate")
+		return httpmock.NewStringResponse(204, ""), nil
+	})
+	httpmock.RegisterResponder("PUT", "/api/v1/groups/rules/0prPlat", func(req *http.Request) (*http.Response, error) {
+		var rule okta.GroupRule
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&rule))
+		assert.Equal(t, `user.team=="Platform"`, rule.Conditions.Expression.GetValue())
+		calls = append(calls, "replace")
+		return oktatest.JSONResponse(200, `{"id":"0prPlat","status":"INACTIVE"}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/groups/rules/0prPlat/lifecycle/activate", func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, "activate")
+		return httpmock.NewStringResponse(204, ""), nil
+	})
+
+	result, err := Apply(context.Background(), oktatest.NewClient(t), structure)
+	require.NoError(t, err)
+	assert.Empty(t, result.Created)
+	assert.Equal(t, []string{"group org-Engineering - Platform", "rule org-Engineering - Platform"}, result.Updated)
+	assert.Equal(t, []string{"deactivate", "replace", "activate"}, calls, "Active rules should be deactivated to be updated")
+	assert.Equal(t, "00gMob", result.GroupIDs["Engineering/Mobile"])
+}
+
+func Test_Apply_Name_Collision(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	_, err := Apply(context.Background(), oktatest.NewClient(t), Structure{
+		Separator: "-",
+		Units: []Unit{
+			{Name: "Sales-EMEA"},
+			{Name: "Sales", Units: []Unit{{Name: "EMEA"}}},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `units Sales-EMEA and Sales/EMEA are both named "Sales-EMEA"`)
+	assert.Zero(t, httpmock.GetTotalCallCount(), "An invalid structure should be rejected before calling the org")
+}
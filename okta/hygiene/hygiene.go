@@ -0,0 +1,334 @@
+// Package hygiene finds orphaned and stale resources of an Okta org, such as
+// groups nobody is a member of, and can remove them.
+package hygiene
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// Kinds of findings reported by Scan.
+const (
+	// KindInactiveAppWithAssignments is a deactivated app that still has
+	// users or groups assigned. It is remediated by deleting the app.
+	KindInactiveAppWithAssignments = "inactiveAppWithAssignments"
+	// KindEmptyGroup is an Okta group without members that no group rule
+	// assigns users to. It is remediated by deleting the group.
+	KindEmptyGroup = "emptyGroup"
+	// KindUnusedNetworkZone is a network zone no policy rule refers to. It is
+	// remediated by deactivating and deleting the zone.
+	KindUnusedNetworkZone = "unusedNetworkZone"
+	// KindInactiveTrustedOrigin is a deactivated trusted origin. Trusted
+	// origins do not expire, deactivated ones are the stale ones. It is
+	// remediated by deleting the origin.
+	KindInactiveTrustedOrigin = "inactiveTrustedOrigin"
+)
+
+// AllKinds are the kinds scanned for when Options.Kinds is empty.
+var AllKinds = []string{KindInactiveAppWithAssignments, KindEmptyGroup, KindUnusedNetworkZone, KindInactiveTrustedOrigin}
+
+// zonePolicyTypes are the types of the policies whose rules can refer to
+// network zones.
+var zonePolicyTypes = []string{"OKTA_SIGN_ON", "ACCESS_POLICY", "MFA_ENROLL", "PASSWORD", "IDP_DISCOVERY", "PROFILE_ENROLLMENT"}
+
+// Finding is an orphaned or stale resource.
+type Finding struct {
+	Kind string
+	ID   string
+	Name string
+	// Reference is the id of the missing resource a finding of Lint refers
+	// to.
+	Reference string
+	// Detail explains why the resource was reported.
+	Detail string
+	// Remediated reports whether the finding was remediated by Scan.
+	Remediated bool
+	// Err is the error of a failed remediation.
+	Err error
+
+	remediate func(ctx context.Context) error
+}
+
+// Remediate removes the resource of the finding.
+func (f *Finding) Remediate(ctx context.Context) error {
+	if f.remediate == nil {
+		return fmt.Errorf("%s findings cannot be remediated", f.Kind)
+	}
+	if err := f.remediate(ctx); err != nil {
+		f.Err = err
+		return err
+	}
+	f.Remediated, f.Err = true, nil
+	return nil
+}
+
+// Options configures Scan.
+type Options struct {
+	// Kinds limits the scan to some kinds of findings, AllKinds when empty.
+	Kinds []string
+	// Remediate removes every resource found. Failed remediations are
+	// recorded in the Err of their finding.
+	Remediate bool
+}
+
+type scanner func(ctx context.Context, client *okta.APIClient) ([]Finding, error)
+
+var scanners = map[string]scanner{
+	KindInactiveAppWithAssignments: inactiveAppsWithAssignments,
+	KindEmptyGroup:                 emptyGroups,
+	KindUnusedNetworkZone:          unusedNetworkZones,
+	KindInactiveTrustedOrigin:      inactiveTrustedOrigins,
+}
+
+// Scan looks for orphaned and stale resources of the org of client and
+// returns them as findings, remediated when Options.Remediate is set.
+func Scan(ctx context.Context, client *okta.APIClient, opts Options) ([]Finding, error) {
+	kinds := opts.Kinds
+	if len(kinds) == 0 {
+		kinds = AllKinds
+	}
+	var findings []Finding
+	for _, kind := range kinds {
+		scan, ok := scanners[kind]
+		if !ok {
+			return nil, fmt.Errorf("unknown finding kind %q", kind)
+		}
+		found, err := scan(ctx, client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan for %s: %w", kind, err)
+		}
+		findings = append(findings, found...)
+	}
+	if opts.Remediate {
+		for i := range findings {
+			_ = findings[i].Remediate(ctx)
+		}
+	}
+	return findings, nil
+}
+
+func inactiveAppsWithAssignments(ctx context.Context, client *okta.APIClient) ([]Finding, error) {
+	apps, err := listAll(client.ApplicationAPI.ListApplications(ctx).Filter(`status eq "INACTIVE"`).Execute())
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, app := range apps {
+		fields, err := fieldsOf(app)
+		if err != nil {
+			return nil, err
+		}
+		id, label := str(fields, "id"), str(fields, "label")
+		users, _, err := client.ApplicationUsersAPI.ListApplicationUsers(ctx, id).Limit(1).Execute()
+		if err != nil {
+			return nil, err
+		}
+		groups, _, err := client.ApplicationGroupsAPI.ListApplicationGroupAssignments(ctx, id).Limit(1).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if len(users) == 0 && len(groups) == 0 {
+			continue
+		}
+		findings = append(findings, Finding{
+			Kind:   KindInactiveAppWithAssignments,
+			ID:     id,
+			Name:   label,
+			Detail: "the app is deactivated but users or groups are still assigned",
+			remediate: func(ctx context.Context) error {
+				_, err := client.ApplicationAPI.DeleteApplication(ctx, id).Execute()
+				return err
+			},
+		})
+	}
+	return findings, nil
+}
+
+func emptyGroups(ctx context.Context, client *okta.APIClient) ([]Finding, error) {
+	rules, err := listAll(client.GroupAPI.ListGroupRules(ctx).Execute())
+	if err != nil {
+		return nil, err
+	}
+	ruled := map[string]bool{}
+	if err := collectStrings(rules, ruled); err != nil {
+		return nil, err
+	}
+	groups, err := listAll(client.GroupAPI.ListGroups(ctx).Filter(`type eq "OKTA_GROUP"`).Execute())
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, g := range groups {
+		group := g.(okta.Group)
+		id := group.GetId()
+		if ruled[id] {
+			continue
+		}
+		members, _, err := client.GroupAPI.ListGroupUsers(ctx, id).Limit(1).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if len(members) > 0 {
+			continue
+		}
+		profile := group.GetProfile()
+		findings = append(findings, Finding{
+			Kind:   KindEmptyGroup,
+			ID:     id,
+			Name:   profile.GetName(),
+			Detail: "the group has no members and no group rule assigns users to it",
+			remediate: func(ctx context.Context) error {
+				_, err := client.GroupAPI.DeleteGroup(ctx, id).Execute()
+				return err
+			},
+		})
+	}
+	return findings, nil
+}
+
+func unusedNetworkZones(ctx context.Context, client *okta.APIClient) ([]Finding, error) {
+	referenced := map[string]bool{}
+	for _, policyType := range zonePolicyTypes {
+		policies, err := listAll(client.PolicyAPI.ListPolicies(ctx).Type_(policyType).Execute())
+		if err != nil {
+			return nil, err
+		}
+		if err := collectStrings(policies, referenced); err != nil {
+			return nil, err
+		}
+		for _, policy := range policies {
+			fields, err := fieldsOf(policy)
+			if err != nil {
+				return nil, err
+			}
+			rules, err := listAll(client.PolicyAPI.ListPolicyRules(ctx, str(fields, "id")).Execute())
+			if err != nil {
+				return nil, err
+			}
+			if err := collectStrings(rules, referenced); err != nil {
+				return nil, err
+			}
+		}
+	}
+	zones, err := listAll(client.NetworkZoneAPI.ListNetworkZones(ctx).Execute())
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, zone := range zones {
+		fields, err := fieldsOf(zone)
+		if err != nil {
+			return nil, err
+		}
+		id := str(fields, "id")
+		if fields["system"] == true || referenced[id] {
+			continue
+		}
+		active := str(fields, "status") == "ACTIVE"
+		findings = append(findings, Finding{
+			Kind:   KindUnusedNetworkZone,
+			ID:     id,
+			Name:   str(fields, "name"),
+			Detail: "no policy rule refers to the zone",
+			remediate: func(ctx context.Context) error {
+				if active {
+					if _, _, err := client.NetworkZoneAPI.DeactivateNetworkZone(ctx, id).Execute(); err != nil {
+						return err
+					}
+				}
+				_, err := client.NetworkZoneAPI.DeleteNetworkZone(ctx, id).Execute()
+				return err
+			},
+		})
+	}
+	return findings, nil
+}
+
+func inactiveTrustedOrigins(ctx context.Context, client *okta.APIClient) ([]Finding, error) {
+	origins, err := listAll(client.TrustedOriginAPI.ListTrustedOrigins(ctx).Filter(`status eq "INACTIVE"`).Execute())
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, o := range origins {
+		origin := o.(okta.TrustedOrigin)
+		id := origin.GetId()
+		findings = append(findings, Finding{
+			Kind:   KindInactiveTrustedOrigin,
+			ID:     id,
+			Name:   origin.GetName(),
+			Detail: fmt.Sprintf("the trusted origin %s is deactivated", origin.GetOrigin()),
+			remediate: func(ctx context.Context) error {
+				_, err := client.TrustedOriginAPI.DeleteTrustedOrigin(ctx, id).Execute()
+				return err
+			},
+		})
+	}
+	return findings, nil
+}
+
+// listAll collects every page of a list call.
+func listAll[T any](first []T, resp *okta.APIResponse, err error) ([]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	all := make([]interface{}, 0, len(first))
+	for _, item := range first {
+		all = append(all, item)
+	}
+	for resp.HasNextPage() {
+		var page []T
+		if resp, err = resp.Next(&page); err != nil {
+			return nil, err
+		}
+		for _, item := range page {
+			all = append(all, item)
+		}
+	}
+	return all, nil
+}
+
+func fieldsOf(resource interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	return fields, json.Unmarshal(data, &fields)
+}
+
+func str(fields map[string]interface{}, name string) string {
+	s, _ := fields[name].(string)
+	return s
+}
+
+// collectStrings adds every string value of resources to set, to find the ids
+// they refer to.
+func collectStrings(resources []interface{}, set map[string]bool) error {
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for _, field := range v {
+				walk(field)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		case string:
+			set[v] = true
+		}
+	}
+	for _, resource := range resources {
+		fields, err := fieldsOf(resource)
+		if err != nil {
+			return err
+		}
+		walk(fields)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package hygiene
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta/internal/oktatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Scan(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/apps", oktatest.JSONResponder(200, `[
+	  {"id":"0oaAssigned","label":"Legacy CRM","signOnMode":"BOOKMARK","status":"INACTIVE"},
+	  {"id":"0oaUnassigned","label":"Old Wiki","signOnMode":"BOOKMARK","status":"INACTIVE"}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/apps/0oaAssigned/users", oktatest.JSONResponder(200, `[{"id":"00u1"}]`))
+	httpmock.RegisterResponder("GET", "/api/v1/apps/0oaAssigned/groups", oktatest.JSONResponder(200, `[]`))
+	httpmock.RegisterResponder("GET", "/api/v1/apps/0oaUnassigned/users", oktatest.JSONResponder(200, `[]`))
+	httpmock.RegisterResponder("GET", "/api/v1/apps/0oaUnassigned/groups", oktatest.JSONResponder(200, `[]`))
+
+	httpmock.RegisterResponder("GET", "/api/v1/groups/rules", oktatest.JSONResponder(200, `[
+	  {"id":"0pr1","type":"group_rule","actions":{"assignUserToGroups":{"groupIds":["00gRuled"]}}}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/groups", oktatest.JSONResponder(200, `[
+	  {"id":"00gEmpty","type":"OKTA_GROUP","profile":{"name":"Project X"}},
+	  {"id":"00gRuled","type":"OKTA_GROUP","profile":{"name":"Engineering"}},
+	  {"id":"00gMembers","type":"OKTA_GROUP","profile":{"name":"Sales"}}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00gEmpty/users", oktatest.JSONResponder(200, `[]`))
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00gMembers/users", oktatest.JSONResponder(200, `[{"id":"00u1"}]`))
+
+	httpmock.RegisterResponder("GET", "/api/v1/policies", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("type") != "OKTA_SIGN_ON" {
+			return oktatest.JSONResponse(200, `[]`), nil
+		}
+		return oktatest.JSONResponse(200, `[{"id":"00p1","type":"OKTA_SIGN_ON","name":"Default"}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/policies/00p1/rules", oktatest.JSONResponder(200, `[
+	  {"id":"0pr2","type":"SIGN_ON","conditions":{"network":{"connection":"ZONE","include":["nzoUsed"]}}}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/zones", oktatest.JSONResponder(200, `[
+	  {"id":"nzoUsed","type":"IP","name":"Office","status":"ACTIVE"},
+	  {"id":"nzoUnused","type":"IP","name":"Old office","status":"ACTIVE"},
+	  {"id":"nzoLegacy","type":"IP","name":"LegacyIpZone","status":"ACTIVE","system":true}
+	]`))
+
+	httpmock.RegisterResponder("GET", "/api/v1/trustedOrigins", oktatest.JSONResponder(200, `[
+	  {"id":"tos1","name":"Old portal","origin":"https://portal.example.com","status":"INACTIVE"}
+	]`))
+
+	for _, path := range []string{"/api/v1/apps/0oaAssigned", "/api/v1/groups/00gEmpty", "/api/v1/zones/nzoUnused", "/api/v1/trustedOrigins/tos1"} {
+		httpmock.RegisterResponder("DELETE", path, httpmock.NewStringResponder(204, ""))
+	}
+	httpmock.RegisterResponder("POST", "/api/v1/zones/nzoUnused/lifecycle/deactivate", oktatest.JSONResponder(200, `{"id":"nzoUnused","type":"IP","status":"INACTIVE"}`))
+
+	client := oktatest.NewClient(t)
+
+	findings, err := Scan(context.Background(), client, Options{Remediate: true})
+	require.NoError(t, err)
+	var found []string
+	for _, f := range findings {
+		found = append(found, f.Kind+" "+f.ID)
+		assert.True(t, f.Remediated, f.ID)
+		assert.NoError(t, f.Err, f.ID)
+	}
+	assert.Equal(t, []string{
+		KindInactiveAppWithAssignments + " 0oaAssigned",
+		KindEmptyGroup + " 00gEmpty",
+		KindUnusedNetworkZone + " nzoUnused",
+		KindInactiveTrustedOrigin + " tos1",
+	}, found)
+
+	calls := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, calls["POST /api/v1/zones/nzoUnused/lifecycle/deactivate"])
+	assert.Equal(t, 1, calls["DELETE /api/v1/zones/nzoUnused"])
+	assert.Equal(t, 1, calls["DELETE /api/v1/groups/00gEmpty"])
+}
+
+func Test_Lint(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/groups", oktatest.JSONResponder(200, `[
+	  {"id":"00gEng","type":"OKTA_GROUP","profile":{"name":"Engineering"}},
+	  {"id":"00gAD","type":"APP_GROUP","profile":{"name":"AD Sales"},"_links":{"source":{"href":"https://example.okta.com/api/v1/apps/0oaAD"}}}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/zones", oktatest.JSONResponder(200, `[
+	  {"id":"nzoOffice","type":"IP","name":"Office","status":"ACTIVE"}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/apps", oktatest.JSONResponder(200, `[
+	  {"id":"0oaCRM","label":"CRM","signOnMode":"BOOKMARK","status":"ACTIVE"},
+	  {"id":"0oaAD","label":"Active Directory","signOnMode":"BOOKMARK","status":"INACTIVE"}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/apps/0oaCRM/groups", oktatest.JSONResponder(200, `[{"id":"00gEng"},{"id":"00gAD"},{"id":"00gDeleted"}]`))
+	httpmock.RegisterResponder("GET", "/api/v1/policies", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("type") != "OKTA_SIGN_ON" {
+			return oktatest.JSONResponse(200, `[]`), nil
+		}
+		return oktatest.JSONResponse(200, `[{"id":"00p1","type":"OKTA_SIGN_ON","name":"Default","conditions":{"people":{"groups":{"include":["00gEng"]}}}}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/policies/00p1/rules", oktatest.JSONResponder(200, `[
+	  {"id":"0pr1","type":"SIGN_ON","name":"Office only","conditions":{
+	    "people":{"groups":{"exclude":["00gGone"]},"users":{"exclude":["00uGone","00uAlice"]}},
+	    "network":{"connection":"ZONE","include":["nzoOffice","nzoGone"]}}},
+	  {"id":"0pr2","type":"SIGN_ON","name":"Anywhere","conditions":{"network":{"connection":"ZONE","include":["ALL_ZONES"]}}}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/groups/rules", oktatest.JSONResponder(200, `[
+	  {"id":"0pr3","type":"group_rule","name":"Contractors","actions":{"assignUserToGroups":{"groupIds":["00gEng","00gOld"]}}}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/users/00uAlice", oktatest.JSONResponder(200, `{"id":"00uAlice"}`))
+	httpmock.RegisterResponder("GET", "/api/v1/users/00uGone", func(req *http.Request) (*http.Response, error) {
+		return oktatest.JSONResponse(404, `{"errorCode":"E0000007","errorSummary":"Not found: Resource not found: 00uGone (User)"}`), nil
+	})
+
+	client := oktatest.NewClient(t)
+
+	findings, err := Lint(context.Background(), client)
+	require.NoError(t, err)
+	var found []string
+	for _, f := range findings {
+		found = append(found, f.Kind+" "+f.ID+" -> "+f.Reference)
+	}
+	assert.Equal(t, []string{
+		KindDanglingGroupReference + " 0pr1 -> 00gGone",
+		KindDanglingUserReference + " 0pr1 -> 00uGone",
+		KindDanglingZoneReference + " 0pr1 -> nzoGone",
+		KindDanglingGroupReference + " 0pr3 -> 00gOld",
+		KindStaleGroupAssignment + " 0oaCRM -> 00gAD",
+		KindDanglingGroupReference + " 0oaCRM -> 00gDeleted",
+	}, found)
+	assert.Equal(t, "rule Office only of policy Default refers to network zone nzoGone, which does not exist", findings[2].Detail)
+	assert.Error(t, findings[0].Remediate(context.Background()), "Dangling references cannot be remediated")
+}
@@ -0,0 +1,289 @@
+package hygiene
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// Kinds of findings reported by Lint. The ID and Name of these findings are
+// the ones of the resource holding the reference, and Reference is the id it
+// refers to. They are not remediated automatically, as the right fix, such
+// as pointing a rule at another group, needs an admin.
+const (
+	// KindDanglingGroupReference is a policy, policy rule, group rule or app
+	// assignment referring to a group that does not exist.
+	KindDanglingGroupReference = "danglingGroupReference"
+	// KindDanglingUserReference is a policy rule or group rule referring to a
+	// user that does not exist.
+	KindDanglingUserReference = "danglingUserReference"
+	// KindDanglingZoneReference is a policy rule referring to a network zone
+	// that does not exist.
+	KindDanglingZoneReference = "danglingZoneReference"
+	// KindStaleGroupAssignment is an app assignment of a group imported from
+	// an app that is deactivated, whose members are no longer updated.
+	KindStaleGroupAssignment = "staleGroupAssignment"
+)
+
+// LintKinds are the kinds reported by Lint, which also reports the network
+// zones no policy refers to.
+var LintKinds = []string{KindDanglingGroupReference, KindDanglingUserReference, KindDanglingZoneReference, KindStaleGroupAssignment, KindUnusedNetworkZone}
+
+// allZones is the value of network conditions matching any zone.
+const allZones = "ALL_ZONES"
+
+// Lint verifies the references between the resources of the org of client,
+// which manual changes in the Admin Console break silently, and reports the
+// dangling ones.
+func Lint(ctx context.Context, client *okta.APIClient) ([]Finding, error) {
+	l := &linter{client: client, users: map[string]bool{}}
+	if err := l.load(ctx); err != nil {
+		return nil, err
+	}
+	for _, lint := range []func(context.Context) error{l.lintPolicies, l.lintGroupRules, l.lintAppAssignments} {
+		if err := lint(ctx); err != nil {
+			return nil, err
+		}
+	}
+	unused, err := unusedNetworkZones(ctx, l.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lint network zones: %w", err)
+	}
+	for i := range unused {
+		unused[i].remediate = nil
+	}
+	return append(l.findings, unused...), nil
+}
+
+type linter struct {
+	client *okta.APIClient
+	// groups maps the ids of the groups to their fields, zones and apps the
+	// ids of the zones and apps to their status.
+	groups map[string]map[string]interface{}
+	zones  map[string]string
+	apps   map[string]string
+	// users caches whether the users referred to exist.
+	users    map[string]bool
+	findings []Finding
+}
+
+func (l *linter) load(ctx context.Context) error {
+	groups, err := listAll(l.client.GroupAPI.ListGroups(ctx).Execute())
+	if err != nil {
+		return fmt.Errorf("failed to list groups: %w", err)
+	}
+	l.groups = map[string]map[string]interface{}{}
+	for _, group := range groups {
+		fields, err := fieldsOf(group)
+		if err != nil {
+			return err
+		}
+		l.groups[str(fields, "id")] = fields
+	}
+	zones, err := listAll(l.client.NetworkZoneAPI.ListNetworkZones(ctx).Execute())
+	if err != nil {
+		return fmt.Errorf("failed to list network zones: %w", err)
+	}
+	if l.zones, err = statuses(zones); err != nil {
+		return err
+	}
+	apps, err := listAll(l.client.ApplicationAPI.ListApplications(ctx).Execute())
+	if err != nil {
+		return fmt.Errorf("failed to list apps: %w", err)
+	}
+	l.apps, err = statuses(apps)
+	return err
+}
+
+func (l *linter) lintPolicies(ctx context.Context) error {
+	for _, policyType := range zonePolicyTypes {
+		policies, err := listAll(l.client.PolicyAPI.ListPolicies(ctx).Type_(policyType).Execute())
+		if err != nil {
+			return fmt.Errorf("failed to list %s policies: %w", policyType, err)
+		}
+		for _, p := range policies {
+			policy, err := fieldsOf(p)
+			if err != nil {
+				return err
+			}
+			policyID, policyName := str(policy, "id"), str(policy, "name")
+			holder := "policy " + policyName
+			l.checkGroups(policyID, policyName, holder, stringsAt(policy, "conditions", "people", "groups", "include"), stringsAt(policy, "conditions", "people", "groups", "exclude"))
+
+			rules, err := listAll(l.client.PolicyAPI.ListPolicyRules(ctx, policyID).Execute())
+			if err != nil {
+				return fmt.Errorf("failed to list the rules of policy %s: %w", policyName, err)
+			}
+			for _, r := range rules {
+				rule, err := fieldsOf(r)
+				if err != nil {
+					return err
+				}
+				ruleID, ruleName := str(rule, "id"), str(rule, "name")
+				holder := fmt.Sprintf("rule %s of policy %s", ruleName, policyName)
+				l.checkGroups(ruleID, ruleName, holder, stringsAt(rule, "conditions", "people", "groups", "include"), stringsAt(rule, "conditions", "people", "groups", "exclude"))
+				if err := l.checkUsers(ctx, ruleID, ruleName, holder, stringsAt(rule, "conditions", "people", "users", "include"), stringsAt(rule, "conditions", "people", "users", "exclude")); err != nil {
+					return err
+				}
+				l.checkZones(ruleID, ruleName, holder, stringsAt(rule, "conditions", "network", "include"), stringsAt(rule, "conditions", "network", "exclude"))
+			}
+		}
+	}
+	return nil
+}
+
+func (l *linter) lintGroupRules(ctx context.Context) error {
+	rules, err := listAll(l.client.GroupAPI.ListGroupRules(ctx).Execute())
+	if err != nil {
+		return fmt.Errorf("failed to list group rules: %w", err)
+	}
+	for _, r := range rules {
+		rule, err := fieldsOf(r)
+		if err != nil {
+			return err
+		}
+		id, name := str(rule, "id"), str(rule, "name")
+		holder := "group rule " + name
+		l.checkGroups(id, name, holder, stringsAt(rule, "actions", "assignUserToGroups", "groupIds"), stringsAt(rule, "conditions", "people", "groups", "exclude"))
+		if err := l.checkUsers(ctx, id, name, holder, stringsAt(rule, "conditions", "people", "users", "exclude")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *linter) lintAppAssignments(ctx context.Context) error {
+	appIDs := make([]string, 0, len(l.apps))
+	for appID, status := range l.apps {
+		if status == "ACTIVE" {
+			appIDs = append(appIDs, appID)
+		}
+	}
+	sort.Strings(appIDs)
+	for _, appID := range appIDs {
+		assignments, err := listAll(l.client.ApplicationGroupsAPI.ListApplicationGroupAssignments(ctx, appID).Execute())
+		if err != nil {
+			return fmt.Errorf("failed to list the groups of app %s: %w", appID, err)
+		}
+		for _, a := range assignments {
+			assignment, err := fieldsOf(a)
+			if err != nil {
+				return err
+			}
+			groupID := str(assignment, "id")
+			holder := "assignment of app " + appID
+			group, ok := l.groups[groupID]
+			if !ok {
+				l.report(KindDanglingGroupReference, appID, "", groupID, holder+" refers to group "+groupID+", which does not exist")
+				continue
+			}
+			source := groupSourceApp(group)
+			if source != "" && l.apps[source] != "" && l.apps[source] != "ACTIVE" {
+				profile, _ := group["profile"].(map[string]interface{})
+				l.report(KindStaleGroupAssignment, appID, "", groupID,
+					fmt.Sprintf("%s refers to group %s imported from app %s, which is deactivated", holder, str(profile, "name"), source))
+			}
+		}
+	}
+	return nil
+}
+
+func (l *linter) checkGroups(id, name, holder string, lists ...[]string) {
+	for _, list := range lists {
+		for _, groupID := range list {
+			if _, ok := l.groups[groupID]; !ok {
+				l.report(KindDanglingGroupReference, id, name, groupID, holder+" refers to group "+groupID+", which does not exist")
+			}
+		}
+	}
+}
+
+func (l *linter) checkZones(id, name, holder string, lists ...[]string) {
+	for _, list := range lists {
+		for _, zoneID := range list {
+			if zoneID == allZones {
+				continue
+			}
+			if _, ok := l.zones[zoneID]; !ok {
+				l.report(KindDanglingZoneReference, id, name, zoneID, holder+" refers to network zone "+zoneID+", which does not exist")
+			}
+		}
+	}
+}
+
+func (l *linter) checkUsers(ctx context.Context, id, name, holder string, lists ...[]string) error {
+	for _, list := range lists {
+		for _, userID := range list {
+			exists, ok := l.users[userID]
+			if !ok {
+				_, _, err := l.client.UserAPI.GetUser(ctx, userID).Execute()
+				switch {
+				case okta.IsNotFound(err):
+				case err != nil:
+					return fmt.Errorf("failed to get user %s: %w", userID, err)
+				default:
+					exists = true
+				}
+				l.users[userID] = exists
+			}
+			if !exists {
+				l.report(KindDanglingUserReference, id, name, userID, holder+" refers to user "+userID+", which does not exist")
+			}
+		}
+	}
+	return nil
+}
+
+func (l *linter) report(kind, id, name, reference, detail string) {
+	l.findings = append(l.findings, Finding{Kind: kind, ID: id, Name: name, Reference: reference, Detail: detail})
+}
+
+// statuses maps the ids of resources to their status.
+func statuses(resources []interface{}) (map[string]string, error) {
+	byID := make(map[string]string, len(resources))
+	for _, resource := range resources {
+		fields, err := fieldsOf(resource)
+		if err != nil {
+			return nil, err
+		}
+		byID[str(fields, "id")] = str(fields, "status")
+	}
+	return byID, nil
+}
+
+// groupSourceApp returns the id of the app an APP_GROUP was imported from.
+func groupSourceApp(group map[string]interface{}) string {
+	if str(group, "type") != "APP_GROUP" {
+		return ""
+	}
+	links, _ := group["_links"].(map[string]interface{})
+	source, _ := links["source"].(map[string]interface{})
+	href := str(source, "href")
+	if i := strings.LastIndex(href, "/apps/"); i >= 0 {
+		return href[i+len("/apps/"):]
+	}
+	return ""
+}
+
+// stringsAt returns the strings of the list at path in fields.
+func stringsAt(fields map[string]interface{}, path ...string) []string {
+	var value interface{} = fields
+	for _, name := range path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	list, _ := value.([]interface{})
+	var values []string
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,16 @@
+package okta
+
+import "github.com/google/uuid"
+
+// IDGenerator returns the unique IDs (jti) of the client assertions and DPoP
+// proofs the client signs. Recorded tests can set one returning a predictable
+// sequence, so cassettes and golden files do not change between runs.
+type IDGenerator func() string
+
+// newID returns a new ID, a random UUID when g is nil.
+func (g IDGenerator) newID() string {
+	if g == nil {
+		return uuid.New().String()
+	}
+	return g()
+}
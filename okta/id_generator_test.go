@@ -0,0 +1,75 @@
+package okta
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ID_Generator(t *testing.T) {
+	assertionKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	dpopKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := NewCryptoSigner(hardwareSigner{assertionKey}, "key")
+	require.NoError(t, err)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var seen []string
+	jti := func(token string) string {
+		parsed, err := jwt.ParseSigned(token)
+		require.NoError(t, err)
+		var claims jwt.Claims
+		require.NoError(t, parsed.UnsafeClaimsWithoutVerification(&claims))
+		return claims.ID
+	}
+	httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, req.ParseForm())
+		seen = append(seen, jti(req.PostForm.Get("client_assertion")))
+		if req.Header.Get("DPoP") == "" {
+			return mockJSONResponse(400, `{"error":"invalid_dpop_proof"}`), nil
+		}
+		seen = append(seen, jti(req.Header.Get("DPoP")))
+		return mockJSONResponse(200, `{"token_type":"DPoP","expires_in":3600,"access_token":"token","scope":"okta.users.read"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		seen = append(seen, jti(req.Header.Get("Dpop")))
+		return mockJSONResponse(200, "[]"), nil
+	})
+
+	var generated []string
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithAuthorizationMode("PrivateKey"),
+		WithClientId("client"),
+		WithScopes([]string{"okta.users.read"}),
+		WithPrivateKeySigner(signer),
+		WithDPoPSigner(hardwareSigner{dpopKey}),
+		WithCache(false),
+		WithIDGenerator(func() string {
+			id := fmt.Sprintf("id-%d", len(generated)+1)
+			generated = append(generated, id)
+			return id
+		}),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err)
+	require.NotEmpty(t, seen)
+	for _, id := range seen {
+		assert.Contains(t, generated, id, "Every jti should come from the generator")
+	}
+}
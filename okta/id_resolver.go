@@ -0,0 +1,234 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultIDResolverSize              = 10000
+	defaultIDResolverTTL               = 10 * time.Minute
+	defaultIDResolverExternalAttribute = "externalId"
+	// idResolverChunk bounds the number of identifiers combined into one
+	// search expression.
+	idResolverChunk = 50
+)
+
+// ErrUserNotFound is returned by an IDResolver for an identifier no user has.
+// IsNotFound reports it as well.
+var ErrUserNotFound = errors.New("user not found")
+
+// Kinds of identifiers resolved by an IDResolver.
+const (
+	UserIdentifierID         = "id"
+	UserIdentifierLogin      = "login"
+	UserIdentifierExternalID = "externalId"
+)
+
+// UserIdentifiers are the identifiers of a user resolved by an IDResolver.
+type UserIdentifiers struct {
+	ID    string
+	Login string
+	// ExternalID is the profile attribute configured as external id, such as
+	// the id of the user in an HR system, "" when it is not set.
+	ExternalID string
+}
+
+// IDResolverOptions configures an IDResolver.
+type IDResolverOptions struct {
+	// Size is the number of users kept in the cache, 10000 by default. The
+	// least recently used users are evicted first.
+	Size int
+	// TTL is how long a user stays cached, 10 minutes by default, as logins
+	// and external ids can change.
+	TTL time.Duration
+	// ExternalIDAttribute is the profile attribute holding the external id of
+	// users, "externalId" by default.
+	ExternalIDAttribute string
+}
+
+// IDResolver resolves between the ids, logins and external ids of users,
+// caching what it resolved, for services that translate identifiers on
+// every request. Single lookups of ids and logins get the user, the cheapest
+// call, and batches are resolved by searches combining up to 50 identifiers.
+// It is safe for concurrent use.
+type IDResolver struct {
+	client *APIClient
+	opts   IDResolverOptions
+	cache  *identifierCache[UserIdentifiers]
+}
+
+// IDResolver returns an IDResolver using the client.
+func (c *APIClient) IDResolver(opts IDResolverOptions) *IDResolver {
+	if opts.Size <= 0 {
+		opts.Size = defaultIDResolverSize
+	}
+	if opts.TTL <= 0 {
+		opts.TTL = defaultIDResolverTTL
+	}
+	if opts.ExternalIDAttribute == "" {
+		opts.ExternalIDAttribute = defaultIDResolverExternalAttribute
+	}
+	return &IDResolver{client: c, opts: opts, cache: newIdentifierCache(opts.Size, opts.TTL, entryKeys)}
+}
+
+// ByID returns the identifiers of the user with id.
+func (r *IDResolver) ByID(ctx context.Context, id string) (UserIdentifiers, error) {
+	return r.resolve(ctx, UserIdentifierID, id)
+}
+
+// ByLogin returns the identifiers of the user with login. Logins are not
+// case sensitive.
+func (r *IDResolver) ByLogin(ctx context.Context, login string) (UserIdentifiers, error) {
+	return r.resolve(ctx, UserIdentifierLogin, login)
+}
+
+// ByExternalID returns the identifiers of the user with externalID.
+func (r *IDResolver) ByExternalID(ctx context.Context, externalID string) (UserIdentifiers, error) {
+	return r.resolve(ctx, UserIdentifierExternalID, externalID)
+}
+
+// Resolve returns the identifiers of the users with the given identifiers of
+// kind, keyed by those identifiers. Identifiers no user has are left out.
+// The uncached ones are resolved with a search per 50 identifiers.
+func (r *IDResolver) Resolve(ctx context.Context, kind string, values []string) (map[string]UserIdentifiers, error) {
+	if _, ok := r.searchAttribute(kind); !ok {
+		return nil, fmt.Errorf("unknown user identifier kind %q", kind)
+	}
+	resolved := make(map[string]UserIdentifiers, len(values))
+	var missing []string
+	for _, value := range values {
+		if user, ok := r.cached(kind, value); ok {
+			resolved[value] = user
+		} else {
+			missing = append(missing, value)
+		}
+	}
+	for start := 0; start < len(missing); start += idResolverChunk {
+		chunk := missing[start:min(start+idResolverChunk, len(missing))]
+		users, err := r.search(ctx, kind, chunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range chunk {
+			for _, user := range users {
+				if identifierOf(user, kind) == normalizeIdentifier(kind, value) {
+					resolved[value] = user
+				}
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// Invalidate removes the user with id from the cache, for example when an
+// event hook reports that its login changed.
+func (r *IDResolver) Invalidate(id string) {
+	r.cache.delete(UserIdentifierID + ":" + id)
+}
+
+func (r *IDResolver) resolve(ctx context.Context, kind, value string) (UserIdentifiers, error) {
+	if value == "" {
+		return UserIdentifiers{}, fmt.Errorf("user %s is required", kind)
+	}
+	if user, ok := r.cached(kind, value); ok {
+		return user, nil
+	}
+	if kind == UserIdentifierExternalID {
+		users, err := r.search(ctx, kind, []string{value})
+		if err != nil {
+			return UserIdentifiers{}, err
+		}
+		if len(users) == 0 {
+			return UserIdentifiers{}, fmt.Errorf("%w: no user has the %s %s", ErrUserNotFound, r.opts.ExternalIDAttribute, value)
+		}
+		return users[0], nil
+	}
+	// ids and logins are both accepted by the user endpoint
+	var user resolverUser
+	if _, err := r.client.Do(ctx, http.MethodGet, "/api/v1/users/"+url.PathEscape(value), nil, &user); err != nil {
+		return UserIdentifiers{}, err
+	}
+	return r.store(user), nil
+}
+
+// resolverUser decodes the identifiers of a user.
+type resolverUser struct {
+	Id      string                 `json:"id"`
+	Profile map[string]interface{} `json:"profile"`
+}
+
+func (r *IDResolver) search(ctx context.Context, kind string, values []string) ([]UserIdentifiers, error) {
+	attribute, _ := r.searchAttribute(kind)
+	terms := make([]string, len(values))
+	for i, value := range values {
+		terms[i] = fmt.Sprintf("%s eq %q", attribute, value)
+	}
+	var users []UserIdentifiers
+	err := ListUsersAs(ctx, r.client, ListUsersOptions{Search: strings.Join(terms, " or "), Limit: 200}, func(page []resolverUser) error {
+		for _, user := range page {
+			users = append(users, r.store(user))
+		}
+		return nil
+	})
+	return users, err
+}
+
+func (r *IDResolver) searchAttribute(kind string) (string, bool) {
+	switch kind {
+	case UserIdentifierID:
+		return "id", true
+	case UserIdentifierLogin:
+		return "profile.login", true
+	case UserIdentifierExternalID:
+		return "profile." + r.opts.ExternalIDAttribute, true
+	}
+	return "", false
+}
+
+func (r *IDResolver) cached(kind, value string) (UserIdentifiers, bool) {
+	return r.cache.get(kind + ":" + normalizeIdentifier(kind, value))
+}
+
+// store caches user, replacing the entry of the same user.
+func (r *IDResolver) store(u resolverUser) UserIdentifiers {
+	user := UserIdentifiers{ID: u.Id}
+	user.Login, _ = u.Profile["login"].(string)
+	user.ExternalID, _ = u.Profile[r.opts.ExternalIDAttribute].(string)
+	r.cache.put(user)
+	return user
+}
+
+func entryKeys(user UserIdentifiers) []string {
+	keys := []string{UserIdentifierID + ":" + user.ID}
+	if user.Login != "" {
+		keys = append(keys, UserIdentifierLogin+":"+normalizeIdentifier(UserIdentifierLogin, user.Login))
+	}
+	if user.ExternalID != "" {
+		keys = append(keys, UserIdentifierExternalID+":"+user.ExternalID)
+	}
+	return keys
+}
+
+func identifierOf(user UserIdentifiers, kind string) string {
+	switch kind {
+	case UserIdentifierID:
+		return user.ID
+	case UserIdentifierLogin:
+		return normalizeIdentifier(kind, user.Login)
+	default:
+		return user.ExternalID
+	}
+}
+
+func normalizeIdentifier(kind, value string) string {
+	if kind == UserIdentifierLogin {
+		return strings.ToLower(value)
+	}
+	return value
+}
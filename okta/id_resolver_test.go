@@ -0,0 +1,90 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ID_Resolver(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/alice@example.com", httpmock.ResponderFromResponse(
+		mockJSONResponse(200, `{"id":"00u1","profile":{"login":"alice@example.com","employeeNumber":"E1"}}`)))
+	httpmock.RegisterResponder("GET", "/api/v1/users/nobody@example.com", httpmock.ResponderFromResponse(
+		mockJSONResponse(404, `{"errorCode":"E0000007","errorSummary":"Not found: Resource not found: nobody@example.com (User)"}`)))
+	var searches []string
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		search := req.URL.Query().Get("search")
+		searches = append(searches, search)
+		switch search {
+		case `profile.employeeNumber eq "E9"`:
+			return mockJSONResponse(200, `[]`), nil
+		case `profile.employeeNumber eq "E2" or profile.employeeNumber eq "E3" or profile.employeeNumber eq "E4"`:
+			return mockJSONResponse(200, `[
+			  {"id":"00u2","profile":{"login":"bob@example.com","employeeNumber":"E2"}},
+			  {"id":"00u3","profile":{"login":"carol@example.com","employeeNumber":"E3"}}
+			]`), nil
+		}
+		t.Errorf("unexpected search %s", search)
+		return mockJSONResponse(400, `{}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	resolver := client.IDResolver(IDResolverOptions{Size: 3, ExternalIDAttribute: "employeeNumber"})
+	ctx := context.Background()
+
+	alice, err := resolver.ByLogin(ctx, "alice@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, UserIdentifiers{ID: "00u1", Login: "alice@example.com", ExternalID: "E1"}, alice)
+	for _, lookup := range []func() (UserIdentifiers, error){
+		func() (UserIdentifiers, error) { return resolver.ByID(ctx, "00u1") },
+		func() (UserIdentifiers, error) { return resolver.ByLogin(ctx, "Alice@Example.com") },
+		func() (UserIdentifiers, error) { return resolver.ByExternalID(ctx, "E1") },
+	} {
+		user, err := lookup()
+		require.NoError(t, err)
+		assert.Equal(t, alice, user)
+	}
+	assert.Equal(t, 1, httpmock.GetTotalCallCount(), "Every identifier of a resolved user should be cached")
+
+	_, err = resolver.ByLogin(ctx, "nobody@example.com")
+	assert.True(t, IsNotFound(err))
+	_, err = resolver.ByExternalID(ctx, "E9")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+	assert.True(t, IsNotFound(err))
+
+	users, err := resolver.Resolve(ctx, UserIdentifierExternalID, []string{"E1", "E2", "E3", "E4"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]UserIdentifiers{
+		"E1": alice,
+		"E2": {ID: "00u2", Login: "bob@example.com", ExternalID: "E2"},
+		"E3": {ID: "00u3", Login: "carol@example.com", ExternalID: "E3"},
+	}, users)
+	assert.Len(t, searches, 2, "Uncached identifiers should be resolved with one search")
+
+	// a fourth user evicts alice, used least recently
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u4", httpmock.ResponderFromResponse(
+		mockJSONResponse(200, `{"id":"00u4","profile":{"login":"dave@example.com"}}`)))
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1", httpmock.ResponderFromResponse(
+		mockJSONResponse(200, `{"id":"00u1","profile":{"login":"alice@example.com","employeeNumber":"E1"}}`)))
+	_, err = resolver.ByID(ctx, "00u4")
+	require.NoError(t, err)
+	_, err = resolver.ByID(ctx, "00u3")
+	require.NoError(t, err)
+	_, err = resolver.ByID(ctx, "00u1")
+	require.NoError(t, err)
+	calls := httpmock.GetCallCountInfo()
+	assert.Equal(t, 0, calls["GET /api/v1/users/00u3"])
+	assert.Equal(t, 1, calls["GET /api/v1/users/00u1"], "The least recently used user should be evicted")
+
+	_, err = resolver.Resolve(ctx, "email", []string{"alice@example.com"})
+	assert.Error(t, err)
+}
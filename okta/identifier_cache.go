@@ -0,0 +1,86 @@
+package okta
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// identifierCache is the LRU cache of the resolvers, holding resources of
+// type T under every key returned by keys, the first of which identifies the
+// resource. It is safe for concurrent use.
+type identifierCache[T any] struct {
+	size int
+	ttl  time.Duration
+	keys func(T) []string
+
+	mu    sync.Mutex
+	order *list.List // of *identifierEntry[T], most recently used first
+	// index maps the keys of the cached resources to their entry, such as
+	// "login:alice@example.com".
+	index map[string]*list.Element
+}
+
+type identifierEntry[T any] struct {
+	value     T
+	keys      []string
+	expiresAt time.Time
+}
+
+func newIdentifierCache[T any](size int, ttl time.Duration, keys func(T) []string) *identifierCache[T] {
+	return &identifierCache[T]{size: size, ttl: ttl, keys: keys, order: list.New(), index: map[string]*list.Element{}}
+}
+
+// get returns the unexpired resource cached under key.
+func (c *identifierCache[T]) get(key string) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.index[key]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	entry := e.Value.(*identifierEntry[T])
+	if time.Now().After(entry.expiresAt) {
+		c.remove(e)
+		var zero T
+		return zero, false
+	}
+	c.order.MoveToFront(e)
+	return entry.value, true
+}
+
+// put caches value, replacing the entry of the same resource.
+func (c *identifierCache[T]) put(value T) {
+	keys := c.keys(value)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.index[keys[0]]; ok {
+		c.remove(e)
+	}
+	e := c.order.PushFront(&identifierEntry[T]{value: value, keys: keys, expiresAt: time.Now().Add(c.ttl)})
+	for _, key := range keys {
+		c.index[key] = e
+	}
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// delete removes the resource cached under key.
+func (c *identifierCache[T]) delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.index[key]; ok {
+		c.remove(e)
+	}
+}
+
+// remove drops an entry and its keys, which must be held by c.mu.
+func (c *identifierCache[T]) remove(e *list.Element) {
+	for _, key := range c.order.Remove(e).(*identifierEntry[T]).keys {
+		if c.index[key] == e {
+			delete(c.index, key)
+		}
+	}
+}
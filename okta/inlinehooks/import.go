@@ -0,0 +1,76 @@
+package inlinehooks
+
+// Commands of import hooks.
+const (
+	AppUserProfileUpdateCommand = "com.okta.appUser.profile.update"
+	UserUpdateCommand           = "com.okta.user.update"
+)
+
+// Results of the matching of an imported user.
+const (
+	ImportCreateUser = "CREATE_USER"
+	ImportLinkUser   = "LINK_USER"
+)
+
+// ImportRequest is the request of an import hook, called for every user
+// imported from an app or a directory.
+type ImportRequest = Request[ImportData]
+
+// ImportData is the data of an ImportRequest.
+type ImportData struct {
+	Context struct {
+		// Conflicts are the attributes conflicting with those of the matched
+		// user.
+		Conflicts   []string `json:"conflicts,omitempty"`
+		Application struct {
+			ID     string `json:"id"`
+			Name   string `json:"name"`
+			Label  string `json:"label"`
+			Status string `json:"status"`
+		} `json:"application"`
+		Job struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"job"`
+		// Matches are the users the imported user matched.
+		Matches []struct {
+			ID string `json:"id"`
+		} `json:"matches,omitempty"`
+		Policy []string `json:"policy,omitempty"`
+	} `json:"context"`
+	AppUser struct {
+		Profile map[string]interface{} `json:"profile"`
+	} `json:"appUser"`
+	User struct {
+		Profile map[string]interface{} `json:"profile"`
+	} `json:"user"`
+	Action struct {
+		// Result is ImportCreateUser or ImportLinkUser.
+		Result string `json:"result"`
+	} `json:"action"`
+}
+
+// UpdateAppUserProfile appends the command setting attributes of the app
+// profile of the imported user to r.
+func (r *Response) UpdateAppUserProfile(attributes map[string]interface{}) *Response {
+	return r.Command(AppUserProfileUpdateCommand, attributes)
+}
+
+// UpdateUserProfile appends the command setting attributes of the Okta
+// profile of the imported user to r. It is UpdateProfile under the name of
+// import hooks.
+func (r *Response) UpdateUserProfile(attributes map[string]interface{}) *Response {
+	return r.UpdateProfile(attributes)
+}
+
+// CreateUser appends the command importing the user as a new user to r.
+func (r *Response) CreateUser() *Response {
+	return r.Command(ActionUpdateCommand, map[string]string{"result": ImportCreateUser})
+}
+
+// LinkUser appends the commands linking the imported user to the existing
+// user with userID to r.
+func (r *Response) LinkUser(userID string) *Response {
+	return r.Command(ActionUpdateCommand, map[string]string{"result": ImportLinkUser}).
+		Command(UserUpdateCommand, map[string]string{"id": userID})
+}
@@ -0,0 +1,201 @@
+// Package inlinehooks implements Okta inline hooks with typed requests and
+// responses. A handler per hook type, such as TokenHandler, authenticates the
+// call from Okta, decodes its request, calls the function implementing the
+// hook and encodes the commands it returns:
+//
+//	http.Handle("/hooks/token", inlinehooks.TokenHandler(opts, func(ctx context.Context, req *inlinehooks.TokenRequest) (*inlinehooks.Response, error) {
+//		return new(inlinehooks.Response).PatchAccessToken(inlinehooks.AddClaim("tenant", tenantOf(req))), nil
+//	}))
+//
+// Token, registration and import hooks share Response, which has builders
+// for their commands. Password import and telephony hooks use the request and
+// response types of the okta package.
+package inlinehooks
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// Types of inline hooks, as registered with the InlineHookAPI.
+const (
+	TokenHookType          = "com.okta.oauth2.tokens.transform"
+	RegistrationHookType   = "com.okta.user.pre-registration"
+	ImportHookType         = "com.okta.import.transform"
+	PasswordImportHookType = okta.PasswordImportHookType
+	TelephonyHookType      = okta.TelephonyHookType
+)
+
+// defaultMaxBodySize bounds the size of hook requests read.
+const defaultMaxBodySize = 1 << 20
+
+// Options configures the handlers.
+type Options struct {
+	// AuthHeader and AuthValue are the header and value of the auth scheme of
+	// the hook, which Okta sends with every call. Calls without them are
+	// rejected. Without AuthHeader authentication is left to a wrapping
+	// handler.
+	AuthHeader string
+	AuthValue  string
+	// MaxBodySize bounds the size of requests, 1 MiB by default.
+	MaxBodySize int64
+}
+
+// Request is the payload Okta sends to an inline hook, with the data of the
+// hook type.
+type Request[D any] struct {
+	EventID           string `json:"eventId"`
+	EventTime         string `json:"eventTime"`
+	EventType         string `json:"eventType"`
+	EventTypeVersion  string `json:"eventTypeVersion"`
+	ContentType       string `json:"contentType"`
+	CloudEventVersion string `json:"cloudEventVersion"`
+	Source            string `json:"source"`
+	// RequestType distinguishes the flows of hook types called for several,
+	// such as self.service.registration and progressive.profile for
+	// registration hooks.
+	RequestType string `json:"requestType,omitempty"`
+	Data        D      `json:"data"`
+}
+
+// HTTPRequest is the request of the user that made Okta call the hook.
+type HTTPRequest struct {
+	ID     string `json:"id"`
+	Method string `json:"method"`
+	URL    struct {
+		Value string `json:"value"`
+	} `json:"url"`
+	IPAddress string `json:"ipAddress"`
+}
+
+// Response is the answer of token, registration and import hooks: commands
+// for Okta to execute, or an error.
+type Response struct {
+	Commands []Command `json:"commands,omitempty"`
+	Error    *Error    `json:"error,omitempty"`
+	// DebugContext is logged by Okta in the System Log event of the call.
+	DebugContext map[string]interface{} `json:"debugContext,omitempty"`
+}
+
+// Command is a command of a Response.
+type Command struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// Command appends a command of type commandType to r.
+func (r *Response) Command(commandType string, value interface{}) *Response {
+	r.Commands = append(r.Commands, Command{Type: commandType, Value: value})
+	return r
+}
+
+// Error is the error a hook answers with, making Okta fail the operation,
+// such as denying a registration with the reasons in ErrorCauses. Handler
+// functions return it as their error.
+type Error struct {
+	ErrorSummary string       `json:"errorSummary"`
+	ErrorCauses  []ErrorCause `json:"errorCauses,omitempty"`
+}
+
+// ErrorCause is a reason of an Error, for example the profile attribute a
+// registration is denied for.
+type ErrorCause struct {
+	ErrorSummary string `json:"errorSummary"`
+	Reason       string `json:"reason,omitempty"`
+	LocationType string `json:"locationType,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Domain       string `json:"domain,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.ErrorSummary
+}
+
+// TokenHandler serves a token inline hook with handle.
+func TokenHandler(opts Options, handle func(ctx context.Context, req *TokenRequest) (*Response, error)) http.Handler {
+	return newHandler(opts, handle)
+}
+
+// RegistrationHandler serves a registration inline hook with handle.
+func RegistrationHandler(opts Options, handle func(ctx context.Context, req *RegistrationRequest) (*Response, error)) http.Handler {
+	return newHandler(opts, handle)
+}
+
+// ImportHandler serves an import inline hook with handle.
+func ImportHandler(opts Options, handle func(ctx context.Context, req *ImportRequest) (*Response, error)) http.Handler {
+	return newHandler(opts, handle)
+}
+
+// PasswordImportHandler serves a password import inline hook with handle,
+// which answers with okta.PasswordImportResult. See also
+// okta.PasswordImportHandler.
+func PasswordImportHandler(opts Options, handle func(ctx context.Context, req *okta.PasswordImportRequest) (*okta.PasswordImportResponse, error)) http.Handler {
+	return newHandler(opts, handle)
+}
+
+// TelephonyHandler serves a telephony inline hook with handle, which answers
+// with okta.NewTelephonyHookResponse.
+func TelephonyHandler(opts Options, handle func(ctx context.Context, req *okta.TelephonyHookRequest) (*okta.TelephonyHookResponse, error)) http.Handler {
+	return newHandler(opts, handle)
+}
+
+// newHandler returns the handler decoding requests into a Req and encoding
+// the Resp of handle. An *Error returned by handle is answered as the error
+// of the hook; other errors fail the call, which Okta handles as set for the
+// hook type, for example by denying the operation.
+func newHandler[Req, Resp any](opts Options, handle func(context.Context, *Req) (*Resp, error)) http.Handler {
+	if opts.MaxBodySize <= 0 {
+		opts.MaxBodySize = defaultMaxBodySize
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if opts.AuthHeader != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(opts.AuthHeader)), []byte(opts.AuthValue)) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		req := new(Req)
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, opts.MaxBodySize)).Decode(req); err != nil {
+			http.Error(w, "invalid inline hook request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := handle(r.Context(), req)
+		var hookErr *Error
+		switch {
+		case errors.As(err, &hookErr):
+			writeJSON(w, map[string]*Error{"error": hookErr})
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		case resp == nil:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeJSON(w, resp)
+		}
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// Patch is a JSON Patch operation of the commands of token hooks.
+type Patch struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// claimPath returns the path of the claim name, escaped as a JSON Pointer.
+func claimPath(name string) string {
+	return "/claims/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
@@ -0,0 +1,109 @@
+package inlinehooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func call(t *testing.T, h http.Handler, method, body string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, "/hook", strings.NewReader(body))
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func Test_Inline_Hooks(t *testing.T) {
+	opts := Options{AuthHeader: "Authorization", AuthValue: "secret"}
+	auth := http.Header{"Authorization": {"secret"}}
+
+	t.Run("token hook patches claims", func(t *testing.T) {
+		h := TokenHandler(opts, func(ctx context.Context, req *TokenRequest) (*Response, error) {
+			assert.Equal(t, TokenHookType, req.EventType)
+			assert.Equal(t, "alice@example.com", req.Data.Context.User.Profile["login"])
+			assert.Equal(t, 3600, req.Data.Access.Token.Token.Lifetime.Expiration)
+			assert.Contains(t, req.Data.Access.Scopes, "openid")
+			return new(Response).
+				PatchAccessToken(AddClaim("tenant", "acme"), SetLifetime(600)).
+				PatchIdentityToken(ReplaceClaim("a/b~c", 1)), nil
+		})
+		rec := call(t, h, http.MethodPost, `{"eventType":"com.okta.oauth2.tokens.transform","data":{
+			"context":{"user":{"id":"00u1","profile":{"login":"alice@example.com"}}},
+			"access":{"claims":{"sub":"alice"},"token":{"lifetime":{"expiration":3600}},"scopes":{"openid":{"id":"scp1","action":"GRANT"}}}}}`, auth)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.JSONEq(t, `{"commands":[
+			{"type":"com.okta.access.patch","value":[{"op":"add","path":"/claims/tenant","value":"acme"},{"op":"replace","path":"/token/lifetime/expiration","value":600}]},
+			{"type":"com.okta.identity.patch","value":[{"op":"replace","path":"/claims/a~1b~0c","value":1}]}]}`, rec.Body.String())
+	})
+
+	t.Run("registration hook denies with an error", func(t *testing.T) {
+		h := RegistrationHandler(opts, func(ctx context.Context, req *RegistrationRequest) (*Response, error) {
+			assert.Equal(t, SelfServiceRegistration, req.RequestType)
+			if req.Data.UserProfile["email"] == "mallory@example.com" {
+				return nil, &Error{ErrorSummary: "Registration denied", ErrorCauses: []ErrorCause{{ErrorSummary: "Blocked", Location: "data.userProfile.email"}}}
+			}
+			return new(Response).AllowRegistration().UpdateProfile(map[string]interface{}{"department": "R&D"}), nil
+		})
+		rec := call(t, h, http.MethodPost, `{"requestType":"self.service.registration","data":{"userProfile":{"email":"mallory@example.com"},"action":"ALLOW"}}`, auth)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"error":{"errorSummary":"Registration denied","errorCauses":[{"errorSummary":"Blocked","location":"data.userProfile.email"}]}}`, rec.Body.String())
+
+		rec = call(t, h, http.MethodPost, `{"requestType":"self.service.registration","data":{"userProfile":{"email":"alice@example.com"},"action":"ALLOW"}}`, auth)
+		assert.JSONEq(t, `{"commands":[
+			{"type":"com.okta.action.update","value":{"registration":"ALLOW"}},
+			{"type":"com.okta.user.profile.update","value":{"department":"R&D"}}]}`, rec.Body.String())
+	})
+
+	t.Run("import hook links users", func(t *testing.T) {
+		h := ImportHandler(opts, func(ctx context.Context, req *ImportRequest) (*Response, error) {
+			assert.Equal(t, "0oa1", req.Data.Context.Application.ID)
+			assert.Equal(t, ImportCreateUser, req.Data.Action.Result)
+			return new(Response).LinkUser("00u9").UpdateAppUserProfile(map[string]interface{}{"imported": true}), nil
+		})
+		rec := call(t, h, http.MethodPost, `{"data":{"context":{"application":{"id":"0oa1"}},"appUser":{"profile":{"email":"bob@example.com"}},"user":{"profile":{}},"action":{"result":"CREATE_USER"}}}`, auth)
+		assert.JSONEq(t, `{"commands":[
+			{"type":"com.okta.action.update","value":{"result":"LINK_USER"}},
+			{"type":"com.okta.user.update","value":{"id":"00u9"}},
+			{"type":"com.okta.appUser.profile.update","value":{"imported":true}}]}`, rec.Body.String())
+	})
+
+	t.Run("password import and telephony hooks", func(t *testing.T) {
+		h := PasswordImportHandler(opts, func(ctx context.Context, req *okta.PasswordImportRequest) (*okta.PasswordImportResponse, error) {
+			return okta.PasswordImportResult(true), nil
+		})
+		rec := call(t, h, http.MethodPost, `{"data":{"context":{"credential":{"username":"alice","password":"pw"}}}}`, auth)
+		want, err := json.Marshal(okta.PasswordImportResult(true))
+		require.NoError(t, err)
+		assert.JSONEq(t, string(want), rec.Body.String())
+
+		h = TelephonyHandler(opts, func(ctx context.Context, req *okta.TelephonyHookRequest) (*okta.TelephonyHookResponse, error) {
+			return okta.NewTelephonyHookResponse("SUCCESSFUL", "acme", "tx1"), nil
+		})
+		rec = call(t, h, http.MethodPost, `{"eventType":"com.okta.telephony.provider","data":{}}`, auth)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"tx1"`)
+	})
+
+	t.Run("rejected calls", func(t *testing.T) {
+		h := TokenHandler(opts, func(ctx context.Context, req *TokenRequest) (*Response, error) {
+			return nil, errors.New("boom")
+		})
+		assert.Equal(t, http.StatusUnauthorized, call(t, h, http.MethodPost, `{}`, nil).Code)
+		assert.Equal(t, http.StatusUnauthorized, call(t, h, http.MethodPost, `{}`, http.Header{"Authorization": {"wrong"}}).Code)
+		assert.Equal(t, http.StatusMethodNotAllowed, call(t, h, http.MethodGet, ``, auth).Code)
+		assert.Equal(t, http.StatusBadRequest, call(t, h, http.MethodPost, `{`, auth).Code)
+		assert.Equal(t, http.StatusInternalServerError, call(t, h, http.MethodPost, `{}`, auth).Code)
+	})
+}
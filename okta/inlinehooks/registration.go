@@ -0,0 +1,59 @@
+package inlinehooks
+
+// Request types of registration hooks.
+const (
+	SelfServiceRegistration = "self.service.registration"
+	ProgressiveProfile      = "progressive.profile"
+)
+
+// Commands of registration hooks.
+const (
+	ProfileUpdateCommand            = "com.okta.user.profile.update"
+	ProgressiveProfileUpdateCommand = "com.okta.user.progressive.profile.update"
+	ActionUpdateCommand             = "com.okta.action.update"
+)
+
+// RegistrationRequest is the request of a registration hook, called before a
+// user registers or, for progressive profiling, updates their profile.
+type RegistrationRequest = Request[RegistrationData]
+
+// RegistrationData is the data of a RegistrationRequest.
+type RegistrationData struct {
+	Context struct {
+		Request HTTPRequest `json:"request"`
+		// User is the existing user of progressive profiling.
+		User *struct {
+			ID      string                 `json:"id"`
+			Profile map[string]interface{} `json:"profile"`
+		} `json:"user,omitempty"`
+	} `json:"context"`
+	// UserProfile is the profile a user registers with.
+	UserProfile map[string]interface{} `json:"userProfile,omitempty"`
+	// UserProfileUpdate holds the attributes of progressive profiling.
+	UserProfileUpdate map[string]interface{} `json:"userProfileUpdate,omitempty"`
+	// Action is ALLOW or DENY, what Okta does unless the hook changes it.
+	Action string `json:"action"`
+}
+
+// AllowRegistration appends the command allowing a registration to r.
+func (r *Response) AllowRegistration() *Response {
+	return r.Command(ActionUpdateCommand, map[string]string{"registration": "ALLOW"})
+}
+
+// DenyRegistration appends the command denying a registration to r. Set
+// r.Error to tell the user why.
+func (r *Response) DenyRegistration() *Response {
+	return r.Command(ActionUpdateCommand, map[string]string{"registration": "DENY"})
+}
+
+// UpdateProfile appends the command setting attributes of the profile of a
+// registering user to r.
+func (r *Response) UpdateProfile(attributes map[string]interface{}) *Response {
+	return r.Command(ProfileUpdateCommand, attributes)
+}
+
+// UpdateProgressiveProfile appends the command setting attributes of the
+// profile of a user during progressive profiling to r.
+func (r *Response) UpdateProgressiveProfile(attributes map[string]interface{}) *Response {
+	return r.Command(ProgressiveProfileUpdateCommand, attributes)
+}
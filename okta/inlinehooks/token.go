@@ -0,0 +1,114 @@
+package inlinehooks
+
+// Commands of token hooks.
+const (
+	IdentityPatchCommand = "com.okta.identity.patch"
+	AccessPatchCommand   = "com.okta.access.patch"
+)
+
+// TokenRequest is the request of a token hook, called before Okta mints the
+// tokens of an authorization server.
+type TokenRequest = Request[TokenData]
+
+// TokenData is the data of a TokenRequest.
+type TokenData struct {
+	Context TokenContext `json:"context"`
+	// Identity is the ID token, nil when none is minted.
+	Identity *Token `json:"identity,omitempty"`
+	// Access is the access token, nil when none is minted.
+	Access *AccessToken `json:"access,omitempty"`
+}
+
+// TokenContext describes the authorization the tokens are minted for.
+type TokenContext struct {
+	Request  HTTPRequest `json:"request"`
+	Protocol struct {
+		Type    string            `json:"type"`
+		Request map[string]string `json:"request"`
+		Issuer  struct {
+			URI string `json:"uri"`
+		} `json:"issuer"`
+		Client struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"client"`
+	} `json:"protocol"`
+	Session *struct {
+		ID        string   `json:"id"`
+		UserID    string   `json:"userId"`
+		Login     string   `json:"login"`
+		CreatedAt string   `json:"createdAt"`
+		ExpiresAt string   `json:"expiresAt"`
+		Status    string   `json:"status"`
+		AMR       []string `json:"amr"`
+		Idp       struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"idp"`
+		MFAActive bool `json:"mfaActive"`
+	} `json:"session,omitempty"`
+	// User is nil for client credentials flows.
+	User *struct {
+		ID              string                 `json:"id"`
+		PasswordChanged string                 `json:"passwordChanged"`
+		Profile         map[string]interface{} `json:"profile"`
+	} `json:"user,omitempty"`
+	Policy struct {
+		ID   string `json:"id"`
+		Rule struct {
+			ID string `json:"id"`
+		} `json:"rule"`
+	} `json:"policy"`
+}
+
+// Token holds the claims and the lifetime of a token about to be minted.
+type Token struct {
+	Claims map[string]interface{} `json:"claims"`
+	Token  struct {
+		Lifetime struct {
+			// Expiration is the lifetime of the token in seconds.
+			Expiration int `json:"expiration"`
+		} `json:"lifetime"`
+	} `json:"token"`
+}
+
+// AccessToken is a Token with the granted scopes.
+type AccessToken struct {
+	Token
+	Scopes map[string]struct {
+		ID     string `json:"id"`
+		Action string `json:"action"`
+	} `json:"scopes"`
+}
+
+// AddClaim returns the patch adding a claim to a token.
+func AddClaim(name string, value interface{}) Patch {
+	return Patch{Op: "add", Path: claimPath(name), Value: value}
+}
+
+// ReplaceClaim returns the patch replacing a claim of a token.
+func ReplaceClaim(name string, value interface{}) Patch {
+	return Patch{Op: "replace", Path: claimPath(name), Value: value}
+}
+
+// RemoveClaim returns the patch removing a claim, which Okta only allows for
+// claims it does not set itself.
+func RemoveClaim(name string) Patch {
+	return Patch{Op: "remove", Path: claimPath(name)}
+}
+
+// SetLifetime returns the patch setting the lifetime of a token, in seconds.
+func SetLifetime(seconds int) Patch {
+	return Patch{Op: "replace", Path: "/token/lifetime/expiration", Value: seconds}
+}
+
+// PatchIdentityToken appends a command patching the ID token to r.
+func (r *Response) PatchIdentityToken(patches ...Patch) *Response {
+	return r.Command(IdentityPatchCommand, patches)
+}
+
+// PatchAccessToken appends a command patching the access token to r.
+func (r *Response) PatchAccessToken(patches ...Patch) *Response {
+	return r.Command(AccessPatchCommand, patches)
+}
@@ -0,0 +1,123 @@
+package okta
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+type operationScope struct {
+	method string
+	path   string
+	scopes []string
+}
+
+// ErrInsufficientScope is returned instead of a generic API error when Okta
+// rejects a call because the access token lacks the scopes of the operation.
+type ErrInsufficientScope struct {
+	Method string
+	Path   string
+	// Required are the scopes the operation requires.
+	Required []string
+	// Granted are the scopes the client was configured to request.
+	Granted []string
+}
+
+func (e *ErrInsufficientScope) Error() string {
+	return fmt.Sprintf("insufficient scope for %s %s: requires %s, granted %s",
+		e.Method, e.Path, scopeList(e.Required), scopeList(e.Granted))
+}
+
+// Missing returns the required scopes that were not granted.
+func (e *ErrInsufficientScope) Missing() []string {
+	var missing []string
+	for _, scope := range e.Required {
+		if !contains(e.Granted, scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+func scopeList(scopes []string) string {
+	if len(scopes) == 0 {
+		return "none"
+	}
+	return strings.Join(scopes, " ")
+}
+
+// RequiredScopes returns the OAuth 2.0 scopes an operation requires, given its
+// HTTP method and the path of a request such as /api/v1/users/00u1. It
+// returns nil for paths of operations that are not part of the API spec.
+func RequiredScopes(method, path string) []string {
+	return matchOperation(method, path).scopes
+}
+
+// matchOperation returns the operation of the spec a request path belongs to,
+// preferring the template with the fewest path parameters, or the zero
+// operationScope when none matches.
+func matchOperation(method, path string) operationScope {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var (
+		best   operationScope
+		params = -1
+	)
+	for _, op := range operationScopes {
+		if op.method != method {
+			continue
+		}
+		if n, ok := matchTemplate(op.path, segments); ok && (params < 0 || n < params) {
+			best, params = op, n
+		}
+	}
+	return best
+}
+
+// matchTemplate reports whether the segments of a path match a templated path
+// of the spec, and how many path parameters were needed to match it.
+func matchTemplate(template string, segments []string) (int, bool) {
+	parts := strings.Split(strings.Trim(template, "/"), "/")
+	if len(parts) != len(segments) {
+		return 0, false
+	}
+	params := 0
+	for i, part := range parts {
+		switch {
+		case strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"):
+			if segments[i] == "" {
+				return 0, false
+			}
+			params++
+		case part != segments[i]:
+			return 0, false
+		}
+	}
+	return params, true
+}
+
+var challengeScope = regexp.MustCompile(`scope="([^"]*)"`)
+
+// insufficientScopeError returns an ErrInsufficientScope for responses that
+// reject the access token for missing scopes, and nil for any other response.
+func (c *APIClient) insufficientScopeError(req *http.Request, resp *http.Response) error {
+	if resp.StatusCode != http.StatusForbidden {
+		return nil
+	}
+	challenge := resp.Header.Get("WWW-Authenticate")
+	if !strings.Contains(challenge, `error="insufficient_scope"`) {
+		return nil
+	}
+	required := RequiredScopes(req.Method, req.URL.Path)
+	if required == nil {
+		if m := challengeScope.FindStringSubmatch(challenge); m != nil {
+			required = strings.Fields(m[1])
+		}
+	}
+	return &ErrInsufficientScope{
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		Required: required,
+		Granted:  c.cfg.Okta.Client.Scopes,
+	}
+}
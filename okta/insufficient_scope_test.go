@@ -0,0 +1,49 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Insufficient_Scope(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1a2b3c4d5e6f7g8h9", func(req *http.Request) (*http.Response, error) {
+		resp := mockJSONResponse(403, `{"errorCode":"E0000006","errorSummary":"You do not have permission to perform the requested action"}`)
+		resp.Header.Set("WWW-Authenticate", `Bearer authorization_uri="https://example.okta.com/oauth2/v1/authorize", realm="https://example.okta.com", scope="okta.users.read", error="insufficient_scope", error_description="The access token provided does not contain the required scopes."`)
+		return resp, nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/groups", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(403, `{"errorCode":"E0000006","errorSummary":"You do not have permission to perform the requested action"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithScopes([]string{"okta.groups.read"}), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	_, _, err = client.UserAPI.GetUser(ctx, "00u1a2b3c4d5e6f7g8h9").Execute()
+	var scopeErr *ErrInsufficientScope
+	require.True(t, errors.As(err, &scopeErr))
+	assert.Equal(t, []string{"okta.users.read"}, scopeErr.Required)
+	assert.Equal(t, []string{"okta.groups.read"}, scopeErr.Granted)
+	assert.Equal(t, []string{"okta.users.read"}, scopeErr.Missing())
+
+	_, _, err = client.GroupAPI.ListGroups(ctx).Execute()
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &scopeErr), "Missing permissions are not missing scopes")
+}
+
+func Test_Required_Scopes(t *testing.T) {
+	assert.Equal(t, []string{"okta.users.read"}, RequiredScopes(http.MethodGet, "/api/v1/users/00u1a2b3c4d5e6f7g8h9"))
+	assert.Equal(t, []string{"okta.users.manage"}, RequiredScopes(http.MethodDelete, "/api/v1/users/00u1a2b3c4d5e6f7g8h9"))
+	assert.Equal(t, []string{"okta.users.read"}, RequiredScopes(http.MethodGet, "/api/v1/users/me"))
+	assert.Nil(t, RequiredScopes(http.MethodGet, "/api/v1/unknown"))
+}
@@ -0,0 +1,292 @@
+// Command apicoverage compares the bundled OpenAPI spec against the generated
+// API services and reports operations that have no generated counterpart.
+//
+// It is wired to the okta package through go generate:
+//
+//	go generate ./okta
+//
+// When -stubs is given, a Go file exposing a minimal method for every
+// uncovered operation is written so new paths can be called before the SDK is
+// regenerated.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	methodCheck = regexp.MustCompile(`localVarHTTPMethod\s+= http\.Method(\w+)`)
+	pathCheck   = regexp.MustCompile(`localVarPath := localBasePath \+ "([^"]+)"`)
+	paramCheck  = regexp.MustCompile(`\{[^}]+\}`)
+)
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// Operation is a single method/path pair described by the spec.
+type Operation struct {
+	Method      string
+	Path        string
+	OperationID string
+	Tag         string
+	Summary     string
+}
+
+func (o Operation) key() string {
+	return endpointKey(o.Method, o.Path)
+}
+
+// endpointKey normalizes path parameter names so that `{userId}` and `{id}`
+// are considered the same endpoint.
+func endpointKey(method, path string) string {
+	return strings.ToUpper(method) + " " + paramCheck.ReplaceAllString(path, "{}")
+}
+
+type specDocument struct {
+	Info struct {
+		Version string `yaml:"version"`
+	} `yaml:"info"`
+	Paths map[string]map[string]yaml.Node `yaml:"paths"`
+}
+
+type specOperation struct {
+	OperationID string   `yaml:"operationId"`
+	Summary     string   `yaml:"summary"`
+	Tags        []string `yaml:"tags"`
+}
+
+// readSpec returns the spec version and every operation it declares.
+func readSpec(r io.Reader) (string, []Operation, error) {
+	var doc specDocument
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return "", nil, err
+	}
+	var ops []Operation
+	for path, item := range doc.Paths {
+		for _, method := range httpMethods {
+			node, ok := item[method]
+			if !ok {
+				continue
+			}
+			var so specOperation
+			if err := node.Decode(&so); err != nil {
+				return "", nil, fmt.Errorf("failed to decode %s %s: %w", method, path, err)
+			}
+			op := Operation{
+				Method:      strings.ToUpper(method),
+				Path:        path,
+				OperationID: so.OperationID,
+				Summary:     so.Summary,
+			}
+			if len(so.Tags) > 0 {
+				op.Tag = so.Tags[0]
+			}
+			ops = append(ops, op)
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].Path == ops[j].Path {
+			return ops[i].Method < ops[j].Method
+		}
+		return ops[i].Path < ops[j].Path
+	})
+	return doc.Info.Version, ops, nil
+}
+
+// scanGenerated collects the endpoints implemented by the api_*.go files in dir.
+func scanGenerated(dir string) (map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "api_*.go"))
+	if err != nil {
+		return nil, err
+	}
+	covered := map[string]bool{}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		scanSource(src, covered)
+	}
+	return covered, nil
+}
+
+// scanSource pairs every HTTP method declaration with the path that follows it
+// inside the same Execute function.
+func scanSource(src []byte, covered map[string]bool) {
+	var method string
+	for _, line := range bytes.Split(src, []byte("\n")) {
+		if m := methodCheck.FindSubmatch(line); m != nil {
+			method = string(m[1])
+			continue
+		}
+		if m := pathCheck.FindSubmatch(line); m != nil && method != "" {
+			covered[endpointKey(method, string(m[1]))] = true
+			method = ""
+		}
+	}
+}
+
+// uncovered returns the spec operations missing from covered.
+func uncovered(ops []Operation, covered map[string]bool) []Operation {
+	var missing []Operation
+	for _, op := range ops {
+		if !covered[op.key()] {
+			missing = append(missing, op)
+		}
+	}
+	return missing
+}
+
+func writeReport(w io.Writer, version string, total int, missing []Operation) {
+	fmt.Fprintf(w, "spec version: %s\n", version)
+	fmt.Fprintf(w, "operations: %d, covered: %d, uncovered: %d\n", total, total-len(missing), len(missing))
+	for _, op := range missing {
+		fmt.Fprintf(w, "  %-7s %s (%s)\n", op.Method, op.Path, op.OperationID)
+	}
+}
+
+// stubName turns an operationId into an exported Go identifier.
+func stubName(op Operation) string {
+	name := op.OperationID
+	if name == "" {
+		name = strings.ToLower(op.Method) + " " + op.Path
+	}
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// writeStubs emits a formatted Go source file declaring UncoveredAPIService
+// with one method per missing operation.
+func writeStubs(w io.Writer, pkg string, missing []Operation) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by apicoverage. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	buf.WriteString(`import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// UncoveredAPIService exposes operations present in the bundled OpenAPI spec
+// that have no generated service yet. Bodies are decoded by the caller.
+type UncoveredAPIService service
+
+// NewUncoveredAPIService returns an UncoveredAPIService sharing the transport,
+// auth and cache of c.
+func NewUncoveredAPIService(c *APIClient) *UncoveredAPIService {
+	return &UncoveredAPIService{client: c}
+}
+
+func (a *UncoveredAPIService) invoke(ctx context.Context, method, path string, pathParams map[string]string, query url.Values, body interface{}) (*APIResponse, error) {
+	for k, v := range pathParams {
+		path = strings.Replace(path, "{"+k+"}", url.PathEscape(v), -1)
+	}
+	localBasePath, err := a.client.cfg.ServerURLWithContext(ctx, "UncoveredAPIService")
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]string{"Accept": "application/json"}
+	if body != nil {
+		headers["Content-Type"] = "application/json"
+	}
+	req, err := a.client.prepareRequest(ctx, localBasePath+path, method, body, headers, query, url.Values{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.client.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return newAPIResponse(resp, a.client, nil), a.client.checkResponseForError(resp)
+}
+`)
+	for _, op := range missing {
+		summary := op.Summary
+		if summary == "" {
+			summary = op.Method + " " + op.Path
+		}
+		fmt.Fprintf(&buf, "\n// %s %s\n", stubName(op), summary)
+		fmt.Fprintf(&buf, "func (a *UncoveredAPIService) %s(ctx context.Context, pathParams map[string]string, query url.Values, body interface{}) (*APIResponse, error) {\n", stubName(op))
+		fmt.Fprintf(&buf, "\treturn a.invoke(ctx, http.Method%s, %q, pathParams, query, body)\n}\n",
+			strings.ToUpper(op.Method[:1])+strings.ToLower(op.Method[1:]), op.Path)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+func main() {
+	specPath := flag.String("spec", "api/openapi.yaml", "path to the bundled OpenAPI spec")
+	dir := flag.String("dir", ".", "directory containing the generated api_*.go files")
+	stubs := flag.String("stubs", "", "write stub services for uncovered operations to this file")
+	pkg := flag.String("package", "okta", "package name used for the stub file")
+	strict := flag.Bool("strict", false, "exit with a non-zero status when operations are uncovered")
+	flag.Parse()
+
+	if err := run(*specPath, *dir, *stubs, *pkg, *strict); err != nil {
+		fmt.Fprintln(os.Stderr, "apicoverage:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, dir, stubs, pkg string, strict bool) error {
+	f, err := os.Open(specPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	version, ops, err := readSpec(f)
+	if err != nil {
+		return err
+	}
+	covered, err := scanGenerated(dir)
+	if err != nil {
+		return err
+	}
+	missing := uncovered(ops, covered)
+	writeReport(os.Stdout, version, len(ops), missing)
+
+	if stubs != "" && len(missing) > 0 {
+		out, err := os.Create(stubs)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		if err := writeStubs(out, pkg, missing); err != nil {
+			return err
+		}
+	}
+	if strict && len(missing) > 0 {
+		return fmt.Errorf("%d operations are not covered by generated services", len(missing))
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `
+openapi: 3.0.3
+info:
+  version: 2024.06.1
+paths:
+  /api/v1/users/{userId}:
+    get:
+      operationId: getUser
+      summary: Retrieve a User
+      tags:
+      - User
+    delete:
+      operationId: deleteUser
+      tags:
+      - User
+  /api/v1/widgets:
+    post:
+      operationId: createWidget
+      summary: Create a Widget
+`
+
+const testGenerated = `
+func (a *UserAPIService) GetUserExecute(r ApiGetUserRequest) (*User, *APIResponse, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+	)
+	localVarPath := localBasePath + "/api/v1/users/{id}"
+}
+
+func (a *UserAPIService) DeleteUserExecute(r ApiDeleteUserRequest) (*APIResponse, error) {
+	var (
+		localVarHTTPMethod   = http.MethodDelete
+	)
+	localVarPath := localBasePath + "/api/v1/users/{id}"
+}
+`
+
+func Test_Coverage_Reports_Uncovered_Operations(t *testing.T) {
+	version, ops, err := readSpec(strings.NewReader(testSpec))
+	require.NoError(t, err)
+	assert.Equal(t, "2024.06.1", version)
+	require.Len(t, ops, 3)
+
+	covered := map[string]bool{}
+	scanSource([]byte(testGenerated), covered)
+	missing := uncovered(ops, covered)
+	require.Len(t, missing, 1)
+	assert.Equal(t, "createWidget", missing[0].OperationID)
+
+	var stubs bytes.Buffer
+	require.NoError(t, writeStubs(&stubs, "okta", missing))
+	assert.Contains(t, stubs.String(), "func (a *UncoveredAPIService) CreateWidget(")
+	assert.Contains(t, stubs.String(), `http.MethodPost, "/api/v1/widgets"`)
+}
@@ -0,0 +1,224 @@
+// Command listall writes a ListAll method to the APIClient for every list
+// operation of the generated services that returns a slice, or an object
+// embedding the items and the link to the next page, so callers can read a
+// whole list without writing the pagination loop themselves.
+//
+// It is wired to the okta package through go generate:
+//
+//	go generate ./okta
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// executeCheck matches the Execute method of a list request returning a slice.
+var executeCheck = regexp.MustCompile(`^func \(r Api(List\w+)Request\) Execute\(\) \(\[\](\w+), \*APIResponse, error\)`)
+
+// pageCheck matches the Execute method of a list request returning an object,
+// which may embed the items of the page.
+var pageCheck = regexp.MustCompile(`^func \(r Api(List\w+)Request\) Execute\(\) \(\*(\w+), \*APIResponse, error\)`)
+
+var (
+	structCheck = regexp.MustCompile(`^type (\w+) struct \{`)
+	itemsCheck  = regexp.MustCompile(`^\t(\w+) \[\](\w+) `)
+	linksCheck  = regexp.MustCompile(`^\tLinks \*(\w+) `)
+	nextCheck   = regexp.MustCompile(`^\tNext \*HrefObject `)
+)
+
+// List is a list operation of the generated services and the type of its
+// items.
+type List struct {
+	Operation string
+	Item      string
+	// Page is the type of the pages of the operations whose items are
+	// embedded in an object, in its Field along with a link to the next
+	// page. It is empty for the operations returning a slice.
+	Page  string
+	Field string
+}
+
+// model is a struct of the generated models.
+type model struct {
+	// Items and Item are the name and the item type of its first slice
+	// field.
+	Items string
+	Item  string
+	// Links is the type of its Links field.
+	Links string
+	// Next is set when it has a Next link.
+	Next bool
+}
+
+// name is the name of the APIClient method reading every page of l.
+func (l List) name() string {
+	return "ListAll" + strings.TrimPrefix(l.Operation, "List")
+}
+
+// scanGenerated collects the list operations of the api_*.go files in dir.
+func scanGenerated(dir string) ([]List, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "api_*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var lists, pages []List
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, scanSource(src)...)
+		pages = append(pages, scanPages(src)...)
+	}
+	files, err = filepath.Glob(filepath.Join(dir, "model_*.go"))
+	if err != nil {
+		return nil, err
+	}
+	models := map[string]model{}
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for name, m := range scanModels(src) {
+			models[name] = m
+		}
+	}
+	lists = append(lists, embeddedLists(pages, models)...)
+	sort.Slice(lists, func(i, j int) bool {
+		return lists[i].Operation < lists[j].Operation
+	})
+	return lists, nil
+}
+
+// scanSource returns the list operations declared in src. Operations already
+// named ListAll are skipped, as they return every item in a single response.
+func scanSource(src []byte) []List {
+	var lists []List
+	for _, line := range bytes.Split(src, []byte("\n")) {
+		m := executeCheck.FindSubmatch(line)
+		if m == nil || bytes.HasPrefix(m[1], []byte("ListAll")) {
+			continue
+		}
+		lists = append(lists, List{Operation: string(m[1]), Item: string(m[2])})
+	}
+	return lists
+}
+
+// scanPages returns the list operations declared in src that return an
+// object, with their Page set.
+func scanPages(src []byte) []List {
+	var lists []List
+	for _, line := range bytes.Split(src, []byte("\n")) {
+		if m := pageCheck.FindSubmatch(line); m != nil {
+			lists = append(lists, List{Operation: string(m[1]), Page: string(m[2])})
+		}
+	}
+	return lists
+}
+
+// scanModels returns the structs declared in src.
+func scanModels(src []byte) map[string]model {
+	models := map[string]model{}
+	var name string
+	var m model
+	for _, line := range bytes.Split(src, []byte("\n")) {
+		if s := structCheck.FindSubmatch(line); s != nil {
+			name, m = string(s[1]), model{}
+			continue
+		}
+		if name == "" {
+			continue
+		}
+		if bytes.Equal(line, []byte("}")) {
+			models[name] = m
+			name = ""
+			continue
+		}
+		if f := itemsCheck.FindSubmatch(line); f != nil && m.Items == "" {
+			m.Items, m.Item = string(f[1]), string(f[2])
+		}
+		if f := linksCheck.FindSubmatch(line); f != nil {
+			m.Links = string(f[1])
+		}
+		if nextCheck.Match(line) {
+			m.Next = true
+		}
+	}
+	return models
+}
+
+// embeddedLists returns the pages whose type embeds a slice of items and whose
+// links have a next link, with their Item and Field set.
+func embeddedLists(pages []List, models map[string]model) []List {
+	var lists []List
+	for _, l := range pages {
+		page, ok := models[l.Page]
+		if !ok || page.Items == "" || page.Links == "" || !models[page.Links].Next {
+			continue
+		}
+		l.Item, l.Field = page.Item, page.Items
+		lists = append(lists, l)
+	}
+	return lists
+}
+
+// writeMethods emits a formatted Go source file declaring the ListAll methods.
+func writeMethods(w io.Writer, pkg string, lists []List) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by listall. DO NOT EDIT.\n\npackage %s\n", pkg)
+	for _, l := range lists {
+		if l.Page != "" {
+			fmt.Fprintf(&buf, "\n// %s returns the items of every page of %s, see ListAllEmbedded.\n", l.name(), l.Operation)
+			fmt.Fprintf(&buf, "func (c *APIClient) %s(r Api%sRequest) ([]%s, error) {\n", l.name(), l.Operation, l.Item)
+			fmt.Fprintf(&buf, "\treturn ListAllEmbedded(r.ctx, c, r.Execute, func(p *%s) ([]%s, *HrefObject) {\n", l.Page, l.Item)
+			fmt.Fprintf(&buf, "\t\tif p.Links == nil {\n\t\t\treturn p.%s, nil\n\t\t}\n\t\treturn p.%s, p.Links.Next\n\t})\n}\n", l.Field, l.Field)
+			continue
+		}
+		fmt.Fprintf(&buf, "\n// %s returns the items of every page of %s, see ListAll.\n", l.name(), l.Operation)
+		fmt.Fprintf(&buf, "func (c *APIClient) %s(r Api%sRequest) ([]%s, error) {\n", l.name(), l.Operation, l.Item)
+		buf.WriteString("\treturn ListAll(r.ctx, c, r.Execute)\n}\n")
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory containing the generated api_*.go files")
+	output := flag.String("o", "list_all_methods.go", "file to write the methods to")
+	pkg := flag.String("package", "okta", "package name used for the generated file")
+	flag.Parse()
+
+	if err := run(*dir, *output, *pkg); err != nil {
+		fmt.Fprintln(os.Stderr, "listall:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, output, pkg string) error {
+	lists, err := scanGenerated(dir)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := writeMethods(&buf, pkg, lists); err != nil {
+		return err
+	}
+	return os.WriteFile(output, buf.Bytes(), 0o644)
+}
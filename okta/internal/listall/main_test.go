@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSource = `
+func (r ApiListUsersRequest) Execute() ([]User, *APIResponse, error) {
+func (r ApiListAllSignInWidgetVersionsRequest) Execute() ([]string, *APIResponse, error) {
+func (r ApiListCustomDomainsRequest) Execute() (*DomainListResponse, *APIResponse, error) {
+func (r ApiGetUserRequest) Execute() (*UserGetSingleton, *APIResponse, error) {
+func (r ApiListResourceSetsRequest) Execute() (*ResourceSets, *APIResponse, error) {
+`
+
+const testModels = `
+type ResourceSets struct {
+	ResourceSets []ResourceSet ` + "`json:\"resource-sets,omitempty\"`" + `
+	Links *LinksNext ` + "`json:\"_links,omitempty\"`" + `
+	AdditionalProperties map[string]interface{}
+}
+type LinksNext struct {
+	Next *HrefObject ` + "`json:\"next,omitempty\"`" + `
+	AdditionalProperties map[string]interface{}
+}
+type DomainListResponse struct {
+	Domains []DomainResponse ` + "`json:\"domains\"`" + `
+	AdditionalProperties map[string]interface{}
+}
+`
+
+func Test_ListAll_Methods(t *testing.T) {
+	lists := scanSource([]byte(testSource))
+	require.Equal(t, []List{{Operation: "ListUsers", Item: "User"}}, lists)
+
+	var src bytes.Buffer
+	require.NoError(t, writeMethods(&src, "okta", lists))
+	assert.Contains(t, src.String(), "// Code generated by listall. DO NOT EDIT.")
+	assert.Contains(t, src.String(), "func (c *APIClient) ListAllUsers(r ApiListUsersRequest) ([]User, error) {")
+}
+
+func Test_ListAll_Embedded_Methods(t *testing.T) {
+	pages := scanPages([]byte(testSource))
+	require.Len(t, pages, 2)
+	lists := embeddedLists(pages, scanModels([]byte(testModels)))
+	require.Equal(t, []List{{Operation: "ListResourceSets", Item: "ResourceSet", Page: "ResourceSets", Field: "ResourceSets"}}, lists)
+
+	var src bytes.Buffer
+	require.NoError(t, writeMethods(&src, "okta", lists))
+	assert.Contains(t, src.String(), "func (c *APIClient) ListAllResourceSets(r ApiListResourceSetsRequest) ([]ResourceSet, error) {")
+	assert.Contains(t, src.String(), "return ListAllEmbedded(r.ctx, c, r.Execute, func(p *ResourceSets) ([]ResourceSet, *HrefObject) {")
+}
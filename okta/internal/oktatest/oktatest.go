@@ -0,0 +1,39 @@
+// Package oktatest holds the helpers shared by the tests of the packages built
+// on the Okta client.
+package oktatest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/stretchr/testify/require"
+)
+
+// OrgUrl is the org of the clients returned by NewClient.
+const OrgUrl = "https://acme.okta.com"
+
+// JSONResponse returns a response with status and the JSON body.
+func JSONResponse(status int, body string) *http.Response {
+	resp := httpmock.NewStringResponse(status, body)
+	resp.Header.Set("Content-Type", "application/json")
+	return resp
+}
+
+// JSONResponder responds to every request with status and the JSON body.
+func JSONResponder(status int, body string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		return JSONResponse(status, body), nil
+	}
+}
+
+// NewClient returns a client of OrgUrl authorized with an SSWS token, without
+// a request cache. conf is applied after those settings.
+func NewClient(t testing.TB, conf ...okta.ConfigSetter) *okta.APIClient {
+	t.Helper()
+	conf = append([]okta.ConfigSetter{okta.WithOrgUrl(OrgUrl), okta.WithToken("token"), okta.WithCache(false)}, conf...)
+	configuration, err := okta.NewConfiguration(conf...)
+	require.NoError(t, err, "Creating a new config should not error")
+	return okta.NewAPIClient(configuration)
+}
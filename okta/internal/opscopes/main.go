@@ -0,0 +1,122 @@
+// Command opscopes reads the OAuth 2.0 scopes every operation of the bundled
+// OpenAPI spec requires and writes them to a Go source file, so the okta
+// package can tell which scopes a rejected call was missing.
+//
+// It is wired to the okta package through go generate:
+//
+//	go generate ./okta
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// Operation is a method/path pair of the spec and the scopes it requires.
+type Operation struct {
+	Method string
+	Path   string
+	Scopes []string
+}
+
+type specDocument struct {
+	Paths map[string]map[string]yaml.Node `yaml:"paths"`
+}
+
+type specOperation struct {
+	Security []map[string][]string `yaml:"security"`
+}
+
+// readSpec returns every operation of the spec that can be called with an
+// OAuth 2.0 access token.
+func readSpec(r io.Reader) ([]Operation, error) {
+	var doc specDocument
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	var ops []Operation
+	for path, item := range doc.Paths {
+		for _, method := range httpMethods {
+			node, ok := item[method]
+			if !ok {
+				continue
+			}
+			var so specOperation
+			if err := node.Decode(&so); err != nil {
+				return nil, fmt.Errorf("failed to decode %s %s: %w", method, path, err)
+			}
+			var scopes []string
+			for _, requirement := range so.Security {
+				scopes = append(scopes, requirement["oauth2"]...)
+			}
+			if len(scopes) == 0 {
+				continue
+			}
+			ops = append(ops, Operation{Method: strings.ToUpper(method), Path: path, Scopes: scopes})
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].Path == ops[j].Path {
+			return ops[i].Method < ops[j].Method
+		}
+		return ops[i].Path < ops[j].Path
+	})
+	return ops, nil
+}
+
+// writeScopes emits a formatted Go source file declaring operationScopes.
+func writeScopes(w io.Writer, pkg string, ops []Operation) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by opscopes. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	buf.WriteString("// operationScopes lists the OAuth 2.0 scopes required by each operation of\n// the API.\n")
+	buf.WriteString("var operationScopes = []operationScope{\n")
+	for _, op := range ops {
+		fmt.Fprintf(&buf, "\t{%q, %q, %#v},\n", op.Method, op.Path, op.Scopes)
+	}
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+func main() {
+	specPath := flag.String("spec", "api/openapi.yaml", "path to the bundled OpenAPI spec")
+	output := flag.String("o", "operation_scopes.go", "file to write the scopes to")
+	pkg := flag.String("package", "okta", "package name used for the generated file")
+	flag.Parse()
+
+	if err := run(*specPath, *output, *pkg); err != nil {
+		fmt.Fprintln(os.Stderr, "opscopes:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, output, pkg string) error {
+	f, err := os.Open(specPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	ops, err := readSpec(f)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := writeScopes(&buf, pkg, ops); err != nil {
+		return err
+	}
+	return os.WriteFile(output, buf.Bytes(), 0o644)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `
+openapi: 3.0.3
+paths:
+  /api/v1/users/{id}:
+    get:
+      operationId: getUser
+      security:
+        - apiToken: []
+        - oauth2:
+            - okta.users.read
+    delete:
+      operationId: deleteUser
+      security:
+        - apiToken: []
+        - oauth2:
+            - okta.users.manage
+  /.well-known/okta-organization:
+    get:
+      operationId: getWellknownOrgMetadata
+      security: []
+`
+
+func Test_Scopes_Of_Operations(t *testing.T) {
+	ops, err := readSpec(strings.NewReader(testSpec))
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+	assert.Equal(t, Operation{Method: "DELETE", Path: "/api/v1/users/{id}", Scopes: []string{"okta.users.manage"}}, ops[0])
+	assert.Equal(t, Operation{Method: "GET", Path: "/api/v1/users/{id}", Scopes: []string{"okta.users.read"}}, ops[1])
+
+	var src bytes.Buffer
+	require.NoError(t, writeScopes(&src, "okta", ops))
+	assert.Contains(t, src.String(), "// Code generated by opscopes. DO NOT EDIT.")
+	assert.Contains(t, src.String(), `{"GET", "/api/v1/users/{id}", []string{"okta.users.read"}},`)
+}
@@ -0,0 +1,190 @@
+// Command patchops writes a Patch method to the APIClient for every operation
+// of the bundled OpenAPI spec that partially updates a resource, so callers
+// can send only the properties they change instead of reading, modifying and
+// replacing the whole resource.
+//
+// It is wired to the okta package through go generate:
+//
+//	go generate ./okta
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const schemaPrefix = "#/components/schemas/"
+
+var pathParam = regexp.MustCompile(`\{(\w+)\}`)
+
+// Operation is a partial update operation of the spec.
+type Operation struct {
+	ID     string
+	Path   string
+	Params []string
+	// Result is the type of the updated resource.
+	Result string
+}
+
+// name is the name of the APIClient method sending a patch to o.
+func (o Operation) name() string {
+	return "Patch" + strings.TrimPrefix(strings.ToUpper(o.ID[:1])+o.ID[1:], "Update")
+}
+
+type specDocument struct {
+	Paths map[string]map[string]yaml.Node `yaml:"paths"`
+}
+
+type specOperation struct {
+	OperationID string `yaml:"operationId"`
+	Summary     string `yaml:"summary"`
+	Description string `yaml:"description"`
+	RequestBody struct {
+		Content map[string]yaml.Node `yaml:"content"`
+	} `yaml:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]struct {
+			Schema struct {
+				Ref string `yaml:"$ref"`
+			} `yaml:"schema"`
+		} `yaml:"content"`
+	} `yaml:"responses"`
+}
+
+// readSpec returns the POST operations of the spec documented as partial
+// updates that take and return a JSON resource.
+func readSpec(r io.Reader) ([]Operation, error) {
+	var doc specDocument
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	var ops []Operation
+	for path, item := range doc.Paths {
+		node, ok := item["post"]
+		if !ok {
+			continue
+		}
+		var so specOperation
+		if err := node.Decode(&so); err != nil {
+			return nil, fmt.Errorf("failed to decode post %s: %w", path, err)
+		}
+		if !strings.Contains(strings.ToLower(so.Summary+" "+so.Description), "partial") {
+			continue
+		}
+		if _, ok := so.RequestBody.Content["application/json"]; !ok {
+			continue
+		}
+		ref := so.Responses["200"].Content["application/json"].Schema.Ref
+		if !strings.HasPrefix(ref, schemaPrefix) {
+			continue
+		}
+		op := Operation{ID: so.OperationID, Path: path, Result: strings.TrimPrefix(ref, schemaPrefix)}
+		for _, m := range pathParam.FindAllStringSubmatch(path, -1) {
+			op.Params = append(op.Params, m[1])
+		}
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].ID < ops[j].ID
+	})
+	return ops, nil
+}
+
+// declaredTypes returns the names of the types declared by the model_*.go
+// files in dir.
+func declaredTypes(dir string) (map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "model_*.go"))
+	if err != nil {
+		return nil, err
+	}
+	typeDecl := regexp.MustCompile(`(?m)^type (\w+) struct`)
+	types := map[string]bool{}
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range typeDecl.FindAllSubmatch(src, -1) {
+			types[string(m[1])] = true
+		}
+	}
+	return types, nil
+}
+
+// writeMethods emits a formatted Go source file declaring the Patch methods.
+func writeMethods(w io.Writer, pkg string, ops []Operation) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by patchops. DO NOT EDIT.\n\npackage %s\n\nimport \"context\"\n", pkg)
+	for _, op := range ops {
+		var params, replacements []string
+		for _, p := range op.Params {
+			params = append(params, p+" string")
+			replacements = append(replacements, fmt.Sprintf("%q, %s", "{"+p+"}", p))
+		}
+		fmt.Fprintf(&buf, "\n// %s partially updates the %s of POST %s with patch, see Patch.\n", op.name(), op.Result, op.Path)
+		fmt.Fprintf(&buf, "func (c *APIClient) %s(ctx context.Context, %s) (*%s, *APIResponse, error) {\n", op.name(), strings.Join(append(params, "patch Patch"), ", "), op.Result)
+		fmt.Fprintf(&buf, "\tvar result %s\n", op.Result)
+		path := fmt.Sprintf("%q", op.Path)
+		if len(replacements) > 0 {
+			path = fmt.Sprintf("expandPath(%s, %s)", path, strings.Join(replacements, ", "))
+		}
+		fmt.Fprintf(&buf, "\tresp, err := c.sendPatch(ctx, %s, patch, &result)\n", path)
+		buf.WriteString("\tif err != nil {\n\t\treturn nil, resp, err\n\t}\n\treturn &result, resp, nil\n}\n")
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+func main() {
+	specPath := flag.String("spec", "api/openapi.yaml", "path to the bundled OpenAPI spec")
+	dir := flag.String("dir", ".", "directory containing the generated model_*.go files")
+	output := flag.String("o", "patch_methods.go", "file to write the methods to")
+	pkg := flag.String("package", "okta", "package name used for the generated file")
+	flag.Parse()
+
+	if err := run(*specPath, *dir, *output, *pkg); err != nil {
+		fmt.Fprintln(os.Stderr, "patchops:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, dir, output, pkg string) error {
+	f, err := os.Open(specPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	ops, err := readSpec(f)
+	if err != nil {
+		return err
+	}
+	types, err := declaredTypes(dir)
+	if err != nil {
+		return err
+	}
+	var generated []Operation
+	for _, op := range ops {
+		if types[op.Result] {
+			generated = append(generated, op)
+		}
+	}
+	var buf bytes.Buffer
+	if err := writeMethods(&buf, pkg, generated); err != nil {
+		return err
+	}
+	return os.WriteFile(output, buf.Bytes(), 0o644)
+}
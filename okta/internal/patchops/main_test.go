@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `
+openapi: 3.0.3
+paths:
+  /api/v1/users/{userId}:
+    post:
+      operationId: updateUser
+      summary: Update a User
+      description: Updates a user's profile or credentials with partial update semantics
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/UpdateUserRequest'
+      responses:
+        '200':
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/User'
+    put:
+      operationId: replaceUser
+      summary: Replace a User
+  /api/v1/users/{userId}/lifecycle/activate:
+    post:
+      operationId: activateUser
+      summary: Activate a User
+`
+
+func Test_Partial_Update_Operations(t *testing.T) {
+	ops, err := readSpec(strings.NewReader(testSpec))
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, Operation{ID: "updateUser", Path: "/api/v1/users/{userId}", Params: []string{"userId"}, Result: "User"}, ops[0])
+	assert.Equal(t, "PatchUser", ops[0].name())
+
+	var src bytes.Buffer
+	require.NoError(t, writeMethods(&src, "okta", ops))
+	assert.Contains(t, src.String(), "// Code generated by patchops. DO NOT EDIT.")
+	assert.Contains(t, src.String(), "func (c *APIClient) PatchUser(ctx context.Context, userId string, patch Patch) (*User, *APIResponse, error) {")
+	assert.Contains(t, src.String(), `expandPath("/api/v1/users/{userId}", "{userId}", userId)`)
+}
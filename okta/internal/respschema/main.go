@@ -0,0 +1,214 @@
+// Command respschema extracts the schemas of the successful JSON responses of
+// every operation of the bundled OpenAPI spec into a compact JSON document,
+// embedded by the schemavalidate package to check responses at runtime.
+//
+// It is wired to the okta package through go generate:
+//
+//	go generate ./okta
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+const schemaPrefix = "#/components/schemas/"
+
+// Schema is the part of an OpenAPI schema object responses are checked
+// against. References are reduced to the name of the component schema.
+type Schema struct {
+	Ref                  string             `yaml:"$ref" json:"ref,omitempty"`
+	Type                 string             `yaml:"type" json:"type,omitempty"`
+	Properties           map[string]*Schema `yaml:"properties" json:"properties,omitempty"`
+	Required             []string           `yaml:"required" json:"required,omitempty"`
+	Items                *Schema            `yaml:"items" json:"items,omitempty"`
+	AllOf                []*Schema          `yaml:"allOf" json:"allOf,omitempty"`
+	OneOf                []*Schema          `yaml:"oneOf" json:"oneOf,omitempty"`
+	AnyOf                []*Schema          `yaml:"anyOf" json:"anyOf,omitempty"`
+	AdditionalProperties yaml.Node          `yaml:"additionalProperties" json:"-"`
+	Additional           *Schema            `yaml:"-" json:"additional,omitempty"`
+	Open                 bool               `yaml:"-" json:"open,omitempty"`
+	Closed               bool               `yaml:"-" json:"closed,omitempty"`
+	Discriminator        *Discriminator     `yaml:"discriminator" json:"discriminator,omitempty"`
+}
+
+// Discriminator maps the values of a property to the schema of the object.
+type Discriminator struct {
+	PropertyName string            `yaml:"propertyName" json:"propertyName"`
+	Mapping      map[string]string `yaml:"mapping" json:"mapping,omitempty"`
+}
+
+// Operation is a method/path pair of the spec and the schemas of its
+// successful responses by status code.
+type Operation struct {
+	Method    string             `json:"method"`
+	Path      string             `json:"path"`
+	Responses map[string]*Schema `json:"responses"`
+}
+
+// Document is the output of the command.
+type Document struct {
+	Operations []Operation        `json:"operations"`
+	Schemas    map[string]*Schema `json:"schemas"`
+}
+
+type specDocument struct {
+	Paths      map[string]map[string]yaml.Node `yaml:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+type specOperation struct {
+	Responses map[string]struct {
+		Content map[string]struct {
+			Schema *Schema `yaml:"schema"`
+		} `yaml:"content"`
+	} `yaml:"responses"`
+}
+
+// readSpec returns the operations of the spec with a successful JSON
+// response and the component schemas these responses refer to.
+func readSpec(r io.Reader) (*Document, error) {
+	var doc specDocument
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	out := &Document{Schemas: map[string]*Schema{}}
+	var pending []string
+	var visit func(s *Schema) error
+	visit = func(s *Schema) error {
+		if s == nil {
+			return nil
+		}
+		if s.Ref != "" {
+			if !strings.HasPrefix(s.Ref, schemaPrefix) {
+				return fmt.Errorf("unsupported reference %s", s.Ref)
+			}
+			s.Ref = strings.TrimPrefix(s.Ref, schemaPrefix)
+			if _, ok := doc.Components.Schemas[s.Ref]; !ok {
+				return fmt.Errorf("unknown schema %s", s.Ref)
+			}
+			if _, ok := out.Schemas[s.Ref]; !ok {
+				out.Schemas[s.Ref] = nil
+				pending = append(pending, s.Ref)
+			}
+			return nil
+		}
+		if node := s.AdditionalProperties; node.Kind != 0 {
+			var open bool
+			if node.Decode(&open) == nil {
+				s.Open, s.Closed = open, !open
+			} else {
+				s.Additional = &Schema{}
+				if err := node.Decode(s.Additional); err != nil {
+					return err
+				}
+			}
+		}
+		if d := s.Discriminator; d != nil {
+			for value, ref := range d.Mapping {
+				target := &Schema{Ref: ref}
+				if err := visit(target); err != nil {
+					return err
+				}
+				d.Mapping[value] = target.Ref
+			}
+		}
+		children := append([]*Schema{s.Items, s.Additional}, s.AllOf...)
+		children = append(children, s.OneOf...)
+		children = append(children, s.AnyOf...)
+		for _, p := range s.Properties {
+			children = append(children, p)
+		}
+		for _, child := range children {
+			if err := visit(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for path, item := range doc.Paths {
+		for _, method := range httpMethods {
+			node, ok := item[method]
+			if !ok {
+				continue
+			}
+			var so specOperation
+			if err := node.Decode(&so); err != nil {
+				return nil, fmt.Errorf("failed to decode %s %s: %w", method, path, err)
+			}
+			op := Operation{Method: strings.ToUpper(method), Path: path, Responses: map[string]*Schema{}}
+			for status, resp := range so.Responses {
+				if !strings.HasPrefix(status, "2") {
+					continue
+				}
+				content, ok := resp.Content["application/json"]
+				if !ok || content.Schema == nil {
+					continue
+				}
+				if err := visit(content.Schema); err != nil {
+					return nil, fmt.Errorf("%s %s: %w", method, path, err)
+				}
+				op.Responses[status] = content.Schema
+			}
+			if len(op.Responses) > 0 {
+				out.Operations = append(out.Operations, op)
+			}
+		}
+	}
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		s := doc.Components.Schemas[name]
+		if err := visit(s); err != nil {
+			return nil, fmt.Errorf("schema %s: %w", name, err)
+		}
+		out.Schemas[name] = s
+	}
+	sort.Slice(out.Operations, func(i, j int) bool {
+		if out.Operations[i].Path == out.Operations[j].Path {
+			return out.Operations[i].Method < out.Operations[j].Method
+		}
+		return out.Operations[i].Path < out.Operations[j].Path
+	})
+	return out, nil
+}
+
+func main() {
+	specPath := flag.String("spec", "api/openapi.yaml", "path to the bundled OpenAPI spec")
+	output := flag.String("o", "schemavalidate/schemas.json", "file to write the schemas to")
+	flag.Parse()
+
+	if err := run(*specPath, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "respschema:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, output string) error {
+	f, err := os.Open(specPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	doc, err := readSpec(f)
+	if err != nil {
+		return err
+	}
+	src, err := json.MarshalIndent(doc, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(output, append(src, '\n'), 0o644)
+}
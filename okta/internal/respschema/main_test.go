@@ -0,0 +1,74 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `
+openapi: 3.0.3
+paths:
+  /api/v1/groups/{groupId}:
+    get:
+      operationId: getGroup
+      responses:
+        "200":
+          content:
+            application/json:
+              example:
+                id: 00g1
+              schema:
+                $ref: '#/components/schemas/Group'
+        "404":
+          $ref: '#/components/responses/ErrorResourceNotFound404'
+    delete:
+      operationId: deleteGroup
+      responses:
+        "204":
+          description: No Content
+components:
+  schemas:
+    Group:
+      type: object
+      description: A group
+      required:
+        - id
+      properties:
+        id:
+          type: string
+          readOnly: true
+        profile:
+          $ref: '#/components/schemas/GroupProfile'
+        _embedded:
+          type: object
+          additionalProperties: true
+    GroupProfile:
+      type: object
+      additionalProperties: false
+      properties:
+        name:
+          type: string
+    Unused:
+      type: string
+`
+
+func Test_Response_Schemas(t *testing.T) {
+	doc, err := readSpec(strings.NewReader(testSpec))
+	require.NoError(t, err)
+	require.Len(t, doc.Operations, 1, "Operations without a JSON success response are skipped")
+	op := doc.Operations[0]
+	assert.Equal(t, "GET", op.Method)
+	assert.Equal(t, "/api/v1/groups/{groupId}", op.Path)
+	assert.Equal(t, "Group", op.Responses["200"].Ref)
+	assert.NotContains(t, op.Responses, "404")
+
+	require.Len(t, doc.Schemas, 2, "Only referenced schemas are kept")
+	group := doc.Schemas["Group"]
+	assert.Equal(t, []string{"id"}, group.Required)
+	assert.Equal(t, "GroupProfile", group.Properties["profile"].Ref)
+	assert.True(t, group.Properties["_embedded"].Open)
+	assert.True(t, doc.Schemas["GroupProfile"].Closed)
+}
@@ -0,0 +1,92 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Resources counted by Limits, as keyed in LimitsReport.Usage and
+// WithPlanCeilings.
+const (
+	LimitUsers     = "users"
+	LimitApps      = "apps"
+	LimitAPITokens = "apiTokens"
+)
+
+// limitCounts are the list endpoints counted for each resource.
+var limitCounts = []struct {
+	resource string
+	path     string
+}{
+	{LimitUsers, "/api/v1/users"},
+	{LimitApps, "/api/v1/apps"},
+	{LimitAPITokens, "/api/v1/api-tokens"},
+}
+
+// LimitUsage is the number of resources of a kind in an org and the ceiling of
+// its plan.
+type LimitUsage struct {
+	Count int
+	// Ceiling is the number of resources the plan of the org allows, as set
+	// with WithPlanCeilings, 0 when unknown.
+	Ceiling int
+}
+
+// Ratio returns the share of the ceiling in use, 0 when the ceiling is
+// unknown.
+func (u LimitUsage) Ratio() float64 {
+	if u.Ceiling <= 0 {
+		return 0
+	}
+	return float64(u.Count) / float64(u.Ceiling)
+}
+
+// LimitsReport gathers the limits of an org, for capacity planning.
+type LimitsReport struct {
+	// RateLimitSettings are the rate limit settings configured by the admins
+	// of the org.
+	RateLimitSettings *RateLimitSettings
+	// RateLimits are the rate limits of the endpoints the client called, see
+	// APIClient.RateLimits.
+	RateLimits map[string]RateLimit
+	// Usage holds the number of users, apps and API tokens of the org, by
+	// LimitUsers, LimitApps and LimitAPITokens.
+	Usage map[string]LimitUsage
+	// CheckedAt is when the report was made.
+	CheckedAt time.Time
+}
+
+// Limits reports the rate limit settings of the org, the rate limits of the
+// endpoints the client called, and the number of users, apps and API tokens
+// against the ceilings of its plan, set with WithPlanCeilings. Counting lists
+// every user, which takes a request per 200 users; deprovisioned users are
+// not counted, as Okta does not list them by default.
+func (c *APIClient) Limits(ctx context.Context) (*LimitsReport, error) {
+	settings, err := (&RateLimitPlanner{client: c}).Read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the rate limit settings: %w", err)
+	}
+	report := &LimitsReport{
+		RateLimitSettings: settings,
+		RateLimits:        c.RateLimits(),
+		Usage:             make(map[string]LimitUsage, len(limitCounts)),
+		CheckedAt:         time.Now(),
+	}
+	for _, count := range limitCounts {
+		usage := LimitUsage{Ceiling: c.cfg.PlanCeilings[count.resource]}
+		query := url.Values{}
+		query.Set("limit", "200")
+		err := listPages(ctx, c, count.path, query, map[string]string{"Accept": "application/json"}, func(page []json.RawMessage) error {
+			usage.Count += len(page)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", count.resource, err)
+		}
+		report.Usage[count.resource] = usage
+	}
+	return report, nil
+}
@@ -0,0 +1,48 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Limits(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/principal-rate-limits", httpmock.ResponderFromResponse(mockJSONResponse(200, `[]`)))
+	httpmock.RegisterResponder("GET", "/api/v1/rate-limit-settings/per-client", httpmock.ResponderFromResponse(mockJSONResponse(200, `{"defaultMode":"PREVIEW"}`)))
+	httpmock.RegisterResponder("GET", "/api/v1/rate-limit-settings/warning-threshold", httpmock.ResponderFromResponse(mockJSONResponse(200, `{"warningThreshold":60}`)))
+	httpmock.RegisterResponder("GET", "/api/v1/rate-limit-settings/admin-notifications", httpmock.ResponderFromResponse(mockJSONResponse(200, `{"notificationsEnabled":false}`)))
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "200", req.URL.Query().Get("limit"))
+		if req.URL.Query().Get("after") == "" {
+			resp := mockJSONResponse(200, `[{"id":"00u1"},{"id":"00u2"}]`)
+			resp.Header.Add("Link", `<https://example.okta.com/api/v1/users?after=00u2&limit=200>; rel="next"`)
+			return resp, nil
+		}
+		return mockJSONResponse(200, `[{"id":"00u3"}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/apps", httpmock.ResponderFromResponse(mockJSONResponse(200, `[{"id":"0oa1"}]`)))
+	httpmock.RegisterResponder("GET", "/api/v1/api-tokens", httpmock.ResponderFromResponse(mockJSONResponse(200, `[]`)))
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false),
+		WithPlanCeilings(map[string]int{LimitUsers: 4, LimitApps: 10}))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	report, err := client.Limits(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "PREVIEW", report.RateLimitSettings.PerClient.DefaultMode)
+	assert.Equal(t, int32(60), report.RateLimitSettings.WarningThreshold)
+	assert.Equal(t, LimitUsage{Count: 3, Ceiling: 4}, report.Usage[LimitUsers], "Every page of users should be counted")
+	assert.Equal(t, 0.75, report.Usage[LimitUsers].Ratio())
+	assert.Equal(t, 0.1, report.Usage[LimitApps].Ratio())
+	assert.Equal(t, LimitUsage{}, report.Usage[LimitAPITokens])
+	assert.Zero(t, report.Usage[LimitAPITokens].Ratio(), "An unknown ceiling has no ratio")
+	assert.False(t, report.CheckedAt.IsZero())
+}
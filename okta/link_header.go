@@ -0,0 +1,135 @@
+package okta
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Link is a link of the Link header of a response.
+type Link struct {
+	// URL is the target of the link as sent by Okta, an absolute URL.
+	URL string
+	// Rel is the relation of the link, such as "self" or "next".
+	Rel string
+	// Params are the parameters of the link, rel included, by lower case
+	// name.
+	Params map[string]string
+}
+
+// ParseLinkHeaders returns the links of the Link headers of resp in order.
+// Okta sends a header per link, as in
+//
+//	Link: <https://example.okta.com/api/v1/users?limit=200>; rel="self"
+//	Link: <https://example.okta.com/api/v1/users?after=00u1&limit=200>; rel="next"
+//
+// and links joined with commas in a single header are parsed as well. It is
+// meant for raw calls, the responses of the generated methods are paginated
+// with APIResponse.Next.
+func ParseLinkHeaders(resp *http.Response) []Link {
+	if resp == nil {
+		return nil
+	}
+	var links []Link
+	for _, header := range resp.Header.Values("Link") {
+		links = append(links, parseLinkHeader(header)...)
+	}
+	return links
+}
+
+// NextPageURL returns the URL of the next page of resp, or an empty string on
+// the last page.
+func NextPageURL(resp *http.Response) string {
+	for _, link := range ParseLinkHeaders(resp) {
+		if hasRel(link, "next") {
+			return link.URL
+		}
+	}
+	return ""
+}
+
+// hasRel reports whether rel is one of the relations of link.
+func hasRel(link Link, rel string) bool {
+	for _, r := range strings.Fields(link.Rel) {
+		if r == rel {
+			return true
+		}
+	}
+	return false
+}
+
+// parseLinkHeader parses the links of a Link header as defined by RFC 8288,
+// skipping malformed ones.
+func parseLinkHeader(header string) []Link {
+	var links []Link
+	for {
+		start := strings.IndexByte(header, '<')
+		if start < 0 {
+			return links
+		}
+		end := strings.IndexByte(header[start:], '>')
+		if end < 0 {
+			return links
+		}
+		link := Link{URL: header[start+1 : start+end], Params: map[string]string{}}
+		header = header[start+end+1:]
+		// the parameters up to the comma ending the link, outside of quotes
+		for {
+			header = strings.TrimLeft(header, " \t")
+			if header == "" || header[0] == ',' {
+				break
+			}
+			if header[0] != ';' {
+				// not a parameter, skip to the next link
+				i := strings.IndexByte(header, ',')
+				if i < 0 {
+					header = ""
+				} else {
+					header = header[i:]
+				}
+				break
+			}
+			var name, value string
+			name, value, header = parseLinkParam(header[1:])
+			if name != "" {
+				link.Params[name] = value
+			}
+		}
+		link.Rel = link.Params["rel"]
+		links = append(links, link)
+	}
+}
+
+// parseLinkParam parses a name=value parameter, the value being a token or a
+// quoted string, and returns the rest of the header.
+func parseLinkParam(s string) (name, value, rest string) {
+	s = strings.TrimLeft(s, " \t")
+	i := strings.IndexAny(s, "=;,")
+	if i < 0 {
+		return strings.ToLower(strings.TrimSpace(s)), "", ""
+	}
+	name = strings.ToLower(strings.TrimSpace(s[:i]))
+	if s[i] != '=' {
+		return name, "", s[i:]
+	}
+	s = strings.TrimLeft(s[i+1:], " \t")
+	if strings.HasPrefix(s, `"`) {
+		var b strings.Builder
+		for j := 1; j < len(s); j++ {
+			switch c := s[j]; {
+			case c == '\\' && j+1 < len(s):
+				j++
+				b.WriteByte(s[j])
+			case c == '"':
+				return name, b.String(), s[j+1:]
+			default:
+				b.WriteByte(c)
+			}
+		}
+		return name, b.String(), ""
+	}
+	i = strings.IndexAny(s, ";,")
+	if i < 0 {
+		return name, strings.TrimSpace(s), ""
+	}
+	return name, strings.TrimSpace(s[:i]), s[i:]
+}
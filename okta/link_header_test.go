@@ -0,0 +1,86 @@
+package okta
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Parse_Link_Headers(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		want    []Link
+		next    string
+	}{
+		{
+			name: "a header per link",
+			headers: []string{
+				`<https://example.okta.com/api/v1/users?limit=200>; rel="self"`,
+				`<https://example.okta.com/api/v1/users?after=00ud4tVDDXYVKPXKVLCO&limit=200>; rel="next"`,
+			},
+			want: []Link{
+				{URL: "https://example.okta.com/api/v1/users?limit=200", Rel: "self", Params: map[string]string{"rel": "self"}},
+				{URL: "https://example.okta.com/api/v1/users?after=00ud4tVDDXYVKPXKVLCO&limit=200", Rel: "next", Params: map[string]string{"rel": "next"}},
+			},
+			next: "https://example.okta.com/api/v1/users?after=00ud4tVDDXYVKPXKVLCO&limit=200",
+		},
+		{
+			name:    "links joined with commas",
+			headers: []string{`<https://example.okta.com/api/v1/logs?since=2024-01-01T00%3A00%3A00Z>; rel="self", <https://example.okta.com/api/v1/logs?after=1700000000000_1>; rel="next"`},
+			want: []Link{
+				{URL: "https://example.okta.com/api/v1/logs?since=2024-01-01T00%3A00%3A00Z", Rel: "self", Params: map[string]string{"rel": "self"}},
+				{URL: "https://example.okta.com/api/v1/logs?after=1700000000000_1", Rel: "next", Params: map[string]string{"rel": "next"}},
+			},
+			next: "https://example.okta.com/api/v1/logs?after=1700000000000_1",
+		},
+		{
+			name:    "last page",
+			headers: []string{`<https://example.okta.com/api/v1/groups?limit=20>; rel="self"`},
+			want:    []Link{{URL: "https://example.okta.com/api/v1/groups?limit=20", Rel: "self", Params: map[string]string{"rel": "self"}}},
+		},
+		{
+			name:    "commas in the URL and unquoted parameters",
+			headers: []string{`<https://example.okta.com/api/v1/users?search=profile.department+eq+"a,b"&after=00u2>;REL=next;title="page, 2"`},
+			want: []Link{{
+				URL:    `https://example.okta.com/api/v1/users?search=profile.department+eq+"a,b"&after=00u2`,
+				Rel:    "next",
+				Params: map[string]string{"rel": "next", "title": "page, 2"},
+			}},
+			next: `https://example.okta.com/api/v1/users?search=profile.department+eq+"a,b"&after=00u2`,
+		},
+		{
+			name:    "several relations",
+			headers: []string{`<https://example.okta.com/api/v1/apps?after=0oa2>; rel="next last"`},
+			want:    []Link{{URL: "https://example.okta.com/api/v1/apps?after=0oa2", Rel: "next last", Params: map[string]string{"rel": "next last"}}},
+			next:    "https://example.okta.com/api/v1/apps?after=0oa2",
+		},
+		{
+			name:    "malformed",
+			headers: []string{`https://example.okta.com/api/v1/users; rel="next"`, `<https://example.okta.com/api/v1/users`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{"Link": tt.headers}}
+			assert.Equal(t, tt.want, ParseLinkHeaders(resp))
+			assert.Equal(t, tt.next, NextPageURL(resp))
+		})
+	}
+	assert.Nil(t, ParseLinkHeaders(nil))
+	assert.Empty(t, NextPageURL(nil))
+}
+
+func Test_Pagination_In_Header_Joined_Links(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://example.okta.com/api/v1/users?limit=2", nil)
+	resp := &http.Response{
+		Request: req,
+		Header: http.Header{"Link": []string{
+			`<https://example.okta.com/api/v1/users?limit=2>; rel="self", <https://example.okta.com/api/v1/users?after=00u2&limit=2>; rel="next"`,
+		}},
+	}
+	pg := newPaginationInHeader(resp)
+	assert.Equal(t, "/api/v1/users?limit=2", pg.Self())
+	assert.Equal(t, "/api/v1/users?after=00u2&limit=2", pg.NextPage())
+}
@@ -0,0 +1,94 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// defaultListAllMaxPages is the number of pages ListAll follows when
+// Configuration.ListAllMaxPages is not set.
+const defaultListAllMaxPages = 1000
+
+// ErrTooManyPages is returned by ListAll when a list has more pages than
+// allowed by WithListAllMaxPages.
+var ErrTooManyPages = errors.New("list has more pages than allowed")
+
+// ListAll calls execute, a list operation such as
+// client.UserAPI.ListUsers(ctx).Execute, and follows the next links of its
+// responses until the last page, returning the items of every page. It fails
+// with ErrTooManyPages, along with the items read so far, once the number of
+// pages set by WithListAllMaxPages was read.
+//
+// The APIClient has a ListAll method for every list operation of the API,
+// such as ListAllUsers, that calls ListAll with the context of the request.
+func ListAll[T any](ctx context.Context, c *APIClient, execute func() ([]T, *APIResponse, error)) ([]T, error) {
+	items, resp, err := execute()
+	if err != nil {
+		return nil, err
+	}
+	maxPages := c.cfg.ListAllMaxPages
+	if maxPages <= 0 {
+		maxPages = defaultListAllMaxPages
+	}
+	for pages := 1; resp.HasNextPage(); pages++ {
+		if pages >= maxPages {
+			return items, fmt.Errorf("%w: stopped after %d pages", ErrTooManyPages, pages)
+		}
+		next, err := url.Parse(resp.NextPage())
+		if err != nil {
+			return items, err
+		}
+		var page []T
+		if resp, err = resp.next(ctx, next, &page); err != nil {
+			return items, err
+		}
+		items = append(items, page...)
+	}
+	return items, nil
+}
+
+// ListAllEmbedded is ListAll for the list operations whose response is an
+// object embedding the items of the page and the link to the next one, such
+// as client.ResourceSetAPI.ListResourceSets(ctx).Execute, rather than a slice
+// with the next link in the Link header. page returns the items and the next
+// link of a response; the Link header is followed when it has no next link.
+func ListAllEmbedded[P any, T any](ctx context.Context, c *APIClient, execute func() (*P, *APIResponse, error), page func(*P) ([]T, *HrefObject)) ([]T, error) {
+	first, resp, err := execute()
+	if err != nil {
+		return nil, err
+	}
+	var items []T
+	var next *HrefObject
+	if first != nil {
+		items, next = page(first)
+	}
+	maxPages := c.cfg.ListAllMaxPages
+	if maxPages <= 0 {
+		maxPages = defaultListAllMaxPages
+	}
+	for pages := 1; ; pages++ {
+		nextPage := resp.NextPage()
+		if next != nil && next.Href != "" {
+			nextPage = next.Href
+		}
+		if nextPage == "" {
+			return items, nil
+		}
+		if pages >= maxPages {
+			return items, fmt.Errorf("%w: stopped after %d pages", ErrTooManyPages, pages)
+		}
+		URL, err := url.Parse(nextPage)
+		if err != nil {
+			return items, err
+		}
+		var p P
+		if resp, err = resp.next(ctx, URL, &p); err != nil {
+			return items, err
+		}
+		var pageItems []T
+		pageItems, next = page(&p)
+		items = append(items, pageItems...)
+	}
+}
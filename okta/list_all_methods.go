@@ -0,0 +1,523 @@
+// Code generated by listall. DO NOT EDIT.
+
+package okta
+
+// ListAllAgentPools returns the items of every page of ListAgentPools, see ListAll.
+func (c *APIClient) ListAllAgentPools(r ApiListAgentPoolsRequest) ([]AgentPool, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAgentPoolsUpdates returns the items of every page of ListAgentPoolsUpdates, see ListAll.
+func (c *APIClient) ListAllAgentPoolsUpdates(r ApiListAgentPoolsUpdatesRequest) ([]AgentPoolUpdate, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllApiServiceIntegrationInstanceSecrets returns the items of every page of ListApiServiceIntegrationInstanceSecrets, see ListAll.
+func (c *APIClient) ListAllApiServiceIntegrationInstanceSecrets(r ApiListApiServiceIntegrationInstanceSecretsRequest) ([]APIServiceIntegrationInstanceSecret, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllApiServiceIntegrationInstances returns the items of every page of ListApiServiceIntegrationInstances, see ListAll.
+func (c *APIClient) ListAllApiServiceIntegrationInstances(r ApiListApiServiceIntegrationInstancesRequest) ([]APIServiceIntegrationInstance, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllApiTokens returns the items of every page of ListApiTokens, see ListAll.
+func (c *APIClient) ListAllApiTokens(r ApiListApiTokensRequest) ([]ApiToken, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAppLinks returns the items of every page of ListAppLinks, see ListAll.
+func (c *APIClient) ListAllAppLinks(r ApiListAppLinksRequest) ([]AppLink, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllApplicationGroupAssignments returns the items of every page of ListApplicationGroupAssignments, see ListAll.
+func (c *APIClient) ListAllApplicationGroupAssignments(r ApiListApplicationGroupAssignmentsRequest) ([]ApplicationGroupAssignment, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllApplicationKeys returns the items of every page of ListApplicationKeys, see ListAll.
+func (c *APIClient) ListAllApplicationKeys(r ApiListApplicationKeysRequest) ([]JsonWebKey, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllApplicationTargetsForApplicationAdministratorRoleForGroup returns the items of every page of ListApplicationTargetsForApplicationAdministratorRoleForGroup, see ListAll.
+func (c *APIClient) ListAllApplicationTargetsForApplicationAdministratorRoleForGroup(r ApiListApplicationTargetsForApplicationAdministratorRoleForGroupRequest) ([]CatalogApplication, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllApplicationTargetsForApplicationAdministratorRoleForUser returns the items of every page of ListApplicationTargetsForApplicationAdministratorRoleForUser, see ListAll.
+func (c *APIClient) ListAllApplicationTargetsForApplicationAdministratorRoleForUser(r ApiListApplicationTargetsForApplicationAdministratorRoleForUserRequest) ([]CatalogApplication, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllApplicationUsers returns the items of every page of ListApplicationUsers, see ListAll.
+func (c *APIClient) ListAllApplicationUsers(r ApiListApplicationUsersRequest) ([]AppUser, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllApplications returns the items of every page of ListApplications, see ListAll.
+func (c *APIClient) ListAllApplications(r ApiListApplicationsRequest) ([]ListApplications200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAssignedApplicationsForGroup returns the items of every page of ListAssignedApplicationsForGroup, see ListAll.
+func (c *APIClient) ListAllAssignedApplicationsForGroup(r ApiListAssignedApplicationsForGroupRequest) ([]ListApplications200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAssignedRolesForUser returns the items of every page of ListAssignedRolesForUser, see ListAll.
+func (c *APIClient) ListAllAssignedRolesForUser(r ApiListAssignedRolesForUserRequest) ([]Role, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAssociatedServersByTrustedType returns the items of every page of ListAssociatedServersByTrustedType, see ListAll.
+func (c *APIClient) ListAllAssociatedServersByTrustedType(r ApiListAssociatedServersByTrustedTypeRequest) ([]AuthorizationServer, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAuthenticatorMethods returns the items of every page of ListAuthenticatorMethods, see ListAll.
+func (c *APIClient) ListAllAuthenticatorMethods(r ApiListAuthenticatorMethodsRequest) ([]ListAuthenticatorMethods200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAuthenticators returns the items of every page of ListAuthenticators, see ListAll.
+func (c *APIClient) ListAllAuthenticators(r ApiListAuthenticatorsRequest) ([]ListAuthenticators200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAuthorizationServerKeys returns the items of every page of ListAuthorizationServerKeys, see ListAll.
+func (c *APIClient) ListAllAuthorizationServerKeys(r ApiListAuthorizationServerKeysRequest) ([]AuthorizationServerJsonWebKey, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAuthorizationServerPolicies returns the items of every page of ListAuthorizationServerPolicies, see ListAll.
+func (c *APIClient) ListAllAuthorizationServerPolicies(r ApiListAuthorizationServerPoliciesRequest) ([]AuthorizationServerPolicy, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAuthorizationServerPolicyRules returns the items of every page of ListAuthorizationServerPolicyRules, see ListAll.
+func (c *APIClient) ListAllAuthorizationServerPolicyRules(r ApiListAuthorizationServerPolicyRulesRequest) ([]AuthorizationServerPolicyRule, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllAuthorizationServers returns the items of every page of ListAuthorizationServers, see ListAll.
+func (c *APIClient) ListAllAuthorizationServers(r ApiListAuthorizationServersRequest) ([]AuthorizationServer, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllBehaviorDetectionRules returns the items of every page of ListBehaviorDetectionRules, see ListAll.
+func (c *APIClient) ListAllBehaviorDetectionRules(r ApiListBehaviorDetectionRulesRequest) ([]ListBehaviorDetectionRules200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllBrandDomains returns the items of every page of ListBrandDomains, see ListAll.
+func (c *APIClient) ListAllBrandDomains(r ApiListBrandDomainsRequest) ([]DomainResponse, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllBrandThemes returns the items of every page of ListBrandThemes, see ListAll.
+func (c *APIClient) ListAllBrandThemes(r ApiListBrandThemesRequest) ([]ThemeResponse, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllBrands returns the items of every page of ListBrands, see ListAll.
+func (c *APIClient) ListAllBrands(r ApiListBrandsRequest) ([]BrandWithEmbedded, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllCaptchaInstances returns the items of every page of ListCaptchaInstances, see ListAll.
+func (c *APIClient) ListAllCaptchaInstances(r ApiListCaptchaInstancesRequest) ([]CAPTCHAInstance, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllCsrsForApplication returns the items of every page of ListCsrsForApplication, see ListAll.
+func (c *APIClient) ListAllCsrsForApplication(r ApiListCsrsForApplicationRequest) ([]Csr, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllCsrsForIdentityProvider returns the items of every page of ListCsrsForIdentityProvider, see ListAll.
+func (c *APIClient) ListAllCsrsForIdentityProvider(r ApiListCsrsForIdentityProviderRequest) ([]Csr, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllDeviceAssurancePolicies returns the items of every page of ListDeviceAssurancePolicies, see ListAll.
+func (c *APIClient) ListAllDeviceAssurancePolicies(r ApiListDeviceAssurancePoliciesRequest) ([]ListDeviceAssurancePolicies200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllDeviceUsers returns the items of every page of ListDeviceUsers, see ListAll.
+func (c *APIClient) ListAllDeviceUsers(r ApiListDeviceUsersRequest) ([]DeviceUser, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllDevices returns the items of every page of ListDevices, see ListAll.
+func (c *APIClient) ListAllDevices(r ApiListDevicesRequest) ([]DeviceList, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllEmailCustomizations returns the items of every page of ListEmailCustomizations, see ListAll.
+func (c *APIClient) ListAllEmailCustomizations(r ApiListEmailCustomizationsRequest) ([]EmailCustomization, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllEmailDomains returns the items of every page of ListEmailDomains, see ListAll.
+func (c *APIClient) ListAllEmailDomains(r ApiListEmailDomainsRequest) ([]EmailDomainResponseWithEmbedded, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllEmailTemplates returns the items of every page of ListEmailTemplates, see ListAll.
+func (c *APIClient) ListAllEmailTemplates(r ApiListEmailTemplatesRequest) ([]EmailTemplateResponse, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllEventHooks returns the items of every page of ListEventHooks, see ListAll.
+func (c *APIClient) ListAllEventHooks(r ApiListEventHooksRequest) ([]EventHook, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllFactors returns the items of every page of ListFactors, see ListAll.
+func (c *APIClient) ListAllFactors(r ApiListFactorsRequest) ([]ListFactors200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllFeatureDependencies returns the items of every page of ListFeatureDependencies, see ListAll.
+func (c *APIClient) ListAllFeatureDependencies(r ApiListFeatureDependenciesRequest) ([]Feature, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllFeatureDependents returns the items of every page of ListFeatureDependents, see ListAll.
+func (c *APIClient) ListAllFeatureDependents(r ApiListFeatureDependentsRequest) ([]Feature, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllFeatures returns the items of every page of ListFeatures, see ListAll.
+func (c *APIClient) ListAllFeatures(r ApiListFeaturesRequest) ([]Feature, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllFeaturesForApplication returns the items of every page of ListFeaturesForApplication, see ListAll.
+func (c *APIClient) ListAllFeaturesForApplication(r ApiListFeaturesForApplicationRequest) ([]ListFeaturesForApplication200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllGrantsForUserAndClient returns the items of every page of ListGrantsForUserAndClient, see ListAll.
+func (c *APIClient) ListAllGrantsForUserAndClient(r ApiListGrantsForUserAndClientRequest) ([]OAuth2ScopeConsentGrant, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllGroupAssignedRoles returns the items of every page of ListGroupAssignedRoles, see ListAll.
+func (c *APIClient) ListAllGroupAssignedRoles(r ApiListGroupAssignedRolesRequest) ([]Role, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllGroupOwners returns the items of every page of ListGroupOwners, see ListAll.
+func (c *APIClient) ListAllGroupOwners(r ApiListGroupOwnersRequest) ([]GroupOwner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllGroupRules returns the items of every page of ListGroupRules, see ListAll.
+func (c *APIClient) ListAllGroupRules(r ApiListGroupRulesRequest) ([]GroupRule, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllGroupTargetsForGroupRole returns the items of every page of ListGroupTargetsForGroupRole, see ListAll.
+func (c *APIClient) ListAllGroupTargetsForGroupRole(r ApiListGroupTargetsForGroupRoleRequest) ([]Group, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllGroupTargetsForRole returns the items of every page of ListGroupTargetsForRole, see ListAll.
+func (c *APIClient) ListAllGroupTargetsForRole(r ApiListGroupTargetsForRoleRequest) ([]Group, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllGroupUsers returns the items of every page of ListGroupUsers, see ListAll.
+func (c *APIClient) ListAllGroupUsers(r ApiListGroupUsersRequest) ([]GroupMember, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllGroups returns the items of every page of ListGroups, see ListAll.
+func (c *APIClient) ListAllGroups(r ApiListGroupsRequest) ([]Group, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllHookKeys returns the items of every page of ListHookKeys, see ListAll.
+func (c *APIClient) ListAllHookKeys(r ApiListHookKeysRequest) ([]HookKey, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllIdentityProviderApplicationUsers returns the items of every page of ListIdentityProviderApplicationUsers, see ListAll.
+func (c *APIClient) ListAllIdentityProviderApplicationUsers(r ApiListIdentityProviderApplicationUsersRequest) ([]IdentityProviderApplicationUser, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllIdentityProviderKeys returns the items of every page of ListIdentityProviderKeys, see ListAll.
+func (c *APIClient) ListAllIdentityProviderKeys(r ApiListIdentityProviderKeysRequest) ([]JsonWebKey, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllIdentityProviderSigningKeys returns the items of every page of ListIdentityProviderSigningKeys, see ListAll.
+func (c *APIClient) ListAllIdentityProviderSigningKeys(r ApiListIdentityProviderSigningKeysRequest) ([]JsonWebKey, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllIdentityProviders returns the items of every page of ListIdentityProviders, see ListAll.
+func (c *APIClient) ListAllIdentityProviders(r ApiListIdentityProvidersRequest) ([]IdentityProvider, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllIdentitySourceSessions returns the items of every page of ListIdentitySourceSessions, see ListAll.
+func (c *APIClient) ListAllIdentitySourceSessions(r ApiListIdentitySourceSessionsRequest) ([]IdentitySourceSession, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllInlineHooks returns the items of every page of ListInlineHooks, see ListAll.
+func (c *APIClient) ListAllInlineHooks(r ApiListInlineHooksRequest) ([]InlineHook, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllLinkedObjectDefinitions returns the items of every page of ListLinkedObjectDefinitions, see ListAll.
+func (c *APIClient) ListAllLinkedObjectDefinitions(r ApiListLinkedObjectDefinitionsRequest) ([]LinkedObject, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllLogEvents returns the items of every page of ListLogEvents, see ListAll.
+func (c *APIClient) ListAllLogEvents(r ApiListLogEventsRequest) ([]LogEvent, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllLogStreamSchemas returns the items of every page of ListLogStreamSchemas, see ListAll.
+func (c *APIClient) ListAllLogStreamSchemas(r ApiListLogStreamSchemasRequest) ([]LogStreamSchema, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllLogStreams returns the items of every page of ListLogStreams, see ListAll.
+func (c *APIClient) ListAllLogStreams(r ApiListLogStreamsRequest) ([]ListLogStreams200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllMembersOfBinding returns the items of every page of ListMembersOfBinding, see ListAllEmbedded.
+func (c *APIClient) ListAllMembersOfBinding(r ApiListMembersOfBindingRequest) ([]ResourceSetBindingMember, error) {
+	return ListAllEmbedded(r.ctx, c, r.Execute, func(p *ResourceSetBindingMembers) ([]ResourceSetBindingMember, *HrefObject) {
+		if p.Links == nil {
+			return p.Members, nil
+		}
+		return p.Members, p.Links.Next
+	})
+}
+
+// ListAllNetworkZones returns the items of every page of ListNetworkZones, see ListAll.
+func (c *APIClient) ListAllNetworkZones(r ApiListNetworkZonesRequest) ([]ListNetworkZones200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllOAuth2Claims returns the items of every page of ListOAuth2Claims, see ListAll.
+func (c *APIClient) ListAllOAuth2Claims(r ApiListOAuth2ClaimsRequest) ([]OAuth2Claim, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllOAuth2ClientsForAuthorizationServer returns the items of every page of ListOAuth2ClientsForAuthorizationServer, see ListAll.
+func (c *APIClient) ListAllOAuth2ClientsForAuthorizationServer(r ApiListOAuth2ClientsForAuthorizationServerRequest) ([]OAuth2Client, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllOAuth2Scopes returns the items of every page of ListOAuth2Scopes, see ListAll.
+func (c *APIClient) ListAllOAuth2Scopes(r ApiListOAuth2ScopesRequest) ([]OAuth2Scope, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllOAuth2TokensForApplication returns the items of every page of ListOAuth2TokensForApplication, see ListAll.
+func (c *APIClient) ListAllOAuth2TokensForApplication(r ApiListOAuth2TokensForApplicationRequest) ([]OAuth2RefreshToken, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllPolicies returns the items of every page of ListPolicies, see ListAll.
+func (c *APIClient) ListAllPolicies(r ApiListPoliciesRequest) ([]ListPolicies200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllPolicyApps returns the items of every page of ListPolicyApps, see ListAll.
+func (c *APIClient) ListAllPolicyApps(r ApiListPolicyAppsRequest) ([]ListApplications200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllPolicyMappings returns the items of every page of ListPolicyMappings, see ListAll.
+func (c *APIClient) ListAllPolicyMappings(r ApiListPolicyMappingsRequest) ([]PolicyMapping, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllPolicyRules returns the items of every page of ListPolicyRules, see ListAll.
+func (c *APIClient) ListAllPolicyRules(r ApiListPolicyRulesRequest) ([]ListPolicyRules200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllPrincipalRateLimitEntities returns the items of every page of ListPrincipalRateLimitEntities, see ListAll.
+func (c *APIClient) ListAllPrincipalRateLimitEntities(r ApiListPrincipalRateLimitEntitiesRequest) ([]PrincipalRateLimitEntity, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllProfileMappings returns the items of every page of ListProfileMappings, see ListAll.
+func (c *APIClient) ListAllProfileMappings(r ApiListProfileMappingsRequest) ([]ListProfileMappings, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllPushProviders returns the items of every page of ListPushProviders, see ListAll.
+func (c *APIClient) ListAllPushProviders(r ApiListPushProvidersRequest) ([]ListPushProviders200ResponseInner, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllRealmAssignmentOperations returns the items of every page of ListRealmAssignmentOperations, see ListAll.
+func (c *APIClient) ListAllRealmAssignmentOperations(r ApiListRealmAssignmentOperationsRequest) ([]OperationResponse, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllRealmAssignments returns the items of every page of ListRealmAssignments, see ListAll.
+func (c *APIClient) ListAllRealmAssignments(r ApiListRealmAssignmentsRequest) ([]RealmAssignment, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllRealms returns the items of every page of ListRealms, see ListAll.
+func (c *APIClient) ListAllRealms(r ApiListRealmsRequest) ([]Realm, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllRefreshTokensForAuthorizationServerAndClient returns the items of every page of ListRefreshTokensForAuthorizationServerAndClient, see ListAll.
+func (c *APIClient) ListAllRefreshTokensForAuthorizationServerAndClient(r ApiListRefreshTokensForAuthorizationServerAndClientRequest) ([]OAuth2RefreshToken, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllRefreshTokensForUserAndClient returns the items of every page of ListRefreshTokensForUserAndClient, see ListAll.
+func (c *APIClient) ListAllRefreshTokensForUserAndClient(r ApiListRefreshTokensForUserAndClientRequest) ([]OAuth2RefreshToken, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllResourceSetResources returns the items of every page of ListResourceSetResources, see ListAllEmbedded.
+func (c *APIClient) ListAllResourceSetResources(r ApiListResourceSetResourcesRequest) ([]ResourceSetResource, error) {
+	return ListAllEmbedded(r.ctx, c, r.Execute, func(p *ResourceSetResources) ([]ResourceSetResource, *HrefObject) {
+		if p.Links == nil {
+			return p.Resources, nil
+		}
+		return p.Resources, p.Links.Next
+	})
+}
+
+// ListAllResourceSets returns the items of every page of ListResourceSets, see ListAllEmbedded.
+func (c *APIClient) ListAllResourceSets(r ApiListResourceSetsRequest) ([]ResourceSet, error) {
+	return ListAllEmbedded(r.ctx, c, r.Execute, func(p *ResourceSets) ([]ResourceSet, *HrefObject) {
+		if p.Links == nil {
+			return p.ResourceSets, nil
+		}
+		return p.ResourceSets, p.Links.Next
+	})
+}
+
+// ListAllRiskProviders returns the items of every page of ListRiskProviders, see ListAll.
+func (c *APIClient) ListAllRiskProviders(r ApiListRiskProvidersRequest) ([]RiskProvider, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllRoles returns the items of every page of ListRoles, see ListAllEmbedded.
+func (c *APIClient) ListAllRoles(r ApiListRolesRequest) ([]IamRole, error) {
+	return ListAllEmbedded(r.ctx, c, r.Execute, func(p *IamRoles) ([]IamRole, *HrefObject) {
+		if p.Links == nil {
+			return p.Roles, nil
+		}
+		return p.Roles, p.Links.Next
+	})
+}
+
+// ListAllScopeConsentGrants returns the items of every page of ListScopeConsentGrants, see ListAll.
+func (c *APIClient) ListAllScopeConsentGrants(r ApiListScopeConsentGrantsRequest) ([]OAuth2ScopeConsentGrant, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllSecurityEventsProviderInstances returns the items of every page of ListSecurityEventsProviderInstances, see ListAll.
+func (c *APIClient) ListAllSecurityEventsProviderInstances(r ApiListSecurityEventsProviderInstancesRequest) ([]SecurityEventsProviderResponse, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllSmsTemplates returns the items of every page of ListSmsTemplates, see ListAll.
+func (c *APIClient) ListAllSmsTemplates(r ApiListSmsTemplatesRequest) ([]SmsTemplate, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllSocialAuthTokens returns the items of every page of ListSocialAuthTokens, see ListAll.
+func (c *APIClient) ListAllSocialAuthTokens(r ApiListSocialAuthTokensRequest) ([]SocialAuthToken, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllSubscriptionsRole returns the items of every page of ListSubscriptionsRole, see ListAll.
+func (c *APIClient) ListAllSubscriptionsRole(r ApiListSubscriptionsRoleRequest) ([]Subscription, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllSubscriptionsUser returns the items of every page of ListSubscriptionsUser, see ListAll.
+func (c *APIClient) ListAllSubscriptionsUser(r ApiListSubscriptionsUserRequest) ([]Subscription, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllSupportedFactors returns the items of every page of ListSupportedFactors, see ListAll.
+func (c *APIClient) ListAllSupportedFactors(r ApiListSupportedFactorsRequest) ([]UserFactorSupported, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllSupportedSecurityQuestions returns the items of every page of ListSupportedSecurityQuestions, see ListAll.
+func (c *APIClient) ListAllSupportedSecurityQuestions(r ApiListSupportedSecurityQuestionsRequest) ([]UserFactorSecurityQuestionProfile, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllTrustedOrigins returns the items of every page of ListTrustedOrigins, see ListAll.
+func (c *APIClient) ListAllTrustedOrigins(r ApiListTrustedOriginsRequest) ([]TrustedOrigin, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllUISchemas returns the items of every page of ListUISchemas, see ListAll.
+func (c *APIClient) ListAllUISchemas(r ApiListUISchemasRequest) ([]UISchemasResponseObject, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllUserBlocks returns the items of every page of ListUserBlocks, see ListAll.
+func (c *APIClient) ListAllUserBlocks(r ApiListUserBlocksRequest) ([]UserBlock, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllUserClients returns the items of every page of ListUserClients, see ListAll.
+func (c *APIClient) ListAllUserClients(r ApiListUserClientsRequest) ([]OAuth2Client, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllUserGrants returns the items of every page of ListUserGrants, see ListAll.
+func (c *APIClient) ListAllUserGrants(r ApiListUserGrantsRequest) ([]OAuth2ScopeConsentGrant, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllUserGroups returns the items of every page of ListUserGroups, see ListAll.
+func (c *APIClient) ListAllUserGroups(r ApiListUserGroupsRequest) ([]Group, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllUserIdentityProviders returns the items of every page of ListUserIdentityProviders, see ListAll.
+func (c *APIClient) ListAllUserIdentityProviders(r ApiListUserIdentityProvidersRequest) ([]IdentityProvider, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllUserTypes returns the items of every page of ListUserTypes, see ListAll.
+func (c *APIClient) ListAllUserTypes(r ApiListUserTypesRequest) ([]UserType, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllUsers returns the items of every page of ListUsers, see ListAll.
+func (c *APIClient) ListAllUsers(r ApiListUsersRequest) ([]User, error) {
+	return ListAll(r.ctx, c, r.Execute)
+}
+
+// ListAllUsersWithRoleAssignments returns the items of every page of ListUsersWithRoleAssignments, see ListAllEmbedded.
+func (c *APIClient) ListAllUsersWithRoleAssignments(r ApiListUsersWithRoleAssignmentsRequest) ([]RoleAssignedUser, error) {
+	return ListAllEmbedded(r.ctx, c, r.Execute, func(p *RoleAssignedUsers) ([]RoleAssignedUser, *HrefObject) {
+		if p.Links == nil {
+			return p.Value, nil
+		}
+		return p.Value, p.Links.Next
+	})
+}
@@ -0,0 +1,327 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultLogBackfillShard       = 24 * time.Hour
+	defaultLogBackfillConcurrency = 4
+	defaultLogBackfillPageSize    = 1000
+
+	// logSampleBuckets is the resolution of LogBackfillOptions.SampleRate.
+	logSampleBuckets = 1000000
+)
+
+// LogShard is the time window [Since, Until) of a System Log backfill fetched
+// as one ordered stream of pages.
+type LogShard struct {
+	Index int
+	Since time.Time
+	Until time.Time
+}
+
+// LogBackfillOptions configures BackfillLogEvents.
+type LogBackfillOptions struct {
+	// Since and Until bound the backfilled range, Until defaults to now.
+	Since time.Time
+	Until time.Time
+	// ShardDuration is the length of each time window, 24 hours by default.
+	ShardDuration time.Duration
+	// Concurrency is the number of shards fetched at the same time, 4 by
+	// default. At most Concurrency pages are held in memory at once.
+	Concurrency int
+	// PageSize is the number of events requested per page, 1000 by default.
+	PageSize int32
+	// Filter and Q are passed to the System Log API as is.
+	Filter string
+	Q      string
+	// EventTypes, ActorIDs and Outcomes, such as FAILURE, restrict the events
+	// the System Log API returns to those matching one of their values. They
+	// are combined with Filter.
+	EventTypes []string
+	ActorIDs   []string
+	Outcomes   []string
+	// Predicate drops the events for which it returns false before they are
+	// handed to the handler.
+	Predicate func(event *LogEvent) bool
+	// SampleRate keeps this fraction of the events, all of them when zero.
+	// Events are sampled by uuid, so that running a backfill again keeps the
+	// same events.
+	SampleRate float64
+	// DeadLetters retries a page the handler fails on and then hands its
+	// events to the sink of the queue, so that the backfill continues. Without
+	// it the first error of the handler stops the backfill.
+	DeadLetters *DeadLetterQueue
+	// Dedupe skips the events it has marked as delivered and marks those the
+	// handler succeeded with, so that a backfill started again after a crash
+	// does not deliver events twice.
+	Dedupe DedupeStore
+}
+
+// logFilter returns the filter expression of the System Log API combining
+// Filter with the event types, actors and outcomes of opts.
+func (opts LogBackfillOptions) logFilter() string {
+	var clauses []string
+	if opts.Filter != "" {
+		clauses = append(clauses, opts.Filter)
+	}
+	for _, field := range []struct {
+		name   string
+		values []string
+	}{
+		{"eventType", opts.EventTypes},
+		{"actor.id", opts.ActorIDs},
+		{"outcome.result", opts.Outcomes},
+	} {
+		var terms []string
+		for _, value := range field.values {
+			terms = append(terms, field.name+" eq "+strconv.Quote(value))
+		}
+		if len(terms) > 0 {
+			clauses = append(clauses, strings.Join(terms, " or "))
+		}
+	}
+	if len(clauses) == 1 {
+		return clauses[0]
+	}
+	for i := range clauses {
+		clauses[i] = "(" + clauses[i] + ")"
+	}
+	return strings.Join(clauses, " and ")
+}
+
+// keep reports whether event passes the sampling and the predicate of opts.
+func (opts LogBackfillOptions) keep(event *LogEvent) bool {
+	if opts.SampleRate > 0 && opts.SampleRate < 1 {
+		// the low bits of FNV hashes of similar strings differ the most
+		h := fnv.New64a()
+		h.Write([]byte(event.GetUuid()))
+		if float64(h.Sum64()%logSampleBuckets) >= opts.SampleRate*logSampleBuckets {
+			return false
+		}
+	}
+	return opts.Predicate == nil || opts.Predicate(event)
+}
+
+// selectEvents returns the events of a page that opts keeps, reusing the
+// page.
+func (opts LogBackfillOptions) selectEvents(events []LogEvent) []LogEvent {
+	if opts.Predicate == nil && (opts.SampleRate <= 0 || opts.SampleRate >= 1) {
+		return events
+	}
+	kept := events[:0]
+	for i := range events {
+		if opts.keep(&events[i]) {
+			kept = append(kept, events[i])
+		}
+	}
+	return kept
+}
+
+// LogPageHandler receives the events of a shard one page at a time, in
+// ascending order of publication. It is called concurrently for different
+// shards and the next page of a shard is only fetched once it returns.
+type LogPageHandler func(ctx context.Context, shard LogShard, events []LogEvent) error
+
+// LogShards splits [since, until) into consecutive windows of at most d.
+func LogShards(since, until time.Time, d time.Duration) []LogShard {
+	if d <= 0 {
+		d = defaultLogBackfillShard
+	}
+	var shards []LogShard
+	for start := since; start.Before(until); start = start.Add(d) {
+		end := start.Add(d)
+		if end.After(until) {
+			end = until
+		}
+		shards = append(shards, LogShard{Index: len(shards), Since: start, Until: end})
+	}
+	return shards
+}
+
+// BackfillLogEvents fetches historical System Log events, for example for the
+// initial load of a SIEM. The range is split into shards fetched with bounded
+// concurrency; within a shard pages are delivered to handle in order. When a
+// response reports that the rate limit is almost exhausted all workers pause
+// until it resets. The first error stops the backfill and is returned, unless
+// opts.DeadLetters takes the pages the handler fails on.
+func (c *APIClient) BackfillLogEvents(ctx context.Context, opts LogBackfillOptions, handle LogPageHandler) error {
+	if opts.Since.IsZero() {
+		return errors.New("backfill start time is required")
+	}
+	if opts.Until.IsZero() {
+		opts.Until = time.Now()
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultLogBackfillConcurrency
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = defaultLogBackfillPageSize
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	b := &logBackfill{client: c, opts: opts, handle: handle}
+
+	shards := make(chan LogShard)
+	go func() {
+		defer close(shards)
+		for _, shard := range LogShards(opts.Since, opts.Until, opts.ShardDuration) {
+			select {
+			case shards <- shard:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for i := 0; i < opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for shard := range shards {
+				if err := b.fetchShard(ctx, shard); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+type logBackfill struct {
+	client *APIClient
+	opts   LogBackfillOptions
+	handle LogPageHandler
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func (b *logBackfill) fetchShard(ctx context.Context, shard LogShard) error {
+	var after string
+	for {
+		if err := b.wait(ctx); err != nil {
+			return err
+		}
+		req := b.client.SystemLogAPI.ListLogEvents(ctx).
+			Since(shard.Since).
+			Until(shard.Until).
+			SortOrder("ASCENDING").
+			Limit(b.opts.PageSize)
+		if filter := b.opts.logFilter(); filter != "" {
+			req = req.Filter(filter)
+		}
+		if b.opts.Q != "" {
+			req = req.Q(b.opts.Q)
+		}
+		if after != "" {
+			req = req.After(after)
+		}
+		events, resp, err := req.Execute()
+		if err != nil {
+			return err
+		}
+		b.observe(resp)
+		if kept := b.opts.selectEvents(events); len(kept) > 0 {
+			if err := b.deliver(ctx, shard, kept); err != nil {
+				return err
+			}
+		}
+		if resp == nil || !resp.HasNextPage() || len(events) == 0 {
+			return nil
+		}
+		next, err := url.Parse(resp.NextPage())
+		if err != nil {
+			return err
+		}
+		after = next.Query().Get("after")
+		if after == "" {
+			return nil
+		}
+	}
+}
+
+// deliver hands the events of a page not delivered yet to the handler,
+// through the dead letter queue when there is one.
+func (b *logBackfill) deliver(ctx context.Context, shard LogShard, events []LogEvent) error {
+	events, err := undelivered(ctx, b.opts.Dedupe, events)
+	if err != nil || len(events) == 0 {
+		return err
+	}
+	handle := func(ctx context.Context) error {
+		return b.handle(ctx, shard, events)
+	}
+	if q := b.opts.DeadLetters; q == nil {
+		err = handle(ctx)
+	} else {
+		var attempts int
+		attempts, err = q.process(ctx, handle)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return q.deadLetter(ctx, DeadLetterSourceSystemLog, events, attempts, err)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return markDelivered(ctx, b.opts.Dedupe, events)
+}
+
+// observe pauses all workers until the rate limit resets once fewer requests
+// than workers remain in the current window.
+func (b *logBackfill) observe(resp *APIResponse) {
+	if resp == nil || resp.Response == nil {
+		return
+	}
+	limit, err := b.client.parseLimitHeaders(resp.Response)
+	if err != nil || limit.Remaining > b.opts.Concurrency {
+		return
+	}
+	until := time.Now().Add(time.Duration(limit.Reset) * time.Second)
+	b.mu.Lock()
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+	b.mu.Unlock()
+}
+
+func (b *logBackfill) wait(ctx context.Context) error {
+	b.mu.Lock()
+	d := time.Until(b.pausedUntil)
+	b.mu.Unlock()
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
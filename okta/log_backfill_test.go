@@ -0,0 +1,312 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Log_Shards(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	shards := LogShards(since, since.Add(50*time.Hour), 24*time.Hour)
+	require.Len(t, shards, 3)
+	assert.Equal(t, since.Add(48*time.Hour), shards[2].Since)
+	assert.Equal(t, since.Add(50*time.Hour), shards[2].Until)
+}
+
+func Test_Backfill_Log_Events(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(72 * time.Hour)
+	var published []time.Time
+	for ts := since; ts.Before(until); ts = ts.Add(time.Hour) {
+		published = append(published, ts)
+	}
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/logs", func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		from, _ := time.Parse(time.RFC3339, q.Get("since"))
+		to, _ := time.Parse(time.RFC3339, q.Get("until"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		after, _ := strconv.Atoi(q.Get("after"))
+		var page []map[string]interface{}
+		next := 0
+		for i, ts := range published {
+			if i < after || ts.Before(from) || !ts.Before(to) {
+				continue
+			}
+			if len(page) == limit {
+				next = i
+				break
+			}
+			page = append(page, map[string]interface{}{"uuid": strconv.Itoa(i), "published": ts})
+		}
+		body, _ := json.Marshal(page)
+		resp := mockJSONResponse(200, string(body))
+		if next > 0 {
+			q.Set("after", strconv.Itoa(next))
+			resp.Header.Add("Link", fmt.Sprintf(`<https://example.okta.com/api/v1/logs?%s>; rel="next"`, q.Encode()))
+		}
+		return resp, nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	var (
+		mu      sync.Mutex
+		seen    = map[int][]time.Time{}
+		active  int
+		maxSeen int
+	)
+	err = client.BackfillLogEvents(context.Background(), LogBackfillOptions{
+		Since:         since,
+		Until:         until,
+		ShardDuration: 24 * time.Hour,
+		Concurrency:   2,
+		PageSize:      5,
+	}, func(ctx context.Context, shard LogShard, events []LogEvent) error {
+		mu.Lock()
+		active++
+		maxSeen = max(maxSeen, active)
+		for _, e := range events {
+			seen[shard.Index] = append(seen[shard.Index], e.GetPublished())
+		}
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		active--
+		mu.Unlock()
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, seen, 3)
+	for index, events := range seen {
+		require.Len(t, events, 24, "shard %d should contain a day of events", index)
+		for i := 1; i < len(events); i++ {
+			assert.True(t, events[i-1].Before(events[i]), "events of a shard should be in order")
+		}
+	}
+	assert.LessOrEqual(t, maxSeen, 2)
+
+	errStop := errors.New("stop")
+	err = client.BackfillLogEvents(context.Background(), LogBackfillOptions{Since: since, Until: until, PageSize: 5},
+		func(ctx context.Context, shard LogShard, events []LogEvent) error {
+			return errStop
+		})
+	assert.ErrorIs(t, err, errStop)
+}
+
+func Test_Log_Backfill_Filter(t *testing.T) {
+	tests := []struct {
+		opts LogBackfillOptions
+		want string
+	}{
+		{LogBackfillOptions{}, ""},
+		{LogBackfillOptions{Filter: `severity eq "WARN"`}, `severity eq "WARN"`},
+		{LogBackfillOptions{EventTypes: []string{"user.session.start", "user.session.end"}}, `eventType eq "user.session.start" or eventType eq "user.session.end"`},
+		{
+			LogBackfillOptions{Filter: `severity eq "WARN"`, ActorIDs: []string{"00u1"}, Outcomes: []string{"FAILURE", "DENY"}},
+			`(severity eq "WARN") and (actor.id eq "00u1") and (outcome.result eq "FAILURE" or outcome.result eq "DENY")`,
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.opts.logFilter())
+	}
+}
+
+func Test_Backfill_Log_Events_Sampling_And_Predicate(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/logs", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, `eventType eq "user.session.start"`, req.URL.Query().Get("filter"))
+		var page []map[string]interface{}
+		for i := 0; i < 1000; i++ {
+			outcome := "SUCCESS"
+			if i%10 == 0 {
+				outcome = "FAILURE"
+			}
+			page = append(page, map[string]interface{}{
+				"uuid":      fmt.Sprintf("event-%d", i),
+				"eventType": "user.session.start",
+				"outcome":   map[string]string{"result": outcome},
+			})
+		}
+		body, _ := json.Marshal(page)
+		return mockJSONResponse(200, string(body)), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	backfill := func(opts LogBackfillOptions) []string {
+		opts.Since, opts.Until, opts.EventTypes = since, since.Add(time.Hour), []string{"user.session.start"}
+		var uuids []string
+		require.NoError(t, client.BackfillLogEvents(context.Background(), opts, func(ctx context.Context, shard LogShard, events []LogEvent) error {
+			for _, e := range events {
+				uuids = append(uuids, e.GetUuid())
+			}
+			return nil
+		}))
+		return uuids
+	}
+
+	failures := backfill(LogBackfillOptions{Predicate: func(event *LogEvent) bool {
+		return event.Outcome != nil && event.Outcome.GetResult() == "FAILURE"
+	}})
+	assert.Len(t, failures, 100)
+
+	sampled := backfill(LogBackfillOptions{SampleRate: 0.2})
+	assert.InDelta(t, 200, len(sampled), 60)
+	assert.Equal(t, sampled, backfill(LogBackfillOptions{SampleRate: 0.2}), "Sampling should keep the same events")
+
+	var handled int
+	require.NoError(t, client.BackfillLogEvents(context.Background(), LogBackfillOptions{
+		Since: since, Until: since.Add(time.Hour), EventTypes: []string{"user.session.start"},
+		Predicate: func(event *LogEvent) bool { return false },
+	}, func(ctx context.Context, shard LogShard, events []LogEvent) error {
+		handled++
+		return nil
+	}))
+	assert.Zero(t, handled, "Pages without kept events should not be handed to the handler")
+}
+
+func Test_Backfill_Log_Events_Dead_Letters(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/logs", func(req *http.Request) (*http.Response, error) {
+		day := req.URL.Query().Get("since")[:10]
+		return mockJSONResponse(200, `[{"uuid":"`+day+`-a"},{"uuid":"`+day+`-b"}]`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	var buf bytes.Buffer
+	queue, err := NewDeadLetterQueue(DeadLetterOptions{MaxAttempts: 3, Backoff: time.Millisecond, Sink: DeadLetterWriter(&buf)})
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var handled []string
+	calls := map[string]int{}
+	err = client.BackfillLogEvents(context.Background(), LogBackfillOptions{
+		Since: since, Until: since.Add(72 * time.Hour), DeadLetters: queue,
+	}, func(ctx context.Context, shard LogShard, events []LogEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		id := events[0].GetUuid()
+		calls[id]++
+		switch {
+		case shard.Index == 1:
+			return errors.New("siem unavailable")
+		case shard.Index == 2 && calls[id] == 1:
+			return errors.New("timeout")
+		}
+		for _, e := range events {
+			handled = append(handled, e.GetUuid())
+		}
+		return nil
+	})
+	require.NoError(t, err, "Failing pages should not stop the backfill")
+	assert.ElementsMatch(t, []string{"2024-01-01-a", "2024-01-01-b", "2024-01-03-a", "2024-01-03-b"}, handled)
+
+	var letters []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var letter map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &letter))
+		letters = append(letters, letter)
+	}
+	require.Len(t, letters, 2)
+	assert.Equal(t, "2024-01-02-a", letters[0]["event"].(map[string]interface{})["uuid"])
+	assert.Equal(t, "2024-01-02-b", letters[1]["event"].(map[string]interface{})["uuid"])
+	assert.Equal(t, DeadLetterSourceSystemLog, letters[0]["source"])
+	assert.Equal(t, "siem unavailable", letters[0]["error"])
+	assert.EqualValues(t, 3, letters[0]["attempts"])
+	assert.Equal(t, DeadLetterStats{Attempts: 6, Retries: 3, DeadLettered: 2}, queue.Stats())
+}
+
+func Test_Backfill_Log_Events_Dead_Letter_Sink_Error(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/logs", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[{"uuid":"a"}]`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, err = NewDeadLetterQueue(DeadLetterOptions{})
+	assert.Error(t, err, "A dead letter queue requires a sink")
+
+	queue, err := NewDeadLetterQueue(DeadLetterOptions{MaxAttempts: 1, Sink: func(ctx context.Context, letter DeadLetter) error {
+		return errors.New("queue full")
+	}})
+	require.NoError(t, err)
+	err = client.BackfillLogEvents(context.Background(), LogBackfillOptions{Since: since, Until: since.Add(time.Hour), DeadLetters: queue},
+		func(ctx context.Context, shard LogShard, events []LogEvent) error {
+			return errors.New("siem unavailable")
+		})
+	assert.EqualError(t, err, "queue full", "An event the sink cannot store should stop the backfill")
+	assert.Equal(t, DeadLetterStats{Attempts: 1, DeadLettered: 1, SinkErrors: 1}, queue.Stats())
+}
+
+func Test_Backfill_Log_Events_Dedupe(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/logs", func(req *http.Request) (*http.Response, error) {
+		day := req.URL.Query().Get("since")[:10]
+		return mockJSONResponse(200, `[{"uuid":"`+day+`-a"},{"uuid":"`+day+`-b"}]`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	opts := LogBackfillOptions{Since: since, Until: since.Add(72 * time.Hour), Concurrency: 1, Dedupe: NewStoreDedupe(store, "", 0)}
+
+	var delivered []string
+	err = client.BackfillLogEvents(context.Background(), opts, func(ctx context.Context, shard LogShard, events []LogEvent) error {
+		if shard.Index == 1 {
+			return errors.New("forwarder crashed")
+		}
+		for _, e := range events {
+			delivered = append(delivered, e.GetUuid())
+		}
+		return nil
+	})
+	require.EqualError(t, err, "forwarder crashed")
+	assert.Equal(t, []string{"2024-01-01-a", "2024-01-01-b"}, delivered)
+
+	delivered = nil
+	require.NoError(t, client.BackfillLogEvents(context.Background(), opts, func(ctx context.Context, shard LogShard, events []LogEvent) error {
+		for _, e := range events {
+			delivered = append(delivered, e.GetUuid())
+		}
+		return nil
+	}))
+	assert.Equal(t, []string{"2024-01-02-a", "2024-01-02-b", "2024-01-03-a", "2024-01-03-b"}, delivered,
+		"A restarted backfill should skip the events it delivered")
+}
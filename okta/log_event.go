@@ -0,0 +1,243 @@
+package okta
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LogLocation is the location Okta resolved for the client or an IP address
+// of a log event.
+type LogLocation struct {
+	City       string
+	State      string
+	Country    string
+	PostalCode string
+	// Latitude and Longitude are zero when Okta has no coordinates.
+	Latitude  float64
+	Longitude float64
+}
+
+// LogChainIP is an IP address of the chain a request of a log event went
+// through, such as a proxy.
+type LogChainIP struct {
+	IP string
+	// Version is V4 or V6.
+	Version string
+	// Source is the header the address was read from, empty for the address
+	// of the connection.
+	Source   string
+	Location LogLocation
+}
+
+// LogRisk is the risk Okta assessed for the request of a log event.
+type LogRisk struct {
+	// Level is LOW, MEDIUM or HIGH.
+	Level string
+	// Reasons explains the level, such as "Anomalous Device".
+	Reasons string
+	// Behaviors maps the behaviors evaluated for the request, such as
+	// "New Device", to POSITIVE or NEGATIVE.
+	Behaviors map[string]string
+}
+
+// ClientLocation returns the location of the client of the event, false when
+// Okta did not resolve it.
+func (o *LogEvent) ClientLocation() (LogLocation, bool) {
+	if o.Client == nil {
+		return LogLocation{}, false
+	}
+	return logLocation(o.Client.GeographicalContext)
+}
+
+// ClientIPAddress returns the IP address of the client of the event.
+func (o *LogEvent) ClientIPAddress() string {
+	if o.Client == nil {
+		return ""
+	}
+	return o.Client.GetIpAddress()
+}
+
+// ClientZone returns the network zone of the client of the event, "null"
+// when it is in no zone.
+func (o *LogEvent) ClientZone() string {
+	if o.Client == nil {
+		return ""
+	}
+	return o.Client.GetZone()
+}
+
+// ClientUserAgent returns the raw user agent of the client of the event.
+func (o *LogEvent) ClientUserAgent() string {
+	if o.Client == nil || o.Client.UserAgent == nil {
+		return ""
+	}
+	return o.Client.UserAgent.GetRawUserAgent()
+}
+
+// IPChain returns the IP addresses of the request of the event, starting
+// with the client.
+func (o *LogEvent) IPChain() []LogChainIP {
+	if o.Request == nil {
+		return nil
+	}
+	chain := make([]LogChainIP, 0, len(o.Request.IpChain))
+	for i := range o.Request.IpChain {
+		address := &o.Request.IpChain[i]
+		location, _ := logLocation(address.GeographicalContext)
+		chain = append(chain, LogChainIP{
+			IP:       address.GetIp(),
+			Version:  address.GetVersion(),
+			Source:   address.GetSource(),
+			Location: location,
+		})
+	}
+	return chain
+}
+
+// Risk returns the risk Okta assessed for the request of the event, read from
+// the risk and behaviors of its debug data, or from its
+// logOnlySecurityData. It returns false for events without risk data.
+func (o *LogEvent) Risk() (LogRisk, bool) {
+	var risk LogRisk
+	found := false
+	if fields, ok := debugFields(o.debugData()["risk"]); ok {
+		risk.Level, risk.Reasons = fields["level"], fields["reasons"]
+		found = true
+	}
+	if fields, ok := debugFields(o.debugData()["behaviors"]); ok {
+		risk.Behaviors = fields
+		found = true
+	}
+	if found {
+		return risk, true
+	}
+	// events of orgs evaluating risk without enforcing it
+	data, ok := o.debugData()["logOnlySecurityData"].(string)
+	if !ok {
+		return risk, false
+	}
+	var security struct {
+		Risk struct {
+			Level   string `json:"level"`
+			Reasons string `json:"reasons"`
+		} `json:"risk"`
+		Behaviors map[string]string `json:"behaviors"`
+	}
+	if err := json.Unmarshal([]byte(data), &security); err != nil {
+		return risk, false
+	}
+	risk.Level, risk.Reasons, risk.Behaviors = security.Risk.Level, security.Risk.Reasons, security.Behaviors
+	return risk, true
+}
+
+// ThreatSuspected reports whether Okta ThreatInsight flagged the request of
+// the event.
+func (o *LogEvent) ThreatSuspected() bool {
+	return o.DebugValue("threatSuspected") == "true"
+}
+
+// DebugValue returns the entry key of the debug data of the event as a
+// string, such as requestUri or dtHash, or an empty string when it is
+// missing.
+func (o *LogEvent) DebugValue(key string) string {
+	switch v := o.debugData()[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// TransactionID returns the id of the transaction of the event, the request
+// id of web requests.
+func (o *LogEvent) TransactionID() string {
+	if o.Transaction == nil {
+		return ""
+	}
+	return o.Transaction.GetId()
+}
+
+// TransactionType returns the type of the transaction of the event, WEB or
+// JOB.
+func (o *LogEvent) TransactionType() string {
+	if o.Transaction == nil {
+		return ""
+	}
+	return o.Transaction.GetType()
+}
+
+// TransactionDetail returns the entry key of the details of the transaction
+// of the event, such as requestApiTokenId.
+func (o *LogEvent) TransactionDetail(key string) (interface{}, bool) {
+	if o.Transaction == nil {
+		return nil, false
+	}
+	v, ok := o.Transaction.Detail[key]
+	return v, ok
+}
+
+func (o *LogEvent) debugData() map[string]interface{} {
+	if o.DebugContext == nil {
+		return nil
+	}
+	return o.DebugContext.DebugData
+}
+
+func logLocation(geo *LogGeographicalContext) (LogLocation, bool) {
+	if geo == nil {
+		return LogLocation{}, false
+	}
+	location := LogLocation{
+		City:       geo.GetCity(),
+		State:      geo.GetState(),
+		Country:    geo.GetCountry(),
+		PostalCode: geo.GetPostalCode(),
+	}
+	if geo.Geolocation != nil {
+		location.Latitude = geo.Geolocation.GetLat()
+		location.Longitude = geo.Geolocation.GetLon()
+	}
+	return location, true
+}
+
+// debugFields returns the fields of an entry of debug data, which Okta
+// formats as "{level=LOW, reasons=New Device, New IP}", as JSON, or as an
+// object.
+func debugFields(v interface{}) (map[string]string, bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		fields := make(map[string]string, len(v))
+		for key, value := range v {
+			fields[key] = fmt.Sprint(value)
+		}
+		return fields, true
+	case string:
+		v = strings.TrimSpace(v)
+		if !strings.HasPrefix(v, "{") || !strings.HasSuffix(v, "}") {
+			return nil, false
+		}
+		var object map[string]interface{}
+		if json.Unmarshal([]byte(v), &object) == nil {
+			return debugFields(object)
+		}
+		fields := map[string]string{}
+		last := ""
+		for _, part := range strings.Split(v[1:len(v)-1], ", ") {
+			key, value, found := strings.Cut(part, "=")
+			if !found {
+				// a comma in the value of the last field
+				if last != "" {
+					fields[last] += ", " + part
+				}
+				continue
+			}
+			last = strings.TrimSpace(key)
+			fields[last] = value
+		}
+		return fields, true
+	}
+	return nil, false
+}
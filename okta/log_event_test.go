@@ -0,0 +1,180 @@
+package okta
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const logEventWithContext = `{
+  "uuid": "dc9fd3c0-598c-11ef-8478-2b7584bf8d5a",
+  "eventType": "user.session.start",
+  "client": {
+    "userAgent": {"rawUserAgent": "Mozilla/5.0", "os": "Mac OS X", "browser": "CHROME"},
+    "zone": "null",
+    "device": "Computer",
+    "ipAddress": "198.51.100.7",
+    "geographicalContext": {
+      "city": "Lyon", "state": "Auvergne-Rhone-Alpes", "country": "France", "postalCode": "69001",
+      "geolocation": {"lat": 45.7485, "lon": 4.8467}
+    }
+  },
+  "request": {
+    "ipChain": [
+      {"ip": "198.51.100.7", "version": "V4", "geographicalContext": {"city": "Lyon", "country": "France", "geolocation": {"lat": 45.7485, "lon": 4.8467}}},
+      {"ip": "203.0.113.9", "version": "V4", "source": "X-Forwarded-For"}
+    ]
+  },
+  "debugContext": {
+    "debugData": {
+      "requestUri": "/idp/idx/identify",
+      "threatSuspected": "false",
+      "risk": "{reasons=Anomalous Device, Anomalous Location, level=MEDIUM}",
+      "behaviors": "{New Geo-Location=NEGATIVE, New Device=POSITIVE, Velocity=NEGATIVE}",
+      "dtHash": "5d8f0ab0"
+    }
+  },
+  "transaction": {"type": "WEB", "id": "ZrqPnkjqcRn3A", "detail": {"requestApiTokenId": "00T1"}}
+}`
+
+func Test_Log_Event_Accessors(t *testing.T) {
+	var event LogEvent
+	require.NoError(t, json.Unmarshal([]byte(logEventWithContext), &event))
+
+	location, ok := event.ClientLocation()
+	require.True(t, ok)
+	assert.Equal(t, LogLocation{City: "Lyon", State: "Auvergne-Rhone-Alpes", Country: "France", PostalCode: "69001", Latitude: 45.7485, Longitude: 4.8467}, location)
+	assert.Equal(t, "198.51.100.7", event.ClientIPAddress())
+	assert.Equal(t, "null", event.ClientZone())
+	assert.Equal(t, "Mozilla/5.0", event.ClientUserAgent())
+
+	assert.Equal(t, []LogChainIP{
+		{IP: "198.51.100.7", Version: "V4", Location: LogLocation{City: "Lyon", Country: "France", Latitude: 45.7485, Longitude: 4.8467}},
+		{IP: "203.0.113.9", Version: "V4", Source: "X-Forwarded-For"},
+	}, event.IPChain())
+
+	risk, ok := event.Risk()
+	require.True(t, ok)
+	assert.Equal(t, LogRisk{
+		Level:     "MEDIUM",
+		Reasons:   "Anomalous Device, Anomalous Location",
+		Behaviors: map[string]string{"New Geo-Location": "NEGATIVE", "New Device": "POSITIVE", "Velocity": "NEGATIVE"},
+	}, risk)
+	assert.False(t, event.ThreatSuspected())
+	assert.Equal(t, "/idp/idx/identify", event.DebugValue("requestUri"))
+	assert.Empty(t, event.DebugValue("missing"))
+
+	assert.Equal(t, "ZrqPnkjqcRn3A", event.TransactionID())
+	assert.Equal(t, "WEB", event.TransactionType())
+	detail, ok := event.TransactionDetail("requestApiTokenId")
+	require.True(t, ok)
+	assert.Equal(t, "00T1", detail)
+}
+
+func Test_Log_Event_Log_Only_Security_Data(t *testing.T) {
+	var event LogEvent
+	require.NoError(t, json.Unmarshal([]byte(`{"debugContext":{"debugData":{
+	  "threatSuspected": "true",
+	  "logOnlySecurityData": "{\"risk\":{\"level\":\"HIGH\",\"reasons\":\"Anomalous Location\"},\"behaviors\":{\"New Country\":\"POSITIVE\"}}"
+	}}}`), &event))
+
+	risk, ok := event.Risk()
+	require.True(t, ok)
+	assert.Equal(t, LogRisk{Level: "HIGH", Reasons: "Anomalous Location", Behaviors: map[string]string{"New Country": "POSITIVE"}}, risk)
+	assert.True(t, event.ThreatSuspected())
+}
+
+func Test_Log_Event_Accessors_Without_Context(t *testing.T) {
+	var event LogEvent
+	_, ok := event.ClientLocation()
+	assert.False(t, ok)
+	_, ok = event.Risk()
+	assert.False(t, ok)
+	assert.Nil(t, event.IPChain())
+	assert.Empty(t, event.ClientIPAddress())
+	assert.Empty(t, event.TransactionID())
+	_, ok = event.TransactionDetail("requestApiTokenId")
+	assert.False(t, ok)
+}
+
+func Test_Log_Event_Payload(t *testing.T) {
+	var session LogEvent
+	require.NoError(t, json.Unmarshal([]byte(logEventWithContext), &session))
+	session.Actor = &LogActor{Id: PtrString("00u1"), Type: PtrString("User"), AlternateId: PtrString("alice@example.com")}
+	session.Outcome = &LogOutcome{Result: PtrString("SUCCESS")}
+	session.AuthenticationContext = &LogAuthenticationContext{CredentialType: PtrString("PASSWORD"), ExternalSessionId: PtrString("102abc")}
+
+	payload, err := session.Payload()
+	require.NoError(t, err)
+	start, ok := payload.(*SessionStartEvent)
+	require.True(t, ok)
+	assert.Equal(t, "00u1", start.ActorID)
+	assert.Equal(t, "alice@example.com", start.ActorLogin)
+	assert.Equal(t, "SUCCESS", start.Result)
+	assert.Equal(t, "198.51.100.7", start.IPAddress)
+	assert.Equal(t, "Lyon", start.Location.City)
+	assert.Equal(t, "MEDIUM", start.Risk.Level)
+	assert.Equal(t, "PASSWORD", start.CredentialType)
+	assert.Equal(t, "102abc", start.ExternalSessionID)
+	assert.Equal(t, "/idp/idx/identify", start.RequestURI)
+
+	var membership LogEvent
+	require.NoError(t, json.Unmarshal([]byte(`{
+	  "eventType": "application.user_membership.add",
+	  "actor": {"id": "00u9", "type": "User", "alternateId": "admin@example.com"},
+	  "target": [
+	    {"id": "0ua1", "type": "AppUser", "alternateId": "alice"},
+	    {"id": "0oa1", "type": "AppInstance", "displayName": "Salesforce"},
+	    {"id": "00u1", "type": "User", "alternateId": "alice@example.com"}
+	  ]
+	}`), &membership))
+	payload, err = membership.Payload()
+	require.NoError(t, err)
+	assert.Equal(t, &AppUserMembershipEvent{
+		LogEventCommon: LogEventCommon{EventType: "application.user_membership.add", ActorID: "00u9", ActorType: "User", ActorLogin: "admin@example.com"},
+		Action:         "add",
+		UserID:         "00u1",
+		UserLogin:      "alice@example.com",
+		AppID:          "0oa1",
+		AppName:        "Salesforce",
+		AppUserID:      "0ua1",
+		AppUsername:    "alice",
+	}, payload)
+
+	var policy LogEvent
+	require.NoError(t, json.Unmarshal([]byte(`{
+	  "eventType": "policy.evaluate_sign_on",
+	  "outcome": {"result": "CHALLENGE", "reason": "Sign-on policy evaluation resulted in CHALLENGE"},
+	  "target": [
+	    {"id": "00p1", "type": "PolicyEntity", "displayName": "Default Policy"},
+	    {"id": "0pr1", "type": "PolicyRule", "displayName": "MFA off network"}
+	  ]
+	}`), &policy))
+	payload, err = policy.Payload()
+	require.NoError(t, err)
+	evaluation := payload.(*SignOnPolicyEvaluationEvent)
+	assert.Equal(t, "CHALLENGE", evaluation.Result)
+	assert.Equal(t, "Default Policy", evaluation.PolicyName)
+	assert.Equal(t, "0pr1", evaluation.RuleID)
+
+	lifecycle := LogEvent{EventType: PtrString("user.lifecycle.delete.initiated"), Target: []LogTarget{{Id: PtrString("00u2"), Type: PtrString("User"), DisplayName: PtrString("Bob")}}}
+	payload, err = lifecycle.Payload()
+	require.NoError(t, err)
+	assert.Equal(t, "delete.initiated", payload.(*UserLifecycleEvent).Action)
+	assert.Equal(t, "Bob", payload.(*UserLifecycleEvent).UserName)
+
+	payload, err = (&LogEvent{EventType: PtrString("group.user_membership.add")}).Payload()
+	require.NoError(t, err)
+	assert.Nil(t, payload)
+
+	// a more specific registration wins over the SDK wildcard
+	RegisterLogEventType("user.lifecycle.create", func(event *LogEvent) (interface{}, error) {
+		return event.GetUuid(), nil
+	})
+	defer RegisterLogEventType("user.lifecycle.create", nil)
+	payload, err = (&LogEvent{EventType: PtrString("user.lifecycle.create"), Uuid: PtrString("u1")}).Payload()
+	require.NoError(t, err)
+	assert.Equal(t, "u1", payload)
+}
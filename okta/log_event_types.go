@@ -0,0 +1,265 @@
+package okta
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Event types with a typed payload registered by the SDK. A type ending with
+// ".*" stands for every event type with that prefix.
+const (
+	EventTypeSessionStart         = "user.session.start"
+	EventTypeUserLifecycle        = "user.lifecycle.*"
+	EventTypeAppUserMembership    = "application.user_membership.*"
+	EventTypePolicyEvaluateSignOn = "policy.evaluate_sign_on"
+)
+
+// logEventPayload is a payload decoded by the SDK.
+type logEventPayload interface {
+	decode(event *LogEvent)
+}
+
+// LogEventDecoder decodes the payload of an event of a registered type.
+type LogEventDecoder func(event *LogEvent) (interface{}, error)
+
+var logEventTypes = struct {
+	sync.RWMutex
+	decoders map[string]LogEventDecoder
+}{decoders: map[string]LogEventDecoder{}}
+
+// RegisterLogEventType makes LogEvent.Payload decode the events of
+// eventType with decode, replacing the decoder of the SDK for the type if
+// any. An eventType ending with ".*", such as "group.user_membership.*",
+// matches every event type with that prefix; the longest match wins. A nil
+// decode removes the registration.
+func RegisterLogEventType(eventType string, decode LogEventDecoder) {
+	logEventTypes.Lock()
+	defer logEventTypes.Unlock()
+	if decode == nil {
+		delete(logEventTypes.decoders, eventType)
+		return
+	}
+	logEventTypes.decoders[eventType] = decode
+}
+
+func registerLogEventPayload[T any, P interface {
+	*T
+	logEventPayload
+}](eventType string) {
+	RegisterLogEventType(eventType, func(event *LogEvent) (interface{}, error) {
+		payload := P(new(T))
+		payload.decode(event)
+		return payload, nil
+	})
+}
+
+func init() {
+	registerLogEventPayload[SessionStartEvent](EventTypeSessionStart)
+	registerLogEventPayload[UserLifecycleEvent](EventTypeUserLifecycle)
+	registerLogEventPayload[AppUserMembershipEvent](EventTypeAppUserMembership)
+	registerLogEventPayload[SignOnPolicyEvaluationEvent](EventTypePolicyEvaluateSignOn)
+}
+
+// Payload decodes the event into the struct registered for its type, such
+// as a *SessionStartEvent for user.session.start, so that pipelines switch
+// on the type of the payload rather than parse debug data and targets. It
+// returns nil for types without a registered payload.
+//
+//	payload, err := event.Payload()
+//	if err != nil {
+//		return err
+//	}
+//	switch payload := payload.(type) {
+//	case *okta.SessionStartEvent:
+//		alertOnRisk(payload.Risk)
+//	case *okta.UserLifecycleEvent:
+//		syncUser(payload.UserID)
+//	}
+func (o *LogEvent) Payload() (interface{}, error) {
+	eventType := o.GetEventType()
+	logEventTypes.RLock()
+	decode, ok := logEventTypes.decoders[eventType]
+	if !ok {
+		longest := -1
+		for pattern, d := range logEventTypes.decoders {
+			prefix, wildcard := strings.CutSuffix(pattern, "*")
+			if wildcard && strings.HasPrefix(eventType, prefix) && len(prefix) > longest {
+				decode, longest = d, len(prefix)
+			}
+		}
+	}
+	logEventTypes.RUnlock()
+	if decode == nil {
+		return nil, nil
+	}
+	return decode(o)
+}
+
+// TargetOfType returns the first target of the event of type, such as User
+// or AppInstance, or nil.
+func (o *LogEvent) TargetOfType(targetType string) *LogTarget {
+	for i := range o.Target {
+		if o.Target[i].GetType() == targetType {
+			return &o.Target[i]
+		}
+	}
+	return nil
+}
+
+// LogEventCommon holds the fields shared by the typed payloads of events.
+type LogEventCommon struct {
+	UUID      string
+	EventType string
+	Published time.Time
+	// ActorID and ActorLogin identify who or what performed the action, a
+	// user or an app.
+	ActorID    string
+	ActorType  string
+	ActorLogin string
+	// Result is SUCCESS, FAILURE, SKIPPED, ALLOW, DENY, CHALLENGE or UNKNOWN,
+	// and Reason explains failures.
+	Result string
+	Reason string
+}
+
+func (c *LogEventCommon) decode(event *LogEvent) {
+	c.UUID = event.GetUuid()
+	c.EventType = event.GetEventType()
+	c.Published = event.GetPublished()
+	if event.Actor != nil {
+		c.ActorID = event.Actor.GetId()
+		c.ActorType = event.Actor.GetType()
+		c.ActorLogin = event.Actor.GetAlternateId()
+	}
+	if event.Outcome != nil {
+		c.Result = event.Outcome.GetResult()
+		c.Reason = event.Outcome.GetReason()
+	}
+}
+
+// LogEventClient holds where the request of an event came from.
+type LogEventClient struct {
+	IPAddress string
+	Zone      string
+	UserAgent string
+	Location  LogLocation
+	// Risk is the risk Okta assessed, with a zero Level when it has none.
+	Risk            LogRisk
+	ThreatSuspected bool
+}
+
+func (c *LogEventClient) decode(event *LogEvent) {
+	c.IPAddress = event.ClientIPAddress()
+	c.Zone = event.ClientZone()
+	c.UserAgent = event.ClientUserAgent()
+	c.Location, _ = event.ClientLocation()
+	c.Risk, _ = event.Risk()
+	c.ThreatSuspected = event.ThreatSuspected()
+}
+
+// SessionStartEvent is the payload of user.session.start events, a user
+// signing in. The user is the actor.
+type SessionStartEvent struct {
+	LogEventCommon
+	LogEventClient
+	// AuthenticationProvider is OKTA_AUTHENTICATION_PROVIDER,
+	// ACTIVE_DIRECTORY, LDAP, FEDERATION, SOCIAL or FACTOR_PROVIDER.
+	AuthenticationProvider string
+	CredentialType         string
+	ExternalSessionID      string
+	RequestURI             string
+}
+
+func (e *SessionStartEvent) decode(event *LogEvent) {
+	e.LogEventCommon.decode(event)
+	e.LogEventClient.decode(event)
+	if ctx := event.AuthenticationContext; ctx != nil {
+		e.AuthenticationProvider = ctx.GetAuthenticationProvider()
+		e.CredentialType = ctx.GetCredentialType()
+		e.ExternalSessionID = ctx.GetExternalSessionId()
+	}
+	e.RequestURI = event.DebugValue("requestUri")
+}
+
+// UserLifecycleEvent is the payload of user.lifecycle.* events, such as
+// user.lifecycle.create or user.lifecycle.deactivate.
+type UserLifecycleEvent struct {
+	LogEventCommon
+	// Action is the event type without its user.lifecycle. prefix, such as
+	// create, activate, suspend or delete.initiated.
+	Action    string
+	UserID    string
+	UserLogin string
+	UserName  string
+}
+
+func (e *UserLifecycleEvent) decode(event *LogEvent) {
+	e.LogEventCommon.decode(event)
+	e.Action = strings.TrimPrefix(e.EventType, "user.lifecycle.")
+	if user := event.TargetOfType("User"); user != nil {
+		e.UserID, e.UserLogin, e.UserName = user.GetId(), user.GetAlternateId(), user.GetDisplayName()
+	}
+}
+
+// AppUserMembershipEvent is the payload of application.user_membership.*
+// events, a user assigned to or removed from an app.
+type AppUserMembershipEvent struct {
+	LogEventCommon
+	// Action is the event type without its application.user_membership.
+	// prefix, such as add, remove or change_username.
+	Action    string
+	UserID    string
+	UserLogin string
+	AppID     string
+	AppName   string
+	// AppUserID is the id of the assignment, the AppUser, and AppUsername
+	// the username of the user in the app.
+	AppUserID   string
+	AppUsername string
+}
+
+func (e *AppUserMembershipEvent) decode(event *LogEvent) {
+	e.LogEventCommon.decode(event)
+	e.Action = strings.TrimPrefix(e.EventType, "application.user_membership.")
+	if user := event.TargetOfType("User"); user != nil {
+		e.UserID, e.UserLogin = user.GetId(), user.GetAlternateId()
+	}
+	if app := event.TargetOfType("AppInstance"); app != nil {
+		e.AppID, e.AppName = app.GetId(), app.GetDisplayName()
+	}
+	if appUser := event.TargetOfType("AppUser"); appUser != nil {
+		e.AppUserID, e.AppUsername = appUser.GetId(), appUser.GetAlternateId()
+	}
+}
+
+// SignOnPolicyEvaluationEvent is the payload of policy.evaluate_sign_on
+// events, the evaluation of the sign-on policies for a user, who is the
+// actor. Result is the action of the matching rule, such as ALLOW or
+// CHALLENGE.
+type SignOnPolicyEvaluationEvent struct {
+	LogEventCommon
+	LogEventClient
+	PolicyID   string
+	PolicyName string
+	RuleID     string
+	RuleName   string
+	// AppID and AppName are the app signed in to, empty for the Okta
+	// dashboard.
+	AppID   string
+	AppName string
+}
+
+func (e *SignOnPolicyEvaluationEvent) decode(event *LogEvent) {
+	e.LogEventCommon.decode(event)
+	e.LogEventClient.decode(event)
+	if policy := event.TargetOfType("PolicyEntity"); policy != nil {
+		e.PolicyID, e.PolicyName = policy.GetId(), policy.GetDisplayName()
+	}
+	if rule := event.TargetOfType("PolicyRule"); rule != nil {
+		e.RuleID, e.RuleName = rule.GetId(), rule.GetDisplayName()
+	}
+	if app := event.TargetOfType("AppInstance"); app != nil {
+		e.AppID, e.AppName = app.GetId(), app.GetDisplayName()
+	}
+}
@@ -0,0 +1,324 @@
+// Package logexport writes System Log events as JSON Lines, CEF or LEEF, to
+// feed SIEMs that read files or syslog rather than the System Log API or Okta
+// Log Streaming.
+//
+// JSON Lines writes the events as the System Log API returns them, which is
+// also the payload Okta Log Streaming sends. CEF and LEEF map the fields
+// SIEMs index, the event type, actor, client, outcome and first target, to
+// the keys of their dictionaries, keeping the names of the System Log for the
+// others.
+package logexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// Formats of NewEncoder.
+const (
+	FormatJSONLines = "jsonl"
+	FormatCEF       = "cef"
+	FormatLEEF      = "leef"
+)
+
+// Values of the vendor, product and version fields of CEF and LEEF headers.
+const (
+	Vendor  = "Okta"
+	Product = "SystemLog"
+	Version = "1.0"
+)
+
+// Encoder writes events to a writer, one line per event written with a single
+// call to Write, so that lines of concurrent encoders sharing a syslog
+// connection do not interleave.
+type Encoder interface {
+	Encode(event *okta.LogEvent) error
+}
+
+// NewEncoder returns the encoder of format, FormatJSONLines, FormatCEF or
+// FormatLEEF, writing to w.
+func NewEncoder(w io.Writer, format string) (Encoder, error) {
+	switch format {
+	case FormatJSONLines:
+		return NewJSONLinesEncoder(w), nil
+	case FormatCEF:
+		return NewCEFEncoder(w), nil
+	case FormatLEEF:
+		return NewLEEFEncoder(w), nil
+	}
+	return nil, fmt.Errorf("unknown log export format %q", format)
+}
+
+// Copy encodes every event of events, such as those of
+// client.SystemLogAPI.Stream, until it ends or fails, and returns the number
+// of events written.
+func Copy(enc Encoder, events iter.Seq2[okta.LogEvent, error]) (int, error) {
+	var n int
+	for event, err := range events {
+		if err != nil {
+			return n, err
+		}
+		if err := enc.Encode(&event); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// WriteAll encodes events, such as a page of ListLogEvents.
+func WriteAll(enc Encoder, events []okta.LogEvent) error {
+	for i := range events {
+		if err := enc.Encode(&events[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonLinesEncoder struct {
+	w io.Writer
+}
+
+// NewJSONLinesEncoder returns an Encoder writing events as JSON, one per line.
+func NewJSONLinesEncoder(w io.Writer) Encoder {
+	return &jsonLinesEncoder{w: w}
+}
+
+func (e *jsonLinesEncoder) Encode(event *okta.LogEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(append(line, '\n'))
+	return err
+}
+
+// field is a key and its value in the extension of a CEF or LEEF line.
+type field struct {
+	key, value string
+}
+
+// severity returns the 0 to 10 severity of CEF and LEEF of a System Log
+// severity.
+func severity(event *okta.LogEvent) int {
+	switch event.GetSeverity() {
+	case "DEBUG":
+		return 1
+	case "WARN":
+		return 6
+	case "ERROR":
+		return 9
+	}
+	return 3
+}
+
+// target returns the user the event targets, or its first target.
+func target(event *okta.LogEvent) *okta.LogTarget {
+	if user := event.TargetOfType("User"); user != nil {
+		return user
+	}
+	if len(event.Target) > 0 {
+		return &event.Target[0]
+	}
+	return nil
+}
+
+func actor(event *okta.LogEvent) (id, login string) {
+	if event.Actor == nil {
+		return "", ""
+	}
+	return event.Actor.GetId(), event.Actor.GetAlternateId()
+}
+
+func outcome(event *okta.LogEvent) (result, reason string) {
+	if event.Outcome == nil {
+		return "", ""
+	}
+	return event.Outcome.GetResult(), event.Outcome.GetReason()
+}
+
+type cefEncoder struct {
+	w io.Writer
+}
+
+// NewCEFEncoder returns an Encoder writing events in the Common Event Format
+// of ArcSight. The signature id of the header is the event type and the name
+// is the display message. The extension maps:
+//
+//	rt                        published, in milliseconds since the epoch
+//	externalId                uuid
+//	suid, suser               actor id and alternate id
+//	src                       client IP address
+//	requestClientApplication  client user agent
+//	outcome, reason           outcome result and reason
+//	duid, duser               id and alternate id of the targeted user, or
+//	                          of the first target
+//	cs1 (transactionId)       transaction id
+//	cs2 (requestUri)          request URI of the debug data
+//	cs3 (zone)                client network zone
+//	cs4 (externalSessionId)   session id of the authentication context
+func NewCEFEncoder(w io.Writer) Encoder {
+	return &cefEncoder{w: w}
+}
+
+func (e *cefEncoder) Encode(event *okta.LogEvent) error {
+	var line bytes.Buffer
+	fmt.Fprintf(&line, "CEF:0|%s|%s|%s|%s|%s|%d|",
+		cefHeader(Vendor), cefHeader(Product), cefHeader(Version),
+		cefHeader(event.GetEventType()), cefHeader(event.GetDisplayMessage()), severity(event))
+	actorID, actorLogin := actor(event)
+	result, reason := outcome(event)
+	fields := []field{
+		{"externalId", event.GetUuid()},
+		{"suid", actorID},
+		{"suser", actorLogin},
+		{"src", event.ClientIPAddress()},
+		{"requestClientApplication", event.ClientUserAgent()},
+		{"outcome", result},
+		{"reason", reason},
+	}
+	if published := event.GetPublished(); !published.IsZero() {
+		fields = append([]field{{"rt", strconv.FormatInt(published.UnixMilli(), 10)}}, fields...)
+	}
+	if t := target(event); t != nil {
+		fields = append(fields, field{"duid", t.GetId()}, field{"duser", t.GetAlternateId()})
+	}
+	for i, custom := range []field{
+		{"transactionId", event.TransactionID()},
+		{"requestUri", event.DebugValue("requestUri")},
+		{"zone", event.ClientZone()},
+		{"externalSessionId", externalSessionID(event)},
+	} {
+		if custom.value != "" {
+			key := "cs" + strconv.Itoa(i+1)
+			fields = append(fields, field{key, custom.value}, field{key + "Label", custom.key})
+		}
+	}
+	sep := ""
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+		line.WriteString(sep + f.key + "=" + cefValue(f.value))
+		sep = " "
+	}
+	line.WriteByte('\n')
+	_, err := e.w.Write(line.Bytes())
+	return err
+}
+
+var (
+	cefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefValueEscaper  = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+func cefHeader(s string) string {
+	return cefHeaderEscaper.Replace(s)
+}
+
+func cefValue(s string) string {
+	return cefValueEscaper.Replace(s)
+}
+
+type leefEncoder struct {
+	w io.Writer
+}
+
+// NewLEEFEncoder returns an Encoder writing events in the Log Event Extended
+// Format 1.0 of QRadar, with attributes separated by tabs. The event id of
+// the header is the event type. The attributes map:
+//
+//	devTime            published, with devTimeFormat
+//	cat                event type
+//	sev                severity
+//	src                client IP address
+//	usrName            actor alternate id
+//	actorId            actor id
+//	outcome, reason    outcome result and reason
+//	targetId, target   id and alternate id of the targeted user, or of the
+//	                   first target
+//	displayMessage     display message
+//	uuid               uuid
+//	transactionId      transaction id
+//	userAgent          client user agent
+//	zone               client network zone
+func NewLEEFEncoder(w io.Writer) Encoder {
+	return &leefEncoder{w: w}
+}
+
+const (
+	// leefTimeFormat is the Java date format of devTime, as LEEF requires,
+	// and leefTimeLayout the same format in Go.
+	leefTimeFormat = "yyyy-MM-dd'T'HH:mm:ss.SSSX"
+	leefTimeLayout = "2006-01-02T15:04:05.000Z07:00"
+)
+
+func (e *leefEncoder) Encode(event *okta.LogEvent) error {
+	var line bytes.Buffer
+	fmt.Fprintf(&line, "LEEF:1.0|%s|%s|%s|%s|",
+		leefHeader(Vendor), leefHeader(Product), leefHeader(Version), leefHeader(event.GetEventType()))
+	actorID, actorLogin := actor(event)
+	result, reason := outcome(event)
+	var fields []field
+	if published := event.GetPublished(); !published.IsZero() {
+		fields = append(fields, field{"devTime", published.UTC().Format(leefTimeLayout)}, field{"devTimeFormat", leefTimeFormat})
+	}
+	fields = append(fields,
+		field{"cat", event.GetEventType()},
+		field{"sev", strconv.Itoa(severity(event))},
+		field{"src", event.ClientIPAddress()},
+		field{"usrName", actorLogin},
+		field{"actorId", actorID},
+		field{"outcome", result},
+		field{"reason", reason},
+	)
+	if t := target(event); t != nil {
+		fields = append(fields, field{"targetId", t.GetId()}, field{"target", t.GetAlternateId()})
+	}
+	fields = append(fields,
+		field{"displayMessage", event.GetDisplayMessage()},
+		field{"uuid", event.GetUuid()},
+		field{"transactionId", event.TransactionID()},
+		field{"userAgent", event.ClientUserAgent()},
+		field{"zone", event.ClientZone()},
+	)
+	sep := ""
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+		line.WriteString(sep + f.key + "=" + leefValue(f.value))
+		sep = "\t"
+	}
+	line.WriteByte('\n')
+	_, err := e.w.Write(line.Bytes())
+	return err
+}
+
+var (
+	leefHeaderEscaper = strings.NewReplacer(`|`, `\|`, "\t", " ", "\r", " ", "\n", " ")
+	leefValueEscaper  = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ")
+)
+
+func leefHeader(s string) string {
+	return leefHeaderEscaper.Replace(s)
+}
+
+func leefValue(s string) string {
+	return leefValueEscaper.Replace(s)
+}
+
+func externalSessionID(event *okta.LogEvent) string {
+	if event.AuthenticationContext == nil {
+		return ""
+	}
+	return event.AuthenticationContext.GetExternalSessionId()
+}
@@ -0,0 +1,95 @@
+package logexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const event = `{
+  "uuid": "dc9fd3c0-598c-11ef-8478-2b7584bf8d5a",
+  "published": "2024-08-13T09:30:00.123Z",
+  "eventType": "user.session.start",
+  "displayMessage": "User login to Okta",
+  "severity": "WARN",
+  "actor": {"id": "00u1", "type": "User", "alternateId": "alice@example.com"},
+  "client": {"userAgent": {"rawUserAgent": "Mozilla/5.0"}, "zone": "Office", "ipAddress": "198.51.100.7"},
+  "outcome": {"result": "FAILURE", "reason": "INVALID_CREDENTIALS=bad|password\nagain"},
+  "target": [{"id": "0oa1", "type": "AppInstance", "alternateId": "Okta Dashboard"}],
+  "debugContext": {"debugData": {"requestUri": "/idp/idx/identify"}},
+  "transaction": {"type": "WEB", "id": "ZrqPnkjqcRn3A"}
+}`
+
+func decode(t *testing.T) okta.LogEvent {
+	var e okta.LogEvent
+	require.NoError(t, json.Unmarshal([]byte(event), &e))
+	return e
+}
+
+func Test_CEF(t *testing.T) {
+	var buf bytes.Buffer
+	e := decode(t)
+	require.NoError(t, NewCEFEncoder(&buf).Encode(&e))
+	assert.Equal(t, "CEF:0|Okta|SystemLog|1.0|user.session.start|User login to Okta|6|"+
+		"rt=1723541400123 externalId=dc9fd3c0-598c-11ef-8478-2b7584bf8d5a suid=00u1 suser=alice@example.com "+
+		"src=198.51.100.7 requestClientApplication=Mozilla/5.0 outcome=FAILURE "+
+		`reason=INVALID_CREDENTIALS\=bad|password\nagain duid=0oa1 duser=Okta Dashboard `+
+		"cs1=ZrqPnkjqcRn3A cs1Label=transactionId cs2=/idp/idx/identify cs2Label=requestUri cs3=Office cs3Label=zone\n", buf.String())
+}
+
+func Test_LEEF(t *testing.T) {
+	var buf bytes.Buffer
+	e := decode(t)
+	require.NoError(t, NewLEEFEncoder(&buf).Encode(&e))
+	line := strings.TrimSuffix(buf.String(), "\n")
+	header, attributes, ok := strings.Cut(line, "|user.session.start|")
+	require.True(t, ok)
+	assert.Equal(t, "LEEF:1.0|Okta|SystemLog|1.0", header)
+	assert.Equal(t, []string{
+		"devTime=2024-08-13T09:30:00.123Z",
+		"devTimeFormat=yyyy-MM-dd'T'HH:mm:ss.SSSX",
+		"cat=user.session.start",
+		"sev=6",
+		"src=198.51.100.7",
+		"usrName=alice@example.com",
+		"actorId=00u1",
+		"outcome=FAILURE",
+		"reason=INVALID_CREDENTIALS=bad|password again",
+		"targetId=0oa1",
+		"target=Okta Dashboard",
+		"displayMessage=User login to Okta",
+		"uuid=dc9fd3c0-598c-11ef-8478-2b7584bf8d5a",
+		"transactionId=ZrqPnkjqcRn3A",
+		"userAgent=Mozilla/5.0",
+		"zone=Office",
+	}, strings.Split(attributes, "\t"))
+}
+
+func Test_Copy(t *testing.T) {
+	var buf bytes.Buffer
+	enc, err := NewEncoder(&buf, FormatJSONLines)
+	require.NoError(t, err)
+	e := decode(t)
+	failure := errors.New("stream failed")
+	n, err := Copy(enc, func(yield func(okta.LogEvent, error) bool) {
+		_ = yield(e, nil) && yield(e, nil) && yield(okta.LogEvent{}, failure)
+	})
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, 2, n)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	var decoded okta.LogEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &decoded))
+	assert.Equal(t, "user.session.start", decoded.GetEventType())
+	assert.Equal(t, "alice@example.com", decoded.Actor.GetAlternateId())
+
+	_, err = NewEncoder(&buf, "syslog")
+	assert.Error(t, err)
+}
@@ -0,0 +1,582 @@
+// Code generated by opscopes. DO NOT EDIT.
+
+package okta
+
+// operationScopes lists the OAuth 2.0 scopes required by each operation of
+// the API.
+var operationScopes = []operationScope{
+	{"GET", "/api/v1/agentPools", []string{"okta.agentPools.read"}},
+	{"GET", "/api/v1/agentPools/{poolId}/updates", []string{"okta.agentPools.read"}},
+	{"POST", "/api/v1/agentPools/{poolId}/updates", []string{"okta.agentPools.manage"}},
+	{"GET", "/api/v1/agentPools/{poolId}/updates/settings", []string{"okta.agentPools.read"}},
+	{"POST", "/api/v1/agentPools/{poolId}/updates/settings", []string{"okta.agentPools.manage"}},
+	{"DELETE", "/api/v1/agentPools/{poolId}/updates/{updateId}", []string{"okta.agentPools.manage"}},
+	{"GET", "/api/v1/agentPools/{poolId}/updates/{updateId}", []string{"okta.agentPools.read"}},
+	{"POST", "/api/v1/agentPools/{poolId}/updates/{updateId}", []string{"okta.agentPools.manage"}},
+	{"POST", "/api/v1/agentPools/{poolId}/updates/{updateId}/activate", []string{"okta.agentPools.manage"}},
+	{"POST", "/api/v1/agentPools/{poolId}/updates/{updateId}/deactivate", []string{"okta.agentPools.manage"}},
+	{"POST", "/api/v1/agentPools/{poolId}/updates/{updateId}/pause", []string{"okta.agentPools.manage"}},
+	{"POST", "/api/v1/agentPools/{poolId}/updates/{updateId}/resume", []string{"okta.agentPools.manage"}},
+	{"POST", "/api/v1/agentPools/{poolId}/updates/{updateId}/retry", []string{"okta.agentPools.manage"}},
+	{"POST", "/api/v1/agentPools/{poolId}/updates/{updateId}/stop", []string{"okta.agentPools.manage"}},
+	{"GET", "/api/v1/api-tokens", []string{"okta.apiTokens.read"}},
+	{"DELETE", "/api/v1/api-tokens/{apiTokenId}", []string{"okta.apiTokens.manage"}},
+	{"GET", "/api/v1/api-tokens/{apiTokenId}", []string{"okta.apiTokens.read"}},
+	{"PUT", "/api/v1/api-tokens/{apiTokenId}", []string{"okta.apiTokens.manage"}},
+	{"GET", "/api/v1/apps", []string{"okta.apps.read"}},
+	{"POST", "/api/v1/apps", []string{"okta.apps.manage"}},
+	{"DELETE", "/api/v1/apps/{appId}", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}", []string{"okta.apps.read"}},
+	{"PUT", "/api/v1/apps/{appId}", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/connections/default", []string{"okta.apps.read"}},
+	{"POST", "/api/v1/apps/{appId}/connections/default", []string{"okta.apps.manage"}},
+	{"POST", "/api/v1/apps/{appId}/connections/default/lifecycle/activate", []string{"okta.apps.manage"}},
+	{"POST", "/api/v1/apps/{appId}/connections/default/lifecycle/deactivate", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/credentials/csrs", []string{"okta.apps.read"}},
+	{"POST", "/api/v1/apps/{appId}/credentials/csrs", []string{"okta.apps.manage"}},
+	{"DELETE", "/api/v1/apps/{appId}/credentials/csrs/{csrId}", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/credentials/csrs/{csrId}", []string{"okta.apps.read"}},
+	{"POST", "/api/v1/apps/{appId}/credentials/csrs/{csrId}/lifecycle/publish", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/credentials/keys", []string{"okta.apps.read"}},
+	{"POST", "/api/v1/apps/{appId}/credentials/keys/generate", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/credentials/keys/{keyId}", []string{"okta.apps.read"}},
+	{"POST", "/api/v1/apps/{appId}/credentials/keys/{keyId}/clone", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/features", []string{"okta.apps.read"}},
+	{"GET", "/api/v1/apps/{appId}/features/{featureName}", []string{"okta.apps.read"}},
+	{"PUT", "/api/v1/apps/{appId}/features/{featureName}", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/grants", []string{"okta.appGrants.read"}},
+	{"POST", "/api/v1/apps/{appId}/grants", []string{"okta.appGrants.manage"}},
+	{"DELETE", "/api/v1/apps/{appId}/grants/{grantId}", []string{"okta.appGrants.manage"}},
+	{"GET", "/api/v1/apps/{appId}/grants/{grantId}", []string{"okta.appGrants.read"}},
+	{"GET", "/api/v1/apps/{appId}/groups", []string{"okta.apps.read"}},
+	{"DELETE", "/api/v1/apps/{appId}/groups/{groupId}", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/groups/{groupId}", []string{"okta.apps.read"}},
+	{"PATCH", "/api/v1/apps/{appId}/groups/{groupId}", []string{"okta.apps.manage"}},
+	{"PUT", "/api/v1/apps/{appId}/groups/{groupId}", []string{"okta.apps.manage"}},
+	{"POST", "/api/v1/apps/{appId}/lifecycle/activate", []string{"okta.apps.manage"}},
+	{"POST", "/api/v1/apps/{appId}/lifecycle/deactivate", []string{"okta.apps.manage"}},
+	{"POST", "/api/v1/apps/{appId}/logo", []string{"okta.apps.manage"}},
+	{"PUT", "/api/v1/apps/{appId}/policies/{policyId}", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/sso/saml/metadata", []string{"okta.apps.read"}},
+	{"DELETE", "/api/v1/apps/{appId}/tokens", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/tokens", []string{"okta.apps.read"}},
+	{"DELETE", "/api/v1/apps/{appId}/tokens/{tokenId}", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/tokens/{tokenId}", []string{"okta.apps.read"}},
+	{"GET", "/api/v1/apps/{appId}/users", []string{"okta.apps.read"}},
+	{"POST", "/api/v1/apps/{appId}/users", []string{"okta.apps.manage"}},
+	{"DELETE", "/api/v1/apps/{appId}/users/{userId}", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/apps/{appId}/users/{userId}", []string{"okta.apps.read"}},
+	{"POST", "/api/v1/apps/{appId}/users/{userId}", []string{"okta.apps.manage"}},
+	{"POST", "/api/v1/apps/{appName}/{appId}/oauth2/callback", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/authenticators", []string{"okta.authenticators.read"}},
+	{"POST", "/api/v1/authenticators", []string{"okta.authenticators.manage"}},
+	{"GET", "/api/v1/authenticators/{authenticatorId}", []string{"okta.authenticators.read"}},
+	{"PUT", "/api/v1/authenticators/{authenticatorId}", []string{"okta.authenticators.manage"}},
+	{"POST", "/api/v1/authenticators/{authenticatorId}/lifecycle/activate", []string{"okta.authenticators.manage"}},
+	{"POST", "/api/v1/authenticators/{authenticatorId}/lifecycle/deactivate", []string{"okta.authenticators.manage"}},
+	{"GET", "/api/v1/authenticators/{authenticatorId}/methods", []string{"okta.authenticators.read"}},
+	{"GET", "/api/v1/authenticators/{authenticatorId}/methods/{methodType}", []string{"okta.authenticators.read"}},
+	{"PUT", "/api/v1/authenticators/{authenticatorId}/methods/{methodType}", []string{"okta.authenticators.manage"}},
+	{"POST", "/api/v1/authenticators/{authenticatorId}/methods/{methodType}/lifecycle/activate", []string{"okta.authenticators.manage"}},
+	{"POST", "/api/v1/authenticators/{authenticatorId}/methods/{methodType}/lifecycle/deactivate", []string{"okta.authenticators.manage"}},
+	{"GET", "/api/v1/authorizationServers", []string{"okta.authorizationServers.read"}},
+	{"POST", "/api/v1/authorizationServers", []string{"okta.authorizationServers.manage"}},
+	{"DELETE", "/api/v1/authorizationServers/{authServerId}", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}", []string{"okta.authorizationServers.read"}},
+	{"PUT", "/api/v1/authorizationServers/{authServerId}", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/associatedServers", []string{"okta.authorizationServers.read"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/associatedServers", []string{"okta.authorizationServers.manage"}},
+	{"DELETE", "/api/v1/authorizationServers/{authServerId}/associatedServers/{associatedServerId}", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/claims", []string{"okta.authorizationServers.read"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/claims", []string{"okta.authorizationServers.manage"}},
+	{"DELETE", "/api/v1/authorizationServers/{authServerId}/claims/{claimId}", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/claims/{claimId}", []string{"okta.authorizationServers.read"}},
+	{"PUT", "/api/v1/authorizationServers/{authServerId}/claims/{claimId}", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/clients", []string{"okta.authorizationServers.read"}},
+	{"DELETE", "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens", []string{"okta.authorizationServers.read"}},
+	{"DELETE", "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens/{tokenId}", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/clients/{clientId}/tokens/{tokenId}", []string{"okta.authorizationServers.read"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/credentials/keys", []string{"okta.authorizationServers.read"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/credentials/lifecycle/keyRotate", []string{"okta.authorizationServers.manage"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/lifecycle/activate", []string{"okta.authorizationServers.manage"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/lifecycle/deactivate", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/policies", []string{"okta.authorizationServers.read"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/policies", []string{"okta.authorizationServers.manage"}},
+	{"DELETE", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}", []string{"okta.authorizationServers.read"}},
+	{"PUT", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}", []string{"okta.authorizationServers.manage"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/lifecycle/activate", []string{"okta.authorizationServers.manage"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/lifecycle/deactivate", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules", []string{"okta.authorizationServers.read"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules", []string{"okta.authorizationServers.manage"}},
+	{"DELETE", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}", []string{"okta.authorizationServers.read"}},
+	{"PUT", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}", []string{"okta.authorizationServers.manage"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}/lifecycle/activate", []string{"okta.authorizationServers.manage"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/policies/{policyId}/rules/{ruleId}/lifecycle/deactivate", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/scopes", []string{"okta.authorizationServers.read"}},
+	{"POST", "/api/v1/authorizationServers/{authServerId}/scopes", []string{"okta.authorizationServers.manage"}},
+	{"DELETE", "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}", []string{"okta.authorizationServers.read"}},
+	{"PUT", "/api/v1/authorizationServers/{authServerId}/scopes/{scopeId}", []string{"okta.authorizationServers.manage"}},
+	{"GET", "/api/v1/behaviors", []string{"okta.behaviors.read"}},
+	{"POST", "/api/v1/behaviors", []string{"okta.behaviors.manage"}},
+	{"DELETE", "/api/v1/behaviors/{behaviorId}", []string{"okta.behaviors.manage"}},
+	{"GET", "/api/v1/behaviors/{behaviorId}", []string{"okta.behaviors.read"}},
+	{"PUT", "/api/v1/behaviors/{behaviorId}", []string{"okta.behaviors.manage"}},
+	{"POST", "/api/v1/behaviors/{behaviorId}/lifecycle/activate", []string{"okta.behaviors.manage"}},
+	{"POST", "/api/v1/behaviors/{behaviorId}/lifecycle/deactivate", []string{"okta.behaviors.manage"}},
+	{"GET", "/api/v1/brands", []string{"okta.brands.read"}},
+	{"POST", "/api/v1/brands", []string{"okta.brands.manage"}},
+	{"DELETE", "/api/v1/brands/{brandId}", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}", []string{"okta.brands.read"}},
+	{"PUT", "/api/v1/brands/{brandId}", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/domains", []string{"okta.brands.read"}},
+	{"GET", "/api/v1/brands/{brandId}/pages/error", []string{"okta.brands.read"}},
+	{"DELETE", "/api/v1/brands/{brandId}/pages/error/customized", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/pages/error/customized", []string{"okta.brands.read"}},
+	{"PUT", "/api/v1/brands/{brandId}/pages/error/customized", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/pages/error/default", []string{"okta.brands.read"}},
+	{"DELETE", "/api/v1/brands/{brandId}/pages/error/preview", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/pages/error/preview", []string{"okta.brands.read"}},
+	{"PUT", "/api/v1/brands/{brandId}/pages/error/preview", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/pages/sign-in", []string{"okta.brands.read"}},
+	{"DELETE", "/api/v1/brands/{brandId}/pages/sign-in/customized", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/pages/sign-in/customized", []string{"okta.brands.read"}},
+	{"PUT", "/api/v1/brands/{brandId}/pages/sign-in/customized", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/pages/sign-in/default", []string{"okta.brands.read"}},
+	{"DELETE", "/api/v1/brands/{brandId}/pages/sign-in/preview", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/pages/sign-in/preview", []string{"okta.brands.read"}},
+	{"PUT", "/api/v1/brands/{brandId}/pages/sign-in/preview", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/pages/sign-in/widget-versions", []string{"okta.brands.read"}},
+	{"GET", "/api/v1/brands/{brandId}/pages/sign-out/customized", []string{"okta.brands.read"}},
+	{"PUT", "/api/v1/brands/{brandId}/pages/sign-out/customized", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/templates/email", []string{"okta.templates.read"}},
+	{"GET", "/api/v1/brands/{brandId}/templates/email/{templateName}", []string{"okta.templates.read"}},
+	{"DELETE", "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations", []string{"okta.templates.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations", []string{"okta.templates.read"}},
+	{"POST", "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations", []string{"okta.templates.manage"}},
+	{"DELETE", "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}", []string{"okta.templates.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}", []string{"okta.templates.read"}},
+	{"PUT", "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}", []string{"okta.templates.manage"}},
+	{"GET", "/api/v1/brands/{brandId}/templates/email/{templateName}/customizations/{customizationId}/preview", []string{"okta.templates.read"}},
+	{"GET", "/api/v1/brands/{brandId}/templates/email/{templateName}/default-content", []string{"okta.templates.read"}},
+	{"GET", "/api/v1/brands/{brandId}/templates/email/{templateName}/default-content/preview", []string{"okta.templates.read"}},
+	{"GET", "/api/v1/brands/{brandId}/templates/email/{templateName}/settings", []string{"okta.templates.read"}},
+	{"PUT", "/api/v1/brands/{brandId}/templates/email/{templateName}/settings", []string{"okta.templates.manage"}},
+	{"POST", "/api/v1/brands/{brandId}/templates/email/{templateName}/test", []string{"okta.templates.read"}},
+	{"GET", "/api/v1/brands/{brandId}/themes", []string{"okta.brands.read"}},
+	{"GET", "/api/v1/brands/{brandId}/themes/{themeId}", []string{"okta.brands.read"}},
+	{"PUT", "/api/v1/brands/{brandId}/themes/{themeId}", []string{"okta.brands.manage"}},
+	{"DELETE", "/api/v1/brands/{brandId}/themes/{themeId}/background-image", []string{"okta.brands.manage"}},
+	{"POST", "/api/v1/brands/{brandId}/themes/{themeId}/background-image", []string{"okta.brands.manage"}},
+	{"DELETE", "/api/v1/brands/{brandId}/themes/{themeId}/favicon", []string{"okta.brands.manage"}},
+	{"POST", "/api/v1/brands/{brandId}/themes/{themeId}/favicon", []string{"okta.brands.manage"}},
+	{"DELETE", "/api/v1/brands/{brandId}/themes/{themeId}/logo", []string{"okta.brands.manage"}},
+	{"POST", "/api/v1/brands/{brandId}/themes/{themeId}/logo", []string{"okta.brands.manage"}},
+	{"GET", "/api/v1/captchas", []string{"okta.captchas.read"}},
+	{"POST", "/api/v1/captchas", []string{"okta.captchas.manage"}},
+	{"DELETE", "/api/v1/captchas/{captchaId}", []string{"okta.captchas.manage"}},
+	{"GET", "/api/v1/captchas/{captchaId}", []string{"okta.captchas.read"}},
+	{"POST", "/api/v1/captchas/{captchaId}", []string{"okta.captchas.manage"}},
+	{"PUT", "/api/v1/captchas/{captchaId}", []string{"okta.captchas.manage"}},
+	{"GET", "/api/v1/device-assurances", []string{"okta.deviceAssurance.read"}},
+	{"POST", "/api/v1/device-assurances", []string{"okta.deviceAssurance.manage"}},
+	{"DELETE", "/api/v1/device-assurances/{deviceAssuranceId}", []string{"okta.deviceAssurance.manage"}},
+	{"GET", "/api/v1/device-assurances/{deviceAssuranceId}", []string{"okta.deviceAssurance.read"}},
+	{"PUT", "/api/v1/device-assurances/{deviceAssuranceId}", []string{"okta.deviceAssurance.manage"}},
+	{"GET", "/api/v1/devices", []string{"okta.devices.read"}},
+	{"DELETE", "/api/v1/devices/{deviceId}", []string{"okta.devices.manage"}},
+	{"GET", "/api/v1/devices/{deviceId}", []string{"okta.devices.read"}},
+	{"POST", "/api/v1/devices/{deviceId}/lifecycle/activate", []string{"okta.devices.manage"}},
+	{"POST", "/api/v1/devices/{deviceId}/lifecycle/deactivate", []string{"okta.devices.manage"}},
+	{"POST", "/api/v1/devices/{deviceId}/lifecycle/suspend", []string{"okta.devices.manage"}},
+	{"POST", "/api/v1/devices/{deviceId}/lifecycle/unsuspend", []string{"okta.devices.manage"}},
+	{"GET", "/api/v1/devices/{deviceId}/users", []string{"okta.devices.read"}},
+	{"POST", "/api/v1/directories/{appInstanceId}/groups/modify", []string{"okta.directories.groups.manage"}},
+	{"GET", "/api/v1/domains", []string{"okta.domains.read"}},
+	{"POST", "/api/v1/domains", []string{"okta.domains.manage"}},
+	{"DELETE", "/api/v1/domains/{domainId}", []string{"okta.domains.manage"}},
+	{"GET", "/api/v1/domains/{domainId}", []string{"okta.domains.read"}},
+	{"PUT", "/api/v1/domains/{domainId}", []string{"okta.domains.manage"}},
+	{"PUT", "/api/v1/domains/{domainId}/certificate", []string{"okta.domains.manage"}},
+	{"POST", "/api/v1/domains/{domainId}/verify", []string{"okta.domains.manage"}},
+	{"GET", "/api/v1/email-domains", []string{"okta.emailDomains.read"}},
+	{"POST", "/api/v1/email-domains", []string{"okta.emailDomains.manage"}},
+	{"DELETE", "/api/v1/email-domains/{emailDomainId}", []string{"okta.emailDomains.manage"}},
+	{"GET", "/api/v1/email-domains/{emailDomainId}", []string{"okta.emailDomains.read"}},
+	{"PUT", "/api/v1/email-domains/{emailDomainId}", []string{"okta.emailDomains.manage"}},
+	{"POST", "/api/v1/email-domains/{emailDomainId}/verify", []string{"okta.emailDomains.manage"}},
+	{"GET", "/api/v1/email-servers", []string{"okta.emailServers.read"}},
+	{"POST", "/api/v1/email-servers", []string{"okta.emailServers.manage"}},
+	{"DELETE", "/api/v1/email-servers/{emailServerId}", []string{"okta.emailServers.manage"}},
+	{"GET", "/api/v1/email-servers/{emailServerId}", []string{"okta.emailServers.read"}},
+	{"PATCH", "/api/v1/email-servers/{emailServerId}", []string{"okta.emailServers.manage"}},
+	{"POST", "/api/v1/email-servers/{emailServerId}/test", []string{"okta.emailServers.manage"}},
+	{"GET", "/api/v1/eventHooks", []string{"okta.eventHooks.read"}},
+	{"POST", "/api/v1/eventHooks", []string{"okta.eventHooks.manage"}},
+	{"DELETE", "/api/v1/eventHooks/{eventHookId}", []string{"okta.eventHooks.manage"}},
+	{"GET", "/api/v1/eventHooks/{eventHookId}", []string{"okta.eventHooks.read"}},
+	{"PUT", "/api/v1/eventHooks/{eventHookId}", []string{"okta.eventHooks.manage"}},
+	{"POST", "/api/v1/eventHooks/{eventHookId}/lifecycle/activate", []string{"okta.eventHooks.manage"}},
+	{"POST", "/api/v1/eventHooks/{eventHookId}/lifecycle/deactivate", []string{"okta.eventHooks.manage"}},
+	{"POST", "/api/v1/eventHooks/{eventHookId}/lifecycle/verify", []string{"okta.eventHooks.manage"}},
+	{"GET", "/api/v1/features", []string{"okta.features.read"}},
+	{"GET", "/api/v1/features/{featureId}", []string{"okta.features.read"}},
+	{"GET", "/api/v1/features/{featureId}/dependencies", []string{"okta.features.read"}},
+	{"GET", "/api/v1/features/{featureId}/dependents", []string{"okta.features.read"}},
+	{"POST", "/api/v1/features/{featureId}/{lifecycle}", []string{"okta.features.manage"}},
+	{"GET", "/api/v1/first-party-app-settings/{appName}", []string{"okta.apps.read"}},
+	{"PUT", "/api/v1/first-party-app-settings/{appName}", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/groups", []string{"okta.groups.read"}},
+	{"POST", "/api/v1/groups", []string{"okta.groups.manage"}},
+	{"GET", "/api/v1/groups/rules", []string{"okta.groups.read"}},
+	{"POST", "/api/v1/groups/rules", []string{"okta.groups.manage"}},
+	{"DELETE", "/api/v1/groups/rules/{groupRuleId}", []string{"okta.groups.manage"}},
+	{"GET", "/api/v1/groups/rules/{groupRuleId}", []string{"okta.groups.read"}},
+	{"PUT", "/api/v1/groups/rules/{groupRuleId}", []string{"okta.groups.manage"}},
+	{"POST", "/api/v1/groups/rules/{groupRuleId}/lifecycle/activate", []string{"okta.groups.manage"}},
+	{"POST", "/api/v1/groups/rules/{groupRuleId}/lifecycle/deactivate", []string{"okta.groups.manage"}},
+	{"DELETE", "/api/v1/groups/{groupId}", []string{"okta.groups.manage"}},
+	{"GET", "/api/v1/groups/{groupId}", []string{"okta.groups.read"}},
+	{"PUT", "/api/v1/groups/{groupId}", []string{"okta.groups.manage"}},
+	{"GET", "/api/v1/groups/{groupId}/apps", []string{"okta.groups.read"}},
+	{"GET", "/api/v1/groups/{groupId}/owners", []string{"okta.groups.read"}},
+	{"POST", "/api/v1/groups/{groupId}/owners", []string{"okta.groups.manage"}},
+	{"DELETE", "/api/v1/groups/{groupId}/owners/{ownerId}", []string{"okta.groups.manage"}},
+	{"GET", "/api/v1/groups/{groupId}/roles", []string{"okta.roles.read"}},
+	{"POST", "/api/v1/groups/{groupId}/roles", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/groups/{groupId}/roles/{roleId}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/groups/{groupId}/roles/{roleId}", []string{"okta.roles.read"}},
+	{"GET", "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps", []string{"okta.roles.read"}},
+	{"DELETE", "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}", []string{"okta.roles.manage"}},
+	{"PUT", "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}", []string{"okta.roles.manage"}},
+	{"PUT", "/api/v1/groups/{groupId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/groups/{groupId}/roles/{roleId}/targets/groups", []string{"okta.roles.read"}},
+	{"DELETE", "/api/v1/groups/{groupId}/roles/{roleId}/targets/groups/{targetGroupId}", []string{"okta.roles.manage"}},
+	{"PUT", "/api/v1/groups/{groupId}/roles/{roleId}/targets/groups/{targetGroupId}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/groups/{groupId}/users", []string{"okta.groups.read"}},
+	{"DELETE", "/api/v1/groups/{groupId}/users/{userId}", []string{"okta.groups.manage"}},
+	{"PUT", "/api/v1/groups/{groupId}/users/{userId}", []string{"okta.groups.manage"}},
+	{"GET", "/api/v1/hook-keys", []string{"okta.inlineHooks.read"}},
+	{"POST", "/api/v1/hook-keys", []string{"okta.inlineHooks.manage"}},
+	{"GET", "/api/v1/hook-keys/public/{publicKeyId}", []string{"okta.inlineHooks.read"}},
+	{"DELETE", "/api/v1/hook-keys/{hookKeyId}", []string{"okta.inlineHooks.manage"}},
+	{"GET", "/api/v1/hook-keys/{hookKeyId}", []string{"okta.inlineHooks.read"}},
+	{"PUT", "/api/v1/hook-keys/{hookKeyId}", []string{"okta.inlineHooks.manage"}},
+	{"GET", "/api/v1/iam/assignees/users", []string{"okta.roles.read"}},
+	{"GET", "/api/v1/iam/resource-sets", []string{"okta.roles.read"}},
+	{"POST", "/api/v1/iam/resource-sets", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/iam/resource-sets/{resourceSetId}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/iam/resource-sets/{resourceSetId}", []string{"okta.roles.read"}},
+	{"PUT", "/api/v1/iam/resource-sets/{resourceSetId}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/iam/resource-sets/{resourceSetId}/bindings", []string{"okta.roles.read"}},
+	{"POST", "/api/v1/iam/resource-sets/{resourceSetId}/bindings", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}", []string{"okta.roles.read"}},
+	{"GET", "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members", []string{"okta.roles.read"}},
+	{"PATCH", "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members/{memberId}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/iam/resource-sets/{resourceSetId}/bindings/{roleIdOrLabel}/members/{memberId}", []string{"okta.roles.read"}},
+	{"GET", "/api/v1/iam/resource-sets/{resourceSetId}/resources", []string{"okta.roles.read"}},
+	{"PATCH", "/api/v1/iam/resource-sets/{resourceSetId}/resources", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/iam/resource-sets/{resourceSetId}/resources/{resourceId}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/iam/roles", []string{"okta.roles.read"}},
+	{"POST", "/api/v1/iam/roles", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/iam/roles/{roleIdOrLabel}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/iam/roles/{roleIdOrLabel}", []string{"okta.roles.read"}},
+	{"PUT", "/api/v1/iam/roles/{roleIdOrLabel}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/iam/roles/{roleIdOrLabel}/permissions", []string{"okta.roles.read"}},
+	{"DELETE", "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}", []string{"okta.roles.read"}},
+	{"POST", "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}", []string{"okta.roles.manage"}},
+	{"PUT", "/api/v1/iam/roles/{roleIdOrLabel}/permissions/{permissionType}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/identity-sources/{identitySourceId}/sessions", []string{"okta.identitySources.read"}},
+	{"POST", "/api/v1/identity-sources/{identitySourceId}/sessions", []string{"okta.identitySources.manage"}},
+	{"DELETE", "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}", []string{"okta.identitySources.manage"}},
+	{"GET", "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}", []string{"okta.identitySources.read"}},
+	{"POST", "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}/bulk-delete", []string{"okta.identitySources.manage"}},
+	{"POST", "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}/bulk-upsert", []string{"okta.identitySources.manage"}},
+	{"POST", "/api/v1/identity-sources/{identitySourceId}/sessions/{sessionId}/start-import", []string{"okta.identitySources.manage"}},
+	{"GET", "/api/v1/idps", []string{"okta.idps.read"}},
+	{"POST", "/api/v1/idps", []string{"okta.idps.manage"}},
+	{"GET", "/api/v1/idps/credentials/keys", []string{"okta.idps.read"}},
+	{"POST", "/api/v1/idps/credentials/keys", []string{"okta.idps.manage"}},
+	{"DELETE", "/api/v1/idps/credentials/keys/{idpKeyId}", []string{"okta.idps.manage"}},
+	{"GET", "/api/v1/idps/credentials/keys/{idpKeyId}", []string{"okta.idps.read"}},
+	{"DELETE", "/api/v1/idps/{idpId}", []string{"okta.idps.manage"}},
+	{"GET", "/api/v1/idps/{idpId}", []string{"okta.idps.read"}},
+	{"PUT", "/api/v1/idps/{idpId}", []string{"okta.idps.manage"}},
+	{"GET", "/api/v1/idps/{idpId}/credentials/csrs", []string{"okta.idps.read"}},
+	{"POST", "/api/v1/idps/{idpId}/credentials/csrs", []string{"okta.idps.manage"}},
+	{"DELETE", "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}", []string{"okta.idps.manage"}},
+	{"GET", "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}", []string{"okta.idps.read"}},
+	{"POST", "/api/v1/idps/{idpId}/credentials/csrs/{idpCsrId}/lifecycle/publish", []string{"okta.idps.manage"}},
+	{"GET", "/api/v1/idps/{idpId}/credentials/keys", []string{"okta.idps.read"}},
+	{"POST", "/api/v1/idps/{idpId}/credentials/keys/generate", []string{"okta.idps.manage"}},
+	{"GET", "/api/v1/idps/{idpId}/credentials/keys/{idpKeyId}", []string{"okta.idps.read"}},
+	{"POST", "/api/v1/idps/{idpId}/credentials/keys/{idpKeyId}/clone", []string{"okta.idps.manage"}},
+	{"POST", "/api/v1/idps/{idpId}/lifecycle/activate", []string{"okta.idps.manage"}},
+	{"POST", "/api/v1/idps/{idpId}/lifecycle/deactivate", []string{"okta.idps.manage"}},
+	{"GET", "/api/v1/idps/{idpId}/users", []string{"okta.idps.read"}},
+	{"DELETE", "/api/v1/idps/{idpId}/users/{userId}", []string{"okta.idps.manage"}},
+	{"GET", "/api/v1/idps/{idpId}/users/{userId}", []string{"okta.idps.read"}},
+	{"POST", "/api/v1/idps/{idpId}/users/{userId}", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/idps/{idpId}/users/{userId}/credentials/tokens", []string{"okta.idps.read"}},
+	{"GET", "/api/v1/inlineHooks", []string{"okta.inlineHooks.read"}},
+	{"POST", "/api/v1/inlineHooks", []string{"okta.inlineHooks.manage"}},
+	{"DELETE", "/api/v1/inlineHooks/{inlineHookId}", []string{"okta.inlineHooks.manage"}},
+	{"GET", "/api/v1/inlineHooks/{inlineHookId}", []string{"okta.inlineHooks.read"}},
+	{"POST", "/api/v1/inlineHooks/{inlineHookId}", []string{"okta.inlineHooks.manage"}},
+	{"PUT", "/api/v1/inlineHooks/{inlineHookId}", []string{"okta.inlineHooks.manage"}},
+	{"POST", "/api/v1/inlineHooks/{inlineHookId}/execute", []string{"okta.inlineHooks.manage"}},
+	{"POST", "/api/v1/inlineHooks/{inlineHookId}/lifecycle/activate", []string{"okta.inlineHooks.manage"}},
+	{"POST", "/api/v1/inlineHooks/{inlineHookId}/lifecycle/deactivate", []string{"okta.inlineHooks.manage"}},
+	{"GET", "/api/v1/logStreams", []string{"okta.logStreams.read"}},
+	{"POST", "/api/v1/logStreams", []string{"okta.logStreams.manage"}},
+	{"DELETE", "/api/v1/logStreams/{logStreamId}", []string{"okta.logStreams.manage"}},
+	{"GET", "/api/v1/logStreams/{logStreamId}", []string{"okta.logStreams.read"}},
+	{"PUT", "/api/v1/logStreams/{logStreamId}", []string{"okta.logStreams.manage"}},
+	{"POST", "/api/v1/logStreams/{logStreamId}/lifecycle/activate", []string{"okta.logStreams.manage"}},
+	{"POST", "/api/v1/logStreams/{logStreamId}/lifecycle/deactivate", []string{"okta.logStreams.manage"}},
+	{"GET", "/api/v1/logs", []string{"okta.logs.read"}},
+	{"GET", "/api/v1/mappings", []string{"okta.profileMappings.read"}},
+	{"GET", "/api/v1/mappings/{mappingId}", []string{"okta.profileMappings.read"}},
+	{"POST", "/api/v1/mappings/{mappingId}", []string{"okta.profileMappings.manage"}},
+	{"GET", "/api/v1/meta/schemas/apps/{appId}/default", []string{"okta.schemas.read"}},
+	{"POST", "/api/v1/meta/schemas/apps/{appId}/default", []string{"okta.schemas.manage"}},
+	{"GET", "/api/v1/meta/schemas/group/default", []string{"okta.schemas.read"}},
+	{"POST", "/api/v1/meta/schemas/group/default", []string{"okta.schemas.manage"}},
+	{"GET", "/api/v1/meta/schemas/logStream", []string{"okta.logStreams.read"}},
+	{"GET", "/api/v1/meta/schemas/logStream/{logStreamType}", []string{"okta.logStreams.read"}},
+	{"GET", "/api/v1/meta/schemas/user/linkedObjects", []string{"okta.linkedObjects.read"}},
+	{"POST", "/api/v1/meta/schemas/user/linkedObjects", []string{"okta.linkedObjects.manage"}},
+	{"DELETE", "/api/v1/meta/schemas/user/linkedObjects/{linkedObjectName}", []string{"okta.linkedObjects.manage"}},
+	{"GET", "/api/v1/meta/schemas/user/linkedObjects/{linkedObjectName}", []string{"okta.linkedObjects.read"}},
+	{"GET", "/api/v1/meta/schemas/user/{schemaId}", []string{"okta.schemas.read"}},
+	{"POST", "/api/v1/meta/schemas/user/{schemaId}", []string{"okta.schemas.manage"}},
+	{"GET", "/api/v1/meta/types/user", []string{"okta.userTypes.read"}},
+	{"POST", "/api/v1/meta/types/user", []string{"okta.userTypes.manage"}},
+	{"DELETE", "/api/v1/meta/types/user/{typeId}", []string{"okta.userTypes.manage"}},
+	{"GET", "/api/v1/meta/types/user/{typeId}", []string{"okta.userTypes.read"}},
+	{"POST", "/api/v1/meta/types/user/{typeId}", []string{"okta.userTypes.manage"}},
+	{"PUT", "/api/v1/meta/types/user/{typeId}", []string{"okta.userTypes.manage"}},
+	{"GET", "/api/v1/meta/uischemas", []string{"okta.uischemas.read"}},
+	{"POST", "/api/v1/meta/uischemas", []string{"okta.uischemas.manage"}},
+	{"DELETE", "/api/v1/meta/uischemas/{id}", []string{"okta.uischemas.manage"}},
+	{"GET", "/api/v1/meta/uischemas/{id}", []string{"okta.uischemas.read"}},
+	{"PUT", "/api/v1/meta/uischemas/{id}", []string{"okta.uischemas.manage"}},
+	{"GET", "/api/v1/org", []string{"okta.orgs.read"}},
+	{"POST", "/api/v1/org", []string{"okta.orgs.manage"}},
+	{"PUT", "/api/v1/org", []string{"okta.orgs.manage"}},
+	{"DELETE", "/api/v1/org/captcha", []string{"okta.captchas.manage"}},
+	{"GET", "/api/v1/org/captcha", []string{"okta.captchas.read"}},
+	{"PUT", "/api/v1/org/captcha", []string{"okta.captchas.manage"}},
+	{"GET", "/api/v1/org/contacts", []string{"okta.orgs.read"}},
+	{"GET", "/api/v1/org/contacts/{contactType}", []string{"okta.orgs.read"}},
+	{"PUT", "/api/v1/org/contacts/{contactType}", []string{"okta.orgs.manage"}},
+	{"POST", "/api/v1/org/email/bounces/remove-list", []string{"okta.orgs.manage"}},
+	{"POST", "/api/v1/org/logo", []string{"okta.apps.manage"}},
+	{"GET", "/api/v1/org/orgSettings/thirdPartyAdminSetting", []string{"okta.orgs.read"}},
+	{"POST", "/api/v1/org/orgSettings/thirdPartyAdminSetting", []string{"okta.orgs.manage"}},
+	{"GET", "/api/v1/org/preferences", []string{"okta.orgs.read"}},
+	{"POST", "/api/v1/org/preferences/hideEndUserFooter", []string{"okta.orgs.manage"}},
+	{"POST", "/api/v1/org/preferences/showEndUserFooter", []string{"okta.orgs.manage"}},
+	{"GET", "/api/v1/org/privacy/oktaCommunication", []string{"okta.orgs.read"}},
+	{"POST", "/api/v1/org/privacy/oktaCommunication/optIn", []string{"okta.orgs.manage"}},
+	{"POST", "/api/v1/org/privacy/oktaCommunication/optOut", []string{"okta.orgs.manage"}},
+	{"GET", "/api/v1/org/privacy/oktaSupport", []string{"okta.orgs.read"}},
+	{"POST", "/api/v1/org/privacy/oktaSupport/extend", []string{"okta.orgs.manage"}},
+	{"POST", "/api/v1/org/privacy/oktaSupport/grant", []string{"okta.orgs.manage"}},
+	{"POST", "/api/v1/org/privacy/oktaSupport/revoke", []string{"okta.orgs.manage"}},
+	{"GET", "/api/v1/org/settings/clientPrivilegesSetting", []string{"okta.orgs.read"}},
+	{"PUT", "/api/v1/org/settings/clientPrivilegesSetting", []string{"okta.orgs.manage"}},
+	{"GET", "/api/v1/policies", []string{"okta.policies.read"}},
+	{"POST", "/api/v1/policies", []string{"okta.policies.manage"}},
+	{"POST", "/api/v1/policies/simulate", []string{"okta.policies.read"}},
+	{"DELETE", "/api/v1/policies/{policyId}", []string{"okta.policies.manage"}},
+	{"GET", "/api/v1/policies/{policyId}", []string{"okta.policies.read"}},
+	{"PUT", "/api/v1/policies/{policyId}", []string{"okta.policies.manage"}},
+	{"GET", "/api/v1/policies/{policyId}/app", []string{"okta.policies.read"}},
+	{"POST", "/api/v1/policies/{policyId}/clone", []string{"okta.policies.manage"}},
+	{"POST", "/api/v1/policies/{policyId}/lifecycle/activate", []string{"okta.policies.manage"}},
+	{"POST", "/api/v1/policies/{policyId}/lifecycle/deactivate", []string{"okta.policies.manage"}},
+	{"GET", "/api/v1/policies/{policyId}/mappings", []string{"okta.policies.read"}},
+	{"POST", "/api/v1/policies/{policyId}/mappings", []string{"okta.policies.manage"}},
+	{"DELETE", "/api/v1/policies/{policyId}/mappings/{mappingId}", []string{"okta.policies.manage"}},
+	{"GET", "/api/v1/policies/{policyId}/mappings/{mappingId}", []string{"okta.policies.read"}},
+	{"GET", "/api/v1/policies/{policyId}/rules", []string{"okta.policies.read"}},
+	{"POST", "/api/v1/policies/{policyId}/rules", []string{"okta.policies.manage"}},
+	{"DELETE", "/api/v1/policies/{policyId}/rules/{ruleId}", []string{"okta.policies.manage"}},
+	{"GET", "/api/v1/policies/{policyId}/rules/{ruleId}", []string{"okta.policies.read"}},
+	{"PUT", "/api/v1/policies/{policyId}/rules/{ruleId}", []string{"okta.policies.manage"}},
+	{"POST", "/api/v1/policies/{policyId}/rules/{ruleId}/lifecycle/activate", []string{"okta.policies.manage"}},
+	{"POST", "/api/v1/policies/{policyId}/rules/{ruleId}/lifecycle/deactivate", []string{"okta.policies.manage"}},
+	{"GET", "/api/v1/principal-rate-limits", []string{"okta.principalRateLimits.read"}},
+	{"POST", "/api/v1/principal-rate-limits", []string{"okta.principalRateLimits.manage"}},
+	{"GET", "/api/v1/principal-rate-limits/{principalRateLimitId}", []string{"okta.principalRateLimits.read"}},
+	{"PUT", "/api/v1/principal-rate-limits/{principalRateLimitId}", []string{"okta.principalRateLimits.manage"}},
+	{"GET", "/api/v1/push-providers", []string{"okta.pushProviders.read"}},
+	{"POST", "/api/v1/push-providers", []string{"okta.pushProviders.manage"}},
+	{"DELETE", "/api/v1/push-providers/{pushProviderId}", []string{"okta.pushProviders.manage"}},
+	{"GET", "/api/v1/push-providers/{pushProviderId}", []string{"okta.pushProviders.read"}},
+	{"PUT", "/api/v1/push-providers/{pushProviderId}", []string{"okta.pushProviders.manage"}},
+	{"GET", "/api/v1/rate-limit-settings/admin-notifications", []string{"okta.rateLimits.read"}},
+	{"PUT", "/api/v1/rate-limit-settings/admin-notifications", []string{"okta.rateLimits.manage"}},
+	{"GET", "/api/v1/rate-limit-settings/per-client", []string{"okta.rateLimits.read"}},
+	{"PUT", "/api/v1/rate-limit-settings/per-client", []string{"okta.rateLimits.manage"}},
+	{"GET", "/api/v1/rate-limit-settings/warning-threshold", []string{"okta.rateLimits.read"}},
+	{"PUT", "/api/v1/rate-limit-settings/warning-threshold", []string{"okta.rateLimits.manage"}},
+	{"GET", "/api/v1/realm-assignments", []string{"okta.realmAssignments.read"}},
+	{"POST", "/api/v1/realm-assignments", []string{"okta.realmAssignments.manage"}},
+	{"GET", "/api/v1/realm-assignments/operations", []string{"okta.realmAssignments.read"}},
+	{"POST", "/api/v1/realm-assignments/operations", []string{"okta.realmAssignments.manage"}},
+	{"DELETE", "/api/v1/realm-assignments/{assignmentId}", []string{"okta.realmAssignments.manage"}},
+	{"GET", "/api/v1/realm-assignments/{assignmentId}", []string{"okta.realmAssignments.read"}},
+	{"PUT", "/api/v1/realm-assignments/{assignmentId}", []string{"okta.realmAssignments.manage"}},
+	{"POST", "/api/v1/realm-assignments/{assignmentId}/lifecycle/activate", []string{"okta.realmAssignments.manage"}},
+	{"POST", "/api/v1/realm-assignments/{assignmentId}/lifecycle/deactivate", []string{"okta.realmAssignments.manage"}},
+	{"GET", "/api/v1/realms", []string{"okta.realms.read"}},
+	{"POST", "/api/v1/realms", []string{"okta.realms.manage"}},
+	{"DELETE", "/api/v1/realms/{realmId}", []string{"okta.realms.manage"}},
+	{"GET", "/api/v1/realms/{realmId}", []string{"okta.realms.read"}},
+	{"PUT", "/api/v1/realms/{realmId}", []string{"okta.realms.manage"}},
+	{"POST", "/api/v1/risk/events/ip", []string{"okta.riskEvents.manage"}},
+	{"GET", "/api/v1/risk/providers", []string{"okta.riskProviders.read"}},
+	{"POST", "/api/v1/risk/providers", []string{"okta.riskProviders.manage"}},
+	{"DELETE", "/api/v1/risk/providers/{riskProviderId}", []string{"okta.riskProviders.manage"}},
+	{"GET", "/api/v1/risk/providers/{riskProviderId}", []string{"okta.riskProviders.read"}},
+	{"PUT", "/api/v1/risk/providers/{riskProviderId}", []string{"okta.riskProviders.manage"}},
+	{"GET", "/api/v1/roles/{roleRef}/subscriptions", []string{"okta.roles.read"}},
+	{"GET", "/api/v1/roles/{roleRef}/subscriptions/{notificationType}", []string{"okta.roles.read"}},
+	{"POST", "/api/v1/roles/{roleRef}/subscriptions/{notificationType}/subscribe", []string{"okta.roles.manage"}},
+	{"POST", "/api/v1/roles/{roleRef}/subscriptions/{notificationType}/unsubscribe", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/security-events-providers", []string{"okta.securityEventsProviders.read"}},
+	{"POST", "/api/v1/security-events-providers", []string{"okta.securityEventsProviders.manage"}},
+	{"DELETE", "/api/v1/security-events-providers/{securityEventProviderId}", []string{"okta.securityEventsProviders.manage"}},
+	{"GET", "/api/v1/security-events-providers/{securityEventProviderId}", []string{"okta.securityEventsProviders.read"}},
+	{"PUT", "/api/v1/security-events-providers/{securityEventProviderId}", []string{"okta.securityEventsProviders.manage"}},
+	{"POST", "/api/v1/security-events-providers/{securityEventProviderId}/lifecycle/activate", []string{"okta.securityEventsProviders.manage"}},
+	{"POST", "/api/v1/security-events-providers/{securityEventProviderId}/lifecycle/deactivate", []string{"okta.securityEventsProviders.manage"}},
+	{"DELETE", "/api/v1/sessions/{sessionId}", []string{"okta.sessions.manage"}},
+	{"GET", "/api/v1/sessions/{sessionId}", []string{"okta.sessions.read"}},
+	{"POST", "/api/v1/sessions/{sessionId}/lifecycle/refresh", []string{"okta.sessions.manage"}},
+	{"DELETE", "/api/v1/ssf/stream", []string{"ssf.manage"}},
+	{"GET", "/api/v1/ssf/stream", []string{"ssf.read"}},
+	{"PATCH", "/api/v1/ssf/stream", []string{"ssf.manage"}},
+	{"POST", "/api/v1/ssf/stream", []string{"ssf.manage"}},
+	{"PUT", "/api/v1/ssf/stream", []string{"ssf.manage"}},
+	{"GET", "/api/v1/templates/sms", []string{"okta.templates.read"}},
+	{"POST", "/api/v1/templates/sms", []string{"okta.templates.manage"}},
+	{"DELETE", "/api/v1/templates/sms/{templateId}", []string{"okta.templates.manage"}},
+	{"GET", "/api/v1/templates/sms/{templateId}", []string{"okta.templates.read"}},
+	{"POST", "/api/v1/templates/sms/{templateId}", []string{"okta.templates.manage"}},
+	{"PUT", "/api/v1/templates/sms/{templateId}", []string{"okta.templates.manage"}},
+	{"GET", "/api/v1/threats/configuration", []string{"okta.threatInsights.read"}},
+	{"POST", "/api/v1/threats/configuration", []string{"okta.threatInsights.manage"}},
+	{"GET", "/api/v1/trustedOrigins", []string{"okta.trustedOrigins.read"}},
+	{"POST", "/api/v1/trustedOrigins", []string{"okta.trustedOrigins.manage"}},
+	{"DELETE", "/api/v1/trustedOrigins/{trustedOriginId}", []string{"okta.trustedOrigins.manage"}},
+	{"GET", "/api/v1/trustedOrigins/{trustedOriginId}", []string{"okta.trustedOrigins.read"}},
+	{"PUT", "/api/v1/trustedOrigins/{trustedOriginId}", []string{"okta.trustedOrigins.manage"}},
+	{"POST", "/api/v1/trustedOrigins/{trustedOriginId}/lifecycle/activate", []string{"okta.trustedOrigins.manage"}},
+	{"POST", "/api/v1/trustedOrigins/{trustedOriginId}/lifecycle/deactivate", []string{"okta.trustedOrigins.manage"}},
+	{"GET", "/api/v1/users", []string{"okta.users.read"}},
+	{"POST", "/api/v1/users", []string{"okta.users.manage"}},
+	{"PUT", "/api/v1/users/{userIdOrLogin}/linkedObjects/{primaryRelationshipName}/{primaryUserId}", []string{"okta.users.manage"}},
+	{"DELETE", "/api/v1/users/{userIdOrLogin}/linkedObjects/{relationshipName}", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userIdOrLogin}/linkedObjects/{relationshipName}", []string{"okta.users.read"}},
+	{"DELETE", "/api/v1/users/{userId}", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}", []string{"okta.users.read"}},
+	{"POST", "/api/v1/users/{userId}", []string{"okta.users.manage"}},
+	{"PUT", "/api/v1/users/{userId}", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/appLinks", []string{"okta.users.read"}},
+	{"GET", "/api/v1/users/{userId}/blocks", []string{"okta.users.read"}},
+	{"GET", "/api/v1/users/{userId}/clients", []string{"okta.users.read"}},
+	{"DELETE", "/api/v1/users/{userId}/clients/{clientId}/grants", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/clients/{clientId}/grants", []string{"okta.users.read"}},
+	{"DELETE", "/api/v1/users/{userId}/clients/{clientId}/tokens", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/clients/{clientId}/tokens", []string{"okta.users.read"}},
+	{"DELETE", "/api/v1/users/{userId}/clients/{clientId}/tokens/{tokenId}", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/clients/{clientId}/tokens/{tokenId}", []string{"okta.users.read"}},
+	{"POST", "/api/v1/users/{userId}/credentials/change_password", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/credentials/change_recovery_question", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/credentials/forgot_password", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/credentials/forgot_password_recovery_question", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/factors", []string{"okta.users.read"}},
+	{"POST", "/api/v1/users/{userId}/factors", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/factors/catalog", []string{"okta.users.read"}},
+	{"DELETE", "/api/v1/users/{userId}/factors/{factorId}", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/factors/{factorId}", []string{"okta.users.read"}},
+	{"POST", "/api/v1/users/{userId}/factors/{factorId}/lifecycle/activate", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/factors/{factorId}/resend", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/factors/{factorId}/transactions/{transactionId}", []string{"okta.users.read"}},
+	{"POST", "/api/v1/users/{userId}/factors/{factorId}/verify", []string{"okta.users.manage"}},
+	{"DELETE", "/api/v1/users/{userId}/grants", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/grants", []string{"okta.users.read"}},
+	{"DELETE", "/api/v1/users/{userId}/grants/{grantId}", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/grants/{grantId}", []string{"okta.users.read"}},
+	{"GET", "/api/v1/users/{userId}/groups", []string{"okta.users.read"}},
+	{"GET", "/api/v1/users/{userId}/idps", []string{"okta.users.read"}},
+	{"POST", "/api/v1/users/{userId}/lifecycle/activate", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/lifecycle/deactivate", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/lifecycle/expire_password", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/lifecycle/expire_password_with_temp_password", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/lifecycle/reactivate", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/lifecycle/reset_factors", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/lifecycle/reset_password", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/lifecycle/suspend", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/lifecycle/unlock", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/lifecycle/unsuspend", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/roles", []string{"okta.roles.read"}},
+	{"POST", "/api/v1/users/{userId}/roles", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/users/{userId}/roles/{roleId}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/users/{userId}/roles/{roleId}", []string{"okta.roles.read"}},
+	{"GET", "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps", []string{"okta.roles.read"}},
+	{"PUT", "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}", []string{"okta.roles.manage"}},
+	{"PUT", "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}", []string{"okta.roles.manage"}},
+	{"PUT", "/api/v1/users/{userId}/roles/{roleId}/targets/catalog/apps/{appName}/{appId}", []string{"okta.roles.manage"}},
+	{"GET", "/api/v1/users/{userId}/roles/{roleId}/targets/groups", []string{"okta.roles.read"}},
+	{"DELETE", "/api/v1/users/{userId}/roles/{roleId}/targets/groups/{groupId}", []string{"okta.roles.manage"}},
+	{"PUT", "/api/v1/users/{userId}/roles/{roleId}/targets/groups/{groupId}", []string{"okta.roles.manage"}},
+	{"DELETE", "/api/v1/users/{userId}/sessions", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/users/{userId}/subscriptions", []string{"okta.users.read"}},
+	{"GET", "/api/v1/users/{userId}/subscriptions/{notificationType}", []string{"okta.users.read"}},
+	{"POST", "/api/v1/users/{userId}/subscriptions/{notificationType}/subscribe", []string{"okta.users.manage"}},
+	{"POST", "/api/v1/users/{userId}/subscriptions/{notificationType}/unsubscribe", []string{"okta.users.manage"}},
+	{"GET", "/api/v1/zones", []string{"okta.networkZones.read"}},
+	{"POST", "/api/v1/zones", []string{"okta.networkZones.manage"}},
+	{"DELETE", "/api/v1/zones/{zoneId}", []string{"okta.networkZones.manage"}},
+	{"GET", "/api/v1/zones/{zoneId}", []string{"okta.networkZones.read"}},
+	{"PUT", "/api/v1/zones/{zoneId}", []string{"okta.networkZones.manage"}},
+	{"POST", "/api/v1/zones/{zoneId}/lifecycle/activate", []string{"okta.networkZones.manage"}},
+	{"POST", "/api/v1/zones/{zoneId}/lifecycle/deactivate", []string{"okta.networkZones.manage"}},
+	{"GET", "/attack-protection/api/v1/authenticator-settings", []string{"okta.orgs.read"}},
+	{"PUT", "/attack-protection/api/v1/authenticator-settings", []string{"okta.orgs.manage"}},
+	{"GET", "/attack-protection/api/v1/user-lockout-settings", []string{"okta.orgs.read"}},
+	{"PUT", "/attack-protection/api/v1/user-lockout-settings", []string{"okta.orgs.manage"}},
+	{"GET", "/integrations/api/v1/api-services", []string{"okta.oauthIntegrations.read"}},
+	{"DELETE", "/integrations/api/v1/api-services/{apiServiceId}", []string{"okta.oauthIntegrations.manage"}},
+	{"GET", "/integrations/api/v1/api-services/{apiServiceId}", []string{"okta.oauthIntegrations.read"}},
+	{"GET", "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets", []string{"okta.oauthIntegrations.read"}},
+	{"POST", "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets", []string{"okta.oauthIntegrations.manage"}},
+	{"DELETE", "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}", []string{"okta.oauthIntegrations.manage"}},
+	{"POST", "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}/lifecycle/activate", []string{"okta.oauthIntegrations.manage"}},
+	{"POST", "/integrations/api/v1/api-services/{apiServiceId}/credentials/secrets/{secretId}/lifecycle/deactivate", []string{"okta.oauthIntegrations.manage"}},
+	{"GET", "/oauth2/v1/clients/{clientId}/roles", []string{"okta.roles.read"}},
+	{"POST", "/oauth2/v1/clients/{clientId}/roles", []string{"okta.roles.manage"}},
+	{"DELETE", "/oauth2/v1/clients/{clientId}/roles/{roleId}", []string{"okta.roles.manage"}},
+	{"GET", "/oauth2/v1/clients/{clientId}/roles/{roleId}", []string{"okta.roles.read"}},
+	{"GET", "/oauth2/v1/clients/{clientId}/roles/{roleId}/targets/catalog/apps", []string{"okta.roles.read"}},
+	{"DELETE", "/oauth2/v1/clients/{clientId}/roles/{roleId}/targets/catalog/apps/{appName}", []string{"okta.roles.manage"}},
+	{"PUT", "/oauth2/v1/clients/{clientId}/roles/{roleId}/targets/catalog/apps/{appName}", []string{"okta.roles.manage"}},
+	{"DELETE", "/oauth2/v1/clients/{clientId}/roles/{roleId}/targets/catalog/apps/{appName}/{appInstanceId}", []string{"okta.roles.manage"}},
+	{"PUT", "/oauth2/v1/clients/{clientId}/roles/{roleId}/targets/catalog/apps/{appName}/{appInstanceId}", []string{"okta.roles.manage"}},
+	{"GET", "/oauth2/v1/clients/{clientId}/roles/{roleId}/targets/groups", []string{"okta.roles.read"}},
+	{"DELETE", "/oauth2/v1/clients/{clientId}/roles/{roleId}/targets/groups/{groupId}", []string{"okta.roles.manage"}},
+	{"PUT", "/oauth2/v1/clients/{clientId}/roles/{roleId}/targets/groups/{groupId}", []string{"okta.roles.manage"}},
+}
@@ -0,0 +1,104 @@
+package okta
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// OperationClass groups API operations that share a default timeout.
+type OperationClass int
+
+const (
+	// OperationRead is the class of GET and HEAD requests.
+	OperationRead OperationClass = iota + 1
+	// OperationWrite is the class of all other requests.
+	OperationWrite
+	// OperationBulk is the class of requests marked with WithOperationClass,
+	// e.g. imports or large membership changes.
+	OperationBulk
+)
+
+type operationClassKey struct{}
+
+// WithOperationClass returns a copy of ctx marking the requests made with it
+// as belonging to class, overriding the class derived from the HTTP method.
+func WithOperationClass(ctx context.Context, class OperationClass) context.Context {
+	return context.WithValue(ctx, operationClassKey{}, class)
+}
+
+// OperationTimeouts are the timeouts applied to requests whose context has no
+// deadline, per operation class. A zero value disables the timeout of a class.
+type OperationTimeouts struct {
+	Read  time.Duration
+	Write time.Duration
+	Bulk  time.Duration
+}
+
+// DefaultOperationTimeouts are reasonable timeouts for services calling Okta
+// on their request path.
+var DefaultOperationTimeouts = OperationTimeouts{
+	Read:  5 * time.Second,
+	Write: 15 * time.Second,
+	Bulk:  120 * time.Second,
+}
+
+func (t OperationTimeouts) timeout(class OperationClass) time.Duration {
+	switch class {
+	case OperationRead:
+		return t.Read
+	case OperationWrite:
+		return t.Write
+	case OperationBulk:
+		return t.Bulk
+	}
+	return 0
+}
+
+func operationClassOf(ctx context.Context, method string) OperationClass {
+	if class, ok := ctx.Value(operationClassKey{}).(OperationClass); ok {
+		return class
+	}
+	if method == http.MethodGet || method == http.MethodHead {
+		return OperationRead
+	}
+	return OperationWrite
+}
+
+// withOperationTimeout applies the timeout of WithCallTimeout, or the timeout
+// of the operation class of req when its context has no deadline. The
+// returned cancel func must be called once the response body is no longer
+// needed.
+func (c *APIClient) withOperationTimeout(req *http.Request) (*http.Request, context.CancelFunc) {
+	ctx := req.Context()
+	if timeout, ok := callTimeout(ctx); ok {
+		if timeout <= 0 {
+			return req, func() {}
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		return req.WithContext(ctx), cancel
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return req, func() {}
+	}
+	timeout := c.cfg.OperationTimeouts.timeout(operationClassOf(ctx, req.Method))
+	if timeout <= 0 {
+		return req, func() {}
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// cancelOnClose releases the context of a request once its response body is
+// closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
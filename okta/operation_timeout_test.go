@@ -0,0 +1,48 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Operation_Timeouts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(50 * time.Millisecond):
+			return mockJSONResponse(200, `[{"id":"00u1"}]`), nil
+		}
+	})
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("token"),
+		WithCache(false),
+		WithOperationTimeouts(OperationTimeouts{Read: 10 * time.Millisecond, Bulk: time.Second}),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.Error(t, err, "Reads should time out without a deadline on the context")
+	assert.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	users, _, err := client.UserAPI.ListUsers(ctx).Execute()
+	require.NoError(t, err, "The deadline of the caller should take precedence")
+	assert.Len(t, users, 1)
+
+	users, _, err = client.UserAPI.ListUsers(WithOperationClass(context.Background(), OperationBulk)).Execute()
+	require.NoError(t, err, "Bulk operations should use the bulk timeout")
+	assert.Len(t, users, 1)
+}
@@ -0,0 +1,105 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ChildOrg is an org created with the Org Creator API, available to the orgs
+// licensed for it.
+type ChildOrg struct {
+	ID string `json:"id,omitempty"`
+	// Name is the display name of the org.
+	Name string `json:"name"`
+	// Subdomain is the subdomain of the org in the Okta domain of the parent
+	// org.
+	Subdomain string `json:"subdomain"`
+	Website   string `json:"website,omitempty"`
+	// Edition is the SKU of the org.
+	Edition string `json:"edition,omitempty"`
+	// Admin is the super admin created with the org. Only set on creation.
+	Admin       *ChildOrgAdmin         `json:"admin,omitempty"`
+	Status      string                 `json:"status,omitempty"`
+	Created     *time.Time             `json:"created,omitempty"`
+	LastUpdated *time.Time             `json:"lastUpdated,omitempty"`
+	Settings    map[string]interface{} `json:"settings,omitempty"`
+	// Token is an API token of the super admin, only returned when the org is
+	// created. ChildOrgClient uses it to configure the new org.
+	Token     string                 `json:"token,omitempty"`
+	TokenType string                 `json:"tokenType,omitempty"`
+	Links     map[string]interface{} `json:"_links,omitempty"`
+}
+
+// ChildOrgAdmin is the super admin of a new child org.
+type ChildOrgAdmin struct {
+	Profile ChildOrgAdminProfile `json:"profile"`
+	// Credentials sets the password and recovery question of the admin, who
+	// gets an activation email when they are not set.
+	Credentials *UserCredentials `json:"credentials,omitempty"`
+}
+
+// ChildOrgAdminProfile is the profile of the super admin of a new child org.
+type ChildOrgAdminProfile struct {
+	FirstName   string `json:"firstName"`
+	LastName    string `json:"lastName"`
+	Email       string `json:"email"`
+	Login       string `json:"login"`
+	MobilePhone string `json:"mobilePhone,omitempty"`
+}
+
+// CreateChildOrg creates a child org with the Org Creator API. The returned
+// org holds the API token of its super admin, which is not returned again.
+// Orgs that are not licensed for the API get a FeatureNotEnabledError.
+func (c *APIClient) CreateChildOrg(ctx context.Context, org ChildOrg) (*ChildOrg, *APIResponse, error) {
+	if org.Name == "" || org.Subdomain == "" {
+		return nil, nil, errors.New("child org requires a name and a subdomain")
+	}
+	var created ChildOrg
+	resp, err := c.Do(ctx, http.MethodPost, "/api/v1/orgs", org, &created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &created, resp, nil
+}
+
+// ChildOrgURL returns the URL of org, in the Okta domain of the client, such
+// as https://child.okta.com for a client of https://parent.okta.com.
+func (c *APIClient) ChildOrgURL(org *ChildOrg) (string, error) {
+	parent, err := url.Parse(c.cfg.Okta.Client.OrgUrl)
+	if err != nil {
+		return "", err
+	}
+	_, domain, found := strings.Cut(parent.Hostname(), ".")
+	if !found || org.Subdomain == "" {
+		return "", fmt.Errorf("cannot derive the URL of child org %q from %s", org.Subdomain, c.cfg.Okta.Client.OrgUrl)
+	}
+	return "https://" + org.Subdomain + "." + domain, nil
+}
+
+// ChildOrgClient returns a client of org authenticated with the API token
+// returned by CreateChildOrg, sharing the HTTP client of c. setters are
+// applied last, to replace the token with other credentials for instance.
+func (c *APIClient) ChildOrgClient(org *ChildOrg, setters ...ConfigSetter) (*APIClient, error) {
+	if org.Token == "" {
+		return nil, errors.New("child org has no API token, it is only returned by CreateChildOrg")
+	}
+	orgURL, err := c.ChildOrgURL(org)
+	if err != nil {
+		return nil, err
+	}
+	config, err := NewConfiguration(append([]ConfigSetter{
+		WithOrgUrl(orgURL),
+		WithAuthorizationMode("SSWS"),
+		WithToken(org.Token),
+		WithHttpClientPtr(c.cfg.HTTPClient),
+	}, setters...)...)
+	if err != nil {
+		return nil, err
+	}
+	return NewAPIClient(config), nil
+}
@@ -0,0 +1,77 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Create_Child_Org(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://parent.okta.com/api/v1/orgs", func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		assert.JSONEq(t, `{
+		  "name": "Acme",
+		  "subdomain": "acme",
+		  "website": "https://acme.example.com",
+		  "admin": {"profile": {"firstName": "Ada", "lastName": "Admin", "email": "ada@acme.example.com", "login": "ada@acme.example.com"}}
+		}`, string(body))
+		return mockJSONResponse(200, `{"id":"00o1","name":"Acme","subdomain":"acme","status":"ACTIVE","token":"00child","tokenType":"SSWS"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "https://acme.okta.com/api/v1/users/me", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "SSWS 00child", req.Header.Get("Authorization"))
+		return mockJSONResponse(200, `{"id":"00u1"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://parent.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	org, _, err := client.CreateChildOrg(context.Background(), ChildOrg{
+		Name:      "Acme",
+		Subdomain: "acme",
+		Website:   "https://acme.example.com",
+		Admin: &ChildOrgAdmin{Profile: ChildOrgAdminProfile{
+			FirstName: "Ada", LastName: "Admin", Email: "ada@acme.example.com", Login: "ada@acme.example.com",
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "00o1", org.ID)
+	assert.Equal(t, "00child", org.Token)
+
+	orgURL, err := client.ChildOrgURL(org)
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme.okta.com", orgURL)
+
+	child, err := client.ChildOrgClient(org, WithCache(false))
+	require.NoError(t, err)
+	user, _, err := child.UserAPI.GetUser(context.Background(), "me").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "00u1", user.GetId())
+
+	_, err = client.ChildOrgClient(&ChildOrg{Subdomain: "acme"})
+	assert.Error(t, err, "A child org without a token cannot get a client")
+}
+
+func Test_Create_Child_Org_Not_Licensed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "/api/v1/orgs", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(403, `{"errorCode":"E0000015","errorSummary":"You do not have permission to access the feature you are requesting"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://parent.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	_, _, err = NewAPIClient(configuration).CreateChildOrg(context.Background(), ChildOrg{Name: "Acme", Subdomain: "acme"})
+	require.True(t, errors.Is(err, ErrFeatureNotEnabled))
+	var featureErr *FeatureNotEnabledError
+	require.ErrorAs(t, err, &featureErr)
+	assert.Equal(t, FeatureOrgCreator, featureErr.Feature)
+}
@@ -0,0 +1,366 @@
+// Package orgbootstrap configures the baseline of a new org, such as a child
+// org created with APIClient.CreateChildOrg: its admins, an API service app
+// for automation and its network zones.
+//
+// Bootstrap is idempotent: admins are matched by login, the service app by
+// label and zones by name, and only missing resources, roles and grants are
+// created, so it can be run again after a failure. Existing resources are not
+// updated.
+package orgbootstrap
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// Baseline is the configuration applied to an org.
+type Baseline struct {
+	Admins       []Admin
+	ServiceApp   *ServiceApp
+	NetworkZones []NetworkZone
+}
+
+// Admin is an admin user of the org.
+type Admin struct {
+	FirstName string
+	LastName  string
+	Email     string
+	// Login defaults to Email.
+	Login string
+	// Roles are the standard admin roles of the user, SUPER_ADMIN when empty.
+	Roles []string
+}
+
+// ServiceApp is an OAuth 2.0 service app authenticating with a private key,
+// for the automation managing the org with the PrivateKey authorization mode.
+type ServiceApp struct {
+	Label string
+	// PublicKey is the public key of the private key of the automation, and
+	// KeyID its kid.
+	PublicKey crypto.PublicKey
+	KeyID     string
+	// Scopes are the okta.* scopes granted to the app.
+	Scopes []string
+	// Roles are the standard admin roles of the app, which the scopes need
+	// for most endpoints.
+	Roles []string
+}
+
+// NetworkZone is an IP network zone.
+type NetworkZone struct {
+	Name string
+	// Gateways are the CIDR blocks, such as 10.0.0.0/8, or ranges, such as
+	// 10.0.0.1-10.0.0.9, of the zone.
+	Gateways []string
+	// Proxies are the trusted proxies in front of the gateways.
+	Proxies []string
+}
+
+// Result holds the ids of the resources of the baseline, created or found.
+type Result struct {
+	// AdminIDs maps the logins of the admins to their user ids.
+	AdminIDs map[string]string
+	// ServiceAppClientID is the client id of the service app.
+	ServiceAppClientID string
+	// NetworkZoneIDs maps the names of the zones to their ids.
+	NetworkZoneIDs map[string]string
+	// Created lists the resources, roles and grants that were created.
+	Created []string
+}
+
+// Bootstrap applies baseline to the org of client, which needs a super admin
+// token such as the one of okta.APIClient.ChildOrgClient.
+func Bootstrap(ctx context.Context, client *okta.APIClient, baseline Baseline) (*Result, error) {
+	result := &Result{AdminIDs: map[string]string{}, NetworkZoneIDs: map[string]string{}}
+	for _, admin := range baseline.Admins {
+		if err := bootstrapAdmin(ctx, client, admin, result); err != nil {
+			return result, err
+		}
+	}
+	if baseline.ServiceApp != nil {
+		if err := bootstrapServiceApp(ctx, client, *baseline.ServiceApp, result); err != nil {
+			return result, err
+		}
+	}
+	if len(baseline.NetworkZones) > 0 {
+		if err := bootstrapNetworkZones(ctx, client, baseline.NetworkZones, result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func bootstrapAdmin(ctx context.Context, client *okta.APIClient, admin Admin, result *Result) error {
+	login := admin.Login
+	if login == "" {
+		login = admin.Email
+	}
+	if login == "" {
+		return errors.New("admin requires a login or an email")
+	}
+	var userID string
+	user, _, err := client.UserAPI.GetUser(ctx, login).Execute()
+	switch {
+	case okta.IsNotFound(err):
+		profile := okta.UserProfile{}
+		profile.SetFirstName(admin.FirstName)
+		profile.SetLastName(admin.LastName)
+		profile.SetEmail(admin.Email)
+		profile.SetLogin(login)
+		created, _, err := client.UserAPI.CreateUser(ctx).Body(okta.CreateUserRequest{Profile: profile}).Activate(true).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to create admin %s: %w", login, err)
+		}
+		userID = created.GetId()
+		result.Created = append(result.Created, "admin "+login)
+	case err != nil:
+		return fmt.Errorf("failed to get admin %s: %w", login, err)
+	default:
+		userID = user.GetId()
+	}
+	result.AdminIDs[login] = userID
+
+	roles := admin.Roles
+	if len(roles) == 0 {
+		roles = []string{"SUPER_ADMIN"}
+	}
+	assigned, err := client.ListAllAssignedRolesForUser(client.RoleAssignmentAPI.ListAssignedRolesForUser(ctx, userID))
+	if err != nil {
+		return fmt.Errorf("failed to list the roles of admin %s: %w", login, err)
+	}
+	for _, role := range missingRoles(roles, assigned) {
+		request := okta.AssignRoleRequest{Type: okta.PtrString(role)}
+		if _, _, err := client.RoleAssignmentAPI.AssignRoleToUser(ctx, userID).AssignRoleRequest(request).Execute(); err != nil {
+			return fmt.Errorf("failed to assign role %s to admin %s: %w", role, login, err)
+		}
+		result.Created = append(result.Created, "role "+role+" of admin "+login)
+	}
+	return nil
+}
+
+func bootstrapServiceApp(ctx context.Context, client *okta.APIClient, app ServiceApp, result *Result) error {
+	if app.Label == "" || app.PublicKey == nil {
+		return errors.New("service app requires a label and a public key")
+	}
+	clientID, err := findServiceApp(ctx, client, app.Label)
+	if err != nil {
+		return err
+	}
+	if clientID == "" {
+		if clientID, err = createServiceApp(ctx, client, app); err != nil {
+			return err
+		}
+		result.Created = append(result.Created, "service app "+app.Label)
+	}
+	result.ServiceAppClientID = clientID
+
+	if len(app.Scopes) > 0 {
+		grants, err := client.ListAllScopeConsentGrants(client.ApplicationGrantsAPI.ListScopeConsentGrants(ctx, clientID))
+		if err != nil {
+			return fmt.Errorf("failed to list the grants of service app %s: %w", app.Label, err)
+		}
+		granted := map[string]bool{}
+		for _, grant := range grants {
+			granted[grant.ScopeId] = true
+		}
+		issuer := client.GetConfig().Okta.Client.OrgUrl
+		for _, scope := range app.Scopes {
+			if granted[scope] {
+				continue
+			}
+			grant := okta.OAuth2ScopeConsentGrant{Issuer: issuer, ScopeId: scope}
+			if _, _, err := client.ApplicationGrantsAPI.GrantConsentToScope(ctx, clientID).OAuth2ScopeConsentGrant(grant).Execute(); err != nil {
+				return fmt.Errorf("failed to grant %s to service app %s: %w", scope, app.Label, err)
+			}
+			result.Created = append(result.Created, "grant "+scope+" of service app "+app.Label)
+		}
+	}
+
+	if len(app.Roles) > 0 {
+		// the generated ListRolesForClient does not decode the list of roles
+		var assigned []okta.Role
+		if _, err := client.Do(ctx, http.MethodGet, "/oauth2/v1/clients/"+url.PathEscape(clientID)+"/roles", nil, &assigned); err != nil {
+			return fmt.Errorf("failed to list the roles of service app %s: %w", app.Label, err)
+		}
+		for _, role := range missingRoles(app.Roles, assigned) {
+			request := okta.StandardRoleAssignmentSchemaAsAssignRoleToClientRequest(&okta.StandardRoleAssignmentSchema{Type: okta.PtrString(role)})
+			if _, _, err := client.RoleAssignmentAPI.AssignRoleToClient(ctx, clientID).AssignRoleToClientRequest(request).Execute(); err != nil {
+				return fmt.Errorf("failed to assign role %s to service app %s: %w", role, app.Label, err)
+			}
+			result.Created = append(result.Created, "role "+role+" of service app "+app.Label)
+		}
+	}
+	return nil
+}
+
+// findServiceApp returns the client id of the app labelled label, or an
+// empty string when there is none.
+func findServiceApp(ctx context.Context, client *okta.APIClient, label string) (string, error) {
+	apps, err := client.ListAllApplications(client.ApplicationAPI.ListApplications(ctx).Q(label))
+	if err != nil {
+		return "", fmt.Errorf("failed to list applications: %w", err)
+	}
+	for _, app := range apps {
+		data, err := json.Marshal(app)
+		if err != nil {
+			return "", err
+		}
+		var fields struct {
+			ID    string `json:"id"`
+			Label string `json:"label"`
+		}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return "", err
+		}
+		if fields.Label == label {
+			return fields.ID, nil
+		}
+	}
+	return "", nil
+}
+
+func createServiceApp(ctx context.Context, client *okta.APIClient, app ServiceApp) (string, error) {
+	key, err := jwk.Import(app.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid public key of service app %s: %w", app.Label, err)
+	}
+	if app.KeyID != "" {
+		if err := key.Set(jwk.KeyIDKey, app.KeyID); err != nil {
+			return "", err
+		}
+	}
+	if err := key.Set(jwk.KeyUsageKey, "sig"); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"name":       "oidc_client",
+		"label":      app.Label,
+		"signOnMode": "OPENID_CONNECT",
+		"credentials": map[string]interface{}{
+			"oauthClient": map[string]interface{}{"token_endpoint_auth_method": "private_key_jwt"},
+		},
+		"settings": map[string]interface{}{
+			"oauthClient": map[string]interface{}{
+				"application_type": "service",
+				"grant_types":      []string{"client_credentials"},
+				"response_types":   []string{"token"},
+				"jwks":             map[string]interface{}{"keys": []jwk.Key{key}},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	var request okta.ListApplications200ResponseInner
+	if err := json.Unmarshal(data, &request); err != nil {
+		return "", err
+	}
+	created, _, err := client.ApplicationAPI.CreateApplication(ctx).Application(request).Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to create service app %s: %w", app.Label, err)
+	}
+	data, err = json.Marshal(created)
+	if err != nil {
+		return "", err
+	}
+	var fields struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+	return fields.ID, nil
+}
+
+func bootstrapNetworkZones(ctx context.Context, client *okta.APIClient, zones []NetworkZone, result *Result) error {
+	existing, err := client.ListAllNetworkZones(client.NetworkZoneAPI.ListNetworkZones(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to list network zones: %w", err)
+	}
+	for _, zone := range existing {
+		data, err := json.Marshal(zone)
+		if err != nil {
+			return err
+		}
+		var fields struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+		result.NetworkZoneIDs[fields.Name] = fields.ID
+	}
+	for _, zone := range zones {
+		if _, found := result.NetworkZoneIDs[zone.Name]; found {
+			continue
+		}
+		data, err := json.Marshal(map[string]interface{}{
+			"type":     "IP",
+			"name":     zone.Name,
+			"status":   "ACTIVE",
+			"gateways": addresses(zone.Gateways),
+			"proxies":  addresses(zone.Proxies),
+		})
+		if err != nil {
+			return err
+		}
+		var request okta.ListNetworkZones200ResponseInner
+		if err := json.Unmarshal(data, &request); err != nil {
+			return err
+		}
+		created, _, err := client.NetworkZoneAPI.CreateNetworkZone(ctx).Zone(request).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to create network zone %s: %w", zone.Name, err)
+		}
+		if data, err = json.Marshal(created); err != nil {
+			return err
+		}
+		var fields struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+		result.NetworkZoneIDs[zone.Name] = fields.ID
+		result.Created = append(result.Created, "network zone "+zone.Name)
+	}
+	return nil
+}
+
+// addresses returns the address objects of an IP zone.
+func addresses(values []string) []map[string]string {
+	out := []map[string]string{}
+	for _, value := range values {
+		kind := "RANGE"
+		if strings.Contains(value, "/") {
+			kind = "CIDR"
+		}
+		out = append(out, map[string]string{"type": kind, "value": value})
+	}
+	return out
+}
+
+// missingRoles returns the roles that are not among the assigned ones.
+func missingRoles(roles []string, assigned []okta.Role) []string {
+	has := map[string]bool{}
+	for _, role := range assigned {
+		has[role.GetType()] = true
+	}
+	var missing []string
+	for _, role := range roles {
+		if !has[role] {
+			missing = append(missing, role)
+		}
+	}
+	return missing
+}
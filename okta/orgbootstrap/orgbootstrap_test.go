@@ -0,0 +1,141 @@
+package orgbootstrap
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta/internal/oktatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Bootstrap(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/ops@acme.example.com", func(req *http.Request) (*http.Response, error) {
+		return oktatest.JSONResponse(404, `{"errorCode":"E0000007","errorSummary":"Not found: Resource not found: ops@acme.example.com (User)"}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "true", req.URL.Query().Get("activate"))
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, "ops@acme.example.com", body["profile"].(map[string]interface{})["login"])
+		return oktatest.JSONResponse(200, `{"id":"00uOps","status":"PROVISIONED"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users/00uOps/roles", oktatest.JSONResponder(200, `[]`))
+	var userRoles []string
+	httpmock.RegisterResponder("POST", "/api/v1/users/00uOps/roles", func(req *http.Request) (*http.Response, error) {
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		userRoles = append(userRoles, body["type"])
+		return oktatest.JSONResponse(200, `{"id":"ra1","type":"`+body["type"]+`"}`), nil
+	})
+
+	httpmock.RegisterResponder("GET", "/api/v1/apps", oktatest.JSONResponder(200, `[]`))
+	httpmock.RegisterResponder("POST", "/api/v1/apps", func(req *http.Request) (*http.Response, error) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, "OPENID_CONNECT", body["signOnMode"])
+		oauthClient := body["settings"].(map[string]interface{})["oauthClient"].(map[string]interface{})
+		assert.Equal(t, "service", oauthClient["application_type"])
+		keys := oauthClient["jwks"].(map[string]interface{})["keys"].([]interface{})
+		require.Len(t, keys, 1)
+		assert.Equal(t, "EC", keys[0].(map[string]interface{})["kty"])
+		assert.Equal(t, "automation", keys[0].(map[string]interface{})["kid"])
+		return oktatest.JSONResponse(200, `{"id":"0oaSvc","label":"Automation","name":"oidc_client","signOnMode":"OPENID_CONNECT"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/apps/0oaSvc/grants", oktatest.JSONResponder(200, `[]`))
+	var grants []string
+	httpmock.RegisterResponder("POST", "/api/v1/apps/0oaSvc/grants", func(req *http.Request) (*http.Response, error) {
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, "https://acme.okta.com", body["issuer"])
+		grants = append(grants, body["scopeId"])
+		return oktatest.JSONResponse(200, `{"id":"oag1","issuer":"https://acme.okta.com","scopeId":"`+body["scopeId"]+`"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/oauth2/v1/clients/0oaSvc/roles", oktatest.JSONResponder(200, `[]`))
+	httpmock.RegisterResponder("POST", "/oauth2/v1/clients/0oaSvc/roles", oktatest.JSONResponder(200, `{"id":"ra2","type":"ORG_ADMIN"}`))
+
+	httpmock.RegisterResponder("GET", "/api/v1/zones", oktatest.JSONResponder(200, `[
+	  {"id":"nzoLegacy","type":"IP","name":"LegacyIpZone","status":"ACTIVE","system":true}
+	]`))
+	httpmock.RegisterResponder("POST", "/api/v1/zones", func(req *http.Request) (*http.Response, error) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"type": "CIDR", "value": "203.0.113.0/24"},
+			map[string]interface{}{"type": "RANGE", "value": "198.51.100.1-198.51.100.9"},
+		}, body["gateways"])
+		return oktatest.JSONResponse(200, `{"id":"nzoOffice","type":"IP","name":"Office","status":"ACTIVE"}`), nil
+	})
+
+	result, err := Bootstrap(context.Background(), oktatest.NewClient(t), Baseline{
+		Admins: []Admin{{FirstName: "Ops", LastName: "Team", Email: "ops@acme.example.com"}},
+		ServiceApp: &ServiceApp{
+			Label:     "Automation",
+			PublicKey: key.Public(),
+			KeyID:     "automation",
+			Scopes:    []string{"okta.users.manage", "okta.groups.manage"},
+			Roles:     []string{"ORG_ADMIN"},
+		},
+		NetworkZones: []NetworkZone{{Name: "Office", Gateways: []string{"203.0.113.0/24", "198.51.100.1-198.51.100.9"}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"ops@acme.example.com": "00uOps"}, result.AdminIDs)
+	assert.Equal(t, "0oaSvc", result.ServiceAppClientID)
+	assert.Equal(t, "nzoOffice", result.NetworkZoneIDs["Office"])
+	assert.Equal(t, []string{"SUPER_ADMIN"}, userRoles)
+	assert.Equal(t, []string{"okta.users.manage", "okta.groups.manage"}, grants)
+	assert.Equal(t, []string{
+		"admin ops@acme.example.com",
+		"role SUPER_ADMIN of admin ops@acme.example.com",
+		"service app Automation",
+		"grant okta.users.manage of service app Automation",
+		"grant okta.groups.manage of service app Automation",
+		"role ORG_ADMIN of service app Automation",
+		"network zone Office",
+	}, result.Created)
+}
+
+func Test_Bootstrap_Existing(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/users/ops@acme.example.com", oktatest.JSONResponder(200, `{"id":"00uOps","status":"ACTIVE"}`))
+	httpmock.RegisterResponder("GET", "/api/v1/users/00uOps/roles", oktatest.JSONResponder(200, `[{"id":"ra1","type":"SUPER_ADMIN"}]`))
+	httpmock.RegisterResponder("GET", "/api/v1/apps", oktatest.JSONResponder(200, `[
+	  {"id":"0oaOther","label":"Automation staging","name":"oidc_client","signOnMode":"OPENID_CONNECT"},
+	  {"id":"0oaSvc","label":"Automation","name":"oidc_client","signOnMode":"OPENID_CONNECT"}
+	]`))
+	httpmock.RegisterResponder("GET", "/api/v1/apps/0oaSvc/grants", oktatest.JSONResponder(200, `[{"id":"oag1","issuer":"https://acme.okta.com","scopeId":"okta.users.manage"}]`))
+	httpmock.RegisterResponder("GET", "/oauth2/v1/clients/0oaSvc/roles", oktatest.JSONResponder(200, `[{"id":"ra2","type":"ORG_ADMIN"}]`))
+	httpmock.RegisterResponder("GET", "/api/v1/zones", oktatest.JSONResponder(200, `[{"id":"nzoOffice","type":"IP","name":"Office","status":"ACTIVE"}]`))
+
+	result, err := Bootstrap(context.Background(), oktatest.NewClient(t), Baseline{
+		Admins:       []Admin{{Email: "ops@acme.example.com"}},
+		ServiceApp:   &ServiceApp{Label: "Automation", PublicKey: key.Public(), Scopes: []string{"okta.users.manage"}, Roles: []string{"ORG_ADMIN"}},
+		NetworkZones: []NetworkZone{{Name: "Office", Gateways: []string{"203.0.113.0/24"}}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, result.Created)
+	assert.Equal(t, "0oaSvc", result.ServiceAppClientID)
+	assert.Equal(t, "nzoOffice", result.NetworkZoneIDs["Office"])
+	info := httpmock.GetCallCountInfo()
+	for call, count := range info {
+		if count > 0 {
+			assert.Equal(t, "GET", call[:3], "an org matching the baseline should not be written")
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Package oteltrace traces the API calls of an okta.APIClient with
+// OpenTelemetry, so they can be correlated with the rest of a distributed
+// trace. It lives outside the okta package to keep OpenTelemetry an optional
+// dependency.
+package oteltrace
+
+import (
+	"context"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
+)
+
+// instrumentationName identifies the spans of the SDK.
+const instrumentationName = "github.com/okta/okta-sdk-golang/v5/okta"
+
+// Attributes set on the spans of API calls, in addition to the HTTP method,
+// route and status code. The rate limit reset is in seconds from the response.
+const (
+	AttributeOperation          = attribute.Key("okta.operation")
+	AttributeCallPurpose        = attribute.Key("okta.call_purpose")
+	AttributeRetryCount         = attribute.Key("okta.retry_count")
+	AttributeRequestID          = attribute.Key("okta.request_id")
+	AttributeRateLimitLimit     = attribute.Key("okta.rate_limit.limit")
+	AttributeRateLimitRemaining = attribute.Key("okta.rate_limit.remaining")
+	AttributeRateLimitReset     = attribute.Key("okta.rate_limit.reset_after")
+	AttributeSchemaPointer      = attribute.Key("okta.schema.pointer")
+	AttributeSchemaMessage      = attribute.Key("okta.schema.message")
+	AttributeTokenType          = attribute.Key("okta.token.type")
+	AttributeTokenExpiry        = attribute.Key("okta.token.expiry")
+)
+
+// SpanToken is the name of the spans of TokenSource.
+const SpanToken = "okta.token"
+
+// EventSchemaMismatch is added to the span of a call for every difference
+// between its response and the API schema, see okta.WithResponseValidator.
+const EventSchemaMismatch = "okta.schema_mismatch"
+
+// Observer is an okta.CallObserver starting a client span for every API call.
+type Observer struct {
+	tracer trace.Tracer
+}
+
+// New returns an Observer creating spans with a tracer of provider, or of the
+// global TracerProvider when provider is nil.
+func New(provider trace.TracerProvider) *Observer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return &Observer{tracer: provider.Tracer(instrumentationName)}
+}
+
+// WithTracerProvider configures a client to trace its API calls with a tracer
+// of provider, or of the global TracerProvider when provider is nil.
+func WithTracerProvider(provider trace.TracerProvider) okta.ConfigSetter {
+	return okta.WithCallObserver(New(provider))
+}
+
+// StartCall starts the span of a call, named after its operation.
+func (o *Observer) StartCall(ctx context.Context, call *okta.Call) context.Context {
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", call.Method),
+		attribute.String("http.route", call.Route),
+		attribute.String("server.address", call.Request.URL.Hostname()),
+		AttributeOperation.String(call.Operation),
+	}
+	if call.Purpose != "" {
+		attributes = append(attributes, AttributeCallPurpose.String(call.Purpose))
+	}
+	ctx, _ = o.tracer.Start(ctx, call.Operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+	return ctx
+}
+
+// EndCall records the outcome of a call and ends its span.
+func (o *Observer) EndCall(ctx context.Context, call *okta.Call) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+	span.SetAttributes(AttributeRetryCount.Int(call.Retries))
+	if call.StatusCode != 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", call.StatusCode))
+	}
+	if call.RequestID != "" {
+		span.SetAttributes(AttributeRequestID.String(call.RequestID))
+	}
+	if limit := call.RateLimit; limit != nil {
+		span.SetAttributes(
+			AttributeRateLimitLimit.Int(limit.Limit),
+			AttributeRateLimitRemaining.Int(limit.Remaining),
+			AttributeRateLimitReset.Int64(limit.Reset),
+		)
+	}
+	for _, m := range call.SchemaMismatches {
+		span.AddEvent(EventSchemaMismatch, trace.WithAttributes(
+			AttributeSchemaPointer.String(m.Pointer),
+			AttributeSchemaMessage.String(m.Message),
+		))
+	}
+	switch {
+	case call.Err != nil:
+		span.RecordError(call.Err)
+		span.SetStatus(codes.Error, call.Err.Error())
+	case call.StatusCode >= 400:
+		span.SetStatus(codes.Error, "")
+	}
+}
+
+// TokenSource wraps source, such as the one of okta.APIClient.TokenSource,
+// starting a span as a child of the span of ctx every time a token is taken
+// from it, with a tracer of provider or of the global TracerProvider when
+// provider is nil. The spans record the type and expiry of the tokens and the
+// failed token requests, which callers of other libraries do not see.
+func TokenSource(ctx context.Context, source oauth2.TokenSource, provider trace.TracerProvider) oauth2.TokenSource {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return &tokenSource{ctx: ctx, source: source, tracer: provider.Tracer(instrumentationName)}
+}
+
+type tokenSource struct {
+	ctx    context.Context
+	source oauth2.TokenSource
+	tracer trace.Tracer
+}
+
+func (s *tokenSource) Token() (*oauth2.Token, error) {
+	_, span := s.tracer.Start(s.ctx, SpanToken, trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+	token, err := s.source.Token()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(AttributeTokenType.String(token.Type()))
+	if !token.Expiry.IsZero() {
+		span.SetAttributes(AttributeTokenExpiry.String(token.Expiry.UTC().Format(time.RFC3339)))
+	}
+	return token, nil
+}
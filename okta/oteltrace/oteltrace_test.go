@@ -0,0 +1,114 @@
+package oteltrace
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"golang.org/x/oauth2"
+)
+
+func Test_Spans_Of_Calls(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00g1a2b3c4d5e6f7g8h9", func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(200, `{"id":"00g1a2b3c4d5e6f7g8h9"}`)
+		resp.Header.Set("Content-Type", "application/json")
+		resp.Header.Set("X-Okta-Request-Id", "req-1")
+		return resp, nil
+	})
+	httpmock.RegisterResponder("DELETE", "/api/v1/groups/00gMissing", func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(404, `{"errorCode":"E0000007","errorSummary":"Not found"}`)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	configuration, err := okta.NewConfiguration(okta.WithOrgUrl("https://example.okta.com"), okta.WithToken("token"), okta.WithCache(false), WithTracerProvider(provider))
+	require.NoError(t, err)
+	client := okta.NewAPIClient(configuration)
+	ctx, parent := provider.Tracer("test").Start(okta.WithCallPurpose(context.Background(), "nightly-sync"), "sync")
+
+	_, _, err = client.GroupAPI.GetGroup(ctx, "00g1a2b3c4d5e6f7g8h9").Execute()
+	require.NoError(t, err)
+	_, err = client.GroupAPI.DeleteGroup(ctx, "00gMissing").Execute()
+	require.Error(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	get := spans[0]
+	assert.Equal(t, "GET /api/v1/groups/{groupId}", get.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), get.Parent().SpanID(), "Calls are children of the span of the context")
+	assert.Contains(t, get.Attributes(), attribute.Int("http.response.status_code", 200))
+	assert.Contains(t, get.Attributes(), AttributeRequestID.String("req-1"))
+	assert.Contains(t, get.Attributes(), AttributeRetryCount.Int(0))
+	assert.Contains(t, get.Attributes(), AttributeCallPurpose.String("nightly-sync"))
+	assert.Equal(t, codes.Unset, get.Status().Code)
+
+	del := spans[1]
+	assert.Equal(t, "DELETE /api/v1/groups/{groupId}", del.Name())
+	assert.Equal(t, codes.Error, del.Status().Code)
+}
+
+func Test_Schema_Mismatch_Events(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	observer := New(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	req, err := http.NewRequest("GET", "https://example.okta.com/api/v1/users/00u1", nil)
+	require.NoError(t, err)
+	call := &okta.Call{Operation: "GET /api/v1/users/{userId}", Method: "GET", Route: "/api/v1/users/{userId}", Request: req}
+
+	ctx := observer.StartCall(context.Background(), call)
+	call.StatusCode = 200
+	call.SchemaMismatches = []okta.SchemaMismatch{{Operation: call.Operation, Pointer: "/profile", Message: "expected an object, got a string"}}
+	observer.EndCall(ctx, call)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	events := spans[0].Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, EventSchemaMismatch, events[0].Name)
+	assert.Contains(t, events[0].Attributes, AttributeSchemaPointer.String("/profile"))
+	assert.Equal(t, codes.Unset, spans[0].Status().Code, "Mismatches do not fail the span")
+}
+
+type failingTokenSource struct{}
+
+func (failingTokenSource) Token() (*oauth2.Token, error) {
+	return nil, errors.New("invalid_client")
+}
+
+func Test_Token_Source_Spans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "grpc-call")
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	token, err := TokenSource(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "abc", TokenType: "Bearer", Expiry: expiry}), provider).Token()
+	require.NoError(t, err)
+	assert.Equal(t, "abc", token.AccessToken)
+	_, err = TokenSource(ctx, failingTokenSource{}, provider).Token()
+	require.Error(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	assert.Equal(t, SpanToken, spans[0].Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Contains(t, spans[0].Attributes(), AttributeTokenType.String("Bearer"))
+	assert.Contains(t, spans[0].Attributes(), AttributeTokenExpiry.String("2030-01-02T03:04:05Z"))
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "invalid_client", spans[1].Status().Description)
+}
@@ -0,0 +1,137 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Next_Retries_Failed_Page(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var nextCalls int
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("after") == "" {
+			resp := mockJSONResponse(200, `[{"id":"00u1"}]`)
+			resp.Header.Add("Link", `<https://example.okta.com/api/v1/users?after=00u1>; rel="next"`)
+			return resp, nil
+		}
+		nextCalls++
+		switch nextCalls {
+		case 1:
+			return nil, errors.New("connection reset by peer")
+		case 2:
+			return mockJSONResponse(503, `{"errorCode":"E0000009","errorSummary":"Internal Server Error"}`), nil
+		}
+		return mockJSONResponse(200, `[{"id":"00u2"}]`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, resp, err := client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err)
+	require.True(t, resp.HasNextPage())
+
+	var page []User
+	_, err = resp.WithPageRetry(PageRetry{MaxRetries: 1, Backoff: time.Millisecond}).Next(&page)
+	require.Error(t, err, "The page should fail once its retries are exhausted")
+
+	nextCalls = 0
+	resp.WithPageRetry(PageRetry{MaxRetries: 2, Backoff: time.Millisecond})
+	_, err = resp.Next(&page)
+	require.NoError(t, err, "The page should succeed after retries")
+	require.Len(t, page, 1)
+	assert.Equal(t, "00u2", page[0].GetId())
+	assert.Equal(t, 3, nextCalls)
+}
+
+func Test_ListAll_Follows_Next_Links(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, `status eq "ACTIVE"`, req.URL.Query().Get("filter"), "The query is kept on every page")
+		switch after := req.URL.Query().Get("after"); after {
+		case "":
+			resp := mockJSONResponse(200, `[{"id":"00u1"}]`)
+			resp.Header.Add("Link", `<https://example.okta.com/api/v1/users?filter=status+eq+%22ACTIVE%22&after=00u1>; rel="next"`)
+			return resp, nil
+		case "00u1":
+			resp := mockJSONResponse(200, `[{"id":"00u2"}]`)
+			resp.Header.Add("Link", `<https://example.okta.com/api/v1/users?filter=status+eq+%22ACTIVE%22&after=00u2>; rel="next"`)
+			return resp, nil
+		default:
+			return mockJSONResponse(200, `[{"id":"00u3"}]`), nil
+		}
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	users, err := client.ListAllUsers(client.UserAPI.ListUsers(ctx).Filter(`status eq "ACTIVE"`))
+	require.NoError(t, err)
+	require.Len(t, users, 3)
+	assert.Equal(t, "00u3", users[2].GetId())
+
+	configuration.ListAllMaxPages = 2
+	users, err = client.ListAllUsers(client.UserAPI.ListUsers(ctx).Filter(`status eq "ACTIVE"`))
+	assert.ErrorIs(t, err, ErrTooManyPages)
+	assert.Len(t, users, 2, "The items read before the limit are returned")
+}
+
+func Test_ListAll_Embedded_Next_Links(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/iam/resource-sets", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("after") == "" {
+			return mockJSONResponse(200, `{"resource-sets":[{"id":"iam1"}],"_links":{"next":{"href":"https://example.okta.com/api/v1/iam/resource-sets?after=iam1"}}}`), nil
+		}
+		return mockJSONResponse(200, `{"resource-sets":[{"id":"iam2"}],"_links":{}}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	sets, err := client.ListAllResourceSets(client.ResourceSetAPI.ListResourceSets(context.Background()))
+	require.NoError(t, err)
+	require.Len(t, sets, 2)
+	assert.Equal(t, "iam2", sets[1].GetId())
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func Test_ListAll_Client_Role_Targets(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/oauth2/v1/clients/0oa1/roles/ra1/targets/groups", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "1", req.URL.Query().Get("limit"), "The query is kept on every page")
+		if req.URL.Query().Get("after") == "" {
+			resp := mockJSONResponse(200, `[{"id":"00g1"}]`)
+			resp.Header.Add("Link", `<https://example.okta.com/oauth2/v1/clients/0oa1/roles/ra1/targets/groups?limit=1&after=00g1>; rel="next"`)
+			return resp, nil
+		}
+		return mockJSONResponse(200, `[{"id":"00g2"}]`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	groups, err := client.ListAllGroupTargetRoleForClient(client.RoleTargetAPI.ListGroupTargetRoleForClient(context.Background(), "0oa1", "ra1").Limit(1))
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "00g2", groups[1].GetId())
+}
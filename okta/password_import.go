@@ -0,0 +1,182 @@
+package okta
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"net/http"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// PasswordImportHookType is the type of the password import inline hook.
+	PasswordImportHookType = "com.okta.user.credential.password.import"
+
+	passwordImportCommand    = "com.okta.action.update"
+	passwordImportVerified   = "VERIFIED"
+	passwordImportUnverified = "UNVERIFIED"
+)
+
+// PasswordImportHookOptions configures the inline hook created by
+// CreatePasswordImportHook.
+type PasswordImportHookOptions struct {
+	// Name of the hook, "Password import" by default.
+	Name string
+	// URL of the handler Okta calls with the credentials of the user.
+	URL string
+	// AuthHeader and AuthValue are sent by Okta with every hook call so the
+	// handler can authenticate it.
+	AuthHeader string
+	AuthValue  string
+}
+
+// CreatePasswordImportHook creates and activates a password import inline
+// hook. Users created with a password hook credential are verified by it the
+// first time they sign in.
+func (c *APIClient) CreatePasswordImportHook(ctx context.Context, opts PasswordImportHookOptions) (*InlineHook, error) {
+	if opts.URL == "" {
+		return nil, errors.New("password import hook URL is required")
+	}
+	if opts.Name == "" {
+		opts.Name = "Password import"
+	}
+	return c.createHTTPInlineHook(ctx, opts.Name, PasswordImportHookType, opts.URL, opts.AuthHeader, opts.AuthValue)
+}
+
+// PasswordImportVerifier checks the credentials Okta received from a user
+// against the system the user is migrated from.
+type PasswordImportVerifier func(ctx context.Context, username, password string) (bool, error)
+
+// PasswordImportResult returns the response telling Okta whether the
+// password of a user was verified. Okta stores verified passwords and stops
+// calling the hook for the user.
+func PasswordImportResult(verified bool) *PasswordImportResponse {
+	credential := passwordImportUnverified
+	if verified {
+		credential = passwordImportVerified
+	}
+	return &PasswordImportResponse{
+		Commands: []PasswordImportResponseCommandsInner{{
+			Type:  PtrString(passwordImportCommand),
+			Value: &PasswordImportResponseCommandsInnerValue{Credential: PtrString(credential)},
+		}},
+	}
+}
+
+// PasswordImportHandler serves the password import inline hook. It decodes
+// the credentials of the request, verifies them with verify and answers with
+// the matching command. An error of verify fails the request, which makes
+// Okta reject the sign in attempt. Authentication of the request is left to a
+// wrapping handler.
+func PasswordImportHandler(verify PasswordImportVerifier) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		var req PasswordImportRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		credential := req.GetData().Context.GetCredential()
+		verified, err := verify(r.Context(), credential.GetUsername(), credential.GetPassword())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(PasswordImportResult(verified))
+	})
+}
+
+// VerifyPasswordHash checks a password against a hash in the format Okta
+// accepts for imported passwords: BCRYPT, SHA-512, SHA-256, SHA-1, MD5 and
+// PBKDF2. Digests and salts are Base64 encoded, the salt is prefixed or
+// postfixed to the password as specified by SaltOrder. BCRYPT hashes are
+// either a complete modular crypt string or a Radix-64 salt and value.
+func VerifyPasswordHash(h PasswordCredentialHash, password string) (bool, error) {
+	algorithm := strings.ToUpper(h.GetAlgorithm())
+	if algorithm == "BCRYPT" {
+		return verifyBcrypt(h, password)
+	}
+
+	want, err := base64.StdEncoding.DecodeString(h.GetValue())
+	if err != nil {
+		return false, fmt.Errorf("decode hash value: %w", err)
+	}
+	var salt []byte
+	if h.GetSalt() != "" {
+		if salt, err = base64.StdEncoding.DecodeString(h.GetSalt()); err != nil {
+			return false, fmt.Errorf("decode hash salt: %w", err)
+		}
+	}
+
+	var got []byte
+	switch algorithm {
+	case "PBKDF2":
+		newHash, err := hashFunc(h.GetDigestAlgorithm())
+		if err != nil {
+			return false, err
+		}
+		keySize := int(h.GetKeySize())
+		if keySize <= 0 {
+			keySize = len(want)
+		}
+		got = pbkdf2.Key([]byte(password), salt, int(h.GetIterationCount()), keySize, newHash)
+	case "SHA-512", "SHA-256", "SHA-1", "MD5":
+		newHash, err := hashFunc(algorithm)
+		if err != nil {
+			return false, err
+		}
+		d := newHash()
+		if strings.EqualFold(h.GetSaltOrder(), "PREFIX") {
+			d.Write(salt)
+			d.Write([]byte(password))
+		} else {
+			d.Write([]byte(password))
+			d.Write(salt)
+		}
+		got = d.Sum(nil)
+	default:
+		return false, fmt.Errorf("unsupported password hash algorithm %q", h.GetAlgorithm())
+	}
+	return subtle.ConstantTimeCompare(got, want) == 1, nil
+}
+
+func verifyBcrypt(h PasswordCredentialHash, password string) (bool, error) {
+	encoded := h.GetValue()
+	if !strings.HasPrefix(encoded, "$2") {
+		encoded = fmt.Sprintf("$2a$%02d$%s%s", h.GetWorkFactor(), h.GetSalt(), h.GetValue())
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func hashFunc(name string) (func() hash.Hash, error) {
+	switch strings.TrimSuffix(strings.ToUpper(name), "_HMAC") {
+	case "SHA-512", "SHA512":
+		return sha512.New, nil
+	case "SHA-256", "SHA256":
+		return sha256.New, nil
+	case "SHA-1", "SHA1":
+		return sha1.New, nil
+	case "MD5":
+		return md5.New, nil
+	}
+	return nil, fmt.Errorf("unsupported digest algorithm %q", name)
+}
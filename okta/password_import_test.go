@@ -0,0 +1,66 @@
+package okta
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+func Test_Verify_Password_Hash(t *testing.T) {
+	salt := []byte("pepper")
+	b64 := base64.StdEncoding.EncodeToString
+	sha := sha256.Sum256(append(append([]byte{}, salt...), "secret"...))
+	md := md5.Sum(append([]byte("secret"), salt...))
+	bcrypted, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+	// $2a$04$ followed by a 22 character salt and a 31 character value
+	split := string(bcrypted)
+
+	hashes := map[string]PasswordCredentialHash{
+		"sha256 prefix": {Algorithm: PtrString("SHA-256"), Salt: PtrString(b64(salt)), SaltOrder: PtrString("PREFIX"), Value: PtrString(b64(sha[:]))},
+		"md5 postfix":   {Algorithm: PtrString("MD5"), Salt: PtrString(b64(salt)), SaltOrder: PtrString("POSTFIX"), Value: PtrString(b64(md[:]))},
+		"bcrypt":        {Algorithm: PtrString("BCRYPT"), Value: PtrString(string(bcrypted))},
+		"bcrypt split":  {Algorithm: PtrString("BCRYPT"), WorkFactor: PtrInt32(4), Salt: PtrString(split[7:29]), Value: PtrString(split[29:])},
+		"pbkdf2": {
+			Algorithm: PtrString("PBKDF2"), DigestAlgorithm: PtrString("SHA512_HMAC"), IterationCount: PtrInt32(4096), KeySize: PtrInt32(32),
+			Salt: PtrString(b64(salt)), Value: PtrString(b64(pbkdf2.Key([]byte("secret"), salt, 4096, 32, sha512.New))),
+		},
+	}
+	for name, h := range hashes {
+		ok, err := VerifyPasswordHash(h, "secret")
+		require.NoError(t, err, name)
+		assert.True(t, ok, name)
+		ok, err = VerifyPasswordHash(h, "guess")
+		require.NoError(t, err, name)
+		assert.False(t, ok, name)
+	}
+
+	_, err = VerifyPasswordHash(PasswordCredentialHash{Algorithm: PtrString("CRC32"), Value: PtrString("")}, "secret")
+	assert.Error(t, err)
+}
+
+func Test_Password_Import_Handler(t *testing.T) {
+	handler := PasswordImportHandler(func(ctx context.Context, username, password string) (bool, error) {
+		return username == "jane@example.com" && password == "secret", nil
+	})
+	call := func(password string) string {
+		body := `{"eventType":"com.okta.user.credential.password.import","data":{"context":{"credential":{"username":"jane@example.com","password":"` + password + `"}},"action":{"credential":"UNVERIFIED"}}}`
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/hooks/password", strings.NewReader(body)))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Body.String()
+	}
+	assert.JSONEq(t, `{"commands":[{"type":"com.okta.action.update","value":{"credential":"VERIFIED"}}]}`, call("secret"))
+	assert.JSONEq(t, `{"commands":[{"type":"com.okta.action.update","value":{"credential":"UNVERIFIED"}}]}`, call("guess"))
+}
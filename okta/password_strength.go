@@ -0,0 +1,207 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Names of the password policy rules reported by EvaluatePassword.
+const (
+	PasswordRuleMinLength         = "minLength"
+	PasswordRuleMinLowerCase      = "minLowerCase"
+	PasswordRuleMinUpperCase      = "minUpperCase"
+	PasswordRuleMinNumber         = "minNumber"
+	PasswordRuleMinSymbol         = "minSymbol"
+	PasswordRuleExcludeUsername   = "excludeUsername"
+	PasswordRuleExcludeAttributes = "excludeAttributes"
+	PasswordRuleDictionary        = "dictionary"
+	PasswordRuleHistory           = "history"
+)
+
+// ErrNoPasswordPolicy is returned when no active password policy applies.
+var ErrNoPasswordPolicy = errors.New("no password policy applies")
+
+// PasswordViolation is a rule of a password policy a password breaks.
+type PasswordViolation struct {
+	Rule    string
+	Message string
+}
+
+// PasswordEvaluation is the result of checking a password against a policy.
+type PasswordEvaluation struct {
+	Policy     *PasswordPolicy
+	Violations []PasswordViolation
+	// Unchecked lists rules of the policy that cannot be evaluated on the
+	// client. The password history is only known to Okta, and the common
+	// password dictionary is only checked when PasswordSubject.Common is set.
+	Unchecked []string
+}
+
+// OK reports whether the password satisfies every rule that was checked.
+func (e *PasswordEvaluation) OK() bool {
+	return len(e.Violations) == 0
+}
+
+// PasswordSubject describes the user a password is evaluated for.
+type PasswordSubject struct {
+	// Username is the login of the user. The part before the @ must not be
+	// part of the password when the policy excludes the username.
+	Username string
+	// Attributes are the profile attributes of the user, such as firstName
+	// and lastName, the policy can exclude from passwords.
+	Attributes map[string]string
+	// Common reports whether a password is in a dictionary of common
+	// passwords.
+	Common func(password string) bool
+}
+
+// EvaluatePassword checks a candidate password against the complexity rules
+// of a password policy.
+func EvaluatePassword(policy *PasswordPolicy, password string, subject PasswordSubject) *PasswordEvaluation {
+	e := &PasswordEvaluation{Policy: policy}
+	settings := policy.GetSettings()
+	pwSettings := settings.GetPassword()
+	complexity := pwSettings.GetComplexity()
+
+	var lower, upper, number, symbol int32
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower++
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsDigit(r):
+			number++
+		case !unicode.IsLetter(r):
+			symbol++
+		}
+	}
+	counts := []struct {
+		rule  string
+		what  string
+		min   int32
+		count int32
+	}{
+		{PasswordRuleMinLength, "characters", complexity.GetMinLength(), int32(len([]rune(password)))},
+		{PasswordRuleMinLowerCase, "lower case letters", complexity.GetMinLowerCase(), lower},
+		{PasswordRuleMinUpperCase, "upper case letters", complexity.GetMinUpperCase(), upper},
+		{PasswordRuleMinNumber, "numbers", complexity.GetMinNumber(), number},
+		{PasswordRuleMinSymbol, "symbols", complexity.GetMinSymbol(), symbol},
+	}
+	for _, c := range counts {
+		if c.count < c.min {
+			e.violate(c.rule, "at least %d %s are required", c.min, c.what)
+		}
+	}
+
+	lowered := strings.ToLower(password)
+	if complexity.GetExcludeUsername() {
+		username, _, _ := strings.Cut(subject.Username, "@")
+		if username != "" && strings.Contains(lowered, strings.ToLower(username)) {
+			e.violate(PasswordRuleExcludeUsername, "the password must not contain the username")
+		}
+	}
+	for _, attr := range complexity.ExcludeAttributes {
+		value := subject.Attributes[attr]
+		if value != "" && strings.Contains(lowered, strings.ToLower(value)) {
+			e.violate(PasswordRuleExcludeAttributes, "the password must not contain the %s of the user", attr)
+		}
+	}
+
+	dictionary := complexity.GetDictionary()
+	common := dictionary.GetCommon()
+	if common.GetExclude() {
+		if subject.Common == nil {
+			e.Unchecked = append(e.Unchecked, PasswordRuleDictionary)
+		} else if subject.Common(password) {
+			e.violate(PasswordRuleDictionary, "the password is a common password")
+		}
+	}
+	age := pwSettings.GetAge()
+	if age.GetHistoryCount() > 0 {
+		e.Unchecked = append(e.Unchecked, PasswordRuleHistory)
+	}
+	return e
+}
+
+func (e *PasswordEvaluation) violate(rule, format string, args ...interface{}) {
+	e.Violations = append(e.Violations, PasswordViolation{Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+// EffectivePasswordPolicy returns the active password policy with the highest
+// priority that applies to members of the given groups.
+func (c *APIClient) EffectivePasswordPolicy(ctx context.Context, groupIDs []string) (*PasswordPolicy, error) {
+	member := make(map[string]bool, len(groupIDs))
+	for _, id := range groupIDs {
+		member[id] = true
+	}
+	query := url.Values{}
+	query.Set("type", "PASSWORD")
+	query.Set("status", "ACTIVE")
+	var policies []PasswordPolicy
+	err := listPages(ctx, c, "/api/v1/policies", query, map[string]string{"Accept": "application/json"}, func(page []PasswordPolicy) error {
+		policies = append(policies, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(policies, func(i, j int) bool {
+		return policies[i].GetPriority() < policies[j].GetPriority()
+	})
+	for i := range policies {
+		conditions := policies[i].GetConditions()
+		people := conditions.GetPeople()
+		groups := people.GetGroups()
+		if matchesAny(groups.Exclude, member) {
+			continue
+		}
+		if matchesAny(groups.Include, member) {
+			return &policies[i], nil
+		}
+	}
+	return nil, ErrNoPasswordPolicy
+}
+
+func matchesAny(ids []string, set map[string]bool) bool {
+	for _, id := range ids {
+		if set[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// EvaluateUserPassword checks a candidate password for a user against the
+// password policy that applies to the user.
+func (c *APIClient) EvaluateUserPassword(ctx context.Context, userID, password string) (*PasswordEvaluation, error) {
+	user, _, err := c.UserAPI.GetUser(ctx, userID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	groupIDs, err := c.userGroupIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := c.EffectivePasswordPolicy(ctx, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	profile := user.GetProfile()
+	attributes := map[string]string{
+		"firstName": profile.GetFirstName(),
+		"lastName":  profile.GetLastName(),
+		"email":     profile.GetEmail(),
+	}
+	for k, v := range profile.AdditionalProperties {
+		if s, ok := v.(string); ok {
+			attributes[k] = s
+		}
+	}
+	return EvaluatePassword(policy, password, PasswordSubject{Username: profile.GetLogin(), Attributes: attributes}), nil
+}
@@ -0,0 +1,65 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPasswordPolicies = `[
+  {"id":"00p2","type":"PASSWORD","status":"ACTIVE","priority":2,"conditions":{"people":{"groups":{"include":["00gEveryone"]}}},
+   "settings":{"password":{"complexity":{"minLength":8}}}},
+  {"id":"00p1","type":"PASSWORD","status":"ACTIVE","priority":1,"conditions":{"people":{"groups":{"include":["00gAdmins"]}}},
+   "settings":{"password":{"complexity":{"minLength":12,"minLowerCase":1,"minUpperCase":1,"minNumber":1,"minSymbol":1,
+     "excludeUsername":true,"excludeAttributes":["firstName"],"dictionary":{"common":{"exclude":true}}},"age":{"historyCount":4}}}}
+]`
+
+func Test_Evaluate_User_Password(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"00u1","profile":{"login":"jdoe@example.com","firstName":"Jane","lastName":"Doe"}}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1/groups", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[{"id":"00gEveryone"},{"id":"00gAdmins"}]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/policies", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "PASSWORD", req.URL.Query().Get("type"))
+		return mockJSONResponse(200, testPasswordPolicies), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	e, err := client.EvaluateUserPassword(context.Background(), "00u1", "jane-jdoe")
+	require.NoError(t, err)
+	assert.Equal(t, "00p1", e.Policy.GetId(), "The policy with the highest priority should apply")
+	assert.False(t, e.OK())
+	var rules []string
+	for _, v := range e.Violations {
+		rules = append(rules, v.Rule)
+	}
+	assert.Equal(t, []string{
+		PasswordRuleMinLength, PasswordRuleMinUpperCase, PasswordRuleMinNumber,
+		PasswordRuleExcludeUsername, PasswordRuleExcludeAttributes,
+	}, rules)
+	assert.Equal(t, []string{PasswordRuleDictionary, PasswordRuleHistory}, e.Unchecked)
+
+	e, err = client.EvaluateUserPassword(context.Background(), "00u1", "Correct-Horse-7-Battery")
+	require.NoError(t, err)
+	assert.True(t, e.OK())
+
+	policy, err := client.EffectivePasswordPolicy(context.Background(), []string{"00gEveryone"})
+	require.NoError(t, err)
+	assert.Equal(t, "00p2", policy.GetId())
+	_, err = client.EffectivePasswordPolicy(context.Background(), []string{"00gOther"})
+	assert.ErrorIs(t, err, ErrNoPasswordPolicy)
+
+	common := EvaluatePassword(policy, "password", PasswordSubject{Common: func(string) bool { return true }})
+	assert.True(t, common.OK(), "The dictionary is only checked when the policy excludes common passwords")
+}
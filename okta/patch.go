@@ -0,0 +1,132 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrUnsupportedPatch is matched by errors.Is when a JSONPatch has operations
+// that cannot be sent to a partial update endpoint of Okta.
+var ErrUnsupportedPatch = errors.New("patch cannot be expressed as a partial update")
+
+// Patch is a partial update of a resource, sent with the Patch methods of the
+// client, such as PatchUser. Okta only changes the properties present in the
+// body of a partial update, so concurrent changes to other properties are
+// kept, unlike with a read-modify-replace. Combine with WithIfMatch to also
+// reject concurrent changes to the patched properties.
+type Patch interface {
+	// PatchBody returns the body of the partial update request.
+	PatchBody() (map[string]interface{}, error)
+}
+
+// MergePatch is a JSON Merge Patch (RFC 7386): nested objects are merged into
+// the resource and null removes a property.
+//
+//	okta.MergePatch{"profile": map[string]interface{}{"nickName": "Bob", "title": nil}}
+type MergePatch map[string]interface{}
+
+// PatchBody implements Patch.
+func (p MergePatch) PatchBody() (map[string]interface{}, error) {
+	return p, nil
+}
+
+// PatchOperation is an operation of a JSONPatch.
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// JSONPatch is a JSON Patch (RFC 6902). Partial updates of Okta merge objects,
+// so only the add, replace and remove operations on object members are
+// supported, arrays being replaced whole; remove sets the property to null.
+//
+//	okta.JSONPatch{{Op: "replace", Path: "/profile/nickName", Value: "Bob"}}
+type JSONPatch []PatchOperation
+
+// PatchBody implements Patch, translating the operations to a merge patch.
+func (p JSONPatch) PatchBody() (map[string]interface{}, error) {
+	body := map[string]interface{}{}
+	for _, op := range p {
+		var value interface{}
+		switch op.Op {
+		case "add", "replace":
+			value = op.Value
+		case "remove":
+			value = nil
+		default:
+			return nil, fmt.Errorf("%w: %s operations are not supported", ErrUnsupportedPatch, op.Op)
+		}
+		if op.Path == "" {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%w: the whole resource can only be replaced with an object", ErrUnsupportedPatch)
+			}
+			for k, v := range object {
+				body[k] = v
+			}
+			continue
+		}
+		if !strings.HasPrefix(op.Path, "/") {
+			return nil, fmt.Errorf("invalid JSON pointer %q", op.Path)
+		}
+		tokens := strings.Split(op.Path[1:], "/")
+		parent := body
+		for i, token := range tokens {
+			token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+			if token == "-" {
+				return nil, fmt.Errorf("%w: %s addresses an array item", ErrUnsupportedPatch, op.Path)
+			}
+			if i == len(tokens)-1 {
+				parent[token] = value
+				break
+			}
+			child, ok := parent[token].(patchObject)
+			if !ok {
+				if _, exists := parent[token]; exists {
+					return nil, fmt.Errorf("%w: %s is inside a value set by another operation", ErrUnsupportedPatch, op.Path)
+				}
+				child = patchObject{}
+				parent[token] = child
+			}
+			parent = child
+		}
+	}
+	return body, nil
+}
+
+// patchObject is an object created by the operations of a JSONPatch, as
+// opposed to a value of an operation, which is never modified.
+type patchObject map[string]interface{}
+
+// sendPatch posts the body of patch to the partial update endpoint at path and
+// decodes the updated resource into result.
+func (c *APIClient) sendPatch(ctx context.Context, path string, patch Patch, result interface{}) (*APIResponse, error) {
+	body, err := patch.PatchBody()
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]string{"Content-Type": "application/json", "Accept": "application/json"}
+	req, err := c.prepareRequest(ctx, path, http.MethodPost, body, headers, url.Values{}, url.Values{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return buildResponse(httpResp, c, result)
+}
+
+// expandPath replaces the path parameters of template, given as pairs of
+// placeholder and value.
+func expandPath(template string, params ...string) string {
+	for i := 0; i+1 < len(params); i += 2 {
+		template = strings.Replace(template, params[i], url.PathEscape(params[i+1]), -1)
+	}
+	return template
+}
@@ -0,0 +1,65 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JSON_Patch_Body(t *testing.T) {
+	body, err := JSONPatch{
+		{Op: "replace", Path: "/profile/nickName", Value: "Bob"},
+		{Op: "remove", Path: "/profile/title"},
+		{Op: "add", Path: "/profile/a~1b", Value: []string{"x"}},
+	}.PatchBody()
+	require.NoError(t, err)
+	encoded, err := json.Marshal(body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"profile":{"nickName":"Bob","title":null,"a/b":["x"]}}`, string(encoded))
+
+	_, err = JSONPatch{{Op: "move", Path: "/profile/title"}}.PatchBody()
+	assert.True(t, errors.Is(err, ErrUnsupportedPatch))
+	_, err = JSONPatch{{Op: "add", Path: "/profile/emails/-", Value: "a@example.com"}}.PatchBody()
+	assert.True(t, errors.Is(err, ErrUnsupportedPatch))
+
+	profile := map[string]interface{}{"nickName": "Bob"}
+	_, err = JSONPatch{
+		{Op: "replace", Path: "/profile", Value: profile},
+		{Op: "replace", Path: "/profile/title", Value: "CTO"},
+	}.PatchBody()
+	assert.True(t, errors.Is(err, ErrUnsupportedPatch))
+	assert.Equal(t, map[string]interface{}{"nickName": "Bob"}, profile, "The value of an operation should not be modified")
+}
+
+func Test_Patch_User(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var sent string
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		sent = string(body)
+		return mockJSONResponse(200, `{"id":"00u1","profile":{"login":"bob@example.com","nickName":"Bob"}}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	user, _, err := client.PatchUser(context.Background(), "00u1", JSONPatch{{Op: "replace", Path: "/profile/nickName", Value: "Bob"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"profile":{"nickName":"Bob"}}`, sent)
+	assert.Equal(t, "00u1", user.GetId())
+	assert.Equal(t, "Bob", user.Profile.GetNickName())
+
+	_, _, err = client.PatchUser(context.Background(), "00u1", JSONPatch{{Op: "copy", Path: "/profile/title"}})
+	assert.True(t, errors.Is(err, ErrUnsupportedPatch))
+	assert.Equal(t, 1, httpmock.GetTotalCallCount(), "An unsupported patch should not be sent")
+}
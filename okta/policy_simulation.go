@@ -0,0 +1,120 @@
+package okta
+
+import (
+	"context"
+	"errors"
+)
+
+const simulateMatch = "MATCH"
+
+// PolicySimulation describes an authentication to simulate against the
+// policies of the org.
+type PolicySimulation struct {
+	// UserID is the user signing in.
+	UserID string
+	// GroupIDs are the groups of the user. They are looked up when nil.
+	GroupIDs []string
+	// AppID is the app instance the user signs in to.
+	AppID string
+	// ZoneIDs are the network zones the request comes from, IP is its
+	// address. Both are optional.
+	ZoneIDs []string
+	IP      string
+	// RiskLevel is LOW, MEDIUM or HIGH, optional.
+	RiskLevel string
+	// Device describes the device of the user, optional.
+	Device *PolicyContextDevice
+	// PolicyTypes limits the simulation to the given policy types, such as
+	// OKTA_SIGN_ON or ACCESS_POLICY. All types are simulated when empty.
+	PolicyTypes []string
+}
+
+// PolicyMatch is the policy and rule applied for a policy type.
+type PolicyMatch struct {
+	PolicyType string
+	PolicyID   string
+	PolicyName string
+	RuleID     string
+	RuleName   string
+}
+
+// PolicySimulationResult is the outcome of SimulatePolicy.
+type PolicySimulationResult struct {
+	// Matches holds the matched policy and rule of every evaluated policy
+	// type.
+	Matches []PolicyMatch
+	// Evaluations is the raw response of the simulation.
+	Evaluations []SimulatePolicyEvaluations
+}
+
+// Match returns the policy and rule that apply for a policy type.
+func (r *PolicySimulationResult) Match(policyType string) (PolicyMatch, bool) {
+	for _, m := range r.Matches {
+		if m.PolicyType == policyType {
+			return m, true
+		}
+	}
+	return PolicyMatch{}, false
+}
+
+// SimulatePolicy answers which policy and rule apply to a user signing in to
+// an app, from a zone or device, before policies are changed.
+func (c *APIClient) SimulatePolicy(ctx context.Context, sim PolicySimulation) (*PolicySimulationResult, error) {
+	if sim.UserID == "" || sim.AppID == "" {
+		return nil, errors.New("policy simulation requires a user and an app")
+	}
+	groupIDs := sim.GroupIDs
+	if groupIDs == nil {
+		var err error
+		if groupIDs, err = c.userGroupIDs(ctx, sim.UserID); err != nil {
+			return nil, err
+		}
+	}
+	policyContext := NewPolicyContext(*NewPolicyContextGroups(groupIDs), *NewPolicyContextUser(sim.UserID))
+	if len(sim.ZoneIDs) > 0 {
+		policyContext.Zones = &PolicyContextZones{Ids: sim.ZoneIDs}
+	}
+	if sim.IP != "" {
+		policyContext.SetIp(sim.IP)
+	}
+	if sim.RiskLevel != "" {
+		policyContext.Risk = &PolicyContextRisk{Level: PtrString(sim.RiskLevel)}
+	}
+	policyContext.Device = sim.Device
+	body := NewSimulatePolicyBody(sim.AppID)
+	body.PolicyContext = policyContext
+	body.PolicyTypes = sim.PolicyTypes
+
+	evaluations, _, err := c.PolicyAPI.CreatePolicySimulation(ctx).SimulatePolicy([]SimulatePolicyBody{*body}).Execute()
+	if err != nil {
+		return nil, err
+	}
+	result := &PolicySimulationResult{Evaluations: evaluations}
+	for _, e := range evaluations {
+		policy, ok := matchedPolicy(e.GetResult().Policies)
+		if !ok {
+			continue
+		}
+		m := PolicyMatch{PolicyID: policy.GetId(), PolicyName: policy.GetName()}
+		if len(e.PolicyType) > 0 {
+			m.PolicyType = e.PolicyType[0]
+		}
+		for _, rule := range policy.Rules {
+			if rule.GetStatus() == simulateMatch {
+				m.RuleID, m.RuleName = rule.GetId(), rule.GetName()
+				break
+			}
+		}
+		result.Matches = append(result.Matches, m)
+	}
+	return result, nil
+}
+
+func matchedPolicy(policies []SimulateResultPoliciesItems) (SimulateResultPoliciesItems, bool) {
+	for _, p := range policies {
+		if p.GetStatus() == simulateMatch {
+			return p, true
+		}
+	}
+	return SimulateResultPoliciesItems{}, false
+}
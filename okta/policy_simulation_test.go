@@ -0,0 +1,58 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Simulate_Policy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users/00u1/groups", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[{"id":"00gEveryone"},{"id":"00gSales"}]`), nil
+	})
+	var body []map[string]interface{}
+	httpmock.RegisterResponder("POST", "/api/v1/policies/simulate", func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return mockJSONResponse(200, `[
+		  {"policyType":["OKTA_SIGN_ON"],"status":"MATCH","result":{"policies":[
+		    {"id":"00pDefault","name":"Default Policy","status":"UNMATCHED"},
+		    {"id":"00pSales","name":"Sales","status":"MATCH","rules":[
+		      {"id":"0prA","name":"Off network","status":"UNMATCHED"},
+		      {"id":"0prB","name":"Corporate network","status":"MATCH"}]}]}},
+		  {"policyType":["ACCESS_POLICY"],"status":"UNMATCHED","result":{"policies":[]}}
+		]`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	result, err := client.SimulatePolicy(context.Background(), PolicySimulation{
+		UserID:  "00u1",
+		AppID:   "0oa1",
+		ZoneIDs: []string{"nzoCorp"},
+	})
+	require.NoError(t, err)
+	require.Len(t, body, 1)
+	assert.Equal(t, "0oa1", body[0]["appInstance"])
+	assert.Equal(t, map[string]interface{}{
+		"groups": map[string]interface{}{"ids": []interface{}{"00gEveryone", "00gSales"}},
+		"user":   map[string]interface{}{"id": "00u1"},
+		"zones":  map[string]interface{}{"ids": []interface{}{"nzoCorp"}},
+	}, body[0]["policyContext"])
+
+	m, ok := result.Match("OKTA_SIGN_ON")
+	require.True(t, ok)
+	assert.Equal(t, PolicyMatch{PolicyType: "OKTA_SIGN_ON", PolicyID: "00pSales", PolicyName: "Sales", RuleID: "0prB", RuleName: "Corporate network"}, m)
+	_, ok = result.Match("ACCESS_POLICY")
+	assert.False(t, ok)
+	assert.Len(t, result.Evaluations, 2)
+}
@@ -0,0 +1,394 @@
+package okta
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultIPRangesURL is where Okta publishes the IP ranges of its cells.
+const DefaultIPRangesURL = "https://s3.amazonaws.com/okta-ip-ranges/ip_ranges.json"
+
+// Steps of the connection to the org checked by Preflight.
+const (
+	PreflightDNS   = "dns"
+	PreflightProxy = "proxy"
+	PreflightTCP   = "tcp"
+	PreflightTLS   = "tls"
+)
+
+const defaultPreflightTimeout = 10 * time.Second
+
+// PreflightOptions tune Preflight.
+type PreflightOptions struct {
+	// IPRangesURL is read for the published IP ranges of Okta,
+	// DefaultIPRangesURL when empty. SkipIPRanges skips reading them.
+	IPRangesURL  string
+	SkipIPRanges bool
+	// Timeout limits each step, 10 seconds by default.
+	Timeout time.Duration
+	// RootCAs verifies the certificate of the org, the system roots when
+	// nil.
+	RootCAs *x509.CertPool
+}
+
+// PreflightCheck is the outcome of a step of the connection to the org.
+type PreflightCheck struct {
+	// Step is PreflightDNS, PreflightProxy, PreflightTCP or PreflightTLS.
+	Step string
+	// Target is the host or address the step connected to.
+	Target   string
+	Duration time.Duration
+	Err      error
+	// Hint suggests how to fix a failed step.
+	Hint string
+}
+
+// PreflightReport describes whether the org can be reached from the host
+// running the check.
+type PreflightReport struct {
+	// Host is the host and port of the org.
+	Host string
+	// Proxy is the proxy the client connects through, nil when it connects
+	// directly.
+	Proxy *url.URL
+	// Addresses are the addresses the org host resolved to. They are not
+	// resolved locally when connecting through a proxy.
+	Addresses []string
+	// Cells are the Okta cells whose published ranges hold Addresses, and
+	// Ranges the ranges of those cells, the ones to allow in egress
+	// firewalls.
+	Cells  []string
+	Ranges []string
+	// Checks are the steps of the connection, in order. They stop at the
+	// first step that failed.
+	Checks []PreflightCheck
+	// Findings are the notable conditions found, in plain text.
+	Findings []string
+}
+
+// OK reports whether every step of the connection succeeded.
+func (r *PreflightReport) OK() bool {
+	return r.Err() == nil
+}
+
+// Err returns the errors of the failed steps, nil when there are none.
+func (r *PreflightReport) Err() error {
+	var errs []error
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", check.Step, check.Target, check.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// String formats the report as plain text, one line per item.
+func (r *PreflightReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "host: %s\n", r.Host)
+	if r.Proxy != nil {
+		fmt.Fprintf(&b, "proxy: %s\n", r.Proxy.Redacted())
+	}
+	if len(r.Addresses) > 0 {
+		fmt.Fprintf(&b, "addresses: %s\n", strings.Join(r.Addresses, ", "))
+	}
+	if len(r.Cells) > 0 {
+		fmt.Fprintf(&b, "cells: %s\n", strings.Join(r.Cells, ", "))
+	}
+	for _, check := range r.Checks {
+		if check.Err == nil {
+			fmt.Fprintf(&b, "ok: %s %s (%s)\n", check.Step, check.Target, check.Duration.Round(time.Millisecond))
+			continue
+		}
+		fmt.Fprintf(&b, "failed: %s %s: %v\n", check.Step, check.Target, check.Err)
+		if check.Hint != "" {
+			fmt.Fprintf(&b, "hint: %s\n", check.Hint)
+		}
+	}
+	for _, f := range r.Findings {
+		fmt.Fprintf(&b, "finding: %s\n", f)
+	}
+	return b.String()
+}
+
+func (r *PreflightReport) finding(format string, args ...interface{}) {
+	r.Findings = append(r.Findings, fmt.Sprintf(format, args...))
+}
+
+// check runs a step, recording its outcome, and reports whether it succeeded.
+func (r *PreflightReport) check(step, target string, hint func(error) string, run func() error) bool {
+	start := time.Now()
+	err := run()
+	check := PreflightCheck{Step: step, Target: target, Duration: time.Since(start), Err: err}
+	if err != nil {
+		check.Hint = hint(err)
+	}
+	r.Checks = append(r.Checks, check)
+	return err == nil
+}
+
+// Preflight checks that the org can be reached the way the client connects to
+// it, before workloads depending on it start: it resolves the org host, opens
+// a TCP connection to it, or a tunnel through the configured proxy, and
+// completes a TLS handshake. The resolved addresses are matched against the
+// published IP ranges of Okta to tell the ranges egress firewalls must allow.
+// Failed steps come with a hint at what to fix. Nothing is sent to the API, so
+// no token is needed.
+func (c *APIClient) Preflight(ctx context.Context, opts PreflightOptions) (*PreflightReport, error) {
+	orgURL, err := url.Parse(c.cfg.Okta.Client.OrgUrl)
+	if err != nil {
+		return nil, err
+	}
+	if orgURL.Hostname() == "" {
+		return nil, fmt.Errorf("org url %q has no host", c.cfg.Okta.Client.OrgUrl)
+	}
+	host := orgURL.Hostname()
+	port := orgURL.Port()
+	if port == "" {
+		port = "443"
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultPreflightTimeout
+	}
+	report := &PreflightReport{Host: net.JoinHostPort(host, port)}
+	report.Proxy, err = c.preflightProxy(orgURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if report.Proxy == nil {
+		ok := report.check(PreflightDNS, host, func(err error) string {
+			return fmt.Sprintf("check that the DNS resolvers of this host can resolve %s, or configure the proxy the network requires with WithProxyHost", host)
+		}, func() error {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+			report.Addresses = addrs
+			return err
+		})
+		if !ok {
+			return report, nil
+		}
+	}
+	if !opts.SkipIPRanges && len(report.Addresses) > 0 {
+		c.preflightRanges(ctx, report, opts.IPRangesURL, timeout)
+	}
+
+	var conn net.Conn
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+	dialer := &net.Dialer{Timeout: timeout}
+	if report.Proxy != nil {
+		proxyHost := report.Proxy.Host
+		if report.Proxy.Port() == "" {
+			proxyHost = net.JoinHostPort(report.Proxy.Hostname(), "80")
+		}
+		ok := report.check(PreflightTCP, proxyHost, func(err error) string {
+			return fmt.Sprintf("check that the proxy %s is up and that egress firewalls allow this host to reach it", proxyHost)
+		}, func() error {
+			conn, err = dialer.DialContext(ctx, "tcp", proxyHost)
+			return err
+		})
+		if !ok {
+			return report, nil
+		}
+		if report.Proxy.Scheme == "https" {
+			tlsConn := tls.Client(conn, &tls.Config{ServerName: report.Proxy.Hostname(), RootCAs: opts.RootCAs})
+			conn = tlsConn
+			ok := report.check(PreflightTLS, proxyHost, preflightTLSHint, func() error {
+				ctx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+				return tlsConn.HandshakeContext(ctx)
+			})
+			if !ok {
+				return report, nil
+			}
+		}
+		ok = report.check(PreflightProxy, report.Host, preflightProxyHint(report.Host), func() error {
+			return preflightConnect(conn, report.Proxy, report.Host, timeout)
+		})
+		if !ok {
+			return report, nil
+		}
+	} else {
+		ok := report.check(PreflightTCP, report.Host, func(err error) string {
+			return preflightTCPHint(report, err)
+		}, func() error {
+			conn, err = dialer.DialContext(ctx, "tcp", report.Host)
+			return err
+		})
+		if !ok {
+			return report, nil
+		}
+	}
+
+	tlsConn := tls.Client(conn, &tls.Config{ServerName: host, RootCAs: opts.RootCAs})
+	conn = tlsConn
+	report.check(PreflightTLS, report.Host, preflightTLSHint, func() error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return tlsConn.HandshakeContext(ctx)
+	})
+	return report, nil
+}
+
+// preflightProxy returns the proxy the client connects to the org through:
+// the one of the configuration, or the one of the environment when the client
+// uses the default transport.
+func (c *APIClient) preflightProxy(orgURL *url.URL) (*url.URL, error) {
+	proxy := c.cfg.Okta.Client.Proxy
+	if proxy.Host != "" {
+		proxyURL := &url.URL{Scheme: "http", Host: fmt.Sprintf("%v:%v", proxy.Host, proxy.Port)}
+		if proxy.Username != "" {
+			proxyURL.User = url.UserPassword(proxy.Username, proxy.Password)
+		}
+		return proxyURL, nil
+	}
+	if c.cfg.Transport != nil {
+		return nil, nil
+	}
+	return http.ProxyFromEnvironment(&http.Request{URL: orgURL})
+}
+
+// preflightConnect opens a tunnel to host through the proxy connected to by
+// conn.
+func preflightConnect(conn net.Conn, proxy *url.URL, host string, timeout time.Duration) error {
+	conn.SetDeadline(time.Now().Add(timeout))
+	defer conn.SetDeadline(time.Time{})
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: host},
+		Host:   host,
+		Header: http.Header{},
+	}
+	if user := proxy.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		return err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return fmt.Errorf("proxy answered %s", resp.Status)
+	}
+	return nil
+}
+
+// preflightRanges matches the addresses of the report against the published IP
+// ranges of Okta. Failures are recorded as findings, as the ranges are only
+// informative.
+func (c *APIClient) preflightRanges(ctx context.Context, report *PreflightReport, rangesURL string, timeout time.Duration) {
+	if rangesURL == "" {
+		rangesURL = DefaultIPRangesURL
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rangesURL, nil)
+	if err != nil {
+		report.finding("could not read the published IP ranges: %v", err)
+		return
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		report.finding("could not read the published IP ranges from %s: %v", rangesURL, err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		report.finding("could not read the published IP ranges from %s: %s", rangesURL, resp.Status)
+		return
+	}
+	var cells map[string]struct {
+		IPRanges []string `json:"ip_ranges"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&cells); err != nil {
+		report.finding("could not read the published IP ranges from %s: %v", rangesURL, err)
+		return
+	}
+
+	names := make([]string, 0, len(cells))
+	for name := range cells {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	matched := map[string]bool{}
+	for _, addr := range report.Addresses {
+		ip := net.ParseIP(addr)
+		found := false
+		for _, name := range names {
+			for _, cidr := range cells[name].IPRanges {
+				_, ipNet, err := net.ParseCIDR(cidr)
+				if err != nil || !ipNet.Contains(ip) {
+					continue
+				}
+				found = true
+				if !matched[name] {
+					matched[name] = true
+					report.Cells = append(report.Cells, name)
+					report.Ranges = append(report.Ranges, cells[name].IPRanges...)
+				}
+			}
+		}
+		if !found {
+			report.finding("address %s of %s is not in the published IP ranges of Okta, the org may be behind a custom domain or a CDN", addr, report.Host)
+		}
+	}
+}
+
+func preflightTCPHint(report *PreflightReport, err error) string {
+	hint := fmt.Sprintf("allow outbound TCP to %s in egress firewalls and security groups", report.Host)
+	if len(report.Cells) > 0 {
+		hint += fmt.Sprintf(", the published IP ranges of %s", strings.Join(report.Cells, ", "))
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		hint += "; the connection timed out, which usually means packets are dropped rather than refused"
+	}
+	return hint + ", or configure the proxy the network requires with WithProxyHost"
+}
+
+func preflightProxyHint(host string) func(error) string {
+	return func(err error) string {
+		switch {
+		case strings.Contains(err.Error(), "407"):
+			return "the proxy requires authentication, set WithProxyUsername and WithProxyPassword"
+		case strings.Contains(err.Error(), "403"):
+			return fmt.Sprintf("the proxy denies %s, ask for it to be added to the allowlist of the proxy", host)
+		}
+		return fmt.Sprintf("check that the proxy allows CONNECT tunnels to %s", host)
+	}
+}
+
+func preflightTLSHint(err error) string {
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	switch {
+	case errors.As(err, &unknownAuthority):
+		return "the certificate is not signed by a trusted authority, a TLS inspecting proxy or firewall may be intercepting the connection: exempt the org from inspection or add its CA to the trust store of this host"
+	case errors.As(err, &hostname):
+		return "the certificate does not match the host, the connection may be redirected to another server: check the org URL and any TLS inspecting proxy"
+	}
+	return "check that nothing on the path closes or intercepts TLS connections, such as a firewall inspecting traffic"
+}
@@ -0,0 +1,138 @@
+package okta
+
+import (
+	"context"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connectProxy serves CONNECT tunnels for clients authenticating as user.
+func connectProxy(t *testing.T, user, password string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+			return
+		}
+		if u, p, ok := parseProxyAuth(r); !ok || u != user || p != password {
+			w.WriteHeader(http.StatusProxyAuthRequired)
+			return
+		}
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		require.NoError(t, err)
+		go func() {
+			io.Copy(upstream, buf)
+			upstream.Close()
+		}()
+		io.Copy(conn, upstream)
+		conn.Close()
+	}))
+}
+
+func parseProxyAuth(r *http.Request) (string, string, bool) {
+	req := &http.Request{Header: http.Header{"Authorization": r.Header["Proxy-Authorization"]}}
+	return req.BasicAuth()
+}
+
+func preflightClient(t *testing.T, org *httptest.Server, setters ...ConfigSetter) (*APIClient, *x509.CertPool) {
+	setters = append([]ConfigSetter{WithOrgUrl(org.URL), WithToken("token"), WithCache(false)}, setters...)
+	cfg, err := NewConfiguration(setters...)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(org.Certificate())
+	return NewAPIClient(cfg), roots
+}
+
+func TestPreflight(t *testing.T) {
+	org := httptest.NewTLSServer(http.NotFoundHandler())
+	defer org.Close()
+	ranges := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"us_cell_1":{"ip_ranges":["198.51.100.0/24"]},"test_cell":{"ip_ranges":["127.0.0.0/8","192.0.2.0/24"]}}`))
+	}))
+	defer ranges.Close()
+	client, roots := preflightClient(t, org)
+
+	report, err := client.Preflight(context.Background(), PreflightOptions{IPRangesURL: ranges.URL, RootCAs: roots})
+	require.NoError(t, err)
+	require.NoError(t, report.Err(), report.String())
+	assert.True(t, report.OK())
+	assert.Nil(t, report.Proxy)
+	assert.Equal(t, []string{"127.0.0.1"}, report.Addresses)
+	assert.Equal(t, []string{"test_cell"}, report.Cells)
+	assert.Equal(t, []string{"127.0.0.0/8", "192.0.2.0/24"}, report.Ranges)
+	var steps []string
+	for _, check := range report.Checks {
+		steps = append(steps, check.Step)
+	}
+	assert.Equal(t, []string{PreflightDNS, PreflightTCP, PreflightTLS}, steps)
+	assert.Empty(t, report.Findings)
+
+	// Without the test CA the certificate of the org looks intercepted.
+	report, err = client.Preflight(context.Background(), PreflightOptions{SkipIPRanges: true})
+	require.NoError(t, err)
+	require.False(t, report.OK())
+	failed := report.Checks[len(report.Checks)-1]
+	assert.Equal(t, PreflightTLS, failed.Step)
+	assert.Contains(t, failed.Hint, "TLS inspecting proxy")
+	assert.Contains(t, report.String(), "failed: tls "+report.Host)
+}
+
+func TestPreflightUnreachable(t *testing.T) {
+	org := httptest.NewTLSServer(http.NotFoundHandler())
+	client, _ := preflightClient(t, org)
+	org.Close()
+
+	report, err := client.Preflight(context.Background(), PreflightOptions{SkipIPRanges: true})
+	require.NoError(t, err)
+	require.Error(t, report.Err())
+	failed := report.Checks[len(report.Checks)-1]
+	assert.Equal(t, PreflightTCP, failed.Step)
+	assert.Contains(t, failed.Hint, "allow outbound TCP to "+report.Host)
+}
+
+func TestPreflightProxy(t *testing.T) {
+	org := httptest.NewTLSServer(http.NotFoundHandler())
+	defer org.Close()
+	proxy := connectProxy(t, "user", "secret")
+	defer proxy.Close()
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(proxyURL.Port())
+	require.NoError(t, err)
+
+	client, roots := preflightClient(t, org, WithProxyHost(proxyURL.Hostname()), WithProxyPort(int32(port)))
+	report, err := client.Preflight(context.Background(), PreflightOptions{RootCAs: roots})
+	require.NoError(t, err)
+	require.False(t, report.OK())
+	failed := report.Checks[len(report.Checks)-1]
+	assert.Equal(t, PreflightProxy, failed.Step)
+	assert.Contains(t, failed.Hint, "WithProxyUsername")
+
+	client, roots = preflightClient(t, org, WithProxyHost(proxyURL.Hostname()), WithProxyPort(int32(port)),
+		WithProxyUsername("user"), WithProxyPassword("secret"))
+	report, err = client.Preflight(context.Background(), PreflightOptions{RootCAs: roots})
+	require.NoError(t, err)
+	require.NoError(t, report.Err(), report.String())
+	assert.Equal(t, proxyURL.Host, report.Proxy.Host)
+	assert.Empty(t, report.Addresses)
+	var steps []string
+	for _, check := range report.Checks {
+		steps = append(steps, check.Step)
+	}
+	assert.Equal(t, []string{PreflightTCP, PreflightProxy, PreflightTLS}, steps)
+	assert.NotContains(t, report.String(), "secret")
+}
@@ -0,0 +1,99 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+const userProvisioningFeature = "USER_PROVISIONING"
+
+// ProvisioningSpec describes the provisioning of users to an app enabled by
+// EnableProvisioning.
+type ProvisioningSpec struct {
+	// Token authenticates Okta to the app with the TOKEN scheme. When it is
+	// empty the existing default connection is activated, for example one
+	// authorized with OAuth 2.0 in the Admin Console.
+	Token string
+	// BaseURL is the base URL of the app for token based connections.
+	BaseURL string
+	// CreateUsers pushes new users to the app.
+	CreateUsers bool
+	// UpdateProfiles pushes profile changes to the app.
+	UpdateProfiles bool
+	// DeactivateUsers deactivates users in the app when they are unassigned
+	// or deactivated in Okta.
+	DeactivateUsers bool
+	// SyncPasswords pushes the Okta password of users to the app, or a random
+	// password when RandomPasswords is set.
+	SyncPasswords   bool
+	RandomPasswords bool
+}
+
+// EnableProvisioning sets up the default provisioning connection of an app,
+// enables the capabilities of the spec on its USER_PROVISIONING feature and
+// checks that the connection ended up enabled. The connection is returned
+// along with an error if it is not.
+func (c *APIClient) EnableProvisioning(ctx context.Context, appID string, spec ProvisioningSpec) (*ProvisioningConnectionResponse, error) {
+	if spec.Token != "" {
+		profile := NewProvisioningConnectionTokenRequestProfile("TOKEN")
+		profile.SetToken(spec.Token)
+		body := NewProvisioningConnectionTokenRequest(*profile)
+		if spec.BaseURL != "" {
+			body.SetBaseUrl(spec.BaseURL)
+		}
+		_, _, err := c.ApplicationConnectionsAPI.UpdateDefaultProvisioningConnectionForApplication(ctx, appID).
+			UpdateDefaultProvisioningConnectionForApplicationRequest(ProvisioningConnectionTokenRequestAsUpdateDefaultProvisioningConnectionForApplicationRequest(body)).
+			Activate(true).
+			Execute()
+		if err != nil {
+			return nil, fmt.Errorf("update provisioning connection: %w", err)
+		}
+	} else if _, err := c.ApplicationConnectionsAPI.ActivateDefaultProvisioningConnectionForApplication(ctx, appID).Execute(); err != nil {
+		return nil, fmt.Errorf("activate provisioning connection: %w", err)
+	}
+
+	capabilities := spec.capabilities()
+	_, _, err := c.ApplicationFeaturesAPI.UpdateFeatureForApplication(ctx, appID, userProvisioningFeature).
+		UpdateFeatureForApplicationRequest(CapabilitiesObjectAsUpdateFeatureForApplicationRequest(capabilities)).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("update provisioning capabilities: %w", err)
+	}
+
+	conn, _, err := c.ApplicationConnectionsAPI.GetDefaultProvisioningConnectionForApplication(ctx, appID).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get provisioning connection: %w", err)
+	}
+	if conn.GetStatus() != "ENABLED" {
+		return conn, fmt.Errorf("provisioning connection of app %s is %s", appID, conn.GetStatus())
+	}
+	return conn, nil
+}
+
+func (spec ProvisioningSpec) capabilities() *CapabilitiesObject {
+	status := func(enabled bool) *string {
+		if enabled {
+			return PtrString("ENABLED")
+		}
+		return PtrString("DISABLED")
+	}
+	password := NewPasswordSettingObject()
+	password.Status = status(spec.SyncPasswords)
+	if spec.SyncPasswords {
+		password.SetChange("CHANGE")
+		password.SetSeed("OKTA")
+		if spec.RandomPasswords {
+			password.SetSeed("RANDOM")
+		}
+	}
+	capabilities := NewCapabilitiesObject()
+	capabilities.Create = &CapabilitiesCreateObject{
+		LifecycleCreate: &LifecycleCreateSettingObject{Status: status(spec.CreateUsers)},
+	}
+	capabilities.Update = &CapabilitiesUpdateObject{
+		LifecycleDeactivate: &LifecycleDeactivateSettingObject{Status: status(spec.DeactivateUsers)},
+		Password:            password,
+		Profile:             &ProfileSettingObject{Status: status(spec.UpdateProfiles)},
+	}
+	return capabilities
+}
@@ -0,0 +1,56 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Enable_Provisioning(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var connection, feature map[string]interface{}
+	status := "ENABLED"
+	httpmock.RegisterResponder("POST", "/api/v1/apps/0oa1/connections/default", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "true", req.URL.Query().Get("activate"))
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&connection))
+		return mockJSONResponse(200, `{"authScheme":"TOKEN","status":"ENABLED","profile":{"authScheme":"TOKEN"}}`), nil
+	})
+	httpmock.RegisterResponder("PUT", "/api/v1/apps/0oa1/features/USER_PROVISIONING", func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&feature))
+		return mockJSONResponse(200, `{"name":"USER_PROVISIONING","status":"ENABLED"}`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/apps/0oa1/connections/default", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"authScheme":"TOKEN","status":"`+status+`","profile":{"authScheme":"TOKEN"}}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	spec := ProvisioningSpec{Token: "scim-token", BaseURL: "https://scim.example.com", CreateUsers: true, DeactivateUsers: true}
+	conn, err := client.EnableProvisioning(context.Background(), "0oa1", spec)
+	require.NoError(t, err)
+	assert.Equal(t, "ENABLED", conn.GetStatus())
+	assert.Equal(t, "https://scim.example.com", connection["baseUrl"])
+	assert.Equal(t, map[string]interface{}{"authScheme": "TOKEN", "token": "scim-token"}, connection["profile"])
+	assert.Equal(t, map[string]interface{}{
+		"create": map[string]interface{}{"lifecycleCreate": map[string]interface{}{"status": "ENABLED"}},
+		"update": map[string]interface{}{
+			"lifecycleDeactivate": map[string]interface{}{"status": "ENABLED"},
+			"password":            map[string]interface{}{"change": "KEEP_EXISTING", "seed": "RANDOM", "status": "DISABLED"},
+			"profile":             map[string]interface{}{"status": "DISABLED"},
+		},
+	}, feature)
+
+	status = "DISABLED"
+	conn, err = client.EnableProvisioning(context.Background(), "0oa1", spec)
+	require.Error(t, err, "A connection that is not enabled should be reported")
+	assert.Equal(t, "DISABLED", conn.GetStatus())
+}
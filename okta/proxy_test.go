@@ -1,6 +1,7 @@
 package okta
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -8,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,3 +45,36 @@ func Test_Config_Proxy(t *testing.T) {
 	require.NoError(t, err, "Read http response should not error")
 	assert.Equal(t, "This is proxy server end point", string(b))
 }
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func Test_Config_Transport(t *testing.T) {
+	var hosts []string
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		hosts = append(hosts, req.URL.Host)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"00u1"}`)),
+			Request:    req,
+		}, nil
+	})
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false),
+		WithHttpClientPtr(&http.Client{Timeout: time.Minute}),
+		WithProxyHost("proxy.example.com"),
+		WithTransport(transport),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	assert.Equal(t, time.Minute, client.GetConfig().HTTPClient.Timeout, "The other settings of the HTTP client are kept")
+
+	user, _, err := client.UserAPI.GetUser(context.Background(), "00u1").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "00u1", user.GetId())
+	assert.Equal(t, []string{"example.okta.com"}, hosts, "The proxy is left to the transport")
+}
@@ -0,0 +1,189 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// oktaIDPattern matches the ids of Okta resources, such as 00u1a2b3c4d5e6f7g8h9.
+var oktaIDPattern = regexp.MustCompile(`^0[0-9A-Za-z]{19}$`)
+
+// rateLimitBucket returns the key the rate limit of a request is tracked by.
+// Okta limits requests per org and endpoint, so the key is the host and the
+// path of the request with resource ids and logins replaced.
+func rateLimitBucket(req *http.Request) string {
+	return req.URL.Host + genericPath(req.URL.Path)
+}
+
+// genericPath replaces the resource ids and logins in path with {id}.
+func genericPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if oktaIDPattern.MatchString(s) || strings.Contains(s, "@") {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// bucketLimit is the rate limit of a bucket as of its last response.
+type bucketLimit struct {
+	limit     int
+	remaining int
+	resetAt   time.Time
+	// nextAt is when the next request may be sent while paced by the
+	// headroom.
+	nextAt time.Time
+}
+
+// rateLimitTracked reports whether the client tracks the rate limits of the
+// responses to wait before requests.
+func (c *APIClient) rateLimitTracked() bool {
+	return c.cfg.Okta.Client.RateLimit.Enable || c.cfg.Okta.Client.RateLimit.Headroom > 0
+}
+
+// RateLimits returns the rate limits of the endpoints the client called, by
+// host and path with resource ids replaced by {id}, such as
+// "acme.okta.com/api/v1/users/{id}". Reset is the number of seconds until
+// the limit resets. Limits are only tracked with WithRateLimitPrevent or
+// WithRateLimitHeadroom.
+func (c *APIClient) RateLimits() map[string]RateLimit {
+	c.rateLimitLock.Lock()
+	defer c.rateLimitLock.Unlock()
+	limits := make(map[string]RateLimit, len(c.rateLimits))
+	now := time.Now()
+	for bucket, limit := range c.rateLimits {
+		reset := int64(0)
+		if d := limit.resetAt.Sub(now); d > 0 {
+			reset = int64((d + time.Second - 1) / time.Second)
+		}
+		limits[bucket] = RateLimit{Limit: limit.limit, Remaining: limit.remaining, Reset: reset}
+	}
+	return limits
+}
+
+// waitForRateLimit takes a request from the remaining requests of bucket,
+// or blocks until the limit of bucket resets when they are exhausted. Below
+// the headroom, requests are spaced to spread the remaining ones until the
+// reset. Other buckets are not affected.
+func (c *APIClient) waitForRateLimit(ctx context.Context, bucket string) error {
+	c.rateLimitLock.Lock()
+	limit := c.rateLimits[bucket]
+	var wait time.Duration
+	if limit != nil {
+		now := time.Now()
+		untilReset := limit.resetAt.Sub(now)
+		if untilReset <= 0 || limit.remaining > 0 {
+			wait = c.pace(limit, now, untilReset)
+			// concurrent callers don't all take the last request
+			limit.remaining--
+		} else {
+			wait = untilReset
+		}
+	}
+	c.rateLimitLock.Unlock()
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// pace returns how long a request of limit waits to spread the remaining
+// requests evenly until the reset, once they are below the headroom.
+func (c *APIClient) pace(limit *bucketLimit, now time.Time, untilReset time.Duration) time.Duration {
+	headroom := c.cfg.Okta.Client.RateLimit.Headroom
+	if headroom <= 0 || untilReset <= 0 || limit.remaining <= 0 || float64(limit.remaining) >= headroom*float64(limit.limit) {
+		return 0
+	}
+	start := now
+	if limit.nextAt.After(now) {
+		start = limit.nextAt
+	}
+	limit.nextAt = start.Add(untilReset / time.Duration(limit.remaining))
+	return start.Sub(now)
+}
+
+// RateLimitEvent is the rate limit of a response, as reported to a
+// RateLimitObserver.
+type RateLimitEvent struct {
+	// Bucket is the host and path the limit applies to, with resource ids
+	// replaced by {id}, as keyed in RateLimits.
+	Bucket    string
+	Method    string
+	Limit     int
+	Remaining int
+	// Reset is when the limit resets.
+	Reset time.Time
+	// Limited reports whether the request was rejected with a 429.
+	Limited bool
+}
+
+// RateLimitObserver is notified of the rate limit of every response,
+// including retried attempts and writes, for example to export gauges and
+// alert before the org hits its limits.
+type RateLimitObserver interface {
+	ObserveRateLimit(ctx context.Context, event RateLimitEvent)
+}
+
+// RateLimitObserverFunc adapts a function to the RateLimitObserver
+// interface.
+type RateLimitObserverFunc func(ctx context.Context, event RateLimitEvent)
+
+func (f RateLimitObserverFunc) ObserveRateLimit(ctx context.Context, event RateLimitEvent) {
+	f(ctx, event)
+}
+
+// recordRateLimit records the rate limit headers of the response to an
+// attempt of req, tracking them when the client waits for rate limits and
+// reporting them to the RateLimitObserver of the configuration. Every
+// response carries them, including writes and 429 responses.
+func (c *APIClient) recordRateLimit(ctx context.Context, req *http.Request, resp *http.Response) {
+	observer := c.cfg.RateLimitObserver
+	if resp == nil || (observer == nil && !c.rateLimitTracked()) {
+		return
+	}
+	limit, err := c.parseLimitHeaders(resp)
+	if err != nil {
+		return
+	}
+	bucket := rateLimitBucket(req)
+	resetAt := time.Now().Add(time.Duration(limit.Reset) * time.Second)
+	if c.rateLimitTracked() {
+		c.updateRateLimit(bucket, limit, resetAt)
+	}
+	if observer != nil {
+		observer.ObserveRateLimit(ctx, RateLimitEvent{
+			Bucket:    bucket,
+			Method:    req.Method,
+			Limit:     limit.Limit,
+			Remaining: limit.Remaining,
+			Reset:     resetAt,
+			Limited:   resp.StatusCode == http.StatusTooManyRequests,
+		})
+	}
+}
+
+// updateRateLimit records the rate limit of a response for bucket.
+func (c *APIClient) updateRateLimit(bucket string, limit *RateLimit, resetAt time.Time) {
+	c.rateLimitLock.Lock()
+	updated := &bucketLimit{
+		limit:     limit.Limit,
+		remaining: limit.Remaining,
+		resetAt:   resetAt,
+	}
+	if previous := c.rateLimits[bucket]; previous != nil {
+		updated.nextAt = previous.nextAt
+	}
+	c.rateLimits[bucket] = updated
+	c.rateLimitLock.Unlock()
+}
@@ -0,0 +1,200 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// Types of the principals Okta assigns a share of the org rate limits to.
+const (
+	PrincipalTypeAPIToken    = "SSWS_TOKEN"
+	PrincipalTypeOAuthClient = "OAUTH_CLIENT"
+)
+
+// DefaultPrincipalPercentage is the share of the org rate limits, in percent,
+// a principal gets when no rate limit entity was configured for it.
+const DefaultPrincipalPercentage = 50
+
+// RateLimitSettings are the rate limit settings configured by the admins of an
+// org.
+type RateLimitSettings struct {
+	// Principals are the rate limit entities of API tokens and OAuth clients.
+	Principals []PrincipalRateLimitEntity
+	// PerClient is the per client rate limit mode of OAuth clients.
+	PerClient *PerClientRateLimitSettings
+	// WarningThreshold is the percentage of a rate limit at which admins are
+	// warned.
+	WarningThreshold int32
+	// NotificationsEnabled reports whether admins are notified when a rate
+	// limit is reached.
+	NotificationsEnabled bool
+}
+
+// Principal returns the rate limit entity of a principal, or nil when none was
+// configured.
+func (s *RateLimitSettings) Principal(principalType, principalID string) *PrincipalRateLimitEntity {
+	for i := range s.Principals {
+		if s.Principals[i].PrincipalType == principalType && s.Principals[i].PrincipalId == principalID {
+			return &s.Principals[i]
+		}
+	}
+	return nil
+}
+
+// AutomationPrincipal is an API token or OAuth client used by automation, and
+// the number of requests it sends at the same time.
+type AutomationPrincipal struct {
+	// Type is PrincipalTypeAPIToken or PrincipalTypeOAuthClient.
+	Type string
+	// ID is the id of the API token or the client id of the OAuth client.
+	ID string
+	// Concurrency is the number of concurrent requests the principal is
+	// configured to send.
+	Concurrency int
+}
+
+// RateLimitWarning reports a principal whose share of the concurrent request
+// limit of the org is lower than the concurrency it is configured for.
+type RateLimitWarning struct {
+	Principal AutomationPrincipal
+	// Entity is the configured rate limit entity of the principal, nil when
+	// the principal has the default share.
+	Entity *PrincipalRateLimitEntity
+	// Percentage is the share of the concurrent request limit the principal
+	// has, and Allowed the number of concurrent requests it amounts to.
+	Percentage int32
+	Allowed    int
+	// Recommended is the smallest share that allows the configured
+	// concurrency.
+	Recommended int32
+}
+
+func (w RateLimitWarning) String() string {
+	return fmt.Sprintf("%s %s is configured for %d concurrent requests but is capped at %d (%d%%), %d%% is recommended",
+		w.Principal.Type, w.Principal.ID, w.Principal.Concurrency, w.Allowed, w.Percentage, w.Recommended)
+}
+
+// RateLimitPlan is the outcome of RateLimitPlanner.Plan.
+type RateLimitPlan struct {
+	Settings *RateLimitSettings
+	Warnings []RateLimitWarning
+}
+
+// RateLimitPlanner checks the rate limit settings of an org against the
+// concurrency automation is configured for, and applies the settings it
+// recommends.
+type RateLimitPlanner struct {
+	client *APIClient
+	// ConcurrencyLimit is the number of concurrent requests the org allows,
+	// which depends on its edition. Principal percentages are a share of it.
+	ConcurrencyLimit int
+}
+
+// RateLimitPlanner returns a RateLimitPlanner for an org allowing
+// concurrencyLimit concurrent requests.
+func (c *APIClient) RateLimitPlanner(concurrencyLimit int) *RateLimitPlanner {
+	return &RateLimitPlanner{client: c, ConcurrencyLimit: concurrencyLimit}
+}
+
+// Read returns the rate limit settings currently configured for the org.
+func (p *RateLimitPlanner) Read(ctx context.Context) (*RateLimitSettings, error) {
+	c := p.client
+	settings := &RateLimitSettings{}
+	for _, principalType := range []string{PrincipalTypeAPIToken, PrincipalTypeOAuthClient} {
+		query := url.Values{}
+		query.Set("filter", fmt.Sprintf("principalType eq %q", principalType))
+		err := listPages(ctx, c, "/api/v1/principal-rate-limits", query, map[string]string{"Accept": "application/json"}, func(page []PrincipalRateLimitEntity) error {
+			settings.Principals = append(settings.Principals, page...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	perClient, _, err := c.RateLimitSettingsAPI.GetRateLimitSettingsPerClient(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	settings.PerClient = perClient
+	threshold, _, err := c.RateLimitSettingsAPI.GetRateLimitSettingsWarningThreshold(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	settings.WarningThreshold = threshold.GetWarningThreshold()
+	notifications, _, err := c.RateLimitSettingsAPI.GetRateLimitSettingsAdminNotifications(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	settings.NotificationsEnabled = notifications.NotificationsEnabled
+	return settings, nil
+}
+
+// Check returns a warning for every principal whose share of the concurrent
+// request limit is lower than its concurrency.
+func (p *RateLimitPlanner) Check(settings *RateLimitSettings, principals ...AutomationPrincipal) []RateLimitWarning {
+	var warnings []RateLimitWarning
+	for _, principal := range principals {
+		entity := settings.Principal(principal.Type, principal.ID)
+		percentage := int32(DefaultPrincipalPercentage)
+		if entity != nil && entity.DefaultConcurrencyPercentage != nil {
+			percentage = *entity.DefaultConcurrencyPercentage
+		}
+		allowed := p.ConcurrencyLimit * int(percentage) / 100
+		if allowed >= principal.Concurrency {
+			continue
+		}
+		recommended := int32((principal.Concurrency*100 + p.ConcurrencyLimit - 1) / p.ConcurrencyLimit)
+		if recommended > 100 {
+			recommended = 100
+		}
+		warnings = append(warnings, RateLimitWarning{
+			Principal:   principal,
+			Entity:      entity,
+			Percentage:  percentage,
+			Allowed:     allowed,
+			Recommended: recommended,
+		})
+	}
+	return warnings
+}
+
+// Plan reads the rate limit settings of the org and checks them against the
+// given principals.
+func (p *RateLimitPlanner) Plan(ctx context.Context, principals ...AutomationPrincipal) (*RateLimitPlan, error) {
+	if p.ConcurrencyLimit <= 0 {
+		return nil, errors.New("rate limit planner requires the concurrency limit of the org")
+	}
+	settings, err := p.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &RateLimitPlan{Settings: settings, Warnings: p.Check(settings, principals...)}, nil
+}
+
+// Apply raises the concurrency percentage of every principal of the plan's
+// warnings to the recommended share. Principals with the default share get a
+// rate limit entity keeping the default rate percentage. All principals are
+// updated and the errors joined.
+func (p *RateLimitPlanner) Apply(ctx context.Context, plan *RateLimitPlan) error {
+	api := p.client.PrincipalRateLimitAPI
+	var errs []error
+	for _, w := range plan.Warnings {
+		var err error
+		if w.Entity == nil {
+			entity := NewPrincipalRateLimitEntity(w.Principal.ID, w.Principal.Type)
+			entity.SetDefaultPercentage(DefaultPrincipalPercentage)
+			entity.SetDefaultConcurrencyPercentage(w.Recommended)
+			_, _, err = api.CreatePrincipalRateLimitEntity(ctx).Entity(*entity).Execute()
+		} else {
+			entity := *w.Entity
+			entity.SetDefaultConcurrencyPercentage(w.Recommended)
+			_, _, err = api.ReplacePrincipalRateLimitEntity(ctx, entity.GetId()).Entity(entity).Execute()
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", w.Principal.Type, w.Principal.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
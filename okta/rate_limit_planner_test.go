@@ -0,0 +1,66 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Rate_Limit_Planner(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/principal-rate-limits", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("filter") == `principalType eq "SSWS_TOKEN"` {
+			return mockJSONResponse(200, `[{"id":"0e8Token","principalId":"00TSync","principalType":"SSWS_TOKEN","defaultPercentage":50,"defaultConcurrencyPercentage":10}]`), nil
+		}
+		return mockJSONResponse(200, `[]`), nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/rate-limit-settings/per-client", httpmock.ResponderFromResponse(mockJSONResponse(200, `{"defaultMode":"ENFORCE"}`)))
+	httpmock.RegisterResponder("GET", "/api/v1/rate-limit-settings/warning-threshold", httpmock.ResponderFromResponse(mockJSONResponse(200, `{"warningThreshold":90}`)))
+	httpmock.RegisterResponder("GET", "/api/v1/rate-limit-settings/admin-notifications", httpmock.ResponderFromResponse(mockJSONResponse(200, `{"notificationsEnabled":true}`)))
+	var replaced, created PrincipalRateLimitEntity
+	httpmock.RegisterResponder("PUT", "/api/v1/principal-rate-limits/0e8Token", func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&replaced))
+		return mockJSONResponse(200, `{}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/principal-rate-limits", func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&created))
+		return mockJSONResponse(201, `{}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	planner := client.RateLimitPlanner(75)
+	plan, err := planner.Plan(ctx,
+		AutomationPrincipal{Type: PrincipalTypeAPIToken, ID: "00TSync", Concurrency: 20},
+		AutomationPrincipal{Type: PrincipalTypeOAuthClient, ID: "0oaReports", Concurrency: 50},
+		AutomationPrincipal{Type: PrincipalTypeOAuthClient, ID: "0oaSmall", Concurrency: 5},
+	)
+	require.NoError(t, err)
+	assert.Len(t, plan.Settings.Principals, 1)
+	assert.Equal(t, "ENFORCE", plan.Settings.PerClient.DefaultMode)
+	assert.Equal(t, int32(90), plan.Settings.WarningThreshold)
+	assert.True(t, plan.Settings.NotificationsEnabled)
+
+	require.Len(t, plan.Warnings, 2)
+	assert.Equal(t, 7, plan.Warnings[0].Allowed)
+	assert.Equal(t, int32(27), plan.Warnings[0].Recommended)
+	assert.Nil(t, plan.Warnings[1].Entity, "Principals without an entity have the default share")
+	assert.Equal(t, int32(DefaultPrincipalPercentage), plan.Warnings[1].Percentage)
+	assert.Equal(t, int32(67), plan.Warnings[1].Recommended)
+
+	require.NoError(t, planner.Apply(ctx, plan))
+	assert.Equal(t, int32(27), replaced.GetDefaultConcurrencyPercentage())
+	assert.Equal(t, int32(50), replaced.GetDefaultPercentage(), "The rate percentage is kept")
+	assert.Equal(t, "0oaReports", created.PrincipalId)
+	assert.Equal(t, int32(67), created.GetDefaultConcurrencyPercentage())
+}
@@ -0,0 +1,35 @@
+package okta
+
+import (
+	"context"
+	"net/url"
+)
+
+// Do sends a request to an endpoint the generated services do not cover yet,
+// such as a beta API, with the authorization, retries, rate limiting, caching
+// and errors of the generated methods. path is relative to the org URL, as
+// "/api/v1/users?limit=2", or a URL of the org such as a next link.
+//
+// A body that is not nil is sent as JSON: strings, []byte and io.Reader as
+// they are, other values encoded. The JSON response is decoded into out
+// unless it is nil. Like the generated methods, the returned APIResponse gets
+// the following pages of a list with Next.
+func (c *APIClient) Do(ctx context.Context, method, path string, body, out interface{}) (*APIResponse, error) {
+	headers := map[string]string{"Accept": "application/json"}
+	if body != nil {
+		headers["Content-Type"] = "application/json"
+	}
+	req, err := c.prepareRequest(ctx, path, method, body, headers, url.Values{}, url.Values{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		var discarded interface{}
+		out = &discarded
+	}
+	return buildResponse(httpResp, c, out)
+}
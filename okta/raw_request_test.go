@@ -0,0 +1,73 @@
+package okta
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Do(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/beta/widgets", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "SSWS token", req.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", req.Header.Get("Accept"))
+		if req.URL.Query().Get("after") == "" {
+			assert.Equal(t, "1", req.URL.Query().Get("limit"))
+			resp := mockJSONResponse(200, `[{"id":"w1"}]`)
+			resp.Header.Add("Link", `<https://example.okta.com/api/v1/beta/widgets?limit=1>; rel="self"`)
+			resp.Header.Add("Link", `<https://example.okta.com/api/v1/beta/widgets?after=w1&limit=1>; rel="next"`)
+			return resp, nil
+		}
+		return mockJSONResponse(200, `[{"id":"w2"}]`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/beta/widgets", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(req.Body)
+		assert.JSONEq(t, `{"name":"gear"}`, string(body))
+		return mockJSONResponse(201, `{"id":"w3","name":"gear"}`), nil
+	})
+	httpmock.RegisterResponder("DELETE", "/api/v1/beta/widgets/w3", httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("GET", "/api/v1/beta/widgets/missing", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(404, `{"errorCode":"E0000007","errorSummary":"Not found: Resource not found: missing (Widget)"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	type widget struct {
+		ID   string `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
+	}
+	var widgets []widget
+	resp, err := client.Do(ctx, http.MethodGet, "/api/v1/beta/widgets?limit=1", nil, &widgets)
+	require.NoError(t, err)
+	assert.Equal(t, []widget{{ID: "w1"}}, widgets)
+	require.True(t, resp.HasNextPage())
+	var next []widget
+	_, err = resp.Next(&next)
+	require.NoError(t, err)
+	assert.Equal(t, []widget{{ID: "w2"}}, next)
+
+	var created widget
+	resp, err = client.Do(ctx, http.MethodPost, "/api/v1/beta/widgets", widget{Name: "gear"}, &created)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "w3", created.ID)
+
+	_, err = client.Do(ctx, http.MethodDelete, "/api/v1/beta/widgets/w3", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, http.MethodGet, "https://example.okta.com/api/v1/beta/widgets/missing", nil, nil)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "E0000007", apiErr.ErrorCode)
+	assert.True(t, IsNotFound(err))
+}
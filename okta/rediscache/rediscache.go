@@ -0,0 +1,330 @@
+// Package rediscache implements okta.CacheStore with Redis, so that the
+// replicas of a service share the GET cache and the access tokens of their
+// okta.APIClient. It speaks the Redis protocol itself, so the SDK does not
+// depend on a Redis client.
+package rediscache
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+// ErrClosed is returned by the methods of a closed Store.
+var ErrClosed = errors.New("rediscache: store is closed")
+
+// Options configure a Store.
+type Options struct {
+	// Addr is the host:port of the Redis server, "localhost:6379" by default.
+	Addr string
+	// Username and Password authenticate the connections with AUTH when
+	// Password is set. Username requires Redis 6.
+	Username string
+	Password string
+	// DB is the database selected with SELECT.
+	DB int
+	// Prefix is prepended to the keys of the SDK, "okta:" by default. Clear
+	// only deletes the keys with the prefix, so stores used for different
+	// purposes need different prefixes.
+	Prefix string
+	// Timeout bounds the dial and every command, 3 seconds by default.
+	Timeout time.Duration
+	// MaxIdle is the number of connections kept open between commands, 4 by
+	// default.
+	MaxIdle int
+	// TLSConfig enables TLS when set.
+	TLSConfig *tls.Config
+	// Dial opens the connections instead of net.Dialer, for example to go
+	// through a tunnel.
+	Dial func(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// Store is an okta.CacheStore keeping its values in Redis. It is safe for
+// concurrent use.
+type Store struct {
+	opts Options
+
+	mu     sync.Mutex
+	idle   []*conn
+	closed bool
+}
+
+var _ okta.PrefixStore = (*Store)(nil)
+
+// New returns a Store connecting to Redis lazily.
+func New(opts Options) *Store {
+	if opts.Addr == "" {
+		opts.Addr = "localhost:6379"
+	}
+	if opts.Prefix == "" {
+		opts.Prefix = "okta:"
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 3 * time.Second
+	}
+	if opts.MaxIdle <= 0 {
+		opts.MaxIdle = 4
+	}
+	if opts.Dial == nil {
+		dialer := &net.Dialer{}
+		opts.Dial = dialer.DialContext
+	}
+	return &Store{opts: opts}
+}
+
+// Get implements okta.CacheStore.
+func (s *Store) Get(key string) ([]byte, bool, error) {
+	reply, err := s.do("GET", s.opts.Prefix+key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("rediscache: unexpected reply to GET: %v", reply)
+	}
+	return value, true, nil
+}
+
+// Set implements okta.CacheStore.
+func (s *Store) Set(key string, value []byte, ttl time.Duration) error {
+	args := []interface{}{"SET", s.opts.Prefix + key, value}
+	if ttl > 0 {
+		ms := ttl.Milliseconds()
+		if ms == 0 {
+			ms = 1
+		}
+		args = append(args, "PX", strconv.FormatInt(ms, 10))
+	}
+	_, err := s.do(args...)
+	return err
+}
+
+// Delete implements okta.CacheStore.
+func (s *Store) Delete(key string) error {
+	_, err := s.do("DEL", s.opts.Prefix+key)
+	return err
+}
+
+// Clear implements okta.CacheStore, deleting the keys with the prefix of the
+// store.
+func (s *Store) Clear() error {
+	return s.DeletePrefix("")
+}
+
+// DeletePrefix implements okta.PrefixStore.
+func (s *Store) DeletePrefix(prefix string) error {
+	cursor := "0"
+	for {
+		reply, err := s.do("SCAN", cursor, "MATCH", escapePattern(s.opts.Prefix+prefix)+"*", "COUNT", "500")
+		if err != nil {
+			return err
+		}
+		page, ok := reply.([]interface{})
+		if !ok || len(page) != 2 {
+			return fmt.Errorf("rediscache: unexpected reply to SCAN: %v", reply)
+		}
+		next, _ := page[0].([]byte)
+		keys, _ := page[1].([]interface{})
+		if len(keys) > 0 {
+			if _, err := s.do(append([]interface{}{"DEL"}, keys...)...); err != nil {
+				return err
+			}
+		}
+		cursor = string(next)
+		if cursor == "0" || cursor == "" {
+			return nil
+		}
+	}
+}
+
+// Close closes the idle connections of the store. Commands fail with
+// ErrClosed afterwards.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	idle := s.idle
+	s.idle, s.closed = nil, true
+	s.mu.Unlock()
+	for _, c := range idle {
+		c.Close()
+	}
+	return nil
+}
+
+// do sends a command and returns its reply: nil, a string for simple
+// strings, an int64, []byte for bulk strings or []interface{} for arrays.
+func (s *Store) do(args ...interface{}) (interface{}, error) {
+	c, err := s.get()
+	if err != nil {
+		return nil, err
+	}
+	reply, err := c.do(s.opts.Timeout, args...)
+	var redisErr Error
+	if err != nil && !errors.As(err, &redisErr) {
+		// the connection is in an unknown state
+		c.Close()
+		return nil, err
+	}
+	s.put(c)
+	return reply, err
+}
+
+func (s *Store) get() (*conn, error) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, ErrClosed
+	}
+	if n := len(s.idle); n > 0 {
+		c := s.idle[n-1]
+		s.idle = s.idle[:n-1]
+		s.mu.Unlock()
+		return c, nil
+	}
+	s.mu.Unlock()
+	return s.dial()
+}
+
+func (s *Store) put(c *conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed || len(s.idle) >= s.opts.MaxIdle {
+		c.Close()
+		return
+	}
+	s.idle = append(s.idle, c)
+}
+
+func (s *Store) dial() (*conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.opts.Timeout)
+	defer cancel()
+	nc, err := s.opts.Dial(ctx, "tcp", s.opts.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("rediscache: %w", err)
+	}
+	if s.opts.TLSConfig != nil {
+		config := s.opts.TLSConfig.Clone()
+		if config.ServerName == "" {
+			config.ServerName, _, _ = net.SplitHostPort(s.opts.Addr)
+		}
+		tlsConn := tls.Client(nc, config)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			nc.Close()
+			return nil, fmt.Errorf("rediscache: %w", err)
+		}
+		nc = tlsConn
+	}
+	c := &conn{Conn: nc, r: bufio.NewReader(nc)}
+	if s.opts.Password != "" {
+		args := []interface{}{"AUTH", s.opts.Password}
+		if s.opts.Username != "" {
+			args = []interface{}{"AUTH", s.opts.Username, s.opts.Password}
+		}
+		if _, err := c.do(s.opts.Timeout, args...); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	if s.opts.DB != 0 {
+		if _, err := c.do(s.opts.Timeout, "SELECT", strconv.Itoa(s.opts.DB)); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Error is an error reply of Redis.
+type Error string
+
+func (e Error) Error() string {
+	return "rediscache: " + string(e)
+}
+
+type conn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *conn) do(timeout time.Duration, args ...interface{}) (interface{}, error) {
+	if err := c.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		var value string
+		switch arg := arg.(type) {
+		case string:
+			value = arg
+		case []byte:
+			value = string(arg)
+		default:
+			return nil, fmt.Errorf("rediscache: unsupported argument %T", arg)
+		}
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(value), value)
+	}
+	if _, err := io.WriteString(c.Conn, b.String()); err != nil {
+		return nil, err
+	}
+	return readReply(c.r)
+}
+
+func readReply(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || !strings.HasSuffix(line, "\r\n") {
+		return nil, fmt.Errorf("rediscache: malformed reply %q", line)
+	}
+	kind, payload := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return payload, nil
+	case '-':
+		return nil, Error(payload)
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(payload)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return buf[:n], nil
+	case '*':
+		n, err := strconv.Atoi(payload)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		items := make([]interface{}, n)
+		for i := range items {
+			if items[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("rediscache: malformed reply %q", line)
+}
+
+// escapePattern escapes the glob characters of a SCAN pattern.
+func escapePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`).Replace(s)
+}
@@ -0,0 +1,172 @@
+package rediscache
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis serves the commands of a Store from memory.
+type fakeRedis struct {
+	t        *testing.T
+	listener net.Listener
+	password string
+
+	mu       sync.Mutex
+	values   map[string]string
+	ttls     map[string]string
+	commands []string
+}
+
+func newFakeRedis(t *testing.T, password string) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	f := &fakeRedis{t: t, listener: listener, password: password, values: map[string]string{}, ttls: map[string]string{}}
+	go func() {
+		for {
+			c, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(c)
+		}
+	}()
+	t.Cleanup(func() { listener.Close() })
+	return f
+}
+
+func (f *fakeRedis) serve(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	authenticated := f.password == ""
+	for {
+		reply, err := readReply(r)
+		if err != nil {
+			return
+		}
+		var args []string
+		for _, arg := range reply.([]interface{}) {
+			args = append(args, string(arg.([]byte)))
+		}
+		f.mu.Lock()
+		f.commands = append(f.commands, args[0])
+		var out string
+		switch {
+		case args[0] == "AUTH":
+			authenticated = args[len(args)-1] == f.password
+			out = "+OK\r\n"
+			if !authenticated {
+				out = "-WRONGPASS invalid password\r\n"
+			}
+		case !authenticated:
+			out = "-NOAUTH Authentication required.\r\n"
+		case args[0] == "GET":
+			if value, ok := f.values[args[1]]; ok {
+				out = bulk(value)
+			} else {
+				out = "$-1\r\n"
+			}
+		case args[0] == "SET":
+			f.values[args[1]] = args[2]
+			f.ttls[args[1]] = strings.Join(args[3:], " ")
+			out = "+OK\r\n"
+		case args[0] == "DEL":
+			for _, key := range args[1:] {
+				delete(f.values, key)
+			}
+			out = ":" + strconv.Itoa(len(args)-1) + "\r\n"
+		case args[0] == "SCAN":
+			var keys []string
+			prefix := strings.NewReplacer(`\\`, `\`, `\*`, "*", `\?`, "?", `\[`, "[", `\]`, "]").Replace(strings.TrimSuffix(args[3], "*"))
+			for key := range f.values {
+				if strings.HasPrefix(key, prefix) {
+					keys = append(keys, bulk(key))
+				}
+			}
+			out = "*2\r\n" + bulk("0") + "*" + strconv.Itoa(len(keys)) + "\r\n" + strings.Join(keys, "")
+		default:
+			out = "-ERR unknown command\r\n"
+		}
+		f.mu.Unlock()
+		if _, err := c.Write([]byte(out)); err != nil {
+			return
+		}
+	}
+}
+
+// ttl returns the options of the last SET of key.
+func (f *fakeRedis) ttl(key string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.ttls[key]
+}
+
+func bulk(s string) string {
+	return "$" + strconv.Itoa(len(s)) + "\r\n" + s + "\r\n"
+}
+
+func Test_Store(t *testing.T) {
+	redis := newFakeRedis(t, "secret")
+	store := New(Options{Addr: redis.listener.Addr().String(), Password: "secret", Prefix: "app:"})
+	defer store.Close()
+
+	_, found, err := store.Get("missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, store.Set("https://example.okta.com/api/v1/users/00u1", []byte("body\r\nwith a line break"), 1500*time.Millisecond))
+	value, found, err := store.Get("https://example.okta.com/api/v1/users/00u1")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "body\r\nwith a line break", string(value))
+	assert.Equal(t, "PX 1500", redis.ttl("app:https://example.okta.com/api/v1/users/00u1"))
+
+	require.NoError(t, store.Set("token", []byte("Bearer abc"), 0))
+	assert.Equal(t, "", redis.ttl("app:token"), "Values without TTL should not expire")
+	require.NoError(t, store.Delete("token"))
+	_, found, err = store.Get("token")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, store.Set("https://example.okta.com/api/v1/groups?limit=1", []byte("[]"), time.Minute))
+	require.NoError(t, store.Set("https://example.okta.com/api/v1/groups/00g1", []byte("{}"), time.Minute))
+	require.NoError(t, store.DeletePrefix("https://example.okta.com/api/v1/groups?"))
+	_, found, err = store.Get("https://example.okta.com/api/v1/groups?limit=1")
+	require.NoError(t, err)
+	assert.False(t, found)
+	_, found, err = store.Get("https://example.okta.com/api/v1/groups/00g1")
+	require.NoError(t, err)
+	assert.True(t, found, "DeletePrefix should only delete the keys with the prefix")
+
+	redis.mu.Lock()
+	redis.values["other:key"] = "kept"
+	redis.mu.Unlock()
+	require.NoError(t, store.Clear())
+
+	redis.mu.Lock()
+	assert.Equal(t, map[string]string{"other:key": "kept"}, redis.values, "Clear should only delete the keys of the prefix")
+	assert.Equal(t, 1, strings.Count(strings.Join(redis.commands, " "), "AUTH"), "Connections should be reused")
+	redis.mu.Unlock()
+
+	require.NoError(t, store.Close())
+	_, _, err = store.Get("token")
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func Test_Store_Error_Reply(t *testing.T) {
+	redis := newFakeRedis(t, "secret")
+	store := New(Options{Addr: redis.listener.Addr().String(), Password: "wrong"})
+	defer store.Close()
+
+	_, _, err := store.Get("token")
+	var redisErr Error
+	require.ErrorAs(t, err, &redisErr)
+	assert.Contains(t, err.Error(), "WRONGPASS")
+}
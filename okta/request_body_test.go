@@ -0,0 +1,84 @@
+package okta
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gzipTransport compresses request bodies, the way a request interceptor in
+// the HTTP client of the configuration would.
+type gzipTransport struct {
+	t       *testing.T
+	chunked bool
+}
+
+func (g gzipTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	assert.Empty(g.t, req.Header.Get("Content-Length"), "The SDK should leave the Content-Length to the transport")
+	if req.Body == nil {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	plain, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	zw.Write(plain)
+	zw.Close()
+
+	out := req.Clone(req.Context())
+	out.Header.Set("Content-Encoding", "gzip")
+	out.Body = io.NopCloser(&compressed)
+	out.ContentLength = int64(compressed.Len())
+	if g.chunked {
+		out.ContentLength = -1
+	}
+	return http.DefaultTransport.RoundTrip(out)
+}
+
+func Test_Request_Body_Interceptors(t *testing.T) {
+	for name, chunked := range map[string]bool{"gzip": false, "chunked gzip": true} {
+		t.Run(name, func(t *testing.T) {
+			var received Group
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+				if chunked {
+					assert.Equal(t, []string{"chunked"}, r.TransferEncoding)
+				} else {
+					assert.Positive(t, r.ContentLength)
+				}
+				zr, err := gzip.NewReader(r.Body)
+				require.NoError(t, err)
+				require.NoError(t, json.NewDecoder(zr).Decode(&received))
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":"00g1","profile":{"name":"Engineering"}}`))
+			}))
+			defer server.Close()
+
+			configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false),
+				WithHttpClientPtr(&http.Client{Transport: gzipTransport{t: t, chunked: chunked}}))
+			require.NoError(t, err, "Creating a new config should not error")
+			serverURL, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			configuration.Host, configuration.Scheme = serverURL.Host, serverURL.Scheme
+			client := NewAPIClient(configuration)
+
+			group, _, err := client.GroupAPI.CreateGroup(context.Background()).
+				Group(Group{Profile: &GroupProfile{Name: PtrString("Engineering")}}).
+				Execute()
+			require.NoError(t, err)
+			assert.Equal(t, "00g1", group.GetId())
+			assert.Equal(t, "Engineering", received.Profile.GetName())
+		})
+	}
+}
@@ -24,6 +24,7 @@ Contact: devex-public@okta.com
 package okta
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/url"
@@ -33,14 +34,36 @@ import (
 	"encoding/xml"
 	"encoding/json"
 	"io"
+	"time"
 )
 
 
 // APIResponse stores the API response returned by the server.
 type APIResponse struct {
 	*http.Response
-	cli *APIClient
-	pg  Pagination
+	cli       *APIClient
+	pg        Pagination
+	pageRetry *PageRetry
+}
+
+// PageRetry configures how Next retries a page that failed with a network
+// error or a 5xx response before giving up on the iteration.
+type PageRetry struct {
+	// MaxRetries is the number of retries of a single page.
+	MaxRetries int
+	// Backoff is the wait before the first retry, doubled for every further retry.
+	Backoff time.Duration
+}
+
+func (r PageRetry) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(r.Backoff << attempt)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
 func newAPIResponse(r *http.Response, cli *APIClient, v interface{}) *APIResponse {
@@ -91,6 +114,7 @@ func (c *APIClient) checkResponseForError(resp *http.Response) error {
 		newErr := &GenericOpenAPIError{
 			body:  localVarBody,
 			error: resp.Status,
+			err:   newAPIError(resp, localVarBody),
 		}
 		if resp.StatusCode == 403 {
 			var v Error
@@ -134,15 +158,55 @@ func (res *APIResponse) Next(v interface{}) (*APIResponse, error) {
 	if err != nil {
 		return nil, err
 	}
-	req, err := res.cli.prepareRequest(res.cli.cfg.Context, URL.Path, http.MethodGet, nil, map[string]string{"Accept": "application/json"}, URL.Query(), nil, nil)
-	if err != nil {
-		return nil, err
+	ctx := res.cli.cfg.Context
+	if ctx == nil {
+		ctx = context.Background()
 	}
-	resp, err := res.cli.do(res.cli.cfg.Context, req)
-	if err != nil {
-		return nil, err
+	return res.next(ctx, URL, v)
+}
+
+// next requests the page at URL with ctx, retrying it according to the page
+// retry configuration.
+func (res *APIResponse) next(ctx context.Context, URL *url.URL, v interface{}) (*APIResponse, error) {
+	retry := res.cli.cfg.PageRetry
+	if res.pageRetry != nil {
+		retry = *res.pageRetry
+	}
+	var resp *http.Response
+	for attempt := 0; ; attempt++ {
+		req, err := res.cli.prepareRequest(ctx, URL.Path, http.MethodGet, nil, map[string]string{"Accept": "application/json"}, URL.Query(), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err = res.cli.do(ctx, req)
+		if err == nil && resp.StatusCode < http.StatusInternalServerError {
+			break
+		}
+		if attempt >= retry.MaxRetries || ctx.Err() != nil {
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		if err == nil {
+			tryDrainBody(resp.Body)
+		}
+		if err := retry.wait(ctx, attempt); err != nil {
+			return nil, err
+		}
+	}
+	next, err := buildResponse(resp, res.cli, v)
+	if next != nil {
+		next.pageRetry = res.pageRetry
 	}
-	return buildResponse(resp, res.cli, v)
+	return next, err
+}
+
+// WithPageRetry overrides the page retry configuration of the client for the
+// following calls to Next in this iteration.
+func (res *APIResponse) WithPageRetry(retry PageRetry) *APIResponse {
+	res.pageRetry = &retry
+	return res
 }
 
 func (res *APIResponse) Self() string {
@@ -170,56 +234,36 @@ func newPaginationInHeader(r *http.Response) *PaginationInHeader {
 	return &PaginationInHeader{r: r}
 }
 
-func (pg *PaginationInHeader) Self() (self string) {
-	links := pg.r.Header["Link"]
-	if len(links) > 0 {
-		for _, link := range links {
-			splitLinkHeader := strings.Split(link, ";")
-			if len(splitLinkHeader) < 2 {
-				continue
-			}
-			rawLink := strings.TrimRight(strings.TrimLeft(splitLinkHeader[0], "<"), ">")
-			rawURL, _ := url.Parse(rawLink)
-			rawURL.Scheme = ""
-			rawURL.Host = ""
-			if pg.r.Request != nil {
-				q := pg.r.Request.URL.Query()
-				for k, v := range rawURL.Query() {
-					q.Set(k, v[0])
-				}
-				rawURL.RawQuery = q.Encode()
-			}
-			if strings.Contains(link, `rel="self"`) {
-				self = rawURL.String()
-			}
-		}
-	}
-	return
+func (pg *PaginationInHeader) Self() string {
+	return pg.link("self")
 }
 
-func (pg *PaginationInHeader) NextPage() (next string) {
-	links := pg.r.Header["Link"]
-	if len(links) > 0 {
-		for _, link := range links {
-			splitLinkHeader := strings.Split(link, ";")
-			if len(splitLinkHeader) < 2 {
-				continue
-			}
-			rawLink := strings.TrimRight(strings.TrimLeft(splitLinkHeader[0], "<"), ">")
-			rawURL, _ := url.Parse(rawLink)
-			rawURL.Scheme = ""
-			rawURL.Host = ""
-			if pg.r.Request != nil {
-				q := pg.r.Request.URL.Query()
-				for k, v := range rawURL.Query() {
-					q.Set(k, v[0])
-				}
-				rawURL.RawQuery = q.Encode()
-			}
-			if strings.Contains(link, `rel="next"`) {
-				next = rawURL.String()
+func (pg *PaginationInHeader) NextPage() string {
+	return pg.link("next")
+}
+
+// link returns the path and query of the last link of the Link headers with
+// the relation rel, with the query of the request overridden by the query of
+// the link.
+func (pg *PaginationInHeader) link(rel string) (target string) {
+	for _, link := range ParseLinkHeaders(pg.r) {
+		if !hasRel(link, rel) {
+			continue
+		}
+		rawURL, err := url.Parse(link.URL)
+		if err != nil {
+			continue
+		}
+		rawURL.Scheme = ""
+		rawURL.Host = ""
+		if pg.r.Request != nil {
+			q := pg.r.Request.URL.Query()
+			for k, v := range rawURL.Query() {
+				q.Set(k, v[0])
 			}
+			rawURL.RawQuery = q.Encode()
 		}
+		target = rawURL.String()
 	}
 	return
 }
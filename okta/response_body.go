@@ -0,0 +1,36 @@
+package okta
+
+import (
+	"io"
+	"net/http"
+)
+
+// ClosedBodyPolicy decides what happens to the body of a response the client
+// returns together with an error. Either way the body received from the
+// network is read and closed, so the connection is reused.
+type ClosedBodyPolicy int
+
+const (
+	// BufferClosedBody keeps the body in memory, so it can still be read
+	// from the APIResponse.
+	BufferClosedBody ClosedBodyPolicy = iota
+	// DiscardClosedBody drops the body, for callers that only look at the
+	// error and want to avoid holding large bodies in memory.
+	DiscardClosedBody
+)
+
+// releaseBody reads and closes the network body of resp according to policy,
+// replacing it with one that needs no closing.
+func releaseBody(resp *http.Response, policy ClosedBodyPolicy) {
+	if resp == nil || resp.Body == nil || resp.Body == http.NoBody {
+		return
+	}
+	switch policy {
+	case DiscardClosedBody:
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		resp.Body = http.NoBody
+	default:
+		_, _ = readAndRestoreBody(resp)
+	}
+}
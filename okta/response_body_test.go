@@ -0,0 +1,87 @@
+package okta
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Response_Bodies_Release_Connections(t *testing.T) {
+	errorBody := `{"errorCode":"E0000060","errorSummary":"Unsupported operation.","padding":"` + strings.Repeat("x", 8192) + `"}`
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/realms", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotImplemented)
+		io.WriteString(w, errorBody)
+	})
+	mux.HandleFunc("/api/v1/groups/00g1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `W/"2"`)
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			io.WriteString(w, errorBody)
+			return
+		}
+		io.WriteString(w, `{"id":"00g1","profile":{"name":"Engineering"}}`)
+	})
+	mux.HandleFunc("/api/v1/users/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, errorBody)
+	})
+
+	for name, policy := range map[string]ClosedBodyPolicy{"buffer": BufferClosedBody, "discard": DiscardClosedBody} {
+		t.Run(name, func(t *testing.T) {
+			var connections int32
+			server := httptest.NewUnstartedServer(mux)
+			server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					atomic.AddInt32(&connections, 1)
+				}
+			}
+			server.Start()
+			defer server.Close()
+
+			configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(true),
+				WithHttpClientPtr(&http.Client{Transport: &http.Transport{}}), WithClosedBodyPolicy(policy))
+			require.NoError(t, err, "Creating a new config should not error")
+			serverURL, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			configuration.Host, configuration.Scheme = serverURL.Host, serverURL.Scheme
+			client := NewAPIClient(configuration)
+			ctx := context.Background()
+
+			for i := 0; i < 3; i++ {
+				_, resp, err := client.RealmAPI.ListRealms(ctx).Execute()
+				require.ErrorIs(t, err, ErrFeatureNotEnabled)
+				body, _ := io.ReadAll(resp.Body)
+				if policy == BufferClosedBody {
+					assert.Equal(t, errorBody, string(body), "The buffered body should be readable")
+				} else {
+					assert.Empty(t, body)
+				}
+
+				_, _, err = client.GroupAPI.GetGroup(ctx, "00g1").Execute()
+				require.NoError(t, err)
+
+				_, _, err = client.GroupAPI.ReplaceGroup(WithIfMatch(ctx, `W/"1"`), "00g1").
+					Group(Group{Profile: &GroupProfile{Name: PtrString("Engineering")}}).
+					Execute()
+				require.ErrorIs(t, err, ErrConflict)
+
+				_, _, err = client.UserAPI.GetUser(ctx, "missing").Execute()
+				require.Error(t, err)
+			}
+			assert.Equal(t, int32(1), atomic.LoadInt32(&connections), "Every request should reuse the connection")
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// ErrSchemaMismatch is matched by errors.Is when a response did not match the
+// schema of its operation and WithFailOnSchemaMismatch is set.
+var ErrSchemaMismatch = errors.New("response does not match the API schema")
+
+// SchemaMismatch is a difference between a response and the schema of its
+// operation in the API spec.
+type SchemaMismatch struct {
+	// Operation is the HTTP method and the path template of the operation,
+	// such as "GET /api/v1/users/{id}".
+	Operation string
+	// Pointer is the JSON pointer of the mismatching value in the body of
+	// the response, such as "/0/profile/login". It is empty for the body
+	// itself.
+	Pointer string
+	Message string
+}
+
+func (m SchemaMismatch) String() string {
+	return fmt.Sprintf("%s: %s: %s", m.Operation, m.Pointer, m.Message)
+}
+
+// ResponseValidator checks the successful JSON responses of the API against
+// the schema of their operation. See the schemavalidate package for a
+// validator using the bundled OpenAPI spec.
+type ResponseValidator interface {
+	// ValidateResponse returns how the body of resp differs from its schema,
+	// nothing when it matches or the operation is not known.
+	ValidateResponse(req *http.Request, resp *http.Response, body []byte) []SchemaMismatch
+}
+
+// SchemaMismatchError is returned instead of the response when it did not
+// match its schema and WithFailOnSchemaMismatch is set.
+type SchemaMismatchError struct {
+	Mismatches []SchemaMismatch
+}
+
+func (e *SchemaMismatchError) Error() string {
+	if len(e.Mismatches) == 1 {
+		return fmt.Sprintf("%s: %s", ErrSchemaMismatch, e.Mismatches[0])
+	}
+	return fmt.Sprintf("%s: %s and %d more", ErrSchemaMismatch, e.Mismatches[0], len(e.Mismatches)-1)
+}
+
+func (e *SchemaMismatchError) Is(target error) bool {
+	return target == ErrSchemaMismatch
+}
+
+type observedCallKey struct{}
+
+// validateResponse checks a successful JSON response with the
+// ResponseValidator of the configuration, recording the mismatches on the
+// call being observed.
+func (c *APIClient) validateResponse(req *http.Request, resp *http.Response) error {
+	validator := c.cfg.ResponseValidator
+	if validator == nil || resp.StatusCode < 200 || resp.StatusCode > 299 || resp.Body == nil {
+		return nil
+	}
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); !strings.HasSuffix(mediaType, "json") {
+		return nil
+	}
+	body, err := readAndRestoreBody(resp)
+	if err != nil || len(body) == 0 {
+		return err
+	}
+	mismatches := validator.ValidateResponse(req, resp, body)
+	if len(mismatches) == 0 {
+		return nil
+	}
+	if call, ok := req.Context().Value(observedCallKey{}).(*Call); ok {
+		call.SchemaMismatches = mismatches
+	}
+	if c.cfg.FailOnSchemaMismatch {
+		return &SchemaMismatchError{Mismatches: mismatches}
+	}
+	return nil
+}
+
+// withCall returns a context carrying the call being observed.
+func withCall(ctx context.Context, call *Call) context.Context {
+	return context.WithValue(ctx, observedCallKey{}, call)
+}
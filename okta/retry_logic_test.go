@@ -1,6 +1,7 @@
 package okta
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -141,3 +143,192 @@ func Test_RateLimitPrevent_Blocks(t *testing.T) {
 	info := httpmock.GetCallCountInfo()
 	require.Equal(t, 2, info["GET /api/v1/users"], "Expected exactly 2 calls to /api/v1/users")
 }
+
+func Test_RateLimitPrevent_Isolates_Hosts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://first.okta.com"), WithToken("token"), WithCache(false), WithRateLimitPrevent(true))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	resetTime := time.Now().Add(30 * time.Second)
+
+	responder := func(req *http.Request) (*http.Response, error) {
+		remaining := "10"
+		if req.URL.Host == "first.okta.com" && req.URL.Path == "/api/v1/users" {
+			remaining = "0"
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       httpmock.NewRespBodyFromString("[]"),
+			Header: http.Header{
+				"X-Rate-Limit-Limit":     []string{"50"},
+				"X-Rate-Limit-Remaining": []string{remaining},
+				"X-Rate-Limit-Reset":     []string{strconv.FormatInt(resetTime.Unix(), 10)},
+				"Content-Type":           []string{"application/json"},
+				"Date":                   []string{time.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05") + " GMT"},
+			},
+		}, nil
+	}
+	httpmock.RegisterResponder("GET", "/api/v1/users", responder)
+	httpmock.RegisterResponder("GET", "/api/v1/groups", responder)
+
+	get := func(rawURL string, timeout time.Duration) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+		require.NoError(t, err)
+		resp, err := client.do(ctx, req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+	require.NoError(t, get("https://first.okta.com/api/v1/users", time.Second))
+
+	assert.NoError(t, get("https://second.okta.com/api/v1/users", time.Second), "Another org should not wait for the exhausted limit")
+	assert.NoError(t, get("https://first.okta.com/api/v1/groups", time.Second), "Another endpoint should not wait for the exhausted limit")
+	assert.ErrorIs(t, get("https://first.okta.com/api/v1/users", 100*time.Millisecond), context.DeadlineExceeded, "The exhausted endpoint should wait for the reset")
+}
+
+func Test_Rate_Limit_Bucket(t *testing.T) {
+	for rawURL, bucket := range map[string]string{
+		"https://example.okta.com/api/v1/users":                              "example.okta.com/api/v1/users",
+		"https://example.okta.com/api/v1/users/00ub0oNGTSWTBKOLGLNR":         "example.okta.com/api/v1/users/{id}",
+		"https://example.okta.com/api/v1/users/john@example.com/groups":      "example.okta.com/api/v1/users/{id}/groups",
+		"https://other.okta.com/api/v1/groups/00g1emaKYZTWRYYRRTSK/users?q=": "other.okta.com/api/v1/groups/{id}/users",
+	} {
+		req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+		require.NoError(t, err)
+		assert.Equal(t, bucket, rateLimitBucket(req))
+	}
+}
+
+func Test_RateLimitPrevent_Tracks_All_Responses(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false),
+		WithRateLimitPrevent(true), WithRateLimitMaxRetries(0))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	resetTime := time.Now().Add(30 * time.Second)
+	httpmock.RegisterResponder("POST", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		resp := mockJSONResponse(429, `{"errorCode":"E0000047","errorSummary":"API call exceeded rate limit due to too many requests."}`)
+		resp.Header.Set("X-Rate-Limit-Limit", "600")
+		resp.Header.Set("X-Rate-Limit-Remaining", "0")
+		resp.Header.Set("X-Rate-Limit-Reset", strconv.FormatInt(resetTime.Unix(), 10))
+		resp.Header.Set("Date", time.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
+		return resp, nil
+	})
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `[]`), nil
+	})
+
+	_, _, err = client.UserAPI.CreateUser(context.Background()).Body(CreateUserRequest{}).Execute()
+	require.True(t, IsRateLimited(err))
+	limit := client.RateLimits()["example.okta.com/api/v1/users"]
+	assert.Equal(t, 600, limit.Limit)
+	assert.Equal(t, 0, limit.Remaining)
+	assert.InDelta(t, 31, limit.Reset, 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, _, err = client.UserAPI.ListUsers(ctx).Execute()
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "A 429 of a write should exhaust the bucket of the endpoint")
+
+	// a limit that was exhausted before its reset no longer blocks
+	client.rateLimitLock.Lock()
+	client.rateLimits["example.okta.com/api/v1/users"].resetAt = time.Now().Add(-time.Second)
+	client.rateLimitLock.Unlock()
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	assert.NoError(t, err)
+}
+
+func Test_Wait_For_Rate_Limit_Reserves_Requests(t *testing.T) {
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	client.rateLimits["example.okta.com/api/v1/logs"] = &bucketLimit{limit: 60, remaining: 1, resetAt: time.Now().Add(time.Minute)}
+
+	require.NoError(t, client.waitForRateLimit(context.Background(), "example.okta.com/api/v1/logs"))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, client.waitForRateLimit(ctx, "example.okta.com/api/v1/logs"), context.DeadlineExceeded,
+		"The last request of a bucket should be taken by one caller")
+	assert.NoError(t, client.waitForRateLimit(ctx, "example.okta.com/api/v1/users"), "Other buckets should not wait")
+}
+
+func Test_Rate_Limit_Headroom(t *testing.T) {
+	_, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithRateLimitHeadroom(1.5))
+	assert.Error(t, err, "A headroom above the limit should be rejected")
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false), WithRateLimitHeadroom(0.2))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	require.True(t, client.rateLimitTracked(), "A headroom should track rate limits")
+
+	client.rateLimits["example.okta.com/api/v1/users"] = &bucketLimit{limit: 100, remaining: 50, resetAt: time.Now().Add(time.Second)}
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, client.waitForRateLimit(context.Background(), "example.okta.com/api/v1/users"))
+	}
+	assert.Less(t, time.Since(start), 50*time.Millisecond, "Requests above the headroom should not be paced")
+
+	// 10 requests left for a second: one every 100ms
+	client.rateLimits["example.okta.com/api/v1/logs"] = &bucketLimit{limit: 100, remaining: 10, resetAt: time.Now().Add(time.Second)}
+	start = time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, client.waitForRateLimit(context.Background(), "example.okta.com/api/v1/logs"))
+	}
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 190*time.Millisecond, "Requests below the headroom should be spread until the reset")
+	assert.Less(t, elapsed, 500*time.Millisecond)
+}
+
+func Test_Rate_Limit_Observer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var events []RateLimitEvent
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false),
+		WithRateLimitMaxRetries(1), WithRateLimitMaxBackOff(1),
+		WithRateLimitObserver(RateLimitObserverFunc(func(ctx context.Context, event RateLimitEvent) {
+			events = append(events, event)
+		})))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	require.False(t, client.rateLimitTracked())
+
+	resetTime := time.Now().Add(30 * time.Second)
+	limited := func(status, remaining int, body string) *http.Response {
+		resp := mockJSONResponse(status, body)
+		resp.Header.Set("X-Rate-Limit-Limit", "600")
+		resp.Header.Set("X-Rate-Limit-Remaining", strconv.Itoa(remaining))
+		resp.Header.Set("X-Rate-Limit-Reset", strconv.FormatInt(resetTime.Unix(), 10))
+		resp.Header.Set("Date", time.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
+		return resp
+	}
+	var calls int
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1a2b3c4d5e6f7g8h9/lifecycle/deactivate", func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return limited(429, 0, `{"errorCode":"E0000047","errorSummary":"API call exceeded rate limit due to too many requests."}`), nil
+		}
+		return limited(200, 599, `{}`), nil
+	})
+
+	_, err = client.UserAPI.DeactivateUser(context.Background(), "00u1a2b3c4d5e6f7g8h9").Execute()
+	require.NoError(t, err)
+	require.Len(t, events, 2, "Every attempt should be observed")
+	assert.Equal(t, "example.okta.com/api/v1/users/{id}/lifecycle/deactivate", events[0].Bucket)
+	assert.Equal(t, http.MethodPost, events[0].Method)
+	assert.True(t, events[0].Limited)
+	assert.Equal(t, 0, events[0].Remaining)
+	assert.False(t, events[1].Limited)
+	assert.Equal(t, 600, events[1].Limit)
+	assert.Equal(t, 599, events[1].Remaining)
+	assert.WithinDuration(t, resetTime, events[1].Reset, 2*time.Second)
+	assert.Empty(t, client.RateLimits(), "Observing should not make the client wait for rate limits")
+}
@@ -0,0 +1,235 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryFunc decides on the retries of a single request. It is called after
+// every attempt with its response or error and returns how long to wait before
+// the next attempt, or false to return the attempt to the caller.
+type RetryFunc func(resp *http.Response, err error) (wait time.Duration, retry bool)
+
+// Retryer is the retry engine used for API requests. It is called once per
+// request so implementations can keep per-request state, such as a backoff
+// timer, in the returned RetryFunc.
+type Retryer interface {
+	Start(req *http.Request) RetryFunc
+}
+
+// RetryerFunc adapts a function to the Retryer interface.
+type RetryerFunc func(req *http.Request) RetryFunc
+
+func (f RetryerFunc) Start(req *http.Request) RetryFunc {
+	return f(req)
+}
+
+// BackOff is the interface of github.com/cenkalti/backoff v4 and v5 BackOff
+// implementations.
+type BackOff interface {
+	NextBackOff() time.Duration
+	Reset()
+}
+
+// NewBackOffRetryer returns a Retryer waiting as instructed by a BackOff, for
+// example a github.com/cenkalti/backoff ExponentialBackOff. newBackOff is
+// called once per request. retryable classifies attempts and defaults to
+// retrying network errors, 429 and 5xx responses. Retries stop when the
+// BackOff returns a negative duration (backoff.Stop).
+func NewBackOffRetryer(newBackOff func() BackOff, retryable func(resp *http.Response, err error) bool) Retryer {
+	if retryable == nil {
+		retryable = defaultRetryable
+	}
+	return RetryerFunc(func(req *http.Request) RetryFunc {
+		b := newBackOff()
+		b.Reset()
+		return func(resp *http.Response, err error) (time.Duration, bool) {
+			if !retryable(resp, err) {
+				return 0, false
+			}
+			wait := b.NextBackOff()
+			return wait, wait >= 0
+		}
+	})
+}
+
+func defaultRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// oktaRetryer is the default Retryer. It retries network errors that ended
+// with an EOF and 429 responses, waiting until the rate limit resets, at most
+// Okta.Client.RateLimit.MaxRetries times.
+type oktaRetryer struct {
+	cfg *Configuration
+}
+
+func (r oktaRetryer) Start(req *http.Request) RetryFunc {
+	var retries int32
+	return func(resp *http.Response, err error) (time.Duration, bool) {
+		if retries >= r.cfg.Okta.Client.RateLimit.MaxRetries {
+			return 0, false
+		}
+		// retry on EOF errors, which might be caused by network connectivity issues
+		if errors.Is(err, io.EOF) {
+			retries++
+			return 0, true
+		}
+		if err != nil || !tooManyRequests(resp) {
+			return 0, false
+		}
+		backoffDuration, err := Get429BackoffTime(resp)
+		if err != nil {
+			return 0, false
+		}
+		if r.cfg.Okta.Client.RateLimit.MaxBackoff < backoffDuration {
+			backoffDuration = r.cfg.Okta.Client.RateLimit.MaxBackoff
+		}
+		retries++
+		return time.Second * time.Duration(backoffDuration), true
+	}
+}
+
+// RetryPolicy is a Retryer configured by its fields: it retries network
+// errors and the response statuses in Statuses with an exponential backoff and
+// jitter, waiting as long as the Retry-After or rate limit headers ask when a
+// response has them. Pass it to WithRetryPolicy, starting from
+// DefaultRetryPolicy.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries of a single request.
+	MaxRetries int
+	// Statuses are the response statuses retried, 429 and the gateway errors
+	// of DefaultRetryPolicy when nil.
+	Statuses []int
+	// BaseDelay is the wait before the first retry, doubled for every
+	// further retry up to MaxDelay. MaxDelay also caps the wait asked for by
+	// a response.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// Jitter is the fraction of the backoff removed at random, from 0 to 1,
+	// so that clients failing together do not retry together.
+	Jitter float64
+	// IgnoreRetryAfter uses the backoff even when a response tells how long
+	// to wait in its Retry-After or X-Rate-Limit-Reset header.
+	IgnoreRetryAfter bool
+	// OnRetry is called before every retry.
+	OnRetry func(RetryAttempt)
+	// Rand returns random numbers in [0, 1), math/rand.Float64 by default.
+	Rand func() float64
+}
+
+// RetryAttempt describes a failed attempt about to be retried.
+type RetryAttempt struct {
+	Request *http.Request
+	// Attempt counts the attempts of the request, from 1.
+	Attempt  int
+	Response *http.Response
+	Err      error
+	// Wait is how long the request waits before the next attempt.
+	Wait time.Duration
+}
+
+// DefaultRetryPolicy retries network errors, 429, 502, 503 and 504 responses
+// up to 3 times, from 500ms to 30s apart.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	Statuses:   []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   30 * time.Second,
+	Jitter:     0.5,
+}
+
+func (p RetryPolicy) Start(req *http.Request) RetryFunc {
+	var attempt int
+	return func(resp *http.Response, err error) (time.Duration, bool) {
+		if attempt >= p.MaxRetries || !p.retryable(resp, err) {
+			return 0, false
+		}
+		wait := p.wait(resp, attempt)
+		attempt++
+		if p.OnRetry != nil {
+			p.OnRetry(RetryAttempt{Request: req, Attempt: attempt, Response: resp, Err: err, Wait: wait})
+		}
+		return wait, true
+	}
+}
+
+func (p RetryPolicy) retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	statuses := p.Statuses
+	if statuses == nil {
+		statuses = DefaultRetryPolicy.Statuses
+	}
+	for _, status := range statuses {
+		if resp.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (p RetryPolicy) wait(resp *http.Response, attempt int) time.Duration {
+	if !p.IgnoreRetryAfter && resp != nil {
+		if wait, ok := retryAfter(resp); ok {
+			return p.cap(wait)
+		}
+	}
+	wait := p.BaseDelay << attempt
+	if wait>>attempt != p.BaseDelay {
+		// the backoff overflowed after many retries
+		wait = math.MaxInt64
+	}
+	wait = p.cap(wait)
+	if wait <= 0 || p.Jitter <= 0 {
+		return wait
+	}
+	random := p.Rand
+	if random == nil {
+		random = rand.Float64
+	}
+	return wait - time.Duration(float64(wait)*p.Jitter*random())
+}
+
+func (p RetryPolicy) cap(wait time.Duration) time.Duration {
+	if p.MaxDelay > 0 && wait > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return wait
+}
+
+// retryAfter returns the wait asked for by the Retry-After header of a
+// response, in seconds or as a date, or else by its rate limit headers.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if value := resp.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			now := time.Now()
+			if served, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+				now = served
+			}
+			if wait := date.Sub(now); wait > 0 {
+				return wait, true
+			}
+			return 0, true
+		}
+	}
+	if tooManyRequests(resp) {
+		if seconds, err := Get429BackoffTime(resp); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,117 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BackOff_Retryer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var calls int
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls < 3 {
+			return mockJSONResponse(503, `{"errorCode":"E0000010","errorSummary":"Service is in read only mode"}`), nil
+		}
+		assert.Equal(t, "2", req.Header.Get("X-Okta-Retry-Count"))
+		return mockJSONResponse(200, "[]"), nil
+	})
+
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("token"),
+		WithCache(false),
+		WithRetryer(NewBackOffRetryer(func() BackOff {
+			return backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Millisecond), 2)
+		}, nil)),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err, "The request should succeed once the backoff retried it")
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	configuration.Retryer = RetryerFunc(func(req *http.Request) RetryFunc {
+		return func(resp *http.Response, err error) (time.Duration, bool) {
+			return 0, false
+		}
+	})
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.Error(t, err, "The request should not be retried")
+	assert.Equal(t, 1, calls)
+}
+
+func Test_Retry_Policy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var calls int
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		calls++
+		switch calls {
+		case 1:
+			return mockJSONResponse(502, `{}`), nil
+		case 2:
+			resp := mockJSONResponse(503, `{}`)
+			resp.Header.Set("Retry-After", "0")
+			return resp, nil
+		}
+		return mockJSONResponse(200, "[]"), nil
+	})
+
+	var attempts []RetryAttempt
+	policy := DefaultRetryPolicy
+	policy.BaseDelay = 10 * time.Millisecond
+	policy.Rand = func() float64 { return 1 }
+	policy.OnRetry = func(a RetryAttempt) { attempts = append(attempts, a) }
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("token"),
+		WithCache(false),
+		WithRetryPolicy(policy),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err, "The request should succeed once the policy retried it")
+	assert.Equal(t, 3, calls)
+	require.Len(t, attempts, 2)
+	assert.Equal(t, 1, attempts[0].Attempt)
+	assert.Equal(t, 502, attempts[0].Response.StatusCode)
+	assert.Equal(t, 5*time.Millisecond, attempts[0].Wait, "The jitter should remove half of the backoff")
+	assert.Equal(t, time.Duration(0), attempts[1].Wait, "Retry-After should replace the backoff")
+
+	calls = 0
+	configuration.Retryer = RetryPolicy{MaxRetries: 3, Statuses: []int{http.StatusBadGateway}}
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.Error(t, err, "503 should not be retried")
+	assert.Equal(t, 2, calls)
+}
+
+func Test_Retry_Policy_Wait(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	assert.Equal(t, time.Second, policy.wait(nil, 0))
+	assert.Equal(t, 4*time.Second, policy.wait(nil, 2))
+	assert.Equal(t, 5*time.Second, policy.wait(nil, 3))
+	assert.Equal(t, 5*time.Second, policy.wait(nil, 70))
+
+	resp := &http.Response{StatusCode: 429, Header: http.Header{}}
+	resp.Header.Set("Date", "Mon, 02 Jan 2006 15:04:05 GMT")
+	resp.Header.Set("Retry-After", "Mon, 02 Jan 2006 15:04:07 GMT")
+	assert.Equal(t, 2*time.Second, policy.wait(resp, 0))
+	resp.Header.Del("Retry-After")
+	resp.Header.Set("X-Rate-Limit-Reset", "1136214247")
+	assert.Equal(t, 3*time.Second, policy.wait(resp, 0), "The rate limit reset should be waited for")
+	policy.IgnoreRetryAfter = true
+	assert.Equal(t, time.Second, policy.wait(resp, 0))
+}
@@ -0,0 +1,124 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Names of the role templates of RoleTemplates.
+const (
+	RoleTemplateHelpdesk   = "helpdesk"
+	RoleTemplateAppAdmin   = "app-admin"
+	RoleTemplateGroupAdmin = "group-admin"
+	RoleTemplateReadOnly   = "read-only"
+)
+
+// RoleTemplates are the permissions custom roles are compared against by
+// DiffRolePermissions, by template name. Templates can be added or replaced
+// before the first comparison.
+var RoleTemplates = map[string][]string{
+	RoleTemplateHelpdesk: {
+		"okta.users.read",
+		"okta.users.lifecycle.unlock",
+		"okta.users.lifecycle.clearSessions",
+		"okta.users.credentials.resetPassword",
+		"okta.users.credentials.resetFactors",
+		"okta.groups.read",
+	},
+	RoleTemplateAppAdmin: {
+		"okta.apps.read",
+		"okta.apps.manage",
+		"okta.apps.assignment.manage",
+		"okta.users.read",
+		"okta.groups.read",
+	},
+	RoleTemplateGroupAdmin: {
+		"okta.groups.read",
+		"okta.groups.members.manage",
+		"okta.users.read",
+	},
+	RoleTemplateReadOnly: {
+		"okta.users.read",
+		"okta.groups.read",
+		"okta.apps.read",
+		"okta.devices.read",
+	},
+}
+
+// RolePermissionDiff is the difference between the permissions of a role and
+// a template.
+type RolePermissionDiff struct {
+	Template string
+	// Excess are the permissions of the role the template does not grant.
+	Excess []string
+	// Missing are the permissions of the template the role does not grant.
+	Missing []string
+}
+
+// OK reports whether the role grants exactly what the template does.
+func (d *RolePermissionDiff) OK() bool {
+	return len(d.Excess) == 0 && len(d.Missing) == 0
+}
+
+// DiffRolePermissions compares permissions against a template of
+// RoleTemplates. A manage permission, such as okta.users.manage, grants the
+// permissions nested under it, such as okta.users.lifecycle.unlock, so it
+// satisfies them but is excess when the template only has the nested ones.
+func DiffRolePermissions(permissions []string, template string) (*RolePermissionDiff, error) {
+	want, ok := RoleTemplates[template]
+	if !ok {
+		return nil, fmt.Errorf("unknown role template %q", template)
+	}
+	diff := &RolePermissionDiff{Template: template}
+	for _, p := range permissions {
+		if !grantsAny(want, p) {
+			diff.Excess = append(diff.Excess, p)
+		}
+	}
+	for _, p := range want {
+		if !grantsAny(permissions, p) {
+			diff.Missing = append(diff.Missing, p)
+		}
+	}
+	sort.Strings(diff.Excess)
+	sort.Strings(diff.Missing)
+	return diff, nil
+}
+
+// grantsAny reports whether any of granted grants permission.
+func grantsAny(granted []string, permission string) bool {
+	for _, g := range granted {
+		if grants(g, permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// grants reports whether the permission granted implies permission.
+func grants(granted, permission string) bool {
+	if granted == permission {
+		return true
+	}
+	scope, ok := strings.CutSuffix(granted, ".manage")
+	return ok && strings.HasPrefix(permission, scope+".")
+}
+
+// DiffCustomRole compares the permissions of a custom role, by id or label,
+// against a template of RoleTemplates, for access reviews.
+func (c *APIClient) DiffCustomRole(ctx context.Context, roleIdOrLabel, template string) (*RolePermissionDiff, error) {
+	if _, ok := RoleTemplates[template]; !ok {
+		return nil, fmt.Errorf("unknown role template %q", template)
+	}
+	list, _, err := c.RoleAPI.ListRolePermissions(ctx, roleIdOrLabel).Execute()
+	if err != nil {
+		return nil, err
+	}
+	permissions := make([]string, 0, len(list.Permissions))
+	for _, p := range list.Permissions {
+		permissions = append(permissions, p.GetLabel())
+	}
+	return DiffRolePermissions(permissions, template)
+}
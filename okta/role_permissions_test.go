@@ -0,0 +1,49 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Diff_Role_Permissions(t *testing.T) {
+	diff, err := DiffRolePermissions([]string{
+		"okta.users.read",
+		"okta.users.lifecycle.unlock",
+		"okta.users.credentials.manage",
+		"okta.apps.read",
+	}, RoleTemplateHelpdesk)
+	require.NoError(t, err)
+	assert.False(t, diff.OK())
+	assert.Equal(t, []string{"okta.apps.read", "okta.users.credentials.manage"}, diff.Excess)
+	assert.Equal(t, []string{"okta.groups.read", "okta.users.lifecycle.clearSessions"}, diff.Missing, "okta.users.credentials.manage grants the credential permissions")
+
+	diff, err = DiffRolePermissions([]string{"okta.groups.read", "okta.groups.members.manage", "okta.users.read"}, RoleTemplateGroupAdmin)
+	require.NoError(t, err)
+	assert.True(t, diff.OK())
+
+	_, err = DiffRolePermissions(nil, "janitor")
+	assert.Error(t, err)
+}
+
+func Test_Diff_Custom_Role(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/iam/roles/app-managers/permissions", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"permissions":[{"label":"okta.apps.manage"},{"label":"okta.apps.read"},{"label":"okta.users.read"}]}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	diff, err := client.DiffCustomRole(context.Background(), "app-managers", RoleTemplateAppAdmin)
+	require.NoError(t, err)
+	assert.Empty(t, diff.Excess)
+	assert.Equal(t, []string{"okta.groups.read"}, diff.Missing)
+}
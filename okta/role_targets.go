@@ -0,0 +1,55 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// The role targets of client applications are lists of catalog applications
+// and groups, but the spec declares them as a single Client, so the generated
+// ListAppTargetRoleToClient and ListGroupTargetRoleForClient fail to decode
+// them and have no generated ListAll method.
+
+// ListAllAppTargetRoleToClient returns the application targets of every page
+// of ListAppTargetRoleToClient, see ListAll.
+func (c *APIClient) ListAllAppTargetRoleToClient(r ApiListAppTargetRoleToClientRequest) ([]CatalogApplication, error) {
+	path := expandPath("/oauth2/v1/clients/{clientId}/roles/{roleId}/targets/catalog/apps", "{clientId}", r.clientId, "{roleId}", r.roleId)
+	return listAllAt[CatalogApplication](r.ctx, c, path, pageQuery(r.after, r.limit))
+}
+
+// ListAllGroupTargetRoleForClient returns the group targets of every page of
+// ListGroupTargetRoleForClient, see ListAll.
+func (c *APIClient) ListAllGroupTargetRoleForClient(r ApiListGroupTargetRoleForClientRequest) ([]Group, error) {
+	path := expandPath("/oauth2/v1/clients/{clientId}/roles/{roleId}/targets/groups", "{clientId}", r.clientId, "{roleId}", r.roleId)
+	return listAllAt[Group](r.ctx, c, path, pageQuery(r.after, r.limit))
+}
+
+// listAllAt reads every page of the list at path, see ListAll.
+func listAllAt[T any](ctx context.Context, c *APIClient, path string, query url.Values) ([]T, error) {
+	return ListAll(ctx, c, func() ([]T, *APIResponse, error) {
+		req, err := c.prepareRequest(ctx, path, http.MethodGet, nil, map[string]string{"Accept": "application/json"}, query, url.Values{}, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		httpResp, err := c.do(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+		var items []T
+		resp, err := buildResponse(httpResp, c, &items)
+		return items, resp, err
+	})
+}
+
+// pageQuery returns the query of the first page of a list.
+func pageQuery(after *string, limit *int32) url.Values {
+	query := url.Values{}
+	if after != nil {
+		query.Set("after", *after)
+	}
+	if limit != nil {
+		query.Set("limit", parameterToString(*limit, ""))
+	}
+	return query
+}
@@ -0,0 +1,121 @@
+package okta
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SAML 2.0 bindings of the endpoints in SAMLMetadata.
+const (
+	SAMLBindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	SAMLBindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+)
+
+// SAMLEndpoint is a SAML service endpoint of an identity provider.
+type SAMLEndpoint struct {
+	Binding  string
+	Location string
+}
+
+// SAMLMetadata is the identity provider metadata of a SAML app, as returned by
+// ApplicationSSOAPI.PreviewSAMLmetadataForApplication.
+type SAMLMetadata struct {
+	EntityID                string
+	WantAuthnRequestsSigned bool
+	NameIDFormats           []string
+	SingleSignOnServices    []SAMLEndpoint
+	SingleLogoutServices    []SAMLEndpoint
+	SigningCertificates     []*x509.Certificate
+	EncryptionCertificates  []*x509.Certificate
+}
+
+// SingleSignOnURL returns the location of the single sign-on service with the
+// given binding, or an empty string when there is none.
+func (m *SAMLMetadata) SingleSignOnURL(binding string) string {
+	for _, e := range m.SingleSignOnServices {
+		if e.Binding == binding {
+			return e.Location
+		}
+	}
+	return ""
+}
+
+type samlEntityDescriptor struct {
+	XMLName  xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID string   `xml:"entityID,attr"`
+	IDP      *struct {
+		WantAuthnRequestsSigned bool                `xml:"WantAuthnRequestsSigned,attr"`
+		KeyDescriptors          []samlKeyDescriptor `xml:"urn:oasis:names:tc:SAML:2.0:metadata KeyDescriptor"`
+		NameIDFormats           []string            `xml:"urn:oasis:names:tc:SAML:2.0:metadata NameIDFormat"`
+		SingleSignOnServices    []samlEndpoint      `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleSignOnService"`
+		SingleLogoutServices    []samlEndpoint      `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleLogoutService"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
+}
+
+type samlKeyDescriptor struct {
+	Use          string   `xml:"use,attr"`
+	Certificates []string `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo>X509Data>X509Certificate"`
+}
+
+type samlEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// ParseSAMLMetadata parses the SAML 2.0 metadata of an identity provider.
+func ParseSAMLMetadata(data []byte) (*SAMLMetadata, error) {
+	var d samlEntityDescriptor
+	if err := xml.Unmarshal(data, &d); err != nil {
+		return nil, fmt.Errorf("parse SAML metadata: %w", err)
+	}
+	if d.IDP == nil {
+		return nil, errors.New("parse SAML metadata: no IDPSSODescriptor")
+	}
+	m := &SAMLMetadata{
+		EntityID:                d.EntityID,
+		WantAuthnRequestsSigned: d.IDP.WantAuthnRequestsSigned,
+	}
+	for _, f := range d.IDP.NameIDFormats {
+		m.NameIDFormats = append(m.NameIDFormats, strings.TrimSpace(f))
+	}
+	for _, e := range d.IDP.SingleSignOnServices {
+		m.SingleSignOnServices = append(m.SingleSignOnServices, SAMLEndpoint(e))
+	}
+	for _, e := range d.IDP.SingleLogoutServices {
+		m.SingleLogoutServices = append(m.SingleLogoutServices, SAMLEndpoint(e))
+	}
+	for _, k := range d.IDP.KeyDescriptors {
+		for _, encoded := range k.Certificates {
+			der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+			if err != nil {
+				return nil, fmt.Errorf("parse SAML metadata certificate: %w", err)
+			}
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, fmt.Errorf("parse SAML metadata certificate: %w", err)
+			}
+			// a key without use is used for both
+			if k.Use != "encryption" {
+				m.SigningCertificates = append(m.SigningCertificates, cert)
+			}
+			if k.Use != "signing" {
+				m.EncryptionCertificates = append(m.EncryptionCertificates, cert)
+			}
+		}
+	}
+	return m, nil
+}
+
+// SAMLMetadata returns the parsed identity provider metadata of a SAML app.
+func (c *APIClient) SAMLMetadata(ctx context.Context, appID string) (*SAMLMetadata, error) {
+	data, _, err := c.ApplicationSSOAPI.PreviewSAMLmetadataForApplication(ctx, appID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return ParseSAMLMetadata([]byte(data))
+}
@@ -0,0 +1,81 @@
+package okta
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const samlMetadataTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="http://www.okta.com/exk1fcia6d6EMsf331d8">
+  <md:IDPSSODescriptor WantAuthnRequestsSigned="false" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+        <ds:X509Data>
+          <ds:X509Certificate>%s</ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</md:NameIDFormat>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://example.okta.com/app/example_app/exk1fcia6d6EMsf331d8/sso/saml"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://example.okta.com/app/example_app/exk1fcia6d6EMsf331d8/sso/saml"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+
+func testSAMLCertificate(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return der
+}
+
+func Test_SAML_Metadata(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	der := testSAMLCertificate(t)
+	metadata := fmt.Sprintf(samlMetadataTemplate, base64.StdEncoding.EncodeToString(der))
+	httpmock.RegisterResponder("GET", "/api/v1/apps/0oa1/sso/saml/metadata", func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(200, metadata)
+		resp.Header.Set("Content-Type", "text/xml")
+		return resp, nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	m, err := client.SAMLMetadata(context.Background(), "0oa1")
+	require.NoError(t, err)
+	assert.Equal(t, "http://www.okta.com/exk1fcia6d6EMsf331d8", m.EntityID)
+	assert.False(t, m.WantAuthnRequestsSigned)
+	assert.Equal(t, []string{"urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified", "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"}, m.NameIDFormats)
+	assert.Equal(t, "https://example.okta.com/app/example_app/exk1fcia6d6EMsf331d8/sso/saml", m.SingleSignOnURL(SAMLBindingHTTPRedirect))
+	require.Len(t, m.SigningCertificates, 1)
+	assert.Equal(t, der, m.SigningCertificates[0].Raw)
+	assert.Empty(t, m.EncryptionCertificates, "Signing keys should not be used for encryption")
+
+	_, err = ParseSAMLMetadata([]byte(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="sp"/>`))
+	assert.Error(t, err, "Metadata without an identity provider should not parse")
+}
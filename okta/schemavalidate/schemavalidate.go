@@ -0,0 +1,353 @@
+// Package schemavalidate checks the responses of an okta.APIClient against
+// the OpenAPI spec the SDK was generated from, to learn early when Okta
+// changes the shape of responses an application depends on. It lives outside
+// the okta package so that applications not using it do not embed the
+// schemas.
+//
+// The schemas are extracted from the bundled spec by go generate, see
+// okta/internal/respschema.
+package schemavalidate
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/okta/okta-sdk-golang/v5/okta"
+)
+
+//go:embed schemas.json
+var bundledSchemas []byte
+
+type schema struct {
+	Ref           string             `json:"ref"`
+	Type          string             `json:"type"`
+	Properties    map[string]*schema `json:"properties"`
+	Required      []string           `json:"required"`
+	Items         *schema            `json:"items"`
+	AllOf         []*schema          `json:"allOf"`
+	OneOf         []*schema          `json:"oneOf"`
+	AnyOf         []*schema          `json:"anyOf"`
+	Additional    *schema            `json:"additional"`
+	Open          bool               `json:"open"`
+	Closed        bool               `json:"closed"`
+	Discriminator *struct {
+		PropertyName string            `json:"propertyName"`
+		Mapping      map[string]string `json:"mapping"`
+	} `json:"discriminator"`
+}
+
+type operation struct {
+	Method    string             `json:"method"`
+	Path      string             `json:"path"`
+	Responses map[string]*schema `json:"responses"`
+	segments  []string
+}
+
+type document struct {
+	Operations []*operation       `json:"operations"`
+	Schemas    map[string]*schema `json:"schemas"`
+}
+
+// Options tune a Validator.
+type Options struct {
+	// UnknownProperties reports properties of objects the schema does not
+	// declare. They are usually additions of Okta that break nothing, so
+	// they are ignored by default.
+	UnknownProperties bool
+	// OnMismatch is called with the differences found in every response that
+	// did not match its schema, in addition to reporting them to the
+	// CallObserver of the client.
+	OnMismatch func(req *http.Request, mismatches []okta.SchemaMismatch)
+}
+
+// Validator is an okta.ResponseValidator checking responses against the
+// schemas of the bundled OpenAPI spec.
+type Validator struct {
+	opts Options
+	doc  *document
+}
+
+// New returns a Validator using the schemas of the bundled spec.
+func New(opts Options) (*Validator, error) {
+	var doc document
+	if err := json.Unmarshal(bundledSchemas, &doc); err != nil {
+		return nil, fmt.Errorf("failed to read the bundled schemas: %w", err)
+	}
+	for _, op := range doc.Operations {
+		op.segments = strings.Split(strings.Trim(op.Path, "/"), "/")
+	}
+	return &Validator{opts: opts, doc: &doc}, nil
+}
+
+// WithValidator configures a client to check its responses against the
+// bundled spec. Differences are reported without failing calls, see
+// okta.WithFailOnSchemaMismatch.
+func WithValidator(opts Options) (okta.ConfigSetter, error) {
+	v, err := New(opts)
+	if err != nil {
+		return nil, err
+	}
+	return okta.WithResponseValidator(v), nil
+}
+
+// ValidateResponse implements okta.ResponseValidator.
+func (v *Validator) ValidateResponse(req *http.Request, resp *http.Response, body []byte) []okta.SchemaMismatch {
+	op := v.match(req.Method, req.URL.Path)
+	if op == nil {
+		return nil
+	}
+	s, ok := op.Responses[strconv.Itoa(resp.StatusCode)]
+	if !ok {
+		if s, ok = op.Responses["2XX"]; !ok {
+			return nil
+		}
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil
+	}
+	c := &checker{v: v, operation: op.Method + " " + op.Path}
+	c.check(s, value, "", "", false)
+	if len(c.mismatches) > 0 && v.opts.OnMismatch != nil {
+		v.opts.OnMismatch(req, c.mismatches)
+	}
+	return c.mismatches
+}
+
+// match returns the operation of the spec a request path belongs to,
+// preferring literal segments over path parameters.
+func (v *Validator) match(method, path string) *operation {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var best *operation
+	params := -1
+	for _, op := range v.doc.Operations {
+		if op.Method != method || len(op.segments) != len(segments) {
+			continue
+		}
+		n, ok := 0, true
+		for i, part := range op.segments {
+			if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+				n++
+				continue
+			}
+			if part != segments[i] {
+				ok = false
+				break
+			}
+		}
+		if ok && (params < 0 || n < params) {
+			best, params = op, n
+		}
+	}
+	return best
+}
+
+type checker struct {
+	v          *Validator
+	operation  string
+	mismatches []okta.SchemaMismatch
+}
+
+func (c *checker) report(pointer, format string, args ...interface{}) {
+	c.mismatches = append(c.mismatches, okta.SchemaMismatch{
+		Operation: c.operation,
+		Pointer:   pointer,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+// check compares value with s. dispatched names the subtype a discriminator
+// selected, so that its base schema, which it includes through allOf, does not
+// dispatch to it again. composed is set for the parts of an allOf,
+// whose unknown properties are told by the composite schema.
+func (c *checker) check(s *schema, value interface{}, pointer, dispatched string, composed bool) {
+	if s.Ref != "" {
+		c.checkNamed(s.Ref, value, pointer, dispatched, composed)
+		return
+	}
+	// Okta returns null for many properties the spec does not declare
+	// nullable, which would drown the mismatches that matter.
+	if value == nil {
+		return
+	}
+	for _, sub := range s.AllOf {
+		c.check(sub, value, pointer, dispatched, true)
+	}
+	if object, ok := value.(map[string]interface{}); ok && c.v.opts.UnknownProperties && !composed {
+		for _, name := range sortedKeys(object) {
+			if !c.declares(s, name) {
+				c.report(pointer+"/"+escapePointer(name), "unknown property")
+			}
+		}
+	}
+	if alternatives := append(append([]*schema{}, s.OneOf...), s.AnyOf...); len(alternatives) > 0 {
+		c.checkAlternatives(alternatives, value, pointer)
+	}
+	switch s.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			c.report(pointer, "expected an object, got %s", kind(value))
+			return
+		}
+		c.checkObject(s, object, pointer)
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			c.report(pointer, "expected an array, got %s", kind(value))
+			return
+		}
+		if s.Items != nil {
+			for i, item := range array {
+				c.check(s.Items, item, pointer+"/"+strconv.Itoa(i), "", false)
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			c.report(pointer, "expected a string, got %s", kind(value))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			c.report(pointer, "expected a boolean, got %s", kind(value))
+		}
+	case "number":
+		if _, ok := value.(json.Number); !ok {
+			c.report(pointer, "expected a number, got %s", kind(value))
+		}
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			c.report(pointer, "expected an integer, got %s", kind(value))
+		} else if _, err := n.Int64(); err != nil {
+			c.report(pointer, "expected an integer, got %s", n)
+		}
+	case "":
+		if len(s.Properties) > 0 || s.Additional != nil {
+			if object, ok := value.(map[string]interface{}); ok {
+				c.checkObject(s, object, pointer)
+			}
+		}
+	}
+}
+
+// checkNamed compares value with a component schema, dispatching on its
+// discriminator.
+func (c *checker) checkNamed(name string, value interface{}, pointer, dispatched string, composed bool) {
+	s, ok := c.v.doc.Schemas[name]
+	if !ok || s == nil {
+		return
+	}
+	if d := s.Discriminator; d != nil {
+		if object, ok := value.(map[string]interface{}); ok {
+			if key, ok := object[d.PropertyName].(string); ok {
+				if target, ok := d.Mapping[key]; ok && target != name && target != dispatched {
+					c.checkNamed(target, value, pointer, target, composed)
+					return
+				}
+			}
+		}
+	}
+	c.check(s, value, pointer, dispatched, composed)
+}
+
+// checkAlternatives reports value when it matches none of alternatives.
+func (c *checker) checkAlternatives(alternatives []*schema, value interface{}, pointer string) {
+	for _, alternative := range alternatives {
+		trial := &checker{v: c.v, operation: c.operation}
+		trial.check(alternative, value, pointer, "", false)
+		if len(trial.mismatches) == 0 {
+			return
+		}
+	}
+	c.report(pointer, "matches none of the %d alternative schemas", len(alternatives))
+}
+
+func (c *checker) checkObject(s *schema, object map[string]interface{}, pointer string) {
+	for _, name := range s.Required {
+		if _, ok := object[name]; !ok {
+			c.report(pointer, "missing required property %q", name)
+		}
+	}
+	for _, name := range sortedKeys(object) {
+		child := pointer + "/" + escapePointer(name)
+		if p, ok := s.Properties[name]; ok {
+			c.check(p, object[name], child, "", false)
+			continue
+		}
+		switch {
+		case s.Additional != nil:
+			c.check(s.Additional, object[name], child, "", false)
+		case s.Closed:
+			c.report(child, "unknown property")
+		}
+	}
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// declares reports whether s or the schemas it is composed of declare a
+// property, or accept any property.
+func (c *checker) declares(s *schema, name string) bool {
+	return c.declaresSeen(s, name, map[string]bool{})
+}
+
+func (c *checker) declaresSeen(s *schema, name string, seen map[string]bool) bool {
+	if s == nil {
+		return false
+	}
+	if s.Ref != "" {
+		if seen[s.Ref] {
+			return false
+		}
+		seen[s.Ref] = true
+		return c.declaresSeen(c.v.doc.Schemas[s.Ref], name, seen)
+	}
+	if _, ok := s.Properties[name]; ok || s.Open || s.Additional != nil || len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
+		return true
+	}
+	if len(s.Properties) == 0 && len(s.AllOf) == 0 {
+		// a free-form object
+		return true
+	}
+	for _, sub := range s.AllOf {
+		if c.declaresSeen(sub, name, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func kind(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case json.Number:
+		return "a number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func escapePointer(name string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
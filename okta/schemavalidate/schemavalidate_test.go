@@ -0,0 +1,99 @@
+package schemavalidate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validate(t *testing.T, v *Validator, method, path, body string) []okta.SchemaMismatch {
+	req, err := http.NewRequest(method, "https://example.okta.com"+path, nil)
+	require.NoError(t, err)
+	return v.ValidateResponse(req, &http.Response{StatusCode: 200}, []byte(body))
+}
+
+func Test_Validate_Response(t *testing.T) {
+	v, err := New(Options{})
+	require.NoError(t, err)
+
+	assert.Empty(t, validate(t, v, "GET", "/api/v1/users/00u1", `{"id":"00u1","status":"ACTIVE","profile":{"login":"a@example.com"},"lastLogin":null}`))
+	assert.Empty(t, validate(t, v, "GET", "/api/v1/unknown", `{"id":1}`), "Unknown operations are not checked")
+
+	mismatches := validate(t, v, "GET", "/api/v1/users", `[{"id":"00u1","profile":"a@example.com"}]`)
+	require.Len(t, mismatches, 1)
+	assert.Equal(t, okta.SchemaMismatch{Operation: "GET /api/v1/users", Pointer: "/0/profile", Message: "expected an object, got a string"}, mismatches[0])
+
+	// applications are checked against the schema of their sign on mode
+	mismatches = validate(t, v, "GET", "/api/v1/apps/0oa1", `{"id":"0oa1","label":"Intranet","signOnMode":"BOOKMARK","name":"bookmark"}`)
+	require.Len(t, mismatches, 1)
+	assert.Equal(t, "GET /api/v1/apps/{appId}", mismatches[0].Operation)
+	assert.Equal(t, `missing required property "settings"`, mismatches[0].Message)
+
+	assert.Empty(t, validate(t, v, "GET", "/api/v1/users/00u1", `{"id":"00u1","newProperty":true}`))
+	v, err = New(Options{UnknownProperties: true})
+	require.NoError(t, err)
+	mismatches = validate(t, v, "GET", "/api/v1/users/00u1", `{"id":"00u1","newProperty":true}`)
+	require.Len(t, mismatches, 1)
+	assert.Equal(t, "/newProperty", mismatches[0].Pointer)
+	assert.Empty(t, validate(t, v, "GET", "/api/v1/apps/0oa1", `{"id":"0oa1","label":"Intranet","signOnMode":"BOOKMARK","name":"bookmark","settings":{}}`),
+		"Properties of the subtype and of its base are known")
+	assert.Empty(t, validate(t, v, "GET", "/api/v1/users", `[{"id":"00u1","_embedded":{"anything":{}}}]`), "Free-form objects accept any property")
+}
+
+type recordingObserver struct {
+	mismatches []okta.SchemaMismatch
+}
+
+func (o *recordingObserver) StartCall(ctx context.Context, call *okta.Call) context.Context {
+	return ctx
+}
+
+func (o *recordingObserver) EndCall(ctx context.Context, call *okta.Call) {
+	o.mismatches = append(o.mismatches, call.SchemaMismatches...)
+}
+
+func Test_Client_Reports_Mismatches(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "/api/v1/groups/00g1", func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(200, `{"id":"00g1","profile":{"name":"Everyone"},"newProperty":true}`)
+		resp.Header.Set("Content-Type", "application/json")
+		return resp, nil
+	})
+
+	var reported []okta.SchemaMismatch
+	setter, err := WithValidator(Options{UnknownProperties: true, OnMismatch: func(req *http.Request, mismatches []okta.SchemaMismatch) {
+		reported = append(reported, mismatches...)
+	}})
+	require.NoError(t, err)
+	observer := &recordingObserver{}
+	configuration, err := okta.NewConfiguration(
+		okta.WithOrgUrl("https://example.okta.com"),
+		okta.WithToken("token"),
+		okta.WithCache(false),
+		okta.WithCallObserver(observer),
+		setter,
+	)
+	require.NoError(t, err)
+	client := okta.NewAPIClient(configuration)
+
+	group, _, err := client.GroupAPI.GetGroup(context.Background(), "00g1").Execute()
+	require.NoError(t, err, "Mismatches should not fail calls by default")
+	assert.Equal(t, "00g1", group.GetId())
+	require.Len(t, observer.mismatches, 1)
+	assert.Equal(t, "/newProperty", observer.mismatches[0].Pointer)
+	assert.Equal(t, observer.mismatches, reported)
+
+	configuration.FailOnSchemaMismatch = true
+	_, _, err = client.GroupAPI.GetGroup(context.Background(), "00g1").Execute()
+	require.True(t, errors.Is(err, okta.ErrSchemaMismatch), "unexpected error %v", err)
+	var mismatchErr *okta.SchemaMismatchError
+	require.ErrorAs(t, err, &mismatchErr)
+	assert.Len(t, mismatchErr.Mismatches, 1)
+}
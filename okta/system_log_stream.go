@@ -0,0 +1,195 @@
+package okta
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultLogStreamPollInterval     = 15 * time.Second
+	defaultLogStreamName             = "default"
+	defaultLogStreamCheckpointPrefix = "okta:logcursor:"
+)
+
+// LogCheckpointStore persists the position of System Log streams, so that a
+// stream restarted after a crash or a deploy resumes where it stopped.
+type LogCheckpointStore interface {
+	// LoadCheckpoint returns the cursor saved for the stream name, "" when
+	// there is none.
+	LoadCheckpoint(ctx context.Context, name string) (string, error)
+	// SaveCheckpoint saves the cursor of the stream name.
+	SaveCheckpoint(ctx context.Context, name, cursor string) error
+}
+
+// StoreCheckpoints is a LogCheckpointStore keeping cursors in a CacheStore,
+// such as a FileStore or a Redis store, without expiry.
+type StoreCheckpoints struct {
+	store  CacheStore
+	prefix string
+}
+
+// NewStoreCheckpoints returns a LogCheckpointStore keeping cursors in store
+// under prefix, "okta:logcursor:" by default.
+func NewStoreCheckpoints(store CacheStore, prefix string) *StoreCheckpoints {
+	if prefix == "" {
+		prefix = defaultLogStreamCheckpointPrefix
+	}
+	return &StoreCheckpoints{store: store, prefix: prefix}
+}
+
+// LoadCheckpoint implements LogCheckpointStore.
+func (s *StoreCheckpoints) LoadCheckpoint(ctx context.Context, name string) (string, error) {
+	cursor, _, err := s.store.Get(s.prefix + name)
+	return string(cursor), err
+}
+
+// SaveCheckpoint implements LogCheckpointStore.
+func (s *StoreCheckpoints) SaveCheckpoint(ctx context.Context, name, cursor string) error {
+	return s.store.Set(s.prefix+name, []byte(cursor), 0)
+}
+
+// LogStreamOptions configures SystemLogAPI.Stream.
+type LogStreamOptions struct {
+	// Name identifies the stream in Checkpoints, "default" by default.
+	Name string
+	// Since is where a stream without a checkpoint starts, now by default.
+	Since time.Time
+	// Filter and Q are passed to the System Log API as is.
+	Filter string
+	Q      string
+	// PageSize is the number of events requested per page, 1000 by default.
+	PageSize int32
+	// PollInterval is how long the stream waits for new events once it
+	// caught up, 15 seconds by default.
+	PollInterval time.Duration
+	// Checkpoints saves the cursor of the stream after every page. Without
+	// it a stream always starts at Since.
+	Checkpoints LogCheckpointStore
+}
+
+// Stream polls the System Log for new events in order of publication,
+// following the bookmark of the last page as Okta recommends. The cursor is
+// saved to opts.Checkpoints once every event of a page was handled by the
+// loop body, so a restarted stream may deliver the events of the page it
+// stopped in again. Rate limited polls wait until the limit resets. The
+// stream ends when ctx is done, yielding its error, or on the first other
+// error.
+//
+//	for event, err := range client.SystemLogAPI.Stream(ctx, opts) {
+//		if err != nil {
+//			return err
+//		}
+//		forward(event)
+//	}
+func (a *SystemLogAPIService) Stream(ctx context.Context, opts LogStreamOptions) iter.Seq2[LogEvent, error] {
+	if opts.Name == "" {
+		opts.Name = defaultLogStreamName
+	}
+	if opts.Since.IsZero() {
+		opts.Since = time.Now()
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = defaultLogBackfillPageSize
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultLogStreamPollInterval
+	}
+	return func(yield func(LogEvent, error) bool) {
+		// polls of a bookmark return new events, they must not be cached
+		ctx := WithNoCache(ctx)
+		var after string
+		if opts.Checkpoints != nil {
+			var err error
+			if after, err = opts.Checkpoints.LoadCheckpoint(ctx, opts.Name); err != nil {
+				yield(LogEvent{}, err)
+				return
+			}
+		}
+		for {
+			req := a.ListLogEvents(ctx).SortOrder("ASCENDING").Limit(opts.PageSize)
+			if after != "" {
+				req = req.After(after)
+			} else {
+				req = req.Since(opts.Since)
+			}
+			if opts.Filter != "" {
+				req = req.Filter(opts.Filter)
+			}
+			if opts.Q != "" {
+				req = req.Q(opts.Q)
+			}
+			events, resp, err := req.Execute()
+			if err != nil {
+				if !IsRateLimited(err) || ctx.Err() != nil {
+					yield(LogEvent{}, err)
+					return
+				}
+				if err := sleep(ctx, a.client.rateLimitReset(resp, opts.PollInterval)); err != nil {
+					yield(LogEvent{}, err)
+					return
+				}
+				continue
+			}
+			for _, event := range events {
+				if !yield(event, nil) {
+					return
+				}
+			}
+			if next := nextAfter(resp); next != "" && next != after {
+				after = next
+				if opts.Checkpoints != nil {
+					if err := opts.Checkpoints.SaveCheckpoint(ctx, opts.Name, after); err != nil {
+						yield(LogEvent{}, err)
+						return
+					}
+				}
+			}
+			// a full page means more events are waiting
+			if len(events) < int(opts.PageSize) {
+				if err := sleep(ctx, opts.PollInterval); err != nil {
+					yield(LogEvent{}, err)
+					return
+				}
+			}
+		}
+	}
+}
+
+// nextAfter returns the after parameter of the next link of resp.
+func nextAfter(resp *APIResponse) string {
+	if resp == nil || !resp.HasNextPage() {
+		return ""
+	}
+	next, err := url.Parse(resp.NextPage())
+	if err != nil {
+		return ""
+	}
+	return next.Query().Get("after")
+}
+
+// rateLimitReset returns how long until the rate limit of resp resets, or
+// fallback when it has no rate limit headers.
+func (c *APIClient) rateLimitReset(resp *APIResponse, fallback time.Duration) time.Duration {
+	if resp == nil || resp.Response == nil {
+		return fallback
+	}
+	limit, err := c.parseLimitHeaders(resp.Response)
+	if err != nil || limit.Reset <= 0 {
+		return fallback
+	}
+	return time.Duration(limit.Reset) * time.Second
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_System_Log_Stream(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var polls []string
+	limited := false
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	httpmock.RegisterResponder("GET", "/api/v1/logs", func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		assert.Equal(t, "ASCENDING", query.Get("sortOrder"))
+		assert.Equal(t, `eventType eq "user.session.start"`, query.Get("filter"))
+		after := query.Get("after")
+		polls = append(polls, after)
+		page := func(body, next string) (*http.Response, error) {
+			resp := mockJSONResponse(200, body)
+			resp.Header.Add("Link", `<https://example.okta.com/api/v1/logs?after=`+next+`&limit=2>; rel="next"`)
+			return resp, nil
+		}
+		switch after {
+		case "":
+			assert.NotEmpty(t, query.Get("since"), "A stream without a checkpoint should start at Since")
+			return page(`[{"uuid":"e1"},{"uuid":"e2"}]`, "c1")
+		case "c1":
+			assert.Empty(t, query.Get("since"))
+			return page(`[{"uuid":"e3"}]`, "c2")
+		default:
+			if !limited {
+				limited = true
+				return mockJSONResponse(429, `{"errorCode":"E0000047","errorSummary":"API call exceeded rate limit due to too many requests."}`), nil
+			}
+			if len(polls) >= 5 {
+				cancel()
+			}
+			return page(`[]`, "c2")
+		}
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithRateLimitMaxRetries(0))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	opts := LogStreamOptions{
+		Name:         "siem",
+		Since:        time.Now().Add(-time.Hour),
+		Filter:       `eventType eq "user.session.start"`,
+		PageSize:     2,
+		PollInterval: 10 * time.Millisecond,
+		Checkpoints:  NewStoreCheckpoints(store, ""),
+	}
+
+	var received []string
+	for event, err := range client.SystemLogAPI.Stream(context.Background(), opts) {
+		require.NoError(t, err)
+		received = append(received, event.GetUuid())
+		if len(received) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []string{"e1", "e2", "e3"}, received)
+	assert.Equal(t, []string{"", "c1"}, polls, "A full page should be followed by the next one at once")
+	cursor, err := opts.Checkpoints.LoadCheckpoint(context.Background(), "siem")
+	require.NoError(t, err)
+	assert.Equal(t, "c1", cursor, "The cursor of a page should be saved once all its events were handled")
+
+	// a restarted stream resumes from the checkpoint and delivers e3 again
+	received, polls = nil, nil
+	var streamErr error
+	for event, err := range client.SystemLogAPI.Stream(ctx, opts) {
+		if err != nil {
+			streamErr = err
+			break
+		}
+		received = append(received, event.GetUuid())
+	}
+	assert.Equal(t, []string{"e3"}, received)
+	assert.ErrorIs(t, streamErr, context.Canceled)
+	assert.True(t, limited, "A rate limited poll should be retried")
+	assert.Equal(t, []string{"c1", "c2", "c2"}, polls[:3])
+	cursor, err = opts.Checkpoints.LoadCheckpoint(context.Background(), "siem")
+	require.NoError(t, err)
+	assert.Equal(t, "c2", cursor)
+}
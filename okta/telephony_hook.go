@@ -0,0 +1,314 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TelephonyHookType is the type of the inline hooks delivering one-time
+// passcodes by SMS and voice call instead of Okta.
+const TelephonyHookType = "com.okta.telephony.provider"
+
+// Flows a telephony hook is called for, the RequestType of a
+// TelephonyHookRequest.
+const (
+	TelephonyRequestEnrollment      = "com.okta.user.telephony.pre-enrollment"
+	TelephonyRequestMFAVerification = "com.okta.user.telephony.mfa-verification"
+	TelephonyRequestAccountUnlock   = "com.okta.user.telephony.account-unlock"
+	TelephonyRequestPasswordReset   = "com.okta.user.telephony.password-reset"
+)
+
+// Delivery channels of the one-time passcode.
+const (
+	TelephonyChannelSMS   = "SMS"
+	TelephonyChannelVoice = "Voice call"
+)
+
+// Delivery statuses a telephony hook answers with.
+const (
+	TelephonyStatusSuccessful = "SUCCESSFUL"
+	TelephonyStatusPending    = "PENDING"
+	TelephonyStatusFailed     = "FAILED"
+)
+
+const telephonyActionCommand = "com.okta.telephony.action"
+
+// TelephonyHookRequest is the payload Okta sends to a telephony hook.
+type TelephonyHookRequest struct {
+	EventID           string            `json:"eventId"`
+	EventTime         string            `json:"eventTime"`
+	EventType         string            `json:"eventType"`
+	EventTypeVersion  string            `json:"eventTypeVersion"`
+	ContentType       string            `json:"contentType"`
+	CloudEventVersion string            `json:"cloudEventVersion"`
+	Source            string            `json:"source"`
+	RequestType       string            `json:"requestType"`
+	Data              TelephonyHookData `json:"data"`
+}
+
+// TelephonyHookData is the data of a TelephonyHookRequest.
+type TelephonyHookData struct {
+	Context struct {
+		Request struct {
+			ID     string `json:"id"`
+			Method string `json:"method"`
+			URL    struct {
+				Value string `json:"value"`
+			} `json:"url"`
+			IPAddress string `json:"ipAddress"`
+		} `json:"request"`
+	} `json:"context"`
+	UserProfile struct {
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+		Login     string `json:"login"`
+		UserID    string `json:"userId"`
+	} `json:"userProfile"`
+	MessageProfile struct {
+		MsgTemplate     string `json:"msgTemplate"`
+		PhoneNumber     string `json:"phoneNumber"`
+		OTPExpires      string `json:"otpExpires"`
+		DeliveryChannel string `json:"deliveryChannel"`
+		OTPCode         string `json:"otpCode"`
+		Locale          string `json:"locale"`
+	} `json:"messageProfile"`
+}
+
+// TelephonyHookResponse is what a telephony hook answers: either the outcome
+// of the delivery in Commands, or an Error.
+type TelephonyHookResponse struct {
+	Commands []TelephonyHookCommand `json:"commands,omitempty"`
+	Error    *TelephonyHookError    `json:"error,omitempty"`
+}
+
+// TelephonyHookCommand reports the delivery of the passcode to Okta.
+type TelephonyHookCommand struct {
+	Type  string                  `json:"type"`
+	Value []TelephonyHookDelivery `json:"value"`
+}
+
+// TelephonyHookDelivery is the outcome of the delivery by the provider.
+type TelephonyHookDelivery struct {
+	Status              string `json:"status"`
+	Provider            string `json:"provider"`
+	TransactionID       string `json:"transactionId"`
+	TransactionMetadata string `json:"transactionMetadata,omitempty"`
+}
+
+// TelephonyHookError is the error a telephony hook answers with when it could
+// not deliver the passcode.
+type TelephonyHookError struct {
+	ErrorSummary string `json:"errorSummary"`
+	ErrorCauses  []struct {
+		ErrorSummary string `json:"errorSummary"`
+		Reason       string `json:"reason"`
+		Location     string `json:"location"`
+	} `json:"errorCauses,omitempty"`
+}
+
+// NewTelephonyHookResponse returns the response of a telephony hook that
+// handed the passcode to provider.
+func NewTelephonyHookResponse(status, provider, transactionID string) *TelephonyHookResponse {
+	return &TelephonyHookResponse{Commands: []TelephonyHookCommand{{
+		Type:  telephonyActionCommand,
+		Value: []TelephonyHookDelivery{{Status: status, Provider: provider, TransactionID: transactionID}},
+	}}}
+}
+
+// TelephonySimulation describes the request simulated by
+// NewTelephonyHookRequest. Empty fields get plausible defaults.
+type TelephonySimulation struct {
+	// RequestType is one of the TelephonyRequest constants, an MFA
+	// verification by default.
+	RequestType string
+	// DeliveryChannel is TelephonyChannelSMS, the default, or
+	// TelephonyChannelVoice.
+	DeliveryChannel string
+	PhoneNumber     string
+	Locale          string
+	OrgURL          string
+	HookID          string
+	UserID          string
+	Login           string
+	FirstName       string
+	LastName        string
+}
+
+// NewTelephonyHookRequest returns the payload Okta sends to a telephony hook
+// for a simulated passcode delivery, with a random six digit passcode that
+// expires in five minutes.
+func NewTelephonyHookRequest(sim TelephonySimulation) (*TelephonyHookRequest, error) {
+	if sim.RequestType == "" {
+		sim.RequestType = TelephonyRequestMFAVerification
+	}
+	if sim.DeliveryChannel == "" {
+		sim.DeliveryChannel = TelephonyChannelSMS
+	}
+	if sim.PhoneNumber == "" {
+		sim.PhoneNumber = "+15555550100"
+	}
+	if sim.Locale == "" {
+		sim.Locale = "EN-US"
+	}
+	if sim.OrgURL == "" {
+		sim.OrgURL = "https://example.okta.com"
+	}
+	if sim.Login == "" {
+		sim.Login = "test.user@example.com"
+	}
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return nil, err
+	}
+	code := fmt.Sprintf("%06d", n.Int64())
+	now := time.Now().UTC()
+
+	req := &TelephonyHookRequest{
+		EventID:           uuid.New().String(),
+		EventTime:         now.Format(time.RFC3339),
+		EventType:         TelephonyHookType,
+		EventTypeVersion:  "1.0",
+		ContentType:       "application/json",
+		CloudEventVersion: "0.1",
+		Source:            sim.OrgURL + "/api/v1/inlineHooks/" + sim.HookID,
+		RequestType:       sim.RequestType,
+	}
+	r := &req.Data.Context.Request
+	r.ID, r.Method, r.URL.Value, r.IPAddress = uuid.New().String(), http.MethodPost, "/idp/idx/challenge/send", "127.0.0.1"
+	u := &req.Data.UserProfile
+	u.FirstName, u.LastName, u.Login, u.UserID = sim.FirstName, sim.LastName, sim.Login, sim.UserID
+	m := &req.Data.MessageProfile
+	m.MsgTemplate = "Your verification code is " + code
+	m.PhoneNumber = sim.PhoneNumber
+	m.OTPExpires = now.Add(5 * time.Minute).Format("2006-01-02T15:04:05.000Z")
+	m.DeliveryChannel = sim.DeliveryChannel
+	m.OTPCode = code
+	m.Locale = sim.Locale
+	return req, nil
+}
+
+// TelephonyHookResult is the outcome of SimulateTelephonyHook.
+type TelephonyHookResult struct {
+	StatusCode int
+	// Response is the decoded response of the handler, nil when it is not
+	// JSON.
+	Response *TelephonyHookResponse
+	// Problems lists how the response deviates from what Okta accepts.
+	Problems []string
+}
+
+// OK reports whether Okta would accept the response as a delivered passcode.
+func (r *TelephonyHookResult) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// SimulateTelephonyHook sends req to a telephony hook handler the way Okta
+// does, with the authorization header of the hook set to authValue, and
+// checks the shape of its response.
+func SimulateTelephonyHook(ctx context.Context, handler http.Handler, req *TelephonyHookRequest, authHeader, authValue string) (*TelephonyHookResult, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	if authHeader != "" {
+		httpReq.Header.Set(authHeader, authValue)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httpReq)
+
+	result := &TelephonyHookResult{StatusCode: recorder.Code}
+	if recorder.Code != http.StatusOK {
+		result.problem("the status code is %d, Okta expects 200", recorder.Code)
+	}
+	var resp TelephonyHookResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &resp); err != nil {
+		result.problem("the response is not JSON: %v", err)
+		return result, nil
+	}
+	result.Response = &resp
+	result.check()
+	return result, nil
+}
+
+func (r *TelephonyHookResult) problem(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+func (r *TelephonyHookResult) check() {
+	resp := r.Response
+	if resp.Error != nil {
+		if resp.Error.ErrorSummary == "" {
+			r.problem("the error has no errorSummary")
+		}
+		r.problem("the hook answered with an error: %s", resp.Error.ErrorSummary)
+		return
+	}
+	if len(resp.Commands) != 1 {
+		r.problem("the response has %d commands, Okta expects 1", len(resp.Commands))
+		return
+	}
+	command := resp.Commands[0]
+	if command.Type != telephonyActionCommand {
+		r.problem("the command type is %q, Okta expects %q", command.Type, telephonyActionCommand)
+	}
+	if len(command.Value) != 1 {
+		r.problem("the command has %d values, Okta expects 1", len(command.Value))
+		return
+	}
+	delivery := command.Value[0]
+	switch delivery.Status {
+	case TelephonyStatusSuccessful, TelephonyStatusPending, TelephonyStatusFailed:
+	default:
+		r.problem("the status is %q, Okta expects SUCCESSFUL, PENDING or FAILED", delivery.Status)
+	}
+	if delivery.Provider == "" {
+		r.problem("the provider is missing")
+	}
+	if delivery.TransactionID == "" {
+		r.problem("the transactionId is missing")
+	}
+}
+
+// TelephonyHookOptions configures the inline hook created by
+// CreateTelephonyHook.
+type TelephonyHookOptions struct {
+	// Name of the hook, "Telephony" by default.
+	Name string
+	// URL of the handler Okta calls with the passcodes to deliver.
+	URL string
+	// AuthHeader and AuthValue are sent by Okta with every hook call so the
+	// handler can authenticate it. Okta requires them for telephony hooks.
+	AuthHeader string
+	AuthValue  string
+}
+
+// CreateTelephonyHook creates and activates a telephony inline hook. Okta
+// calls it instead of its own SMS and voice providers once it is active.
+func (c *APIClient) CreateTelephonyHook(ctx context.Context, opts TelephonyHookOptions) (*InlineHook, error) {
+	if opts.URL == "" {
+		return nil, errors.New("telephony hook URL is required")
+	}
+	if opts.AuthHeader == "" || opts.AuthValue == "" {
+		return nil, errors.New("telephony hooks require an authorization header and value")
+	}
+	if opts.Name == "" {
+		opts.Name = "Telephony"
+	}
+	return c.createHTTPInlineHook(ctx, opts.Name, TelephonyHookType, opts.URL, opts.AuthHeader, opts.AuthValue)
+}
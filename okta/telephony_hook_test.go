@@ -0,0 +1,81 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Simulate_Telephony_Hook(t *testing.T) {
+	req, err := NewTelephonyHookRequest(TelephonySimulation{RequestType: TelephonyRequestEnrollment, PhoneNumber: "+15555550123"})
+	require.NoError(t, err)
+	assert.Equal(t, TelephonyHookType, req.EventType)
+	assert.Len(t, req.Data.MessageProfile.OTPCode, 6)
+	assert.Contains(t, req.Data.MessageProfile.MsgTemplate, req.Data.MessageProfile.OTPCode)
+
+	var received TelephonyHookRequest
+	good := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(NewTelephonyHookResponse(TelephonyStatusSuccessful, "TWILIO", "SM123"))
+	})
+	result, err := SimulateTelephonyHook(context.Background(), good, req, "Authorization", "secret")
+	require.NoError(t, err)
+	assert.True(t, result.OK(), result.Problems)
+	assert.Equal(t, TelephonyRequestEnrollment, received.RequestType)
+	assert.Equal(t, "+15555550123", received.Data.MessageProfile.PhoneNumber)
+
+	result, err = SimulateTelephonyHook(context.Background(), good, req, "Authorization", "wrong")
+	require.NoError(t, err)
+	assert.False(t, result.OK())
+
+	bad := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"commands":[{"type":"com.okta.action.update","value":[{"status":"SENT"}]}]}`))
+	})
+	result, err = SimulateTelephonyHook(context.Background(), bad, req, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`the command type is "com.okta.action.update", Okta expects "com.okta.telephony.action"`,
+		`the status is "SENT", Okta expects SUCCESSFUL, PENDING or FAILED`,
+		"the provider is missing",
+		"the transactionId is missing",
+	}, result.Problems)
+}
+
+func Test_Create_Telephony_Hook(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var hook map[string]interface{}
+	httpmock.RegisterResponder("POST", "/api/v1/inlineHooks", func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&hook))
+		return mockJSONResponse(200, `{"id":"cal1","status":"INACTIVE","type":"com.okta.telephony.provider"}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/inlineHooks/cal1/lifecycle/activate", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"cal1","status":"ACTIVE","type":"com.okta.telephony.provider"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	created, err := client.CreateTelephonyHook(context.Background(), TelephonyHookOptions{URL: "https://sms.example.com/hook", AuthHeader: "Authorization", AuthValue: "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "cal1", created.GetId())
+	assert.Equal(t, TelephonyHookType, hook["type"])
+	config := hook["channel"].(map[string]interface{})["config"].(map[string]interface{})
+	assert.Equal(t, "secret", config["authScheme"].(map[string]interface{})["value"])
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST /api/v1/inlineHooks/cal1/lifecycle/activate"])
+
+	_, err = client.CreateTelephonyHook(context.Background(), TelephonyHookOptions{URL: "https://sms.example.com/hook"})
+	assert.Error(t, err)
+}
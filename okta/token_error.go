@@ -0,0 +1,152 @@
+package okta
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// Classes of token endpoint errors, to be used with errors.Is on errors
+// returned while acquiring an OAuth 2.0 access token.
+var (
+	ErrInvalidClient    = errors.New("invalid_client")
+	ErrInvalidScope     = errors.New("invalid_scope")
+	ErrTokenRateLimited = errors.New("rate_limited")
+)
+
+// defaultTokenRetryStatuses are the token endpoint response statuses retried
+// when WithTokenRetryStatuses is not set.
+var defaultTokenRetryStatuses = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// TokenEndpointError is returned when the /oauth2/v1/token endpoint rejects a
+// token request.
+type TokenEndpointError struct {
+	StatusCode       int
+	ErrorCode        string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	// Retryable is true when the status is one of the token retry statuses,
+	// in which case the request was retried before the error was returned.
+	Retryable bool
+}
+
+func newTokenEndpointError(resp *http.Response, body []byte, retryable bool) *TokenEndpointError {
+	e := &TokenEndpointError{}
+	// The body is not always JSON, e.g. for errors returned by proxies.
+	_ = json.Unmarshal(body, e)
+	e.StatusCode = resp.StatusCode
+	e.Retryable = retryable
+	if e.ErrorCode == "" && resp.StatusCode == http.StatusTooManyRequests {
+		e.ErrorCode = ErrTokenRateLimited.Error()
+	}
+	return e
+}
+
+func (e *TokenEndpointError) Error() string {
+	msg := fmt.Sprintf("token request failed with status %d", e.StatusCode)
+	if e.ErrorCode != "" {
+		msg += ": " + e.ErrorCode
+	}
+	if e.ErrorDescription != "" {
+		msg += ": " + e.ErrorDescription
+	}
+	return msg
+}
+
+// Is reports whether the error belongs to the class of target, one of
+// ErrInvalidClient, ErrInvalidScope or ErrTokenRateLimited.
+func (e *TokenEndpointError) Is(target error) bool {
+	switch target {
+	case ErrInvalidClient, ErrInvalidScope:
+		return e.ErrorCode == target.Error()
+	case ErrTokenRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+func isTokenRetryStatus(status int, retryStatuses []int) bool {
+	if retryStatuses == nil {
+		retryStatuses = defaultTokenRetryStatuses
+	}
+	for _, s := range retryStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// setTokenRequestBody replaces the form body of a token request so it can be
+// sent again.
+func setTokenRequestBody(req *http.Request, form string) {
+	req.Body = io.NopCloser(strings.NewReader(form))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(form)), nil
+	}
+	req.ContentLength = int64(len(form))
+}
+
+// doTokenRequest sends a token request, retrying transport errors and
+// responses with a retryable status. Retries wait maxBackoff seconds, or as
+// long as the Retry-After or X-Rate-Limit-Reset header of the response asks
+// when that is shorter, and stop when the context of req is done. The
+// response body is returned already read. Other non-2xx responses are
+// returned without an error so callers can inspect them.
+func doTokenRequest(httpClient *http.Client, req *http.Request, maxRetries int32, maxBackoff int64, retryStatuses []int) (*http.Response, []byte, error) {
+	maxWait := time.Duration(maxBackoff) * time.Second
+	bOff := &oktaBackoff{
+		ctx:             req.Context(),
+		maxRetries:      maxRetries,
+		backoffDuration: maxWait,
+	}
+	var (
+		resp *http.Response
+		body []byte
+	)
+	operation := func() error {
+		if bOff.retryCount > 0 && req.GetBody != nil {
+			b, err := req.GetBody()
+			if err != nil {
+				return backoff.Permanent(err)
+			}
+			req.Body = b
+		}
+		bOff.retryCount++
+		bOff.backoffDuration = maxWait
+		var err error
+		resp, err = httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+		body, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if isTokenRetryStatus(resp.StatusCode, retryStatuses) {
+			if wait, ok := retryAfter(resp); ok && wait < maxWait {
+				bOff.backoffDuration = wait
+			}
+			return newTokenEndpointError(resp, body, true)
+		}
+		return nil
+	}
+	if err := backoff.Retry(operation, bOff); err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
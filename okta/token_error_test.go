@@ -0,0 +1,131 @@
+package okta
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTokenErrorTestClient(t *testing.T, conf ...ConfigSetter) *APIClient {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := NewCryptoSigner(key, "")
+	require.NoError(t, err)
+	conf = append([]ConfigSetter{
+		WithOrgUrl("https://example.okta.com"),
+		WithAuthorizationMode("PrivateKey"),
+		WithClientId("client"),
+		WithScopes([]string{"okta.users.read"}),
+		WithPrivateKeySigner(signer),
+		WithCache(false),
+		WithRateLimitMaxRetries(2),
+	}, conf...)
+	configuration, err := NewConfiguration(conf...)
+	require.NoError(t, err, "Creating a new config should not error")
+	return NewAPIClient(configuration)
+}
+
+func Test_Token_Endpoint_Errors(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	t.Run("invalid client is not retried", func(t *testing.T) {
+		httpmock.Reset()
+		httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+			return mockJSONResponse(401, `{"error":"invalid_client","error_description":"The client_assertion signature is invalid."}`), nil
+		})
+		_, err := newTokenErrorTestClient(t).AccessToken(context.Background())
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrInvalidClient))
+		assert.False(t, errors.Is(err, ErrInvalidScope))
+		var tokenErr *TokenEndpointError
+		require.True(t, errors.As(err, &tokenErr))
+		assert.Equal(t, 401, tokenErr.StatusCode)
+		assert.Equal(t, "The client_assertion signature is invalid.", tokenErr.ErrorDescription)
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("server errors are retried", func(t *testing.T) {
+		httpmock.Reset()
+		var calls int
+		httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls < 3 {
+				return mockJSONResponse(503, `{"error":"temporarily_unavailable"}`), nil
+			}
+			return mockJSONResponse(200, `{"token_type":"Bearer","expires_in":3600,"access_token":"abc"}`), nil
+		})
+		token, err := newTokenErrorTestClient(t).AccessToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "abc", token.Token)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("retries are exhausted", func(t *testing.T) {
+		httpmock.Reset()
+		httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+			return mockJSONResponse(429, `{}`), nil
+		})
+		_, err := newTokenErrorTestClient(t).AccessToken(context.Background())
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrTokenRateLimited))
+		var tokenErr *TokenEndpointError
+		require.True(t, errors.As(err, &tokenErr))
+		assert.True(t, tokenErr.Retryable)
+		assert.Equal(t, 3, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("retry statuses are configurable", func(t *testing.T) {
+		httpmock.Reset()
+		httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+			return mockJSONResponse(429, `{}`), nil
+		})
+		_, err := newTokenErrorTestClient(t, WithTokenRetryStatuses(http.StatusServiceUnavailable)).AccessToken(context.Background())
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrTokenRateLimited))
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("retries wait as long as the rate limit headers ask", func(t *testing.T) {
+		httpmock.Reset()
+		var calls int
+		httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls < 3 {
+				resp := mockJSONResponse(429, `{}`)
+				resp.Header.Set("Retry-After", "0")
+				return resp, nil
+			}
+			return mockJSONResponse(200, `{"token_type":"Bearer","expires_in":3600,"access_token":"abc"}`), nil
+		})
+		start := time.Now()
+		token, err := newTokenErrorTestClient(t, WithRateLimitMaxBackOff(30)).AccessToken(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "abc", token.Token)
+		assert.Equal(t, 3, calls)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("retries stop when the context is done", func(t *testing.T) {
+		httpmock.Reset()
+		httpmock.RegisterResponder("POST", "/oauth2/v1/token", func(req *http.Request) (*http.Response, error) {
+			return mockJSONResponse(503, `{"error":"temporarily_unavailable"}`), nil
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, err := newTokenErrorTestClient(t, WithRateLimitMaxBackOff(30)).AccessToken(ctx)
+		require.Error(t, err)
+		assert.Less(t, time.Since(start), 5*time.Second)
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+}
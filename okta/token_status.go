@@ -0,0 +1,236 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// errorCodeInvalidToken is returned by Okta when an SSWS token is invalid,
+// revoked or expired.
+const errorCodeInvalidToken = "E0000011"
+
+// defaultTokenExpiryWarning is how long before a known expiry a token is
+// reported as expiring soon.
+const defaultTokenExpiryWarning = 72 * time.Hour
+
+// tokenRotationBackoff is how long after a failed rotation the TokenRotator is
+// not called again.
+const tokenRotationBackoff = time.Minute
+
+// TokenStatus describes the health of the SSWS API token as observed by the
+// client.
+type TokenStatus struct {
+	// Valid is false once Okta rejected the token with E0000011.
+	Valid bool
+	// ExpiresAt is the known token expiry, zero when unknown.
+	ExpiresAt time.Time
+	// ExpiringSoon is true when ExpiresAt falls within the configured warning window.
+	ExpiringSoon bool
+	// Rotated is true when the token in use was provided by the TokenRotator.
+	Rotated bool
+	// LastError is the summary of the last token rejection, if any.
+	LastError string
+	// LastChecked is when the token was last used or rotated.
+	LastChecked time.Time
+}
+
+// TokenRotator is called with the current status when the SSWS token is
+// rejected or about to expire. It returns a replacement token and its expiry
+// (zero when unknown) which are used for all subsequent requests.
+type TokenRotator func(ctx context.Context, status TokenStatus) (token string, expiresAt time.Time, err error)
+
+// TokenRotationError is returned when Okta rejected the SSWS token and the
+// TokenRotator failed to replace it. errors.Is and errors.As match both the
+// error of the TokenRotator and the APIError of the rejection.
+type TokenRotationError struct {
+	// Rejected is the error Okta answered the request with.
+	Rejected *APIError
+	// Err is the error of the TokenRotator.
+	Err error
+}
+
+func (e *TokenRotationError) Error() string {
+	return fmt.Sprintf("the SSWS token was rejected (%v) and could not be rotated: %v", e.Rejected, e.Err)
+}
+
+func (e *TokenRotationError) Unwrap() []error {
+	return []error{e.Err, e.Rejected}
+}
+
+// sswsTokenState tracks the SSWS token in use and its observed health.
+type sswsTokenState struct {
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+	valid     bool
+	rotated   bool
+	lastError string
+	checked   time.Time
+
+	// rotating is closed when the rotation in progress, if any, is done.
+	rotating chan struct{}
+	// rotateErr and rotateFailed record the last failed rotation.
+	rotateErr    error
+	rotateFailed time.Time
+}
+
+func newSSWSTokenState(cfg *Configuration) *sswsTokenState {
+	return &sswsTokenState{expiresAt: cfg.TokenExpiresAt, valid: true}
+}
+
+func (s *sswsTokenState) status(cfg *Configuration) TokenStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.statusLocked(cfg)
+}
+
+func (s *sswsTokenState) statusLocked(cfg *Configuration) TokenStatus {
+	warning := cfg.TokenExpiryWarning
+	if warning == 0 {
+		warning = defaultTokenExpiryWarning
+	}
+	return TokenStatus{
+		Valid:        s.valid,
+		ExpiresAt:    s.expiresAt,
+		ExpiringSoon: !s.expiresAt.IsZero() && time.Until(s.expiresAt) < warning,
+		Rotated:      s.rotated,
+		LastError:    s.lastError,
+		LastChecked:  s.checked,
+	}
+}
+
+// current returns the token to use, rotating it first when it is known to be
+// invalid or expiring soon and a TokenRotator is configured. The TokenRotator
+// is called without holding the lock, by one request at a time, and not again
+// within tokenRotationBackoff of a failure. A token that is only expiring soon
+// keeps being used while the rotation fails; the rotation error is returned
+// when the token is invalid.
+func (s *sswsTokenState) current(ctx context.Context, cfg *Configuration) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for cfg.TokenRotator != nil {
+		st := s.statusLocked(cfg)
+		if st.Valid && !st.ExpiringSoon {
+			break
+		}
+		if s.rotating != nil {
+			if st.Valid {
+				break
+			}
+			// wait for the rotation in progress to replace the invalid token
+			done := s.rotating
+			s.mu.Unlock()
+			select {
+			case <-done:
+			case <-ctx.Done():
+				s.mu.Lock()
+				return "", ctx.Err()
+			}
+			s.mu.Lock()
+			continue
+		}
+		if !s.rotateFailed.IsZero() && time.Since(s.rotateFailed) < tokenRotationBackoff {
+			if !st.Valid {
+				return "", s.rotateErr
+			}
+			break
+		}
+		if err := s.rotateLocked(ctx, cfg, st); err != nil && !st.Valid {
+			return "", err
+		}
+		break
+	}
+	s.checked = time.Now()
+	return s.tokenLocked(cfg), nil
+}
+
+// tokenLocked returns the token in use.
+func (s *sswsTokenState) tokenLocked(cfg *Configuration) string {
+	if s.rotated {
+		return s.token
+	}
+	return cfg.Okta.Client.Token
+}
+
+// rotateLocked calls the TokenRotator with the lock released and records its
+// result.
+func (s *sswsTokenState) rotateLocked(ctx context.Context, cfg *Configuration, st TokenStatus) error {
+	done := make(chan struct{})
+	s.rotating = done
+	s.mu.Unlock()
+	token, expiresAt, err := cfg.TokenRotator(ctx, st)
+	if err == nil && token == "" {
+		err = errors.New("token rotator returned an empty token")
+	}
+	s.mu.Lock()
+	s.rotating = nil
+	close(done)
+	if err != nil {
+		s.rotateErr = err
+		s.rotateFailed = time.Now()
+		return err
+	}
+	s.token = token
+	s.expiresAt = expiresAt
+	s.valid = true
+	s.rotated = true
+	s.lastError = ""
+	s.checked = time.Now()
+	s.rotateErr = nil
+	s.rotateFailed = time.Time{}
+	return nil
+}
+
+// observe inspects the response to a request made with the token sent and
+// records whether Okta rejected the token in use. It reports true when the
+// token was marked invalid. A rejection of a token that was replaced while the
+// request was in flight is ignored.
+func (s *sswsTokenState) observe(cfg *Configuration, sent string, resp *http.Response, body []byte) bool {
+	if resp.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	var e Error
+	if err := json.Unmarshal(body, &e); err != nil || e.GetErrorCode() != errorCodeInvalidToken {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sent != s.tokenLocked(cfg) {
+		return false
+	}
+	if s.valid {
+		// a rotation that failed while the token was still accepted doesn't
+		// hold back the one replacing the rejected token
+		s.rotateFailed = time.Time{}
+	}
+	s.valid = false
+	s.lastError = e.GetErrorSummary()
+	s.checked = time.Now()
+	return true
+}
+
+// TokenStatus reports the health of the SSWS API token. It is only meaningful
+// when the client uses the SSWS authorization mode.
+func (c *APIClient) TokenStatus() TokenStatus {
+	return c.ssws.status(c.cfg)
+}
+
+// observeSSWSResponse records token rejections for SSWS clients and reports
+// whether the request should be retried with a rotated token.
+func (c *APIClient) observeSSWSResponse(req *http.Request, resp *http.Response) (bool, error) {
+	if c.cfg.Okta.Client.AuthorizationMode != "SSWS" || resp.StatusCode != http.StatusUnauthorized {
+		return false, nil
+	}
+	body, err := readAndRestoreBody(resp)
+	if err != nil {
+		return false, err
+	}
+	sent := strings.TrimPrefix(req.Header.Get("Authorization"), "SSWS ")
+	return c.ssws.observe(c.cfg, sent, resp, body) && c.cfg.TokenRotator != nil, nil
+}
@@ -0,0 +1,187 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SSWS_Token_Rotation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("Authorization") != "SSWS rotated" {
+			return mockJSONResponse(401, `{"errorCode":"E0000011","errorSummary":"Invalid token provided"}`), nil
+		}
+		return mockJSONResponse(200, "[]"), nil
+	})
+
+	var rotations int
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithToken("stale"),
+		WithCache(false),
+		WithTokenRotator(func(ctx context.Context, status TokenStatus) (string, time.Time, error) {
+			rotations++
+			assert.False(t, status.Valid, "Rotation should be triggered by the rejected token")
+			return "rotated", time.Time{}, nil
+		}),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+	require.NoError(t, err, "Request should succeed with the rotated token")
+	assert.Equal(t, 1, rotations)
+	status := client.TokenStatus()
+	assert.True(t, status.Valid)
+	assert.True(t, status.Rotated)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func Test_SSWS_Token_Rotation_Failures(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("Authorization") != "SSWS current" {
+			return mockJSONResponse(401, `{"errorCode":"E0000011","errorSummary":"Invalid token provided"}`), nil
+		}
+		return mockJSONResponse(200, "[]"), nil
+	})
+	rotateErr := errors.New("vault unavailable")
+
+	t.Run("expiring token is used while rotation fails", func(t *testing.T) {
+		var rotations int
+		configuration, err := NewConfiguration(
+			WithOrgUrl("https://example.okta.com"),
+			WithToken("current"),
+			WithCache(false),
+			WithTokenExpiry(time.Now().Add(time.Hour), 24*time.Hour),
+			WithTokenRotator(func(ctx context.Context, status TokenStatus) (string, time.Time, error) {
+				rotations++
+				return "", time.Time{}, rotateErr
+			}),
+		)
+		require.NoError(t, err, "Creating a new config should not error")
+		client := NewAPIClient(configuration)
+
+		for i := 0; i < 2; i++ {
+			_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+			require.NoError(t, err, "Request should succeed with the expiring token")
+		}
+		assert.Equal(t, 1, rotations, "Failed rotations should be backed off")
+		assert.False(t, client.TokenStatus().Rotated)
+	})
+
+	t.Run("invalid token fails with the rotation error", func(t *testing.T) {
+		var rotations int
+		configuration, err := NewConfiguration(
+			WithOrgUrl("https://example.okta.com"),
+			WithToken("stale"),
+			WithCache(false),
+			WithTokenRotator(func(ctx context.Context, status TokenStatus) (string, time.Time, error) {
+				rotations++
+				return "", time.Time{}, rotateErr
+			}),
+		)
+		require.NoError(t, err, "Creating a new config should not error")
+		client := NewAPIClient(configuration)
+
+		_, resp, err := client.UserAPI.ListUsers(context.Background()).Execute()
+		assert.ErrorIs(t, err, rotateErr)
+		var rotationErr *TokenRotationError
+		require.ErrorAs(t, err, &rotationErr)
+		assert.Equal(t, http.StatusUnauthorized, rotationErr.Rejected.StatusCode)
+		assert.Equal(t, "E0000011", rotationErr.Rejected.ErrorCode)
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		calls := httpmock.GetTotalCallCount()
+
+		_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+		assert.ErrorIs(t, err, rotateErr)
+		assert.Equal(t, calls, httpmock.GetTotalCallCount(), "The invalid token should not be sent again")
+		assert.Equal(t, 1, rotations, "Failed rotations should be backed off")
+		assert.False(t, client.TokenStatus().Valid)
+	})
+}
+
+func Test_SSWS_Token_Rejections(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("Authorization") != "SSWS rotated" {
+			return mockJSONResponse(401, `{"errorCode":"E0000011","errorSummary":"Invalid token provided"}`), nil
+		}
+		return mockJSONResponse(200, "[]"), nil
+	})
+
+	t.Run("rejections of a replaced token are ignored", func(t *testing.T) {
+		configuration, err := NewConfiguration(
+			WithOrgUrl("https://example.okta.com"),
+			WithToken("stale"),
+			WithCache(false),
+			WithTokenRotator(func(ctx context.Context, status TokenStatus) (string, time.Time, error) {
+				return "rotated", time.Time{}, nil
+			}),
+		)
+		require.NoError(t, err, "Creating a new config should not error")
+		client := NewAPIClient(configuration)
+		_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+		require.NoError(t, err)
+
+		// a request sent with the stale token before the rotation fails after it
+		resp := mockJSONResponse(401, `{"errorCode":"E0000011","errorSummary":"Invalid token provided"}`)
+		req := httptest.NewRequest(http.MethodGet, "https://example.okta.com/api/v1/users", nil)
+		req.Header.Set("Authorization", "SSWS stale")
+		retry, err := client.observeSSWSResponse(req, resp)
+		require.NoError(t, err)
+		assert.False(t, retry)
+		assert.True(t, client.TokenStatus().Valid, "The rotated token should be kept")
+	})
+
+	t.Run("rejections of the rotated token are recorded", func(t *testing.T) {
+		configuration, err := NewConfiguration(
+			WithOrgUrl("https://example.okta.com"),
+			WithToken("stale"),
+			WithCache(false),
+			WithTokenRotator(func(ctx context.Context, status TokenStatus) (string, time.Time, error) {
+				return "revoked", time.Time{}, nil
+			}),
+		)
+		require.NoError(t, err, "Creating a new config should not error")
+		client := NewAPIClient(configuration)
+		_, _, err = client.UserAPI.ListUsers(context.Background()).Execute()
+		require.Error(t, err)
+		status := client.TokenStatus()
+		assert.True(t, status.Rotated)
+		assert.False(t, status.Valid, "The rejection of the retried request should be observed")
+		assert.Equal(t, "Invalid token provided", status.LastError)
+	})
+}
+
+func Test_SSWS_Token_Expiry_Warning(t *testing.T) {
+	configuration, err := NewConfiguration(
+		WithOrgUrl("https://example.okta.com"),
+		WithTokenExpiry(time.Now().Add(time.Hour), 24*time.Hour),
+	)
+	require.NoError(t, err, "Creating a new config should not error")
+	status := NewAPIClient(configuration).TokenStatus()
+	assert.True(t, status.Valid)
+	assert.True(t, status.ExpiringSoon)
+}
+
+func mockJSONResponse(status int, body string) *http.Response {
+	resp := httpmock.NewStringResponse(status, body)
+	resp.Header.Set("Content-Type", "application/json")
+	return resp
+}
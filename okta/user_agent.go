@@ -33,3 +33,22 @@ func (ua UserAgent) String() string {
 
 	return userAgentString
 }
+
+// userAgentValue is the User-Agent of a client with the UserAgentExtra it was
+// computed for.
+type userAgentValue struct {
+	extra string
+	value string
+}
+
+// UserAgent returns the User-Agent header the client sends. It is computed
+// once, and again when the UserAgentExtra of the configuration changes.
+func (c *APIClient) UserAgent() string {
+	extra := c.cfg.UserAgentExtra
+	if ua := c.userAgent.Load(); ua != nil && ua.extra == extra {
+		return ua.value
+	}
+	ua := &userAgentValue{extra: extra, value: NewUserAgent(c.cfg).String()}
+	c.userAgent.Store(ua)
+	return ua.value
+}
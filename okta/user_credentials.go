@@ -0,0 +1,89 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+)
+
+// TemporaryPassword is the one time password of a user whose password was
+// expired by ExpirePasswordWithTempPassword.
+type TemporaryPassword struct {
+	UserID   string
+	Password string
+}
+
+// PasswordResetLink is a password reset link generated for a user. URL is
+// empty when Okta emailed the link to the user instead of returning it.
+type PasswordResetLink struct {
+	UserID  string
+	URL     string
+	Emailed bool
+}
+
+// SetPasswordAndExpire sets the password of a user and expires it, so the
+// user has to choose a new password at the next sign in.
+func (c *APIClient) SetPasswordAndExpire(ctx context.Context, userID, password string) (*User, error) {
+	credentials := UserCredentials{Password: &PasswordCredential{Value: PtrString(password)}}
+	_, _, err := c.UserAPI.UpdateUser(ctx, userID).User(UpdateUserRequest{Credentials: &credentials}).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("set password: %w", err)
+	}
+	user, _, err := c.UserAPI.ExpirePassword(ctx, userID).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("expire password: %w", err)
+	}
+	return user, nil
+}
+
+// GenerateAndEmailResetLink generates a password reset link and has Okta
+// email it to the user.
+func (c *APIClient) GenerateAndEmailResetLink(ctx context.Context, userID string, revokeSessions bool) (*PasswordResetLink, error) {
+	return c.generateResetLink(ctx, userID, true, revokeSessions)
+}
+
+// GenerateResetLink generates a password reset link and returns it without
+// notifying the user.
+func (c *APIClient) GenerateResetLink(ctx context.Context, userID string, revokeSessions bool) (*PasswordResetLink, error) {
+	return c.generateResetLink(ctx, userID, false, revokeSessions)
+}
+
+func (c *APIClient) generateResetLink(ctx context.Context, userID string, sendEmail, revokeSessions bool) (*PasswordResetLink, error) {
+	token, _, err := c.UserAPI.GenerateResetPasswordToken(ctx, userID).
+		SendEmail(sendEmail).
+		RevokeSessions(revokeSessions).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("generate reset password token: %w", err)
+	}
+	return &PasswordResetLink{UserID: userID, URL: token.GetResetPasswordUrl(), Emailed: sendEmail}, nil
+}
+
+// SetRecoveryQuestion replaces the recovery question and answer of a user
+// without requiring the current password of the user.
+func (c *APIClient) SetRecoveryQuestion(ctx context.Context, userID, question, answer string) (*RecoveryQuestionCredential, error) {
+	credentials := UserCredentials{RecoveryQuestion: &RecoveryQuestionCredential{
+		Question: PtrString(question),
+		Answer:   PtrString(answer),
+	}}
+	user, _, err := c.UserAPI.UpdateUser(ctx, userID).User(UpdateUserRequest{Credentials: &credentials}).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("set recovery question: %w", err)
+	}
+	creds := user.GetCredentials()
+	if creds.RecoveryQuestion == nil {
+		return &RecoveryQuestionCredential{Question: PtrString(question)}, nil
+	}
+	return creds.RecoveryQuestion, nil
+}
+
+// ExpirePasswordWithTempPassword expires the password of a user and returns
+// a temporary password the user signs in with once before choosing a new one.
+func (c *APIClient) ExpirePasswordWithTempPassword(ctx context.Context, userID string, revokeSessions bool) (*TemporaryPassword, error) {
+	temp, _, err := c.UserAPI.ExpirePasswordAndGetTemporaryPassword(ctx, userID).
+		RevokeSessions(revokeSessions).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("expire password: %w", err)
+	}
+	return &TemporaryPassword{UserID: userID, Password: temp.GetTempPassword()}, nil
+}
@@ -0,0 +1,66 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_User_Credential_Operations(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var updates []map[string]interface{}
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1", func(req *http.Request) (*http.Response, error) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		updates = append(updates, body)
+		return mockJSONResponse(200, `{"id":"00u1","status":"ACTIVE","credentials":{"recovery_question":{"question":"Favorite color?"}}}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1/lifecycle/expire_password", func(req *http.Request) (*http.Response, error) {
+		return mockJSONResponse(200, `{"id":"00u1","status":"PASSWORD_EXPIRED"}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1/lifecycle/expire_password_with_temp_password", func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "true", req.URL.Query().Get("revokeSessions"))
+		return mockJSONResponse(200, `{"tempPassword":"F0rg0t3n"}`), nil
+	})
+	httpmock.RegisterResponder("POST", "/api/v1/users/00u1/lifecycle/reset_password", func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("sendEmail") == "true" {
+			return mockJSONResponse(200, `{}`), nil
+		}
+		return mockJSONResponse(200, `{"resetPasswordUrl":"https://example.okta.com/reset_password/abc"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	user, err := client.SetPasswordAndExpire(ctx, "00u1", "Initial-Passw0rd")
+	require.NoError(t, err)
+	assert.Equal(t, "PASSWORD_EXPIRED", user.GetStatus())
+	assert.Equal(t, map[string]interface{}{"password": map[string]interface{}{"value": "Initial-Passw0rd"}}, updates[0]["credentials"])
+
+	question, err := client.SetRecoveryQuestion(ctx, "00u1", "Favorite color?", "blue")
+	require.NoError(t, err)
+	assert.Equal(t, "Favorite color?", question.GetQuestion())
+	assert.Equal(t, map[string]interface{}{"recovery_question": map[string]interface{}{"question": "Favorite color?", "answer": "blue"}}, updates[1]["credentials"])
+
+	temp, err := client.ExpirePasswordWithTempPassword(ctx, "00u1", true)
+	require.NoError(t, err)
+	assert.Equal(t, &TemporaryPassword{UserID: "00u1", Password: "F0rg0t3n"}, temp)
+
+	link, err := client.GenerateResetLink(ctx, "00u1", false)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.okta.com/reset_password/abc", link.URL)
+	assert.False(t, link.Emailed)
+	link, err = client.GenerateAndEmailResetLink(ctx, "00u1", false)
+	require.NoError(t, err)
+	assert.True(t, link.Emailed)
+	assert.Empty(t, link.URL)
+}
@@ -0,0 +1,61 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_List_User_Summaries(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var contentTypes []string
+	httpmock.RegisterResponder("GET", "/api/v1/users", func(req *http.Request) (*http.Response, error) {
+		contentTypes = append(contentTypes, req.Header.Get("Content-Type"))
+		if req.URL.Query().Get("after") == "" {
+			assert.Equal(t, "status eq \"ACTIVE\"", req.URL.Query().Get("filter"))
+			resp := mockJSONResponse(200, `[{"id":"00u1","status":"ACTIVE","profile":{"login":"a@example.com","nickName":"a"}}]`)
+			resp.Header.Add("Link", `<https://example.okta.com/api/v1/users?after=00u1&filter=status+eq+%22ACTIVE%22>; rel="next"`)
+			return resp, nil
+		}
+		return mockJSONResponse(200, `[{"id":"00u2","status":"ACTIVE","profile":{"login":"b@example.com"}}]`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+
+	var users []UserSummary
+	err = client.ListUserSummaries(context.Background(), ListUsersOptions{Filter: `status eq "ACTIVE"`}, func(page []UserSummary) error {
+		users = append(users, page...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, "a@example.com", users[0].Profile.Login)
+	assert.Equal(t, "00u2", users[1].Id)
+	minimal := "application/json; okta-response=omitCredentials,omitCredentialsLinks,omitTransitioningToStatus"
+	assert.Equal(t, []string{minimal, minimal}, contentTypes, "Every page should request the minimal representation")
+
+	type login struct {
+		Profile struct {
+			Login string `json:"login"`
+		} `json:"profile"`
+	}
+	contentTypes = nil
+	var logins []string
+	err = ListUsersAs(context.Background(), client, ListUsersOptions{Filter: `status eq "ACTIVE"`, Omit: []UserOmission{OmitCredentials}}, func(page []login) error {
+		for _, u := range page {
+			logins = append(logins, u.Profile.Login)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, logins)
+	assert.Equal(t, "application/json; okta-response=omitCredentials", contentTypes[0])
+}
@@ -0,0 +1,75 @@
+package okta
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseAPIVersion splits a "YYYY.MM.N" API version into its numeric parts.
+func parseAPIVersion(version string) ([3]int, error) {
+	var parsed [3]int
+	parts := strings.Split(version, ".")
+	if len(parts) != 3 {
+		return parsed, fmt.Errorf("invalid API version %q, expected YYYY.MM.N", version)
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return parsed, fmt.Errorf("invalid API version %q, expected YYYY.MM.N", version)
+		}
+		parsed[i] = n
+	}
+	return parsed, nil
+}
+
+// compareAPIVersions returns -1, 0 or 1 depending on whether a is older than,
+// equal to or newer than b.
+func compareAPIVersions(a, b string) (int, error) {
+	pa, err := parseAPIVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	pb, err := parseAPIVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range pa {
+		switch {
+		case pa[i] < pb[i]:
+			return -1, nil
+		case pa[i] > pb[i]:
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// checkAPIVersion validates a pinned API version against SpecVersion. Pinning an
+// older version is allowed so callers can migrate to a versioned model package
+// at their own pace; pinning a newer one means the caller depends on models
+// this package does not have. The check is local only: Okta does not version
+// the management API per request, so the pinned version is never sent.
+func checkAPIVersion(version string) error {
+	if version == "" {
+		return nil
+	}
+	cmp, err := compareAPIVersions(version, SpecVersion)
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
+		return fmt.Errorf("API version %s is newer than the SDK spec version %s", version, SpecVersion)
+	}
+	return nil
+}
+
+// APIVersion returns the management API version the client was pinned to, or
+// SpecVersion when no version was configured. It does not change the requests
+// the client sends.
+func (c *APIClient) APIVersion() string {
+	if c.cfg.Okta.Client.APIVersion != "" {
+		return c.cfg.Okta.Client.APIVersion
+	}
+	return SpecVersion
+}
@@ -0,0 +1,46 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Workflow_Hook_Invoke(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://example.workflows.okta.com/api/flo/d71da1d0/invoke", func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("x-api-client-token") != "client-token" {
+			return mockJSONResponse(401, `{"error":"Unauthorized"}`), nil
+		}
+		assert.Empty(t, req.Header.Get("Authorization"), "Okta credentials must not be sent to Workflows")
+		var input map[string]string
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&input))
+		return mockJSONResponse(200, `{"greeting":"hello `+input["name"]+`"}`), nil
+	})
+
+	configuration, err := NewConfiguration(WithOrgUrl("https://example.okta.com"), WithToken("token"), WithCache(false))
+	require.NoError(t, err, "Creating a new config should not error")
+	client := NewAPIClient(configuration)
+	ctx := context.Background()
+
+	result, err := client.WorkflowHook("https://example.workflows.okta.com/api/flo/d71da1d0/invoke", "client-token").Invoke(ctx, map[string]string{"name": "okta"})
+	require.NoError(t, err)
+	var output struct {
+		Greeting string `json:"greeting"`
+	}
+	require.NoError(t, result.Decode(&output))
+	assert.Equal(t, "hello okta", output.Greeting)
+
+	_, err = client.WorkflowHook("https://example.workflows.okta.com/api/flo/d71da1d0/invoke", "wrong").Invoke(ctx, nil)
+	var workflowErr *WorkflowError
+	require.True(t, errors.As(err, &workflowErr))
+	assert.Equal(t, http.StatusUnauthorized, workflowErr.StatusCode)
+}